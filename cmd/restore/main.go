@@ -0,0 +1,76 @@
+// restore 在 syncer 之外离线恢复备份文件。
+//
+// 使用 age 公钥加密的备份只能用对应的私钥解密，而 syncer 本身不保存私钥，
+// 因此恢复时通过该工具在本地提供私钥：
+//
+//	restore -identity key.txt -out ./data/vaultwarden full.zip.age incr-1.zip.age
+//
+// 增量/差异备份需要按顺序传入完整备份和之后的备份。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+func main() {
+	identityFile := flag.String("identity", "", "age identity file (private key), e.g. generated by age-keygen")
+	password := flag.String("password", "", "password for password-encrypted (.enc) backups")
+	out := flag.String("out", "./restore", "directory to restore into")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] backup-file...\n\n", os.Args[0])
+		fmt.Fprintln(flag.CommandLine.Output(), "Backup files are applied in order: pass the full backup first for incremental chains.")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*identityFile, *password, *out, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "restore failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(identityFile, password, out string, files []string) error {
+	ctx := context.Background()
+
+	if identityFile != "" {
+		data, err := os.ReadFile(identityFile)
+		if err != nil {
+			return fmt.Errorf("failed to read identity file: %w", err)
+		}
+		identities, err := backup.ParseIdentities(string(data))
+		if err != nil {
+			return err
+		}
+		ctx = backup.WithIdentities(ctx, identities)
+	}
+
+	service := backup.NewService(backup.BackupOptions{Password: password})
+
+	for _, name := range files {
+		if err := extractFile(ctx, service, name, out); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		fmt.Printf("Restored %s into %s\n", name, out)
+	}
+	return nil
+}
+
+func extractFile(ctx context.Context, service *backup.Service, name, out string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return service.ExtractBackup(ctx, f, out)
+}
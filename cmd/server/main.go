@@ -46,7 +46,18 @@ func main() {
 					VaultwardenDataPath: vaultwardenDataPath,
 					CompressionLevel:    cfg.Sync.CompressionLevel,
 					Password:            cfg.Sync.Password,
+					KDF:                 backup.KDF(cfg.Sync.KDF),
+					Recipients:          cfg.Sync.Recipients,
+					MaxRestoreSize:      cfg.Sync.MaxRestoreSizeMB << 20,
+					MaxRestoreFiles:     cfg.Sync.MaxRestoreFiles,
+					IntegrityCheck:      backup.IntegrityCheck(cfg.Sync.IntegrityCheck),
 					Logger:              log,
+					Format:              backup.ArchiveFormat(cfg.Sync.ArchiveFormat),
+					Include:             cfg.Sync.Include,
+					Exclude:             cfg.Sync.Exclude,
+					Mode:                backup.BackupType(cfg.Sync.BackupMode),
+					FullEvery:           cfg.Sync.FullBackupEvery,
+					StateDir:            cfg.Sync.StateDir,
 				})
 			},
 			service.NewUserService,
@@ -60,6 +71,7 @@ func main() {
 			},
 			func(client *ent.Client, syncService *sync.Service, cleanupService *cleanup.Service, cfg *config.Config, notificationService *notification.Service) *scheduler.Service {
 				schedulerService := scheduler.NewService(client, syncService, cleanupService, cfg)
+				syncService.SetNotifier(notificationService)
 
 				// 设置同步服务的重试配置
 				if cfg.Sync.MaxRetries > 0 || cfg.Sync.RetryDelaySeconds > 0 {
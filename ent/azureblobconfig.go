@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// AzureBlobConfig is the model entity for the AzureBlobConfig schema.
+type AzureBlobConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AccountName holds the value of the "account_name" field.
+	AccountName string `json:"account_name,omitempty"`
+	// AccountKey holds the value of the "account_key" field.
+	AccountKey string `json:"-"`
+	// SasToken holds the value of the "sas_token" field.
+	SasToken string `json:"-"`
+	// Container holds the value of the "container" field.
+	Container string `json:"container,omitempty"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// AccessTier holds the value of the "access_tier" field.
+	AccessTier azureblobconfig.AccessTier `json:"access_tier,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AzureBlobConfigQuery when eager-loading is set.
+	Edges                     AzureBlobConfigEdges `json:"edges"`
+	storage_azure_blob_config *int
+	selectValues              sql.SelectValues
+}
+
+// AzureBlobConfigEdges holds the relations/edges for other nodes in the graph.
+type AzureBlobConfigEdges struct {
+	// Storage holds the value of the storage edge.
+	Storage *Storage `json:"storage,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StorageOrErr returns the Storage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AzureBlobConfigEdges) StorageOrErr() (*Storage, error) {
+	if e.Storage != nil {
+		return e.Storage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: storage.Label}
+	}
+	return nil, &NotLoadedError{edge: "storage"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AzureBlobConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case azureblobconfig.FieldID:
+			values[i] = new(sql.NullInt64)
+		case azureblobconfig.FieldAccountName, azureblobconfig.FieldAccountKey, azureblobconfig.FieldSasToken, azureblobconfig.FieldContainer, azureblobconfig.FieldEndpoint, azureblobconfig.FieldAccessTier:
+			values[i] = new(sql.NullString)
+		case azureblobconfig.ForeignKeys[0]: // storage_azure_blob_config
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AzureBlobConfig fields.
+func (abc *AzureBlobConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case azureblobconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			abc.ID = int(value.Int64)
+		case azureblobconfig.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				abc.AccountName = value.String
+			}
+		case azureblobconfig.FieldAccountKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_key", values[i])
+			} else if value.Valid {
+				abc.AccountKey = value.String
+			}
+		case azureblobconfig.FieldSasToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sas_token", values[i])
+			} else if value.Valid {
+				abc.SasToken = value.String
+			}
+		case azureblobconfig.FieldContainer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container", values[i])
+			} else if value.Valid {
+				abc.Container = value.String
+			}
+		case azureblobconfig.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				abc.Endpoint = value.String
+			}
+		case azureblobconfig.FieldAccessTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_tier", values[i])
+			} else if value.Valid {
+				abc.AccessTier = azureblobconfig.AccessTier(value.String)
+			}
+		case azureblobconfig.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field storage_azure_blob_config", value)
+			} else if value.Valid {
+				abc.storage_azure_blob_config = new(int)
+				*abc.storage_azure_blob_config = int(value.Int64)
+			}
+		default:
+			abc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AzureBlobConfig.
+// This includes values selected through modifiers, order, etc.
+func (abc *AzureBlobConfig) Value(name string) (ent.Value, error) {
+	return abc.selectValues.Get(name)
+}
+
+// QueryStorage queries the "storage" edge of the AzureBlobConfig entity.
+func (abc *AzureBlobConfig) QueryStorage() *StorageQuery {
+	return NewAzureBlobConfigClient(abc.config).QueryStorage(abc)
+}
+
+// Update returns a builder for updating this AzureBlobConfig.
+// Note that you need to call AzureBlobConfig.Unwrap() before calling this method if this AzureBlobConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (abc *AzureBlobConfig) Update() *AzureBlobConfigUpdateOne {
+	return NewAzureBlobConfigClient(abc.config).UpdateOne(abc)
+}
+
+// Unwrap unwraps the AzureBlobConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (abc *AzureBlobConfig) Unwrap() *AzureBlobConfig {
+	_tx, ok := abc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AzureBlobConfig is not a transactional entity")
+	}
+	abc.config.driver = _tx.drv
+	return abc
+}
+
+// String implements the fmt.Stringer.
+func (abc *AzureBlobConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("AzureBlobConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", abc.ID))
+	builder.WriteString("account_name=")
+	builder.WriteString(abc.AccountName)
+	builder.WriteString(", ")
+	builder.WriteString("account_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("sas_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("container=")
+	builder.WriteString(abc.Container)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(abc.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("access_tier=")
+	builder.WriteString(fmt.Sprintf("%v", abc.AccessTier))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AzureBlobConfigs is a parsable slice of AzureBlobConfig.
+type AzureBlobConfigs []*AzureBlobConfig
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package azureblobconfig
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the azureblobconfig type in the database.
+	Label = "azure_blob_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountName holds the string denoting the account_name field in the database.
+	FieldAccountName = "account_name"
+	// FieldAccountKey holds the string denoting the account_key field in the database.
+	FieldAccountKey = "account_key"
+	// FieldSasToken holds the string denoting the sas_token field in the database.
+	FieldSasToken = "sas_token"
+	// FieldContainer holds the string denoting the container field in the database.
+	FieldContainer = "container"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldAccessTier holds the string denoting the access_tier field in the database.
+	FieldAccessTier = "access_tier"
+	// EdgeStorage holds the string denoting the storage edge name in mutations.
+	EdgeStorage = "storage"
+	// Table holds the table name of the azureblobconfig in the database.
+	Table = "azure_blob_configs"
+	// StorageTable is the table that holds the storage relation/edge.
+	StorageTable = "azure_blob_configs"
+	// StorageInverseTable is the table name for the Storage entity.
+	// It exists in this package in order to avoid circular dependency with the "storage" package.
+	StorageInverseTable = "storages"
+	// StorageColumn is the table column denoting the storage relation/edge.
+	StorageColumn = "storage_azure_blob_config"
+)
+
+// Columns holds all SQL columns for azureblobconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountName,
+	FieldAccountKey,
+	FieldSasToken,
+	FieldContainer,
+	FieldEndpoint,
+	FieldAccessTier,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "azure_blob_configs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"storage_azure_blob_config",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// AccessTier defines the type for the "access_tier" enum field.
+type AccessTier string
+
+// AccessTier values.
+const (
+	AccessTierHot     AccessTier = "Hot"
+	AccessTierCool    AccessTier = "Cool"
+	AccessTierArchive AccessTier = "Archive"
+)
+
+func (at AccessTier) String() string {
+	return string(at)
+}
+
+// AccessTierValidator is a validator for the "access_tier" field enum values. It is called by the builders before save.
+func AccessTierValidator(at AccessTier) error {
+	switch at {
+	case AccessTierHot, AccessTierCool, AccessTierArchive:
+		return nil
+	default:
+		return fmt.Errorf("azureblobconfig: invalid enum value for access_tier field: %q", at)
+	}
+}
+
+// OrderOption defines the ordering options for the AzureBlobConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAccountName orders the results by the account_name field.
+func ByAccountName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountName, opts...).ToFunc()
+}
+
+// ByAccountKey orders the results by the account_key field.
+func ByAccountKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountKey, opts...).ToFunc()
+}
+
+// BySasToken orders the results by the sas_token field.
+func BySasToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSasToken, opts...).ToFunc()
+}
+
+// ByContainer orders the results by the container field.
+func ByContainer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContainer, opts...).ToFunc()
+}
+
+// ByEndpoint orders the results by the endpoint field.
+func ByEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpoint, opts...).ToFunc()
+}
+
+// ByAccessTier orders the results by the access_tier field.
+func ByAccessTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessTier, opts...).ToFunc()
+}
+
+// ByStorageField orders the results by storage field.
+func ByStorageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStorageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStorageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StorageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+	)
+}
@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package azureblobconfig
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLTE(FieldID, id))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountKey applies equality check predicate on the "account_key" field. It's identical to AccountKeyEQ.
+func AccountKey(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldAccountKey, v))
+}
+
+// SasToken applies equality check predicate on the "sas_token" field. It's identical to SasTokenEQ.
+func SasToken(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldSasToken, v))
+}
+
+// Container applies equality check predicate on the "container" field. It's identical to ContainerEQ.
+func Container(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldContainer, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// AccountKeyEQ applies the EQ predicate on the "account_key" field.
+func AccountKeyEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldAccountKey, v))
+}
+
+// AccountKeyNEQ applies the NEQ predicate on the "account_key" field.
+func AccountKeyNEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldAccountKey, v))
+}
+
+// AccountKeyIn applies the In predicate on the "account_key" field.
+func AccountKeyIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldAccountKey, vs...))
+}
+
+// AccountKeyNotIn applies the NotIn predicate on the "account_key" field.
+func AccountKeyNotIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldAccountKey, vs...))
+}
+
+// AccountKeyGT applies the GT predicate on the "account_key" field.
+func AccountKeyGT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGT(FieldAccountKey, v))
+}
+
+// AccountKeyGTE applies the GTE predicate on the "account_key" field.
+func AccountKeyGTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGTE(FieldAccountKey, v))
+}
+
+// AccountKeyLT applies the LT predicate on the "account_key" field.
+func AccountKeyLT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLT(FieldAccountKey, v))
+}
+
+// AccountKeyLTE applies the LTE predicate on the "account_key" field.
+func AccountKeyLTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLTE(FieldAccountKey, v))
+}
+
+// AccountKeyContains applies the Contains predicate on the "account_key" field.
+func AccountKeyContains(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContains(FieldAccountKey, v))
+}
+
+// AccountKeyHasPrefix applies the HasPrefix predicate on the "account_key" field.
+func AccountKeyHasPrefix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasPrefix(FieldAccountKey, v))
+}
+
+// AccountKeyHasSuffix applies the HasSuffix predicate on the "account_key" field.
+func AccountKeyHasSuffix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasSuffix(FieldAccountKey, v))
+}
+
+// AccountKeyIsNil applies the IsNil predicate on the "account_key" field.
+func AccountKeyIsNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIsNull(FieldAccountKey))
+}
+
+// AccountKeyNotNil applies the NotNil predicate on the "account_key" field.
+func AccountKeyNotNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotNull(FieldAccountKey))
+}
+
+// AccountKeyEqualFold applies the EqualFold predicate on the "account_key" field.
+func AccountKeyEqualFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEqualFold(FieldAccountKey, v))
+}
+
+// AccountKeyContainsFold applies the ContainsFold predicate on the "account_key" field.
+func AccountKeyContainsFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContainsFold(FieldAccountKey, v))
+}
+
+// SasTokenEQ applies the EQ predicate on the "sas_token" field.
+func SasTokenEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldSasToken, v))
+}
+
+// SasTokenNEQ applies the NEQ predicate on the "sas_token" field.
+func SasTokenNEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldSasToken, v))
+}
+
+// SasTokenIn applies the In predicate on the "sas_token" field.
+func SasTokenIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldSasToken, vs...))
+}
+
+// SasTokenNotIn applies the NotIn predicate on the "sas_token" field.
+func SasTokenNotIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldSasToken, vs...))
+}
+
+// SasTokenGT applies the GT predicate on the "sas_token" field.
+func SasTokenGT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGT(FieldSasToken, v))
+}
+
+// SasTokenGTE applies the GTE predicate on the "sas_token" field.
+func SasTokenGTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGTE(FieldSasToken, v))
+}
+
+// SasTokenLT applies the LT predicate on the "sas_token" field.
+func SasTokenLT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLT(FieldSasToken, v))
+}
+
+// SasTokenLTE applies the LTE predicate on the "sas_token" field.
+func SasTokenLTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLTE(FieldSasToken, v))
+}
+
+// SasTokenContains applies the Contains predicate on the "sas_token" field.
+func SasTokenContains(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContains(FieldSasToken, v))
+}
+
+// SasTokenHasPrefix applies the HasPrefix predicate on the "sas_token" field.
+func SasTokenHasPrefix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasPrefix(FieldSasToken, v))
+}
+
+// SasTokenHasSuffix applies the HasSuffix predicate on the "sas_token" field.
+func SasTokenHasSuffix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasSuffix(FieldSasToken, v))
+}
+
+// SasTokenIsNil applies the IsNil predicate on the "sas_token" field.
+func SasTokenIsNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIsNull(FieldSasToken))
+}
+
+// SasTokenNotNil applies the NotNil predicate on the "sas_token" field.
+func SasTokenNotNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotNull(FieldSasToken))
+}
+
+// SasTokenEqualFold applies the EqualFold predicate on the "sas_token" field.
+func SasTokenEqualFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEqualFold(FieldSasToken, v))
+}
+
+// SasTokenContainsFold applies the ContainsFold predicate on the "sas_token" field.
+func SasTokenContainsFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContainsFold(FieldSasToken, v))
+}
+
+// ContainerEQ applies the EQ predicate on the "container" field.
+func ContainerEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldContainer, v))
+}
+
+// ContainerNEQ applies the NEQ predicate on the "container" field.
+func ContainerNEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldContainer, v))
+}
+
+// ContainerIn applies the In predicate on the "container" field.
+func ContainerIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldContainer, vs...))
+}
+
+// ContainerNotIn applies the NotIn predicate on the "container" field.
+func ContainerNotIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldContainer, vs...))
+}
+
+// ContainerGT applies the GT predicate on the "container" field.
+func ContainerGT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGT(FieldContainer, v))
+}
+
+// ContainerGTE applies the GTE predicate on the "container" field.
+func ContainerGTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGTE(FieldContainer, v))
+}
+
+// ContainerLT applies the LT predicate on the "container" field.
+func ContainerLT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLT(FieldContainer, v))
+}
+
+// ContainerLTE applies the LTE predicate on the "container" field.
+func ContainerLTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLTE(FieldContainer, v))
+}
+
+// ContainerContains applies the Contains predicate on the "container" field.
+func ContainerContains(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContains(FieldContainer, v))
+}
+
+// ContainerHasPrefix applies the HasPrefix predicate on the "container" field.
+func ContainerHasPrefix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasPrefix(FieldContainer, v))
+}
+
+// ContainerHasSuffix applies the HasSuffix predicate on the "container" field.
+func ContainerHasSuffix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasSuffix(FieldContainer, v))
+}
+
+// ContainerEqualFold applies the EqualFold predicate on the "container" field.
+func ContainerEqualFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEqualFold(FieldContainer, v))
+}
+
+// ContainerContainsFold applies the ContainsFold predicate on the "container" field.
+func ContainerContainsFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContainsFold(FieldContainer, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointIsNil applies the IsNil predicate on the "endpoint" field.
+func EndpointIsNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIsNull(FieldEndpoint))
+}
+
+// EndpointNotNil applies the NotNil predicate on the "endpoint" field.
+func EndpointNotNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotNull(FieldEndpoint))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// AccessTierEQ applies the EQ predicate on the "access_tier" field.
+func AccessTierEQ(v AccessTier) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldEQ(FieldAccessTier, v))
+}
+
+// AccessTierNEQ applies the NEQ predicate on the "access_tier" field.
+func AccessTierNEQ(v AccessTier) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNEQ(FieldAccessTier, v))
+}
+
+// AccessTierIn applies the In predicate on the "access_tier" field.
+func AccessTierIn(vs ...AccessTier) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIn(FieldAccessTier, vs...))
+}
+
+// AccessTierNotIn applies the NotIn predicate on the "access_tier" field.
+func AccessTierNotIn(vs ...AccessTier) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotIn(FieldAccessTier, vs...))
+}
+
+// AccessTierIsNil applies the IsNil predicate on the "access_tier" field.
+func AccessTierIsNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldIsNull(FieldAccessTier))
+}
+
+// AccessTierNotNil applies the NotNil predicate on the "access_tier" field.
+func AccessTierNotNil() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.FieldNotNull(FieldAccessTier))
+}
+
+// HasStorage applies the HasEdge predicate on the "storage" edge.
+func HasStorage() predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStorageWith applies the HasEdge predicate on the "storage" edge with a given conditions (other predicates).
+func HasStorageWith(preds ...predicate.Storage) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(func(s *sql.Selector) {
+		step := newStorageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AzureBlobConfig) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AzureBlobConfig) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AzureBlobConfig) predicate.AzureBlobConfig {
+	return predicate.AzureBlobConfig(sql.NotPredicates(p))
+}
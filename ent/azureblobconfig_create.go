@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// AzureBlobConfigCreate is the builder for creating a AzureBlobConfig entity.
+type AzureBlobConfigCreate struct {
+	config
+	mutation *AzureBlobConfigMutation
+	hooks    []Hook
+}
+
+// SetAccountName sets the "account_name" field.
+func (abcc *AzureBlobConfigCreate) SetAccountName(s string) *AzureBlobConfigCreate {
+	abcc.mutation.SetAccountName(s)
+	return abcc
+}
+
+// SetAccountKey sets the "account_key" field.
+func (abcc *AzureBlobConfigCreate) SetAccountKey(s string) *AzureBlobConfigCreate {
+	abcc.mutation.SetAccountKey(s)
+	return abcc
+}
+
+// SetNillableAccountKey sets the "account_key" field if the given value is not nil.
+func (abcc *AzureBlobConfigCreate) SetNillableAccountKey(s *string) *AzureBlobConfigCreate {
+	if s != nil {
+		abcc.SetAccountKey(*s)
+	}
+	return abcc
+}
+
+// SetSasToken sets the "sas_token" field.
+func (abcc *AzureBlobConfigCreate) SetSasToken(s string) *AzureBlobConfigCreate {
+	abcc.mutation.SetSasToken(s)
+	return abcc
+}
+
+// SetNillableSasToken sets the "sas_token" field if the given value is not nil.
+func (abcc *AzureBlobConfigCreate) SetNillableSasToken(s *string) *AzureBlobConfigCreate {
+	if s != nil {
+		abcc.SetSasToken(*s)
+	}
+	return abcc
+}
+
+// SetContainer sets the "container" field.
+func (abcc *AzureBlobConfigCreate) SetContainer(s string) *AzureBlobConfigCreate {
+	abcc.mutation.SetContainer(s)
+	return abcc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (abcc *AzureBlobConfigCreate) SetEndpoint(s string) *AzureBlobConfigCreate {
+	abcc.mutation.SetEndpoint(s)
+	return abcc
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (abcc *AzureBlobConfigCreate) SetNillableEndpoint(s *string) *AzureBlobConfigCreate {
+	if s != nil {
+		abcc.SetEndpoint(*s)
+	}
+	return abcc
+}
+
+// SetAccessTier sets the "access_tier" field.
+func (abcc *AzureBlobConfigCreate) SetAccessTier(at azureblobconfig.AccessTier) *AzureBlobConfigCreate {
+	abcc.mutation.SetAccessTier(at)
+	return abcc
+}
+
+// SetNillableAccessTier sets the "access_tier" field if the given value is not nil.
+func (abcc *AzureBlobConfigCreate) SetNillableAccessTier(at *azureblobconfig.AccessTier) *AzureBlobConfigCreate {
+	if at != nil {
+		abcc.SetAccessTier(*at)
+	}
+	return abcc
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (abcc *AzureBlobConfigCreate) SetStorageID(id int) *AzureBlobConfigCreate {
+	abcc.mutation.SetStorageID(id)
+	return abcc
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (abcc *AzureBlobConfigCreate) SetNillableStorageID(id *int) *AzureBlobConfigCreate {
+	if id != nil {
+		abcc = abcc.SetStorageID(*id)
+	}
+	return abcc
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (abcc *AzureBlobConfigCreate) SetStorage(s *Storage) *AzureBlobConfigCreate {
+	return abcc.SetStorageID(s.ID)
+}
+
+// Mutation returns the AzureBlobConfigMutation object of the builder.
+func (abcc *AzureBlobConfigCreate) Mutation() *AzureBlobConfigMutation {
+	return abcc.mutation
+}
+
+// Save creates the AzureBlobConfig in the database.
+func (abcc *AzureBlobConfigCreate) Save(ctx context.Context) (*AzureBlobConfig, error) {
+	return withHooks(ctx, abcc.sqlSave, abcc.mutation, abcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (abcc *AzureBlobConfigCreate) SaveX(ctx context.Context) *AzureBlobConfig {
+	v, err := abcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (abcc *AzureBlobConfigCreate) Exec(ctx context.Context) error {
+	_, err := abcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (abcc *AzureBlobConfigCreate) ExecX(ctx context.Context) {
+	if err := abcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (abcc *AzureBlobConfigCreate) check() error {
+	if _, ok := abcc.mutation.AccountName(); !ok {
+		return &ValidationError{Name: "account_name", err: errors.New(`ent: missing required field "AzureBlobConfig.account_name"`)}
+	}
+	if _, ok := abcc.mutation.Container(); !ok {
+		return &ValidationError{Name: "container", err: errors.New(`ent: missing required field "AzureBlobConfig.container"`)}
+	}
+	if v, ok := abcc.mutation.AccessTier(); ok {
+		if err := azureblobconfig.AccessTierValidator(v); err != nil {
+			return &ValidationError{Name: "access_tier", err: fmt.Errorf(`ent: validator failed for field "AzureBlobConfig.access_tier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (abcc *AzureBlobConfigCreate) sqlSave(ctx context.Context) (*AzureBlobConfig, error) {
+	if err := abcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := abcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, abcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	abcc.mutation.id = &_node.ID
+	abcc.mutation.done = true
+	return _node, nil
+}
+
+func (abcc *AzureBlobConfigCreate) createSpec() (*AzureBlobConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AzureBlobConfig{config: abcc.config}
+		_spec = sqlgraph.NewCreateSpec(azureblobconfig.Table, sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt))
+	)
+	if value, ok := abcc.mutation.AccountName(); ok {
+		_spec.SetField(azureblobconfig.FieldAccountName, field.TypeString, value)
+		_node.AccountName = value
+	}
+	if value, ok := abcc.mutation.AccountKey(); ok {
+		_spec.SetField(azureblobconfig.FieldAccountKey, field.TypeString, value)
+		_node.AccountKey = value
+	}
+	if value, ok := abcc.mutation.SasToken(); ok {
+		_spec.SetField(azureblobconfig.FieldSasToken, field.TypeString, value)
+		_node.SasToken = value
+	}
+	if value, ok := abcc.mutation.Container(); ok {
+		_spec.SetField(azureblobconfig.FieldContainer, field.TypeString, value)
+		_node.Container = value
+	}
+	if value, ok := abcc.mutation.Endpoint(); ok {
+		_spec.SetField(azureblobconfig.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := abcc.mutation.AccessTier(); ok {
+		_spec.SetField(azureblobconfig.FieldAccessTier, field.TypeEnum, value)
+		_node.AccessTier = value
+	}
+	if nodes := abcc.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   azureblobconfig.StorageTable,
+			Columns: []string{azureblobconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.storage_azure_blob_config = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AzureBlobConfigCreateBulk is the builder for creating many AzureBlobConfig entities in bulk.
+type AzureBlobConfigCreateBulk struct {
+	config
+	err      error
+	builders []*AzureBlobConfigCreate
+}
+
+// Save creates the AzureBlobConfig entities in the database.
+func (abccb *AzureBlobConfigCreateBulk) Save(ctx context.Context) ([]*AzureBlobConfig, error) {
+	if abccb.err != nil {
+		return nil, abccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(abccb.builders))
+	nodes := make([]*AzureBlobConfig, len(abccb.builders))
+	mutators := make([]Mutator, len(abccb.builders))
+	for i := range abccb.builders {
+		func(i int, root context.Context) {
+			builder := abccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AzureBlobConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, abccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, abccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, abccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (abccb *AzureBlobConfigCreateBulk) SaveX(ctx context.Context) []*AzureBlobConfig {
+	v, err := abccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (abccb *AzureBlobConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := abccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (abccb *AzureBlobConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := abccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// AzureBlobConfigDelete is the builder for deleting a AzureBlobConfig entity.
+type AzureBlobConfigDelete struct {
+	config
+	hooks    []Hook
+	mutation *AzureBlobConfigMutation
+}
+
+// Where appends a list predicates to the AzureBlobConfigDelete builder.
+func (abcd *AzureBlobConfigDelete) Where(ps ...predicate.AzureBlobConfig) *AzureBlobConfigDelete {
+	abcd.mutation.Where(ps...)
+	return abcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (abcd *AzureBlobConfigDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, abcd.sqlExec, abcd.mutation, abcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (abcd *AzureBlobConfigDelete) ExecX(ctx context.Context) int {
+	n, err := abcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (abcd *AzureBlobConfigDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(azureblobconfig.Table, sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt))
+	if ps := abcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, abcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	abcd.mutation.done = true
+	return affected, err
+}
+
+// AzureBlobConfigDeleteOne is the builder for deleting a single AzureBlobConfig entity.
+type AzureBlobConfigDeleteOne struct {
+	abcd *AzureBlobConfigDelete
+}
+
+// Where appends a list predicates to the AzureBlobConfigDelete builder.
+func (abcdo *AzureBlobConfigDeleteOne) Where(ps ...predicate.AzureBlobConfig) *AzureBlobConfigDeleteOne {
+	abcdo.abcd.mutation.Where(ps...)
+	return abcdo
+}
+
+// Exec executes the deletion query.
+func (abcdo *AzureBlobConfigDeleteOne) Exec(ctx context.Context) error {
+	n, err := abcdo.abcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{azureblobconfig.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (abcdo *AzureBlobConfigDeleteOne) ExecX(ctx context.Context) {
+	if err := abcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// AzureBlobConfigQuery is the builder for querying AzureBlobConfig entities.
+type AzureBlobConfigQuery struct {
+	config
+	ctx         *QueryContext
+	order       []azureblobconfig.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.AzureBlobConfig
+	withStorage *StorageQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AzureBlobConfigQuery builder.
+func (abcq *AzureBlobConfigQuery) Where(ps ...predicate.AzureBlobConfig) *AzureBlobConfigQuery {
+	abcq.predicates = append(abcq.predicates, ps...)
+	return abcq
+}
+
+// Limit the number of records to be returned by this query.
+func (abcq *AzureBlobConfigQuery) Limit(limit int) *AzureBlobConfigQuery {
+	abcq.ctx.Limit = &limit
+	return abcq
+}
+
+// Offset to start from.
+func (abcq *AzureBlobConfigQuery) Offset(offset int) *AzureBlobConfigQuery {
+	abcq.ctx.Offset = &offset
+	return abcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (abcq *AzureBlobConfigQuery) Unique(unique bool) *AzureBlobConfigQuery {
+	abcq.ctx.Unique = &unique
+	return abcq
+}
+
+// Order specifies how the records should be ordered.
+func (abcq *AzureBlobConfigQuery) Order(o ...azureblobconfig.OrderOption) *AzureBlobConfigQuery {
+	abcq.order = append(abcq.order, o...)
+	return abcq
+}
+
+// QueryStorage chains the current query on the "storage" edge.
+func (abcq *AzureBlobConfigQuery) QueryStorage() *StorageQuery {
+	query := (&StorageClient{config: abcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := abcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := abcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(azureblobconfig.Table, azureblobconfig.FieldID, selector),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, azureblobconfig.StorageTable, azureblobconfig.StorageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(abcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AzureBlobConfig entity from the query.
+// Returns a *NotFoundError when no AzureBlobConfig was found.
+func (abcq *AzureBlobConfigQuery) First(ctx context.Context) (*AzureBlobConfig, error) {
+	nodes, err := abcq.Limit(1).All(setContextOp(ctx, abcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{azureblobconfig.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) FirstX(ctx context.Context) *AzureBlobConfig {
+	node, err := abcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AzureBlobConfig ID from the query.
+// Returns a *NotFoundError when no AzureBlobConfig ID was found.
+func (abcq *AzureBlobConfigQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = abcq.Limit(1).IDs(setContextOp(ctx, abcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{azureblobconfig.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) FirstIDX(ctx context.Context) int {
+	id, err := abcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AzureBlobConfig entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AzureBlobConfig entity is found.
+// Returns a *NotFoundError when no AzureBlobConfig entities are found.
+func (abcq *AzureBlobConfigQuery) Only(ctx context.Context) (*AzureBlobConfig, error) {
+	nodes, err := abcq.Limit(2).All(setContextOp(ctx, abcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{azureblobconfig.Label}
+	default:
+		return nil, &NotSingularError{azureblobconfig.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) OnlyX(ctx context.Context) *AzureBlobConfig {
+	node, err := abcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AzureBlobConfig ID in the query.
+// Returns a *NotSingularError when more than one AzureBlobConfig ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (abcq *AzureBlobConfigQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = abcq.Limit(2).IDs(setContextOp(ctx, abcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{azureblobconfig.Label}
+	default:
+		err = &NotSingularError{azureblobconfig.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) OnlyIDX(ctx context.Context) int {
+	id, err := abcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AzureBlobConfigs.
+func (abcq *AzureBlobConfigQuery) All(ctx context.Context) ([]*AzureBlobConfig, error) {
+	ctx = setContextOp(ctx, abcq.ctx, ent.OpQueryAll)
+	if err := abcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AzureBlobConfig, *AzureBlobConfigQuery]()
+	return withInterceptors[[]*AzureBlobConfig](ctx, abcq, qr, abcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) AllX(ctx context.Context) []*AzureBlobConfig {
+	nodes, err := abcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AzureBlobConfig IDs.
+func (abcq *AzureBlobConfigQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if abcq.ctx.Unique == nil && abcq.path != nil {
+		abcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, abcq.ctx, ent.OpQueryIDs)
+	if err = abcq.Select(azureblobconfig.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) IDsX(ctx context.Context) []int {
+	ids, err := abcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (abcq *AzureBlobConfigQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, abcq.ctx, ent.OpQueryCount)
+	if err := abcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, abcq, querierCount[*AzureBlobConfigQuery](), abcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) CountX(ctx context.Context) int {
+	count, err := abcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (abcq *AzureBlobConfigQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, abcq.ctx, ent.OpQueryExist)
+	switch _, err := abcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (abcq *AzureBlobConfigQuery) ExistX(ctx context.Context) bool {
+	exist, err := abcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AzureBlobConfigQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (abcq *AzureBlobConfigQuery) Clone() *AzureBlobConfigQuery {
+	if abcq == nil {
+		return nil
+	}
+	return &AzureBlobConfigQuery{
+		config:      abcq.config,
+		ctx:         abcq.ctx.Clone(),
+		order:       append([]azureblobconfig.OrderOption{}, abcq.order...),
+		inters:      append([]Interceptor{}, abcq.inters...),
+		predicates:  append([]predicate.AzureBlobConfig{}, abcq.predicates...),
+		withStorage: abcq.withStorage.Clone(),
+		// clone intermediate query.
+		sql:  abcq.sql.Clone(),
+		path: abcq.path,
+	}
+}
+
+// WithStorage tells the query-builder to eager-load the nodes that are connected to
+// the "storage" edge. The optional arguments are used to configure the query builder of the edge.
+func (abcq *AzureBlobConfigQuery) WithStorage(opts ...func(*StorageQuery)) *AzureBlobConfigQuery {
+	query := (&StorageClient{config: abcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	abcq.withStorage = query
+	return abcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountName string `json:"account_name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AzureBlobConfig.Query().
+//		GroupBy(azureblobconfig.FieldAccountName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (abcq *AzureBlobConfigQuery) GroupBy(field string, fields ...string) *AzureBlobConfigGroupBy {
+	abcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AzureBlobConfigGroupBy{build: abcq}
+	grbuild.flds = &abcq.ctx.Fields
+	grbuild.label = azureblobconfig.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountName string `json:"account_name,omitempty"`
+//	}
+//
+//	client.AzureBlobConfig.Query().
+//		Select(azureblobconfig.FieldAccountName).
+//		Scan(ctx, &v)
+func (abcq *AzureBlobConfigQuery) Select(fields ...string) *AzureBlobConfigSelect {
+	abcq.ctx.Fields = append(abcq.ctx.Fields, fields...)
+	sbuild := &AzureBlobConfigSelect{AzureBlobConfigQuery: abcq}
+	sbuild.label = azureblobconfig.Label
+	sbuild.flds, sbuild.scan = &abcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AzureBlobConfigSelect configured with the given aggregations.
+func (abcq *AzureBlobConfigQuery) Aggregate(fns ...AggregateFunc) *AzureBlobConfigSelect {
+	return abcq.Select().Aggregate(fns...)
+}
+
+func (abcq *AzureBlobConfigQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range abcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, abcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range abcq.ctx.Fields {
+		if !azureblobconfig.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if abcq.path != nil {
+		prev, err := abcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		abcq.sql = prev
+	}
+	return nil
+}
+
+func (abcq *AzureBlobConfigQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AzureBlobConfig, error) {
+	var (
+		nodes       = []*AzureBlobConfig{}
+		withFKs     = abcq.withFKs
+		_spec       = abcq.querySpec()
+		loadedTypes = [1]bool{
+			abcq.withStorage != nil,
+		}
+	)
+	if abcq.withStorage != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, azureblobconfig.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AzureBlobConfig).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AzureBlobConfig{config: abcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, abcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := abcq.withStorage; query != nil {
+		if err := abcq.loadStorage(ctx, query, nodes, nil,
+			func(n *AzureBlobConfig, e *Storage) { n.Edges.Storage = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (abcq *AzureBlobConfigQuery) loadStorage(ctx context.Context, query *StorageQuery, nodes []*AzureBlobConfig, init func(*AzureBlobConfig), assign func(*AzureBlobConfig, *Storage)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AzureBlobConfig)
+	for i := range nodes {
+		if nodes[i].storage_azure_blob_config == nil {
+			continue
+		}
+		fk := *nodes[i].storage_azure_blob_config
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(storage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "storage_azure_blob_config" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (abcq *AzureBlobConfigQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := abcq.querySpec()
+	_spec.Node.Columns = abcq.ctx.Fields
+	if len(abcq.ctx.Fields) > 0 {
+		_spec.Unique = abcq.ctx.Unique != nil && *abcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, abcq.driver, _spec)
+}
+
+func (abcq *AzureBlobConfigQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(azureblobconfig.Table, azureblobconfig.Columns, sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt))
+	_spec.From = abcq.sql
+	if unique := abcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if abcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := abcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, azureblobconfig.FieldID)
+		for i := range fields {
+			if fields[i] != azureblobconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := abcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := abcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := abcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := abcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (abcq *AzureBlobConfigQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(abcq.driver.Dialect())
+	t1 := builder.Table(azureblobconfig.Table)
+	columns := abcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = azureblobconfig.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if abcq.sql != nil {
+		selector = abcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if abcq.ctx.Unique != nil && *abcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range abcq.predicates {
+		p(selector)
+	}
+	for _, p := range abcq.order {
+		p(selector)
+	}
+	if offset := abcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := abcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AzureBlobConfigGroupBy is the group-by builder for AzureBlobConfig entities.
+type AzureBlobConfigGroupBy struct {
+	selector
+	build *AzureBlobConfigQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (abcgb *AzureBlobConfigGroupBy) Aggregate(fns ...AggregateFunc) *AzureBlobConfigGroupBy {
+	abcgb.fns = append(abcgb.fns, fns...)
+	return abcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (abcgb *AzureBlobConfigGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, abcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := abcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AzureBlobConfigQuery, *AzureBlobConfigGroupBy](ctx, abcgb.build, abcgb, abcgb.build.inters, v)
+}
+
+func (abcgb *AzureBlobConfigGroupBy) sqlScan(ctx context.Context, root *AzureBlobConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(abcgb.fns))
+	for _, fn := range abcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*abcgb.flds)+len(abcgb.fns))
+		for _, f := range *abcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*abcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := abcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AzureBlobConfigSelect is the builder for selecting fields of AzureBlobConfig entities.
+type AzureBlobConfigSelect struct {
+	*AzureBlobConfigQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (abcs *AzureBlobConfigSelect) Aggregate(fns ...AggregateFunc) *AzureBlobConfigSelect {
+	abcs.fns = append(abcs.fns, fns...)
+	return abcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (abcs *AzureBlobConfigSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, abcs.ctx, ent.OpQuerySelect)
+	if err := abcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AzureBlobConfigQuery, *AzureBlobConfigSelect](ctx, abcs.AzureBlobConfigQuery, abcs, abcs.inters, v)
+}
+
+func (abcs *AzureBlobConfigSelect) sqlScan(ctx context.Context, root *AzureBlobConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(abcs.fns))
+	for _, fn := range abcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*abcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := abcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
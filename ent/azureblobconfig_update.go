@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// AzureBlobConfigUpdate is the builder for updating AzureBlobConfig entities.
+type AzureBlobConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AzureBlobConfigMutation
+}
+
+// Where appends a list predicates to the AzureBlobConfigUpdate builder.
+func (abcu *AzureBlobConfigUpdate) Where(ps ...predicate.AzureBlobConfig) *AzureBlobConfigUpdate {
+	abcu.mutation.Where(ps...)
+	return abcu
+}
+
+// SetAccountName sets the "account_name" field.
+func (abcu *AzureBlobConfigUpdate) SetAccountName(s string) *AzureBlobConfigUpdate {
+	abcu.mutation.SetAccountName(s)
+	return abcu
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableAccountName(s *string) *AzureBlobConfigUpdate {
+	if s != nil {
+		abcu.SetAccountName(*s)
+	}
+	return abcu
+}
+
+// SetAccountKey sets the "account_key" field.
+func (abcu *AzureBlobConfigUpdate) SetAccountKey(s string) *AzureBlobConfigUpdate {
+	abcu.mutation.SetAccountKey(s)
+	return abcu
+}
+
+// SetNillableAccountKey sets the "account_key" field if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableAccountKey(s *string) *AzureBlobConfigUpdate {
+	if s != nil {
+		abcu.SetAccountKey(*s)
+	}
+	return abcu
+}
+
+// ClearAccountKey clears the value of the "account_key" field.
+func (abcu *AzureBlobConfigUpdate) ClearAccountKey() *AzureBlobConfigUpdate {
+	abcu.mutation.ClearAccountKey()
+	return abcu
+}
+
+// SetSasToken sets the "sas_token" field.
+func (abcu *AzureBlobConfigUpdate) SetSasToken(s string) *AzureBlobConfigUpdate {
+	abcu.mutation.SetSasToken(s)
+	return abcu
+}
+
+// SetNillableSasToken sets the "sas_token" field if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableSasToken(s *string) *AzureBlobConfigUpdate {
+	if s != nil {
+		abcu.SetSasToken(*s)
+	}
+	return abcu
+}
+
+// ClearSasToken clears the value of the "sas_token" field.
+func (abcu *AzureBlobConfigUpdate) ClearSasToken() *AzureBlobConfigUpdate {
+	abcu.mutation.ClearSasToken()
+	return abcu
+}
+
+// SetContainer sets the "container" field.
+func (abcu *AzureBlobConfigUpdate) SetContainer(s string) *AzureBlobConfigUpdate {
+	abcu.mutation.SetContainer(s)
+	return abcu
+}
+
+// SetNillableContainer sets the "container" field if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableContainer(s *string) *AzureBlobConfigUpdate {
+	if s != nil {
+		abcu.SetContainer(*s)
+	}
+	return abcu
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (abcu *AzureBlobConfigUpdate) SetEndpoint(s string) *AzureBlobConfigUpdate {
+	abcu.mutation.SetEndpoint(s)
+	return abcu
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableEndpoint(s *string) *AzureBlobConfigUpdate {
+	if s != nil {
+		abcu.SetEndpoint(*s)
+	}
+	return abcu
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (abcu *AzureBlobConfigUpdate) ClearEndpoint() *AzureBlobConfigUpdate {
+	abcu.mutation.ClearEndpoint()
+	return abcu
+}
+
+// SetAccessTier sets the "access_tier" field.
+func (abcu *AzureBlobConfigUpdate) SetAccessTier(at azureblobconfig.AccessTier) *AzureBlobConfigUpdate {
+	abcu.mutation.SetAccessTier(at)
+	return abcu
+}
+
+// SetNillableAccessTier sets the "access_tier" field if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableAccessTier(at *azureblobconfig.AccessTier) *AzureBlobConfigUpdate {
+	if at != nil {
+		abcu.SetAccessTier(*at)
+	}
+	return abcu
+}
+
+// ClearAccessTier clears the value of the "access_tier" field.
+func (abcu *AzureBlobConfigUpdate) ClearAccessTier() *AzureBlobConfigUpdate {
+	abcu.mutation.ClearAccessTier()
+	return abcu
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (abcu *AzureBlobConfigUpdate) SetStorageID(id int) *AzureBlobConfigUpdate {
+	abcu.mutation.SetStorageID(id)
+	return abcu
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (abcu *AzureBlobConfigUpdate) SetNillableStorageID(id *int) *AzureBlobConfigUpdate {
+	if id != nil {
+		abcu = abcu.SetStorageID(*id)
+	}
+	return abcu
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (abcu *AzureBlobConfigUpdate) SetStorage(s *Storage) *AzureBlobConfigUpdate {
+	return abcu.SetStorageID(s.ID)
+}
+
+// Mutation returns the AzureBlobConfigMutation object of the builder.
+func (abcu *AzureBlobConfigUpdate) Mutation() *AzureBlobConfigMutation {
+	return abcu.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (abcu *AzureBlobConfigUpdate) ClearStorage() *AzureBlobConfigUpdate {
+	abcu.mutation.ClearStorage()
+	return abcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (abcu *AzureBlobConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, abcu.sqlSave, abcu.mutation, abcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (abcu *AzureBlobConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := abcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (abcu *AzureBlobConfigUpdate) Exec(ctx context.Context) error {
+	_, err := abcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (abcu *AzureBlobConfigUpdate) ExecX(ctx context.Context) {
+	if err := abcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (abcu *AzureBlobConfigUpdate) check() error {
+	if v, ok := abcu.mutation.AccessTier(); ok {
+		if err := azureblobconfig.AccessTierValidator(v); err != nil {
+			return &ValidationError{Name: "access_tier", err: fmt.Errorf(`ent: validator failed for field "AzureBlobConfig.access_tier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (abcu *AzureBlobConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := abcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(azureblobconfig.Table, azureblobconfig.Columns, sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt))
+	if ps := abcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := abcu.mutation.AccountName(); ok {
+		_spec.SetField(azureblobconfig.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := abcu.mutation.AccountKey(); ok {
+		_spec.SetField(azureblobconfig.FieldAccountKey, field.TypeString, value)
+	}
+	if abcu.mutation.AccountKeyCleared() {
+		_spec.ClearField(azureblobconfig.FieldAccountKey, field.TypeString)
+	}
+	if value, ok := abcu.mutation.SasToken(); ok {
+		_spec.SetField(azureblobconfig.FieldSasToken, field.TypeString, value)
+	}
+	if abcu.mutation.SasTokenCleared() {
+		_spec.ClearField(azureblobconfig.FieldSasToken, field.TypeString)
+	}
+	if value, ok := abcu.mutation.Container(); ok {
+		_spec.SetField(azureblobconfig.FieldContainer, field.TypeString, value)
+	}
+	if value, ok := abcu.mutation.Endpoint(); ok {
+		_spec.SetField(azureblobconfig.FieldEndpoint, field.TypeString, value)
+	}
+	if abcu.mutation.EndpointCleared() {
+		_spec.ClearField(azureblobconfig.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := abcu.mutation.AccessTier(); ok {
+		_spec.SetField(azureblobconfig.FieldAccessTier, field.TypeEnum, value)
+	}
+	if abcu.mutation.AccessTierCleared() {
+		_spec.ClearField(azureblobconfig.FieldAccessTier, field.TypeEnum)
+	}
+	if abcu.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   azureblobconfig.StorageTable,
+			Columns: []string{azureblobconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := abcu.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   azureblobconfig.StorageTable,
+			Columns: []string{azureblobconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, abcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{azureblobconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	abcu.mutation.done = true
+	return n, nil
+}
+
+// AzureBlobConfigUpdateOne is the builder for updating a single AzureBlobConfig entity.
+type AzureBlobConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AzureBlobConfigMutation
+}
+
+// SetAccountName sets the "account_name" field.
+func (abcuo *AzureBlobConfigUpdateOne) SetAccountName(s string) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetAccountName(s)
+	return abcuo
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableAccountName(s *string) *AzureBlobConfigUpdateOne {
+	if s != nil {
+		abcuo.SetAccountName(*s)
+	}
+	return abcuo
+}
+
+// SetAccountKey sets the "account_key" field.
+func (abcuo *AzureBlobConfigUpdateOne) SetAccountKey(s string) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetAccountKey(s)
+	return abcuo
+}
+
+// SetNillableAccountKey sets the "account_key" field if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableAccountKey(s *string) *AzureBlobConfigUpdateOne {
+	if s != nil {
+		abcuo.SetAccountKey(*s)
+	}
+	return abcuo
+}
+
+// ClearAccountKey clears the value of the "account_key" field.
+func (abcuo *AzureBlobConfigUpdateOne) ClearAccountKey() *AzureBlobConfigUpdateOne {
+	abcuo.mutation.ClearAccountKey()
+	return abcuo
+}
+
+// SetSasToken sets the "sas_token" field.
+func (abcuo *AzureBlobConfigUpdateOne) SetSasToken(s string) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetSasToken(s)
+	return abcuo
+}
+
+// SetNillableSasToken sets the "sas_token" field if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableSasToken(s *string) *AzureBlobConfigUpdateOne {
+	if s != nil {
+		abcuo.SetSasToken(*s)
+	}
+	return abcuo
+}
+
+// ClearSasToken clears the value of the "sas_token" field.
+func (abcuo *AzureBlobConfigUpdateOne) ClearSasToken() *AzureBlobConfigUpdateOne {
+	abcuo.mutation.ClearSasToken()
+	return abcuo
+}
+
+// SetContainer sets the "container" field.
+func (abcuo *AzureBlobConfigUpdateOne) SetContainer(s string) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetContainer(s)
+	return abcuo
+}
+
+// SetNillableContainer sets the "container" field if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableContainer(s *string) *AzureBlobConfigUpdateOne {
+	if s != nil {
+		abcuo.SetContainer(*s)
+	}
+	return abcuo
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (abcuo *AzureBlobConfigUpdateOne) SetEndpoint(s string) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetEndpoint(s)
+	return abcuo
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableEndpoint(s *string) *AzureBlobConfigUpdateOne {
+	if s != nil {
+		abcuo.SetEndpoint(*s)
+	}
+	return abcuo
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (abcuo *AzureBlobConfigUpdateOne) ClearEndpoint() *AzureBlobConfigUpdateOne {
+	abcuo.mutation.ClearEndpoint()
+	return abcuo
+}
+
+// SetAccessTier sets the "access_tier" field.
+func (abcuo *AzureBlobConfigUpdateOne) SetAccessTier(at azureblobconfig.AccessTier) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetAccessTier(at)
+	return abcuo
+}
+
+// SetNillableAccessTier sets the "access_tier" field if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableAccessTier(at *azureblobconfig.AccessTier) *AzureBlobConfigUpdateOne {
+	if at != nil {
+		abcuo.SetAccessTier(*at)
+	}
+	return abcuo
+}
+
+// ClearAccessTier clears the value of the "access_tier" field.
+func (abcuo *AzureBlobConfigUpdateOne) ClearAccessTier() *AzureBlobConfigUpdateOne {
+	abcuo.mutation.ClearAccessTier()
+	return abcuo
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (abcuo *AzureBlobConfigUpdateOne) SetStorageID(id int) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.SetStorageID(id)
+	return abcuo
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (abcuo *AzureBlobConfigUpdateOne) SetNillableStorageID(id *int) *AzureBlobConfigUpdateOne {
+	if id != nil {
+		abcuo = abcuo.SetStorageID(*id)
+	}
+	return abcuo
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (abcuo *AzureBlobConfigUpdateOne) SetStorage(s *Storage) *AzureBlobConfigUpdateOne {
+	return abcuo.SetStorageID(s.ID)
+}
+
+// Mutation returns the AzureBlobConfigMutation object of the builder.
+func (abcuo *AzureBlobConfigUpdateOne) Mutation() *AzureBlobConfigMutation {
+	return abcuo.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (abcuo *AzureBlobConfigUpdateOne) ClearStorage() *AzureBlobConfigUpdateOne {
+	abcuo.mutation.ClearStorage()
+	return abcuo
+}
+
+// Where appends a list predicates to the AzureBlobConfigUpdate builder.
+func (abcuo *AzureBlobConfigUpdateOne) Where(ps ...predicate.AzureBlobConfig) *AzureBlobConfigUpdateOne {
+	abcuo.mutation.Where(ps...)
+	return abcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (abcuo *AzureBlobConfigUpdateOne) Select(field string, fields ...string) *AzureBlobConfigUpdateOne {
+	abcuo.fields = append([]string{field}, fields...)
+	return abcuo
+}
+
+// Save executes the query and returns the updated AzureBlobConfig entity.
+func (abcuo *AzureBlobConfigUpdateOne) Save(ctx context.Context) (*AzureBlobConfig, error) {
+	return withHooks(ctx, abcuo.sqlSave, abcuo.mutation, abcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (abcuo *AzureBlobConfigUpdateOne) SaveX(ctx context.Context) *AzureBlobConfig {
+	node, err := abcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (abcuo *AzureBlobConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := abcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (abcuo *AzureBlobConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := abcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (abcuo *AzureBlobConfigUpdateOne) check() error {
+	if v, ok := abcuo.mutation.AccessTier(); ok {
+		if err := azureblobconfig.AccessTierValidator(v); err != nil {
+			return &ValidationError{Name: "access_tier", err: fmt.Errorf(`ent: validator failed for field "AzureBlobConfig.access_tier": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (abcuo *AzureBlobConfigUpdateOne) sqlSave(ctx context.Context) (_node *AzureBlobConfig, err error) {
+	if err := abcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(azureblobconfig.Table, azureblobconfig.Columns, sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt))
+	id, ok := abcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AzureBlobConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := abcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, azureblobconfig.FieldID)
+		for _, f := range fields {
+			if !azureblobconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != azureblobconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := abcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := abcuo.mutation.AccountName(); ok {
+		_spec.SetField(azureblobconfig.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := abcuo.mutation.AccountKey(); ok {
+		_spec.SetField(azureblobconfig.FieldAccountKey, field.TypeString, value)
+	}
+	if abcuo.mutation.AccountKeyCleared() {
+		_spec.ClearField(azureblobconfig.FieldAccountKey, field.TypeString)
+	}
+	if value, ok := abcuo.mutation.SasToken(); ok {
+		_spec.SetField(azureblobconfig.FieldSasToken, field.TypeString, value)
+	}
+	if abcuo.mutation.SasTokenCleared() {
+		_spec.ClearField(azureblobconfig.FieldSasToken, field.TypeString)
+	}
+	if value, ok := abcuo.mutation.Container(); ok {
+		_spec.SetField(azureblobconfig.FieldContainer, field.TypeString, value)
+	}
+	if value, ok := abcuo.mutation.Endpoint(); ok {
+		_spec.SetField(azureblobconfig.FieldEndpoint, field.TypeString, value)
+	}
+	if abcuo.mutation.EndpointCleared() {
+		_spec.ClearField(azureblobconfig.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := abcuo.mutation.AccessTier(); ok {
+		_spec.SetField(azureblobconfig.FieldAccessTier, field.TypeEnum, value)
+	}
+	if abcuo.mutation.AccessTierCleared() {
+		_spec.ClearField(azureblobconfig.FieldAccessTier, field.TypeEnum)
+	}
+	if abcuo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   azureblobconfig.StorageTable,
+			Columns: []string{azureblobconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := abcuo.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   azureblobconfig.StorageTable,
+			Columns: []string{azureblobconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AzureBlobConfig{config: abcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, abcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{azureblobconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	abcuo.mutation.done = true
+	return _node, nil
+}
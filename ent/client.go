@@ -15,7 +15,13 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/user"
@@ -27,8 +33,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AzureBlobConfig is the client for interacting with the AzureBlobConfig builders.
+	AzureBlobConfig *AzureBlobConfigClient
+	// FTPConfig is the client for interacting with the FTPConfig builders.
+	FTPConfig *FTPConfigClient
+	// GCSConfig is the client for interacting with the GCSConfig builders.
+	GCSConfig *GCSConfigClient
+	// LocalConfig is the client for interacting with the LocalConfig builders.
+	LocalConfig *LocalConfigClient
 	// S3Config is the client for interacting with the S3Config builders.
 	S3Config *S3ConfigClient
+	// SFTPConfig is the client for interacting with the SFTPConfig builders.
+	SFTPConfig *SFTPConfigClient
+	// Source is the client for interacting with the Source builders.
+	Source *SourceClient
 	// Storage is the client for interacting with the Storage builders.
 	Storage *StorageClient
 	// SyncJob is the client for interacting with the SyncJob builders.
@@ -48,7 +66,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AzureBlobConfig = NewAzureBlobConfigClient(c.config)
+	c.FTPConfig = NewFTPConfigClient(c.config)
+	c.GCSConfig = NewGCSConfigClient(c.config)
+	c.LocalConfig = NewLocalConfigClient(c.config)
 	c.S3Config = NewS3ConfigClient(c.config)
+	c.SFTPConfig = NewSFTPConfigClient(c.config)
+	c.Source = NewSourceClient(c.config)
 	c.Storage = NewStorageClient(c.config)
 	c.SyncJob = NewSyncJobClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -143,13 +167,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		S3Config:     NewS3ConfigClient(cfg),
-		Storage:      NewStorageClient(cfg),
-		SyncJob:      NewSyncJobClient(cfg),
-		User:         NewUserClient(cfg),
-		WebDAVConfig: NewWebDAVConfigClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AzureBlobConfig: NewAzureBlobConfigClient(cfg),
+		FTPConfig:       NewFTPConfigClient(cfg),
+		GCSConfig:       NewGCSConfigClient(cfg),
+		LocalConfig:     NewLocalConfigClient(cfg),
+		S3Config:        NewS3ConfigClient(cfg),
+		SFTPConfig:      NewSFTPConfigClient(cfg),
+		Source:          NewSourceClient(cfg),
+		Storage:         NewStorageClient(cfg),
+		SyncJob:         NewSyncJobClient(cfg),
+		User:            NewUserClient(cfg),
+		WebDAVConfig:    NewWebDAVConfigClient(cfg),
 	}, nil
 }
 
@@ -167,20 +197,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		S3Config:     NewS3ConfigClient(cfg),
-		Storage:      NewStorageClient(cfg),
-		SyncJob:      NewSyncJobClient(cfg),
-		User:         NewUserClient(cfg),
-		WebDAVConfig: NewWebDAVConfigClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AzureBlobConfig: NewAzureBlobConfigClient(cfg),
+		FTPConfig:       NewFTPConfigClient(cfg),
+		GCSConfig:       NewGCSConfigClient(cfg),
+		LocalConfig:     NewLocalConfigClient(cfg),
+		S3Config:        NewS3ConfigClient(cfg),
+		SFTPConfig:      NewSFTPConfigClient(cfg),
+		Source:          NewSourceClient(cfg),
+		Storage:         NewStorageClient(cfg),
+		SyncJob:         NewSyncJobClient(cfg),
+		User:            NewUserClient(cfg),
+		WebDAVConfig:    NewWebDAVConfigClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		S3Config.
+//		AzureBlobConfig.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -202,38 +238,648 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.S3Config.Use(hooks...)
-	c.Storage.Use(hooks...)
-	c.SyncJob.Use(hooks...)
-	c.User.Use(hooks...)
-	c.WebDAVConfig.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AzureBlobConfig, c.FTPConfig, c.GCSConfig, c.LocalConfig, c.S3Config,
+		c.SFTPConfig, c.Source, c.Storage, c.SyncJob, c.User, c.WebDAVConfig,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.S3Config.Intercept(interceptors...)
-	c.Storage.Intercept(interceptors...)
-	c.SyncJob.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
-	c.WebDAVConfig.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AzureBlobConfig, c.FTPConfig, c.GCSConfig, c.LocalConfig, c.S3Config,
+		c.SFTPConfig, c.Source, c.Storage, c.SyncJob, c.User, c.WebDAVConfig,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *AzureBlobConfigMutation:
+		return c.AzureBlobConfig.mutate(ctx, m)
+	case *FTPConfigMutation:
+		return c.FTPConfig.mutate(ctx, m)
+	case *GCSConfigMutation:
+		return c.GCSConfig.mutate(ctx, m)
+	case *LocalConfigMutation:
+		return c.LocalConfig.mutate(ctx, m)
+	case *S3ConfigMutation:
+		return c.S3Config.mutate(ctx, m)
+	case *SFTPConfigMutation:
+		return c.SFTPConfig.mutate(ctx, m)
+	case *SourceMutation:
+		return c.Source.mutate(ctx, m)
+	case *StorageMutation:
+		return c.Storage.mutate(ctx, m)
+	case *SyncJobMutation:
+		return c.SyncJob.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
+	case *WebDAVConfigMutation:
+		return c.WebDAVConfig.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// AzureBlobConfigClient is a client for the AzureBlobConfig schema.
+type AzureBlobConfigClient struct {
+	config
+}
+
+// NewAzureBlobConfigClient returns a client for the AzureBlobConfig from the given config.
+func NewAzureBlobConfigClient(c config) *AzureBlobConfigClient {
+	return &AzureBlobConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `azureblobconfig.Hooks(f(g(h())))`.
+func (c *AzureBlobConfigClient) Use(hooks ...Hook) {
+	c.hooks.AzureBlobConfig = append(c.hooks.AzureBlobConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `azureblobconfig.Intercept(f(g(h())))`.
+func (c *AzureBlobConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AzureBlobConfig = append(c.inters.AzureBlobConfig, interceptors...)
+}
+
+// Create returns a builder for creating a AzureBlobConfig entity.
+func (c *AzureBlobConfigClient) Create() *AzureBlobConfigCreate {
+	mutation := newAzureBlobConfigMutation(c.config, OpCreate)
+	return &AzureBlobConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AzureBlobConfig entities.
+func (c *AzureBlobConfigClient) CreateBulk(builders ...*AzureBlobConfigCreate) *AzureBlobConfigCreateBulk {
+	return &AzureBlobConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AzureBlobConfigClient) MapCreateBulk(slice any, setFunc func(*AzureBlobConfigCreate, int)) *AzureBlobConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AzureBlobConfigCreateBulk{err: fmt.Errorf("calling to AzureBlobConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AzureBlobConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AzureBlobConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AzureBlobConfig.
+func (c *AzureBlobConfigClient) Update() *AzureBlobConfigUpdate {
+	mutation := newAzureBlobConfigMutation(c.config, OpUpdate)
+	return &AzureBlobConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AzureBlobConfigClient) UpdateOne(abc *AzureBlobConfig) *AzureBlobConfigUpdateOne {
+	mutation := newAzureBlobConfigMutation(c.config, OpUpdateOne, withAzureBlobConfig(abc))
+	return &AzureBlobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AzureBlobConfigClient) UpdateOneID(id int) *AzureBlobConfigUpdateOne {
+	mutation := newAzureBlobConfigMutation(c.config, OpUpdateOne, withAzureBlobConfigID(id))
+	return &AzureBlobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AzureBlobConfig.
+func (c *AzureBlobConfigClient) Delete() *AzureBlobConfigDelete {
+	mutation := newAzureBlobConfigMutation(c.config, OpDelete)
+	return &AzureBlobConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AzureBlobConfigClient) DeleteOne(abc *AzureBlobConfig) *AzureBlobConfigDeleteOne {
+	return c.DeleteOneID(abc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AzureBlobConfigClient) DeleteOneID(id int) *AzureBlobConfigDeleteOne {
+	builder := c.Delete().Where(azureblobconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AzureBlobConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for AzureBlobConfig.
+func (c *AzureBlobConfigClient) Query() *AzureBlobConfigQuery {
+	return &AzureBlobConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAzureBlobConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AzureBlobConfig entity by its id.
+func (c *AzureBlobConfigClient) Get(ctx context.Context, id int) (*AzureBlobConfig, error) {
+	return c.Query().Where(azureblobconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AzureBlobConfigClient) GetX(ctx context.Context, id int) *AzureBlobConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStorage queries the storage edge of a AzureBlobConfig.
+func (c *AzureBlobConfigClient) QueryStorage(abc *AzureBlobConfig) *StorageQuery {
+	query := (&StorageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := abc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(azureblobconfig.Table, azureblobconfig.FieldID, id),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, azureblobconfig.StorageTable, azureblobconfig.StorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(abc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AzureBlobConfigClient) Hooks() []Hook {
+	return c.hooks.AzureBlobConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *AzureBlobConfigClient) Interceptors() []Interceptor {
+	return c.inters.AzureBlobConfig
+}
+
+func (c *AzureBlobConfigClient) mutate(ctx context.Context, m *AzureBlobConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AzureBlobConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AzureBlobConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AzureBlobConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AzureBlobConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AzureBlobConfig mutation op: %q", m.Op())
+	}
+}
+
+// FTPConfigClient is a client for the FTPConfig schema.
+type FTPConfigClient struct {
+	config
+}
+
+// NewFTPConfigClient returns a client for the FTPConfig from the given config.
+func NewFTPConfigClient(c config) *FTPConfigClient {
+	return &FTPConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ftpconfig.Hooks(f(g(h())))`.
+func (c *FTPConfigClient) Use(hooks ...Hook) {
+	c.hooks.FTPConfig = append(c.hooks.FTPConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ftpconfig.Intercept(f(g(h())))`.
+func (c *FTPConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FTPConfig = append(c.inters.FTPConfig, interceptors...)
+}
+
+// Create returns a builder for creating a FTPConfig entity.
+func (c *FTPConfigClient) Create() *FTPConfigCreate {
+	mutation := newFTPConfigMutation(c.config, OpCreate)
+	return &FTPConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FTPConfig entities.
+func (c *FTPConfigClient) CreateBulk(builders ...*FTPConfigCreate) *FTPConfigCreateBulk {
+	return &FTPConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FTPConfigClient) MapCreateBulk(slice any, setFunc func(*FTPConfigCreate, int)) *FTPConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FTPConfigCreateBulk{err: fmt.Errorf("calling to FTPConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FTPConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FTPConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FTPConfig.
+func (c *FTPConfigClient) Update() *FTPConfigUpdate {
+	mutation := newFTPConfigMutation(c.config, OpUpdate)
+	return &FTPConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FTPConfigClient) UpdateOne(fc *FTPConfig) *FTPConfigUpdateOne {
+	mutation := newFTPConfigMutation(c.config, OpUpdateOne, withFTPConfig(fc))
+	return &FTPConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FTPConfigClient) UpdateOneID(id int) *FTPConfigUpdateOne {
+	mutation := newFTPConfigMutation(c.config, OpUpdateOne, withFTPConfigID(id))
+	return &FTPConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FTPConfig.
+func (c *FTPConfigClient) Delete() *FTPConfigDelete {
+	mutation := newFTPConfigMutation(c.config, OpDelete)
+	return &FTPConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FTPConfigClient) DeleteOne(fc *FTPConfig) *FTPConfigDeleteOne {
+	return c.DeleteOneID(fc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FTPConfigClient) DeleteOneID(id int) *FTPConfigDeleteOne {
+	builder := c.Delete().Where(ftpconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FTPConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for FTPConfig.
+func (c *FTPConfigClient) Query() *FTPConfigQuery {
+	return &FTPConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFTPConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FTPConfig entity by its id.
+func (c *FTPConfigClient) Get(ctx context.Context, id int) (*FTPConfig, error) {
+	return c.Query().Where(ftpconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FTPConfigClient) GetX(ctx context.Context, id int) *FTPConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStorage queries the storage edge of a FTPConfig.
+func (c *FTPConfigClient) QueryStorage(fc *FTPConfig) *StorageQuery {
+	query := (&StorageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ftpconfig.Table, ftpconfig.FieldID, id),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ftpconfig.StorageTable, ftpconfig.StorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FTPConfigClient) Hooks() []Hook {
+	return c.hooks.FTPConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *FTPConfigClient) Interceptors() []Interceptor {
+	return c.inters.FTPConfig
+}
+
+func (c *FTPConfigClient) mutate(ctx context.Context, m *FTPConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FTPConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FTPConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FTPConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FTPConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FTPConfig mutation op: %q", m.Op())
+	}
+}
+
+// GCSConfigClient is a client for the GCSConfig schema.
+type GCSConfigClient struct {
+	config
+}
+
+// NewGCSConfigClient returns a client for the GCSConfig from the given config.
+func NewGCSConfigClient(c config) *GCSConfigClient {
+	return &GCSConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gcsconfig.Hooks(f(g(h())))`.
+func (c *GCSConfigClient) Use(hooks ...Hook) {
+	c.hooks.GCSConfig = append(c.hooks.GCSConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gcsconfig.Intercept(f(g(h())))`.
+func (c *GCSConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GCSConfig = append(c.inters.GCSConfig, interceptors...)
+}
+
+// Create returns a builder for creating a GCSConfig entity.
+func (c *GCSConfigClient) Create() *GCSConfigCreate {
+	mutation := newGCSConfigMutation(c.config, OpCreate)
+	return &GCSConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GCSConfig entities.
+func (c *GCSConfigClient) CreateBulk(builders ...*GCSConfigCreate) *GCSConfigCreateBulk {
+	return &GCSConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GCSConfigClient) MapCreateBulk(slice any, setFunc func(*GCSConfigCreate, int)) *GCSConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GCSConfigCreateBulk{err: fmt.Errorf("calling to GCSConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GCSConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GCSConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GCSConfig.
+func (c *GCSConfigClient) Update() *GCSConfigUpdate {
+	mutation := newGCSConfigMutation(c.config, OpUpdate)
+	return &GCSConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GCSConfigClient) UpdateOne(gc *GCSConfig) *GCSConfigUpdateOne {
+	mutation := newGCSConfigMutation(c.config, OpUpdateOne, withGCSConfig(gc))
+	return &GCSConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GCSConfigClient) UpdateOneID(id int) *GCSConfigUpdateOne {
+	mutation := newGCSConfigMutation(c.config, OpUpdateOne, withGCSConfigID(id))
+	return &GCSConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GCSConfig.
+func (c *GCSConfigClient) Delete() *GCSConfigDelete {
+	mutation := newGCSConfigMutation(c.config, OpDelete)
+	return &GCSConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GCSConfigClient) DeleteOne(gc *GCSConfig) *GCSConfigDeleteOne {
+	return c.DeleteOneID(gc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GCSConfigClient) DeleteOneID(id int) *GCSConfigDeleteOne {
+	builder := c.Delete().Where(gcsconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GCSConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for GCSConfig.
+func (c *GCSConfigClient) Query() *GCSConfigQuery {
+	return &GCSConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGCSConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GCSConfig entity by its id.
+func (c *GCSConfigClient) Get(ctx context.Context, id int) (*GCSConfig, error) {
+	return c.Query().Where(gcsconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GCSConfigClient) GetX(ctx context.Context, id int) *GCSConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStorage queries the storage edge of a GCSConfig.
+func (c *GCSConfigClient) QueryStorage(gc *GCSConfig) *StorageQuery {
+	query := (&StorageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gcsconfig.Table, gcsconfig.FieldID, id),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gcsconfig.StorageTable, gcsconfig.StorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(gc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GCSConfigClient) Hooks() []Hook {
+	return c.hooks.GCSConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *GCSConfigClient) Interceptors() []Interceptor {
+	return c.inters.GCSConfig
+}
+
+func (c *GCSConfigClient) mutate(ctx context.Context, m *GCSConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GCSConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GCSConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GCSConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GCSConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GCSConfig mutation op: %q", m.Op())
+	}
+}
+
+// LocalConfigClient is a client for the LocalConfig schema.
+type LocalConfigClient struct {
+	config
+}
+
+// NewLocalConfigClient returns a client for the LocalConfig from the given config.
+func NewLocalConfigClient(c config) *LocalConfigClient {
+	return &LocalConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `localconfig.Hooks(f(g(h())))`.
+func (c *LocalConfigClient) Use(hooks ...Hook) {
+	c.hooks.LocalConfig = append(c.hooks.LocalConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `localconfig.Intercept(f(g(h())))`.
+func (c *LocalConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LocalConfig = append(c.inters.LocalConfig, interceptors...)
+}
+
+// Create returns a builder for creating a LocalConfig entity.
+func (c *LocalConfigClient) Create() *LocalConfigCreate {
+	mutation := newLocalConfigMutation(c.config, OpCreate)
+	return &LocalConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LocalConfig entities.
+func (c *LocalConfigClient) CreateBulk(builders ...*LocalConfigCreate) *LocalConfigCreateBulk {
+	return &LocalConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocalConfigClient) MapCreateBulk(slice any, setFunc func(*LocalConfigCreate, int)) *LocalConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocalConfigCreateBulk{err: fmt.Errorf("calling to LocalConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocalConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocalConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LocalConfig.
+func (c *LocalConfigClient) Update() *LocalConfigUpdate {
+	mutation := newLocalConfigMutation(c.config, OpUpdate)
+	return &LocalConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocalConfigClient) UpdateOne(lc *LocalConfig) *LocalConfigUpdateOne {
+	mutation := newLocalConfigMutation(c.config, OpUpdateOne, withLocalConfig(lc))
+	return &LocalConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocalConfigClient) UpdateOneID(id int) *LocalConfigUpdateOne {
+	mutation := newLocalConfigMutation(c.config, OpUpdateOne, withLocalConfigID(id))
+	return &LocalConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LocalConfig.
+func (c *LocalConfigClient) Delete() *LocalConfigDelete {
+	mutation := newLocalConfigMutation(c.config, OpDelete)
+	return &LocalConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocalConfigClient) DeleteOne(lc *LocalConfig) *LocalConfigDeleteOne {
+	return c.DeleteOneID(lc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocalConfigClient) DeleteOneID(id int) *LocalConfigDeleteOne {
+	builder := c.Delete().Where(localconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocalConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for LocalConfig.
+func (c *LocalConfigClient) Query() *LocalConfigQuery {
+	return &LocalConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocalConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LocalConfig entity by its id.
+func (c *LocalConfigClient) Get(ctx context.Context, id int) (*LocalConfig, error) {
+	return c.Query().Where(localconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocalConfigClient) GetX(ctx context.Context, id int) *LocalConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStorage queries the storage edge of a LocalConfig.
+func (c *LocalConfigClient) QueryStorage(lc *LocalConfig) *StorageQuery {
+	query := (&StorageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(localconfig.Table, localconfig.FieldID, id),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, localconfig.StorageTable, localconfig.StorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(lc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocalConfigClient) Hooks() []Hook {
+	return c.hooks.LocalConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocalConfigClient) Interceptors() []Interceptor {
+	return c.inters.LocalConfig
 }
 
-// Mutate implements the ent.Mutator interface.
-func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
-	switch m := m.(type) {
-	case *S3ConfigMutation:
-		return c.S3Config.mutate(ctx, m)
-	case *StorageMutation:
-		return c.Storage.mutate(ctx, m)
-	case *SyncJobMutation:
-		return c.SyncJob.mutate(ctx, m)
-	case *UserMutation:
-		return c.User.mutate(ctx, m)
-	case *WebDAVConfigMutation:
-		return c.WebDAVConfig.mutate(ctx, m)
+func (c *LocalConfigClient) mutate(ctx context.Context, m *LocalConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocalConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocalConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocalConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocalConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+		return nil, fmt.Errorf("ent: unknown LocalConfig mutation op: %q", m.Op())
 	}
 }
 
@@ -386,6 +1032,304 @@ func (c *S3ConfigClient) mutate(ctx context.Context, m *S3ConfigMutation) (Value
 	}
 }
 
+// SFTPConfigClient is a client for the SFTPConfig schema.
+type SFTPConfigClient struct {
+	config
+}
+
+// NewSFTPConfigClient returns a client for the SFTPConfig from the given config.
+func NewSFTPConfigClient(c config) *SFTPConfigClient {
+	return &SFTPConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sftpconfig.Hooks(f(g(h())))`.
+func (c *SFTPConfigClient) Use(hooks ...Hook) {
+	c.hooks.SFTPConfig = append(c.hooks.SFTPConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sftpconfig.Intercept(f(g(h())))`.
+func (c *SFTPConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SFTPConfig = append(c.inters.SFTPConfig, interceptors...)
+}
+
+// Create returns a builder for creating a SFTPConfig entity.
+func (c *SFTPConfigClient) Create() *SFTPConfigCreate {
+	mutation := newSFTPConfigMutation(c.config, OpCreate)
+	return &SFTPConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SFTPConfig entities.
+func (c *SFTPConfigClient) CreateBulk(builders ...*SFTPConfigCreate) *SFTPConfigCreateBulk {
+	return &SFTPConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SFTPConfigClient) MapCreateBulk(slice any, setFunc func(*SFTPConfigCreate, int)) *SFTPConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SFTPConfigCreateBulk{err: fmt.Errorf("calling to SFTPConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SFTPConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SFTPConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SFTPConfig.
+func (c *SFTPConfigClient) Update() *SFTPConfigUpdate {
+	mutation := newSFTPConfigMutation(c.config, OpUpdate)
+	return &SFTPConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SFTPConfigClient) UpdateOne(sc *SFTPConfig) *SFTPConfigUpdateOne {
+	mutation := newSFTPConfigMutation(c.config, OpUpdateOne, withSFTPConfig(sc))
+	return &SFTPConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SFTPConfigClient) UpdateOneID(id int) *SFTPConfigUpdateOne {
+	mutation := newSFTPConfigMutation(c.config, OpUpdateOne, withSFTPConfigID(id))
+	return &SFTPConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SFTPConfig.
+func (c *SFTPConfigClient) Delete() *SFTPConfigDelete {
+	mutation := newSFTPConfigMutation(c.config, OpDelete)
+	return &SFTPConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SFTPConfigClient) DeleteOne(sc *SFTPConfig) *SFTPConfigDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SFTPConfigClient) DeleteOneID(id int) *SFTPConfigDeleteOne {
+	builder := c.Delete().Where(sftpconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SFTPConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for SFTPConfig.
+func (c *SFTPConfigClient) Query() *SFTPConfigQuery {
+	return &SFTPConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSFTPConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SFTPConfig entity by its id.
+func (c *SFTPConfigClient) Get(ctx context.Context, id int) (*SFTPConfig, error) {
+	return c.Query().Where(sftpconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SFTPConfigClient) GetX(ctx context.Context, id int) *SFTPConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStorage queries the storage edge of a SFTPConfig.
+func (c *SFTPConfigClient) QueryStorage(sc *SFTPConfig) *StorageQuery {
+	query := (&StorageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sftpconfig.Table, sftpconfig.FieldID, id),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, sftpconfig.StorageTable, sftpconfig.StorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SFTPConfigClient) Hooks() []Hook {
+	return c.hooks.SFTPConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *SFTPConfigClient) Interceptors() []Interceptor {
+	return c.inters.SFTPConfig
+}
+
+func (c *SFTPConfigClient) mutate(ctx context.Context, m *SFTPConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SFTPConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SFTPConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SFTPConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SFTPConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SFTPConfig mutation op: %q", m.Op())
+	}
+}
+
+// SourceClient is a client for the Source schema.
+type SourceClient struct {
+	config
+}
+
+// NewSourceClient returns a client for the Source from the given config.
+func NewSourceClient(c config) *SourceClient {
+	return &SourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `source.Hooks(f(g(h())))`.
+func (c *SourceClient) Use(hooks ...Hook) {
+	c.hooks.Source = append(c.hooks.Source, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `source.Intercept(f(g(h())))`.
+func (c *SourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Source = append(c.inters.Source, interceptors...)
+}
+
+// Create returns a builder for creating a Source entity.
+func (c *SourceClient) Create() *SourceCreate {
+	mutation := newSourceMutation(c.config, OpCreate)
+	return &SourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Source entities.
+func (c *SourceClient) CreateBulk(builders ...*SourceCreate) *SourceCreateBulk {
+	return &SourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SourceClient) MapCreateBulk(slice any, setFunc func(*SourceCreate, int)) *SourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SourceCreateBulk{err: fmt.Errorf("calling to SourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Source.
+func (c *SourceClient) Update() *SourceUpdate {
+	mutation := newSourceMutation(c.config, OpUpdate)
+	return &SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceClient) UpdateOne(s *Source) *SourceUpdateOne {
+	mutation := newSourceMutation(c.config, OpUpdateOne, withSource(s))
+	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceClient) UpdateOneID(id int) *SourceUpdateOne {
+	mutation := newSourceMutation(c.config, OpUpdateOne, withSourceID(id))
+	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Source.
+func (c *SourceClient) Delete() *SourceDelete {
+	mutation := newSourceMutation(c.config, OpDelete)
+	return &SourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceClient) DeleteOne(s *Source) *SourceDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceClient) DeleteOneID(id int) *SourceDeleteOne {
+	builder := c.Delete().Where(source.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceDeleteOne{builder}
+}
+
+// Query returns a query builder for Source.
+func (c *SourceClient) Query() *SourceQuery {
+	return &SourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Source entity by its id.
+func (c *SourceClient) Get(ctx context.Context, id int) (*Source, error) {
+	return c.Query().Where(source.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceClient) GetX(ctx context.Context, id int) *Source {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySyncJobs queries the sync_jobs edge of a Source.
+func (c *SourceClient) QuerySyncJobs(s *Source) *SyncJobQuery {
+	query := (&SyncJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(source.Table, source.FieldID, id),
+			sqlgraph.To(syncjob.Table, syncjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, source.SyncJobsTable, source.SyncJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SourceClient) Hooks() []Hook {
+	return c.hooks.Source
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceClient) Interceptors() []Interceptor {
+	return c.inters.Source
+}
+
+func (c *SourceClient) mutate(ctx context.Context, m *SourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Source mutation op: %q", m.Op())
+	}
+}
+
 // StorageClient is a client for the Storage schema.
 type StorageClient struct {
 	config
@@ -542,6 +1486,86 @@ func (c *StorageClient) QueryS3Config(s *Storage) *S3ConfigQuery {
 	return query
 }
 
+// QueryLocalConfig queries the local_config edge of a Storage.
+func (c *StorageClient) QueryLocalConfig(s *Storage) *LocalConfigQuery {
+	query := (&LocalConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, id),
+			sqlgraph.To(localconfig.Table, localconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.LocalConfigTable, storage.LocalConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySftpConfig queries the sftp_config edge of a Storage.
+func (c *StorageClient) QuerySftpConfig(s *Storage) *SFTPConfigQuery {
+	query := (&SFTPConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, id),
+			sqlgraph.To(sftpconfig.Table, sftpconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.SftpConfigTable, storage.SftpConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFtpConfig queries the ftp_config edge of a Storage.
+func (c *StorageClient) QueryFtpConfig(s *Storage) *FTPConfigQuery {
+	query := (&FTPConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, id),
+			sqlgraph.To(ftpconfig.Table, ftpconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.FtpConfigTable, storage.FtpConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAzureBlobConfig queries the azure_blob_config edge of a Storage.
+func (c *StorageClient) QueryAzureBlobConfig(s *Storage) *AzureBlobConfigQuery {
+	query := (&AzureBlobConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, id),
+			sqlgraph.To(azureblobconfig.Table, azureblobconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.AzureBlobConfigTable, storage.AzureBlobConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGcsConfig queries the gcs_config edge of a Storage.
+func (c *StorageClient) QueryGcsConfig(s *Storage) *GCSConfigQuery {
+	query := (&GCSConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, id),
+			sqlgraph.To(gcsconfig.Table, gcsconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.GcsConfigTable, storage.GcsConfigColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *StorageClient) Hooks() []Hook {
 	return c.hooks.Storage
@@ -691,6 +1715,22 @@ func (c *SyncJobClient) QueryStorage(sj *SyncJob) *StorageQuery {
 	return query
 }
 
+// QuerySource queries the source edge of a SyncJob.
+func (c *SyncJobClient) QuerySource(sj *SyncJob) *SourceQuery {
+	query := (&SourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sj.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(syncjob.Table, syncjob.FieldID, id),
+			sqlgraph.To(source.Table, source.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, syncjob.SourceTable, syncjob.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(sj.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SyncJobClient) Hooks() []Hook {
 	return c.hooks.SyncJob
@@ -1001,9 +2041,11 @@ func (c *WebDAVConfigClient) mutate(ctx context.Context, m *WebDAVConfigMutation
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		S3Config, Storage, SyncJob, User, WebDAVConfig []ent.Hook
+		AzureBlobConfig, FTPConfig, GCSConfig, LocalConfig, S3Config, SFTPConfig,
+		Source, Storage, SyncJob, User, WebDAVConfig []ent.Hook
 	}
 	inters struct {
-		S3Config, Storage, SyncJob, User, WebDAVConfig []ent.Interceptor
+		AzureBlobConfig, FTPConfig, GCSConfig, LocalConfig, S3Config, SFTPConfig,
+		Source, Storage, SyncJob, User, WebDAVConfig []ent.Interceptor
 	}
 )
@@ -12,7 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/user"
@@ -77,11 +83,17 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			s3config.Table:     s3config.ValidColumn,
-			storage.Table:      storage.ValidColumn,
-			syncjob.Table:      syncjob.ValidColumn,
-			user.Table:         user.ValidColumn,
-			webdavconfig.Table: webdavconfig.ValidColumn,
+			azureblobconfig.Table: azureblobconfig.ValidColumn,
+			ftpconfig.Table:       ftpconfig.ValidColumn,
+			gcsconfig.Table:       gcsconfig.ValidColumn,
+			localconfig.Table:     localconfig.ValidColumn,
+			s3config.Table:        s3config.ValidColumn,
+			sftpconfig.Table:      sftpconfig.ValidColumn,
+			source.Table:          source.ValidColumn,
+			storage.Table:         storage.ValidColumn,
+			syncjob.Table:         syncjob.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webdavconfig.Table:    webdavconfig.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
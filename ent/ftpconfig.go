@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// FTPConfig is the model entity for the FTPConfig schema.
+type FTPConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Host holds the value of the "host" field.
+	Host string `json:"host,omitempty"`
+	// Port holds the value of the "port" field.
+	Port int `json:"port,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// TLSMode holds the value of the "tls_mode" field.
+	TLSMode ftpconfig.TLSMode `json:"tls_mode,omitempty"`
+	// SkipTLSVerify holds the value of the "skip_tls_verify" field.
+	SkipTLSVerify bool `json:"skip_tls_verify,omitempty"`
+	// BaseDir holds the value of the "base_dir" field.
+	BaseDir string `json:"base_dir,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FTPConfigQuery when eager-loading is set.
+	Edges              FTPConfigEdges `json:"edges"`
+	storage_ftp_config *int
+	selectValues       sql.SelectValues
+}
+
+// FTPConfigEdges holds the relations/edges for other nodes in the graph.
+type FTPConfigEdges struct {
+	// Storage holds the value of the storage edge.
+	Storage *Storage `json:"storage,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StorageOrErr returns the Storage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FTPConfigEdges) StorageOrErr() (*Storage, error) {
+	if e.Storage != nil {
+		return e.Storage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: storage.Label}
+	}
+	return nil, &NotLoadedError{edge: "storage"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FTPConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ftpconfig.FieldSkipTLSVerify:
+			values[i] = new(sql.NullBool)
+		case ftpconfig.FieldID, ftpconfig.FieldPort:
+			values[i] = new(sql.NullInt64)
+		case ftpconfig.FieldHost, ftpconfig.FieldUsername, ftpconfig.FieldPassword, ftpconfig.FieldTLSMode, ftpconfig.FieldBaseDir:
+			values[i] = new(sql.NullString)
+		case ftpconfig.ForeignKeys[0]: // storage_ftp_config
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FTPConfig fields.
+func (fc *FTPConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ftpconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fc.ID = int(value.Int64)
+		case ftpconfig.FieldHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host", values[i])
+			} else if value.Valid {
+				fc.Host = value.String
+			}
+		case ftpconfig.FieldPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field port", values[i])
+			} else if value.Valid {
+				fc.Port = int(value.Int64)
+			}
+		case ftpconfig.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				fc.Username = value.String
+			}
+		case ftpconfig.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				fc.Password = value.String
+			}
+		case ftpconfig.FieldTLSMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_mode", values[i])
+			} else if value.Valid {
+				fc.TLSMode = ftpconfig.TLSMode(value.String)
+			}
+		case ftpconfig.FieldSkipTLSVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_tls_verify", values[i])
+			} else if value.Valid {
+				fc.SkipTLSVerify = value.Bool
+			}
+		case ftpconfig.FieldBaseDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_dir", values[i])
+			} else if value.Valid {
+				fc.BaseDir = value.String
+			}
+		case ftpconfig.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field storage_ftp_config", value)
+			} else if value.Valid {
+				fc.storage_ftp_config = new(int)
+				*fc.storage_ftp_config = int(value.Int64)
+			}
+		default:
+			fc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FTPConfig.
+// This includes values selected through modifiers, order, etc.
+func (fc *FTPConfig) Value(name string) (ent.Value, error) {
+	return fc.selectValues.Get(name)
+}
+
+// QueryStorage queries the "storage" edge of the FTPConfig entity.
+func (fc *FTPConfig) QueryStorage() *StorageQuery {
+	return NewFTPConfigClient(fc.config).QueryStorage(fc)
+}
+
+// Update returns a builder for updating this FTPConfig.
+// Note that you need to call FTPConfig.Unwrap() before calling this method if this FTPConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fc *FTPConfig) Update() *FTPConfigUpdateOne {
+	return NewFTPConfigClient(fc.config).UpdateOne(fc)
+}
+
+// Unwrap unwraps the FTPConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fc *FTPConfig) Unwrap() *FTPConfig {
+	_tx, ok := fc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FTPConfig is not a transactional entity")
+	}
+	fc.config.driver = _tx.drv
+	return fc
+}
+
+// String implements the fmt.Stringer.
+func (fc *FTPConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("FTPConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fc.ID))
+	builder.WriteString("host=")
+	builder.WriteString(fc.Host)
+	builder.WriteString(", ")
+	builder.WriteString("port=")
+	builder.WriteString(fmt.Sprintf("%v", fc.Port))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(fc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("tls_mode=")
+	builder.WriteString(fmt.Sprintf("%v", fc.TLSMode))
+	builder.WriteString(", ")
+	builder.WriteString("skip_tls_verify=")
+	builder.WriteString(fmt.Sprintf("%v", fc.SkipTLSVerify))
+	builder.WriteString(", ")
+	builder.WriteString("base_dir=")
+	builder.WriteString(fc.BaseDir)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FTPConfigs is a parsable slice of FTPConfig.
+type FTPConfigs []*FTPConfig
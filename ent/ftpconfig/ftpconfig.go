@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ftpconfig
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ftpconfig type in the database.
+	Label = "ftp_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHost holds the string denoting the host field in the database.
+	FieldHost = "host"
+	// FieldPort holds the string denoting the port field in the database.
+	FieldPort = "port"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldTLSMode holds the string denoting the tls_mode field in the database.
+	FieldTLSMode = "tls_mode"
+	// FieldSkipTLSVerify holds the string denoting the skip_tls_verify field in the database.
+	FieldSkipTLSVerify = "skip_tls_verify"
+	// FieldBaseDir holds the string denoting the base_dir field in the database.
+	FieldBaseDir = "base_dir"
+	// EdgeStorage holds the string denoting the storage edge name in mutations.
+	EdgeStorage = "storage"
+	// Table holds the table name of the ftpconfig in the database.
+	Table = "ftp_configs"
+	// StorageTable is the table that holds the storage relation/edge.
+	StorageTable = "ftp_configs"
+	// StorageInverseTable is the table name for the Storage entity.
+	// It exists in this package in order to avoid circular dependency with the "storage" package.
+	StorageInverseTable = "storages"
+	// StorageColumn is the table column denoting the storage relation/edge.
+	StorageColumn = "storage_ftp_config"
+)
+
+// Columns holds all SQL columns for ftpconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldHost,
+	FieldPort,
+	FieldUsername,
+	FieldPassword,
+	FieldTLSMode,
+	FieldSkipTLSVerify,
+	FieldBaseDir,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "ftp_configs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"storage_ftp_config",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPort holds the default value on creation for the "port" field.
+	DefaultPort int
+	// PortValidator is a validator for the "port" field. It is called by the builders before save.
+	PortValidator func(int) error
+	// DefaultSkipTLSVerify holds the default value on creation for the "skip_tls_verify" field.
+	DefaultSkipTLSVerify bool
+)
+
+// TLSMode defines the type for the "tls_mode" enum field.
+type TLSMode string
+
+// TLSModeNone is the default value of the TLSMode enum.
+const DefaultTLSMode = TLSModeNone
+
+// TLSMode values.
+const (
+	TLSModeNone     TLSMode = "none"
+	TLSModeExplicit TLSMode = "explicit"
+	TLSModeImplicit TLSMode = "implicit"
+)
+
+func (tm TLSMode) String() string {
+	return string(tm)
+}
+
+// TLSModeValidator is a validator for the "tls_mode" field enum values. It is called by the builders before save.
+func TLSModeValidator(tm TLSMode) error {
+	switch tm {
+	case TLSModeNone, TLSModeExplicit, TLSModeImplicit:
+		return nil
+	default:
+		return fmt.Errorf("ftpconfig: invalid enum value for tls_mode field: %q", tm)
+	}
+}
+
+// OrderOption defines the ordering options for the FTPConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHost orders the results by the host field.
+func ByHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHost, opts...).ToFunc()
+}
+
+// ByPort orders the results by the port field.
+func ByPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPort, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByPassword orders the results by the password field.
+func ByPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassword, opts...).ToFunc()
+}
+
+// ByTLSMode orders the results by the tls_mode field.
+func ByTLSMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSMode, opts...).ToFunc()
+}
+
+// BySkipTLSVerify orders the results by the skip_tls_verify field.
+func BySkipTLSVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipTLSVerify, opts...).ToFunc()
+}
+
+// ByBaseDir orders the results by the base_dir field.
+func ByBaseDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseDir, opts...).ToFunc()
+}
+
+// ByStorageField orders the results by storage field.
+func ByStorageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStorageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStorageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StorageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+	)
+}
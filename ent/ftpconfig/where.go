@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ftpconfig
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLTE(FieldID, id))
+}
+
+// Host applies equality check predicate on the "host" field. It's identical to HostEQ.
+func Host(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldHost, v))
+}
+
+// Port applies equality check predicate on the "port" field. It's identical to PortEQ.
+func Port(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldPort, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldUsername, v))
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldPassword, v))
+}
+
+// SkipTLSVerify applies equality check predicate on the "skip_tls_verify" field. It's identical to SkipTLSVerifyEQ.
+func SkipTLSVerify(v bool) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldSkipTLSVerify, v))
+}
+
+// BaseDir applies equality check predicate on the "base_dir" field. It's identical to BaseDirEQ.
+func BaseDir(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldBaseDir, v))
+}
+
+// HostEQ applies the EQ predicate on the "host" field.
+func HostEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldHost, v))
+}
+
+// HostNEQ applies the NEQ predicate on the "host" field.
+func HostNEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldHost, v))
+}
+
+// HostIn applies the In predicate on the "host" field.
+func HostIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldHost, vs...))
+}
+
+// HostNotIn applies the NotIn predicate on the "host" field.
+func HostNotIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldHost, vs...))
+}
+
+// HostGT applies the GT predicate on the "host" field.
+func HostGT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGT(FieldHost, v))
+}
+
+// HostGTE applies the GTE predicate on the "host" field.
+func HostGTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGTE(FieldHost, v))
+}
+
+// HostLT applies the LT predicate on the "host" field.
+func HostLT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLT(FieldHost, v))
+}
+
+// HostLTE applies the LTE predicate on the "host" field.
+func HostLTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLTE(FieldHost, v))
+}
+
+// HostContains applies the Contains predicate on the "host" field.
+func HostContains(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContains(FieldHost, v))
+}
+
+// HostHasPrefix applies the HasPrefix predicate on the "host" field.
+func HostHasPrefix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasPrefix(FieldHost, v))
+}
+
+// HostHasSuffix applies the HasSuffix predicate on the "host" field.
+func HostHasSuffix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasSuffix(FieldHost, v))
+}
+
+// HostEqualFold applies the EqualFold predicate on the "host" field.
+func HostEqualFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEqualFold(FieldHost, v))
+}
+
+// HostContainsFold applies the ContainsFold predicate on the "host" field.
+func HostContainsFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContainsFold(FieldHost, v))
+}
+
+// PortEQ applies the EQ predicate on the "port" field.
+func PortEQ(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldPort, v))
+}
+
+// PortNEQ applies the NEQ predicate on the "port" field.
+func PortNEQ(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldPort, v))
+}
+
+// PortIn applies the In predicate on the "port" field.
+func PortIn(vs ...int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldPort, vs...))
+}
+
+// PortNotIn applies the NotIn predicate on the "port" field.
+func PortNotIn(vs ...int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldPort, vs...))
+}
+
+// PortGT applies the GT predicate on the "port" field.
+func PortGT(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGT(FieldPort, v))
+}
+
+// PortGTE applies the GTE predicate on the "port" field.
+func PortGTE(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGTE(FieldPort, v))
+}
+
+// PortLT applies the LT predicate on the "port" field.
+func PortLT(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLT(FieldPort, v))
+}
+
+// PortLTE applies the LTE predicate on the "port" field.
+func PortLTE(v int) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLTE(FieldPort, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// TLSModeEQ applies the EQ predicate on the "tls_mode" field.
+func TLSModeEQ(v TLSMode) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldTLSMode, v))
+}
+
+// TLSModeNEQ applies the NEQ predicate on the "tls_mode" field.
+func TLSModeNEQ(v TLSMode) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldTLSMode, v))
+}
+
+// TLSModeIn applies the In predicate on the "tls_mode" field.
+func TLSModeIn(vs ...TLSMode) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldTLSMode, vs...))
+}
+
+// TLSModeNotIn applies the NotIn predicate on the "tls_mode" field.
+func TLSModeNotIn(vs ...TLSMode) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldTLSMode, vs...))
+}
+
+// SkipTLSVerifyEQ applies the EQ predicate on the "skip_tls_verify" field.
+func SkipTLSVerifyEQ(v bool) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldSkipTLSVerify, v))
+}
+
+// SkipTLSVerifyNEQ applies the NEQ predicate on the "skip_tls_verify" field.
+func SkipTLSVerifyNEQ(v bool) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldSkipTLSVerify, v))
+}
+
+// BaseDirEQ applies the EQ predicate on the "base_dir" field.
+func BaseDirEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEQ(FieldBaseDir, v))
+}
+
+// BaseDirNEQ applies the NEQ predicate on the "base_dir" field.
+func BaseDirNEQ(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNEQ(FieldBaseDir, v))
+}
+
+// BaseDirIn applies the In predicate on the "base_dir" field.
+func BaseDirIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIn(FieldBaseDir, vs...))
+}
+
+// BaseDirNotIn applies the NotIn predicate on the "base_dir" field.
+func BaseDirNotIn(vs ...string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotIn(FieldBaseDir, vs...))
+}
+
+// BaseDirGT applies the GT predicate on the "base_dir" field.
+func BaseDirGT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGT(FieldBaseDir, v))
+}
+
+// BaseDirGTE applies the GTE predicate on the "base_dir" field.
+func BaseDirGTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldGTE(FieldBaseDir, v))
+}
+
+// BaseDirLT applies the LT predicate on the "base_dir" field.
+func BaseDirLT(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLT(FieldBaseDir, v))
+}
+
+// BaseDirLTE applies the LTE predicate on the "base_dir" field.
+func BaseDirLTE(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldLTE(FieldBaseDir, v))
+}
+
+// BaseDirContains applies the Contains predicate on the "base_dir" field.
+func BaseDirContains(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContains(FieldBaseDir, v))
+}
+
+// BaseDirHasPrefix applies the HasPrefix predicate on the "base_dir" field.
+func BaseDirHasPrefix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasPrefix(FieldBaseDir, v))
+}
+
+// BaseDirHasSuffix applies the HasSuffix predicate on the "base_dir" field.
+func BaseDirHasSuffix(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldHasSuffix(FieldBaseDir, v))
+}
+
+// BaseDirIsNil applies the IsNil predicate on the "base_dir" field.
+func BaseDirIsNil() predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldIsNull(FieldBaseDir))
+}
+
+// BaseDirNotNil applies the NotNil predicate on the "base_dir" field.
+func BaseDirNotNil() predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldNotNull(FieldBaseDir))
+}
+
+// BaseDirEqualFold applies the EqualFold predicate on the "base_dir" field.
+func BaseDirEqualFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldEqualFold(FieldBaseDir, v))
+}
+
+// BaseDirContainsFold applies the ContainsFold predicate on the "base_dir" field.
+func BaseDirContainsFold(v string) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.FieldContainsFold(FieldBaseDir, v))
+}
+
+// HasStorage applies the HasEdge predicate on the "storage" edge.
+func HasStorage() predicate.FTPConfig {
+	return predicate.FTPConfig(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStorageWith applies the HasEdge predicate on the "storage" edge with a given conditions (other predicates).
+func HasStorageWith(preds ...predicate.Storage) predicate.FTPConfig {
+	return predicate.FTPConfig(func(s *sql.Selector) {
+		step := newStorageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FTPConfig) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FTPConfig) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FTPConfig) predicate.FTPConfig {
+	return predicate.FTPConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// FTPConfigCreate is the builder for creating a FTPConfig entity.
+type FTPConfigCreate struct {
+	config
+	mutation *FTPConfigMutation
+	hooks    []Hook
+}
+
+// SetHost sets the "host" field.
+func (fcc *FTPConfigCreate) SetHost(s string) *FTPConfigCreate {
+	fcc.mutation.SetHost(s)
+	return fcc
+}
+
+// SetPort sets the "port" field.
+func (fcc *FTPConfigCreate) SetPort(i int) *FTPConfigCreate {
+	fcc.mutation.SetPort(i)
+	return fcc
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (fcc *FTPConfigCreate) SetNillablePort(i *int) *FTPConfigCreate {
+	if i != nil {
+		fcc.SetPort(*i)
+	}
+	return fcc
+}
+
+// SetUsername sets the "username" field.
+func (fcc *FTPConfigCreate) SetUsername(s string) *FTPConfigCreate {
+	fcc.mutation.SetUsername(s)
+	return fcc
+}
+
+// SetPassword sets the "password" field.
+func (fcc *FTPConfigCreate) SetPassword(s string) *FTPConfigCreate {
+	fcc.mutation.SetPassword(s)
+	return fcc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (fcc *FTPConfigCreate) SetNillablePassword(s *string) *FTPConfigCreate {
+	if s != nil {
+		fcc.SetPassword(*s)
+	}
+	return fcc
+}
+
+// SetTLSMode sets the "tls_mode" field.
+func (fcc *FTPConfigCreate) SetTLSMode(fm ftpconfig.TLSMode) *FTPConfigCreate {
+	fcc.mutation.SetTLSMode(fm)
+	return fcc
+}
+
+// SetNillableTLSMode sets the "tls_mode" field if the given value is not nil.
+func (fcc *FTPConfigCreate) SetNillableTLSMode(fm *ftpconfig.TLSMode) *FTPConfigCreate {
+	if fm != nil {
+		fcc.SetTLSMode(*fm)
+	}
+	return fcc
+}
+
+// SetSkipTLSVerify sets the "skip_tls_verify" field.
+func (fcc *FTPConfigCreate) SetSkipTLSVerify(b bool) *FTPConfigCreate {
+	fcc.mutation.SetSkipTLSVerify(b)
+	return fcc
+}
+
+// SetNillableSkipTLSVerify sets the "skip_tls_verify" field if the given value is not nil.
+func (fcc *FTPConfigCreate) SetNillableSkipTLSVerify(b *bool) *FTPConfigCreate {
+	if b != nil {
+		fcc.SetSkipTLSVerify(*b)
+	}
+	return fcc
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (fcc *FTPConfigCreate) SetBaseDir(s string) *FTPConfigCreate {
+	fcc.mutation.SetBaseDir(s)
+	return fcc
+}
+
+// SetNillableBaseDir sets the "base_dir" field if the given value is not nil.
+func (fcc *FTPConfigCreate) SetNillableBaseDir(s *string) *FTPConfigCreate {
+	if s != nil {
+		fcc.SetBaseDir(*s)
+	}
+	return fcc
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (fcc *FTPConfigCreate) SetStorageID(id int) *FTPConfigCreate {
+	fcc.mutation.SetStorageID(id)
+	return fcc
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (fcc *FTPConfigCreate) SetNillableStorageID(id *int) *FTPConfigCreate {
+	if id != nil {
+		fcc = fcc.SetStorageID(*id)
+	}
+	return fcc
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (fcc *FTPConfigCreate) SetStorage(s *Storage) *FTPConfigCreate {
+	return fcc.SetStorageID(s.ID)
+}
+
+// Mutation returns the FTPConfigMutation object of the builder.
+func (fcc *FTPConfigCreate) Mutation() *FTPConfigMutation {
+	return fcc.mutation
+}
+
+// Save creates the FTPConfig in the database.
+func (fcc *FTPConfigCreate) Save(ctx context.Context) (*FTPConfig, error) {
+	fcc.defaults()
+	return withHooks(ctx, fcc.sqlSave, fcc.mutation, fcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fcc *FTPConfigCreate) SaveX(ctx context.Context) *FTPConfig {
+	v, err := fcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcc *FTPConfigCreate) Exec(ctx context.Context) error {
+	_, err := fcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcc *FTPConfigCreate) ExecX(ctx context.Context) {
+	if err := fcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fcc *FTPConfigCreate) defaults() {
+	if _, ok := fcc.mutation.Port(); !ok {
+		v := ftpconfig.DefaultPort
+		fcc.mutation.SetPort(v)
+	}
+	if _, ok := fcc.mutation.TLSMode(); !ok {
+		v := ftpconfig.DefaultTLSMode
+		fcc.mutation.SetTLSMode(v)
+	}
+	if _, ok := fcc.mutation.SkipTLSVerify(); !ok {
+		v := ftpconfig.DefaultSkipTLSVerify
+		fcc.mutation.SetSkipTLSVerify(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fcc *FTPConfigCreate) check() error {
+	if _, ok := fcc.mutation.Host(); !ok {
+		return &ValidationError{Name: "host", err: errors.New(`ent: missing required field "FTPConfig.host"`)}
+	}
+	if _, ok := fcc.mutation.Port(); !ok {
+		return &ValidationError{Name: "port", err: errors.New(`ent: missing required field "FTPConfig.port"`)}
+	}
+	if v, ok := fcc.mutation.Port(); ok {
+		if err := ftpconfig.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "FTPConfig.port": %w`, err)}
+		}
+	}
+	if _, ok := fcc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "FTPConfig.username"`)}
+	}
+	if _, ok := fcc.mutation.TLSMode(); !ok {
+		return &ValidationError{Name: "tls_mode", err: errors.New(`ent: missing required field "FTPConfig.tls_mode"`)}
+	}
+	if v, ok := fcc.mutation.TLSMode(); ok {
+		if err := ftpconfig.TLSModeValidator(v); err != nil {
+			return &ValidationError{Name: "tls_mode", err: fmt.Errorf(`ent: validator failed for field "FTPConfig.tls_mode": %w`, err)}
+		}
+	}
+	if _, ok := fcc.mutation.SkipTLSVerify(); !ok {
+		return &ValidationError{Name: "skip_tls_verify", err: errors.New(`ent: missing required field "FTPConfig.skip_tls_verify"`)}
+	}
+	return nil
+}
+
+func (fcc *FTPConfigCreate) sqlSave(ctx context.Context) (*FTPConfig, error) {
+	if err := fcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fcc.mutation.id = &_node.ID
+	fcc.mutation.done = true
+	return _node, nil
+}
+
+func (fcc *FTPConfigCreate) createSpec() (*FTPConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FTPConfig{config: fcc.config}
+		_spec = sqlgraph.NewCreateSpec(ftpconfig.Table, sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt))
+	)
+	if value, ok := fcc.mutation.Host(); ok {
+		_spec.SetField(ftpconfig.FieldHost, field.TypeString, value)
+		_node.Host = value
+	}
+	if value, ok := fcc.mutation.Port(); ok {
+		_spec.SetField(ftpconfig.FieldPort, field.TypeInt, value)
+		_node.Port = value
+	}
+	if value, ok := fcc.mutation.Username(); ok {
+		_spec.SetField(ftpconfig.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := fcc.mutation.Password(); ok {
+		_spec.SetField(ftpconfig.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := fcc.mutation.TLSMode(); ok {
+		_spec.SetField(ftpconfig.FieldTLSMode, field.TypeEnum, value)
+		_node.TLSMode = value
+	}
+	if value, ok := fcc.mutation.SkipTLSVerify(); ok {
+		_spec.SetField(ftpconfig.FieldSkipTLSVerify, field.TypeBool, value)
+		_node.SkipTLSVerify = value
+	}
+	if value, ok := fcc.mutation.BaseDir(); ok {
+		_spec.SetField(ftpconfig.FieldBaseDir, field.TypeString, value)
+		_node.BaseDir = value
+	}
+	if nodes := fcc.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ftpconfig.StorageTable,
+			Columns: []string{ftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.storage_ftp_config = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FTPConfigCreateBulk is the builder for creating many FTPConfig entities in bulk.
+type FTPConfigCreateBulk struct {
+	config
+	err      error
+	builders []*FTPConfigCreate
+}
+
+// Save creates the FTPConfig entities in the database.
+func (fccb *FTPConfigCreateBulk) Save(ctx context.Context) ([]*FTPConfig, error) {
+	if fccb.err != nil {
+		return nil, fccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fccb.builders))
+	nodes := make([]*FTPConfig, len(fccb.builders))
+	mutators := make([]Mutator, len(fccb.builders))
+	for i := range fccb.builders {
+		func(i int, root context.Context) {
+			builder := fccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FTPConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fccb *FTPConfigCreateBulk) SaveX(ctx context.Context) []*FTPConfig {
+	v, err := fccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fccb *FTPConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := fccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fccb *FTPConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := fccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// FTPConfigDelete is the builder for deleting a FTPConfig entity.
+type FTPConfigDelete struct {
+	config
+	hooks    []Hook
+	mutation *FTPConfigMutation
+}
+
+// Where appends a list predicates to the FTPConfigDelete builder.
+func (fcd *FTPConfigDelete) Where(ps ...predicate.FTPConfig) *FTPConfigDelete {
+	fcd.mutation.Where(ps...)
+	return fcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fcd *FTPConfigDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fcd.sqlExec, fcd.mutation, fcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcd *FTPConfigDelete) ExecX(ctx context.Context) int {
+	n, err := fcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fcd *FTPConfigDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ftpconfig.Table, sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt))
+	if ps := fcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fcd.mutation.done = true
+	return affected, err
+}
+
+// FTPConfigDeleteOne is the builder for deleting a single FTPConfig entity.
+type FTPConfigDeleteOne struct {
+	fcd *FTPConfigDelete
+}
+
+// Where appends a list predicates to the FTPConfigDelete builder.
+func (fcdo *FTPConfigDeleteOne) Where(ps ...predicate.FTPConfig) *FTPConfigDeleteOne {
+	fcdo.fcd.mutation.Where(ps...)
+	return fcdo
+}
+
+// Exec executes the deletion query.
+func (fcdo *FTPConfigDeleteOne) Exec(ctx context.Context) error {
+	n, err := fcdo.fcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ftpconfig.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcdo *FTPConfigDeleteOne) ExecX(ctx context.Context) {
+	if err := fcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
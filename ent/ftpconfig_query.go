@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// FTPConfigQuery is the builder for querying FTPConfig entities.
+type FTPConfigQuery struct {
+	config
+	ctx         *QueryContext
+	order       []ftpconfig.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.FTPConfig
+	withStorage *StorageQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FTPConfigQuery builder.
+func (fcq *FTPConfigQuery) Where(ps ...predicate.FTPConfig) *FTPConfigQuery {
+	fcq.predicates = append(fcq.predicates, ps...)
+	return fcq
+}
+
+// Limit the number of records to be returned by this query.
+func (fcq *FTPConfigQuery) Limit(limit int) *FTPConfigQuery {
+	fcq.ctx.Limit = &limit
+	return fcq
+}
+
+// Offset to start from.
+func (fcq *FTPConfigQuery) Offset(offset int) *FTPConfigQuery {
+	fcq.ctx.Offset = &offset
+	return fcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fcq *FTPConfigQuery) Unique(unique bool) *FTPConfigQuery {
+	fcq.ctx.Unique = &unique
+	return fcq
+}
+
+// Order specifies how the records should be ordered.
+func (fcq *FTPConfigQuery) Order(o ...ftpconfig.OrderOption) *FTPConfigQuery {
+	fcq.order = append(fcq.order, o...)
+	return fcq
+}
+
+// QueryStorage chains the current query on the "storage" edge.
+func (fcq *FTPConfigQuery) QueryStorage() *StorageQuery {
+	query := (&StorageClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ftpconfig.Table, ftpconfig.FieldID, selector),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ftpconfig.StorageTable, ftpconfig.StorageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FTPConfig entity from the query.
+// Returns a *NotFoundError when no FTPConfig was found.
+func (fcq *FTPConfigQuery) First(ctx context.Context) (*FTPConfig, error) {
+	nodes, err := fcq.Limit(1).All(setContextOp(ctx, fcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ftpconfig.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fcq *FTPConfigQuery) FirstX(ctx context.Context) *FTPConfig {
+	node, err := fcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FTPConfig ID from the query.
+// Returns a *NotFoundError when no FTPConfig ID was found.
+func (fcq *FTPConfigQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fcq.Limit(1).IDs(setContextOp(ctx, fcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ftpconfig.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fcq *FTPConfigQuery) FirstIDX(ctx context.Context) int {
+	id, err := fcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FTPConfig entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FTPConfig entity is found.
+// Returns a *NotFoundError when no FTPConfig entities are found.
+func (fcq *FTPConfigQuery) Only(ctx context.Context) (*FTPConfig, error) {
+	nodes, err := fcq.Limit(2).All(setContextOp(ctx, fcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ftpconfig.Label}
+	default:
+		return nil, &NotSingularError{ftpconfig.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fcq *FTPConfigQuery) OnlyX(ctx context.Context) *FTPConfig {
+	node, err := fcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FTPConfig ID in the query.
+// Returns a *NotSingularError when more than one FTPConfig ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fcq *FTPConfigQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fcq.Limit(2).IDs(setContextOp(ctx, fcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ftpconfig.Label}
+	default:
+		err = &NotSingularError{ftpconfig.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fcq *FTPConfigQuery) OnlyIDX(ctx context.Context) int {
+	id, err := fcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FTPConfigs.
+func (fcq *FTPConfigQuery) All(ctx context.Context) ([]*FTPConfig, error) {
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryAll)
+	if err := fcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FTPConfig, *FTPConfigQuery]()
+	return withInterceptors[[]*FTPConfig](ctx, fcq, qr, fcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fcq *FTPConfigQuery) AllX(ctx context.Context) []*FTPConfig {
+	nodes, err := fcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FTPConfig IDs.
+func (fcq *FTPConfigQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if fcq.ctx.Unique == nil && fcq.path != nil {
+		fcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryIDs)
+	if err = fcq.Select(ftpconfig.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fcq *FTPConfigQuery) IDsX(ctx context.Context) []int {
+	ids, err := fcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fcq *FTPConfigQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryCount)
+	if err := fcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fcq, querierCount[*FTPConfigQuery](), fcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fcq *FTPConfigQuery) CountX(ctx context.Context) int {
+	count, err := fcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fcq *FTPConfigQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryExist)
+	switch _, err := fcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fcq *FTPConfigQuery) ExistX(ctx context.Context) bool {
+	exist, err := fcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FTPConfigQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fcq *FTPConfigQuery) Clone() *FTPConfigQuery {
+	if fcq == nil {
+		return nil
+	}
+	return &FTPConfigQuery{
+		config:      fcq.config,
+		ctx:         fcq.ctx.Clone(),
+		order:       append([]ftpconfig.OrderOption{}, fcq.order...),
+		inters:      append([]Interceptor{}, fcq.inters...),
+		predicates:  append([]predicate.FTPConfig{}, fcq.predicates...),
+		withStorage: fcq.withStorage.Clone(),
+		// clone intermediate query.
+		sql:  fcq.sql.Clone(),
+		path: fcq.path,
+	}
+}
+
+// WithStorage tells the query-builder to eager-load the nodes that are connected to
+// the "storage" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FTPConfigQuery) WithStorage(opts ...func(*StorageQuery)) *FTPConfigQuery {
+	query := (&StorageClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withStorage = query
+	return fcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Host string `json:"host,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FTPConfig.Query().
+//		GroupBy(ftpconfig.FieldHost).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fcq *FTPConfigQuery) GroupBy(field string, fields ...string) *FTPConfigGroupBy {
+	fcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FTPConfigGroupBy{build: fcq}
+	grbuild.flds = &fcq.ctx.Fields
+	grbuild.label = ftpconfig.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Host string `json:"host,omitempty"`
+//	}
+//
+//	client.FTPConfig.Query().
+//		Select(ftpconfig.FieldHost).
+//		Scan(ctx, &v)
+func (fcq *FTPConfigQuery) Select(fields ...string) *FTPConfigSelect {
+	fcq.ctx.Fields = append(fcq.ctx.Fields, fields...)
+	sbuild := &FTPConfigSelect{FTPConfigQuery: fcq}
+	sbuild.label = ftpconfig.Label
+	sbuild.flds, sbuild.scan = &fcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FTPConfigSelect configured with the given aggregations.
+func (fcq *FTPConfigQuery) Aggregate(fns ...AggregateFunc) *FTPConfigSelect {
+	return fcq.Select().Aggregate(fns...)
+}
+
+func (fcq *FTPConfigQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fcq.ctx.Fields {
+		if !ftpconfig.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fcq.path != nil {
+		prev, err := fcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fcq.sql = prev
+	}
+	return nil
+}
+
+func (fcq *FTPConfigQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FTPConfig, error) {
+	var (
+		nodes       = []*FTPConfig{}
+		withFKs     = fcq.withFKs
+		_spec       = fcq.querySpec()
+		loadedTypes = [1]bool{
+			fcq.withStorage != nil,
+		}
+	)
+	if fcq.withStorage != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ftpconfig.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FTPConfig).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FTPConfig{config: fcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := fcq.withStorage; query != nil {
+		if err := fcq.loadStorage(ctx, query, nodes, nil,
+			func(n *FTPConfig, e *Storage) { n.Edges.Storage = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (fcq *FTPConfigQuery) loadStorage(ctx context.Context, query *StorageQuery, nodes []*FTPConfig, init func(*FTPConfig), assign func(*FTPConfig, *Storage)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*FTPConfig)
+	for i := range nodes {
+		if nodes[i].storage_ftp_config == nil {
+			continue
+		}
+		fk := *nodes[i].storage_ftp_config
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(storage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "storage_ftp_config" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (fcq *FTPConfigQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fcq.querySpec()
+	_spec.Node.Columns = fcq.ctx.Fields
+	if len(fcq.ctx.Fields) > 0 {
+		_spec.Unique = fcq.ctx.Unique != nil && *fcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fcq.driver, _spec)
+}
+
+func (fcq *FTPConfigQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ftpconfig.Table, ftpconfig.Columns, sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt))
+	_spec.From = fcq.sql
+	if unique := fcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ftpconfig.FieldID)
+		for i := range fields {
+			if fields[i] != ftpconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := fcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fcq *FTPConfigQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fcq.driver.Dialect())
+	t1 := builder.Table(ftpconfig.Table)
+	columns := fcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ftpconfig.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fcq.sql != nil {
+		selector = fcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fcq.ctx.Unique != nil && *fcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fcq.predicates {
+		p(selector)
+	}
+	for _, p := range fcq.order {
+		p(selector)
+	}
+	if offset := fcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FTPConfigGroupBy is the group-by builder for FTPConfig entities.
+type FTPConfigGroupBy struct {
+	selector
+	build *FTPConfigQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fcgb *FTPConfigGroupBy) Aggregate(fns ...AggregateFunc) *FTPConfigGroupBy {
+	fcgb.fns = append(fcgb.fns, fns...)
+	return fcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fcgb *FTPConfigGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := fcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FTPConfigQuery, *FTPConfigGroupBy](ctx, fcgb.build, fcgb, fcgb.build.inters, v)
+}
+
+func (fcgb *FTPConfigGroupBy) sqlScan(ctx context.Context, root *FTPConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fcgb.fns))
+	for _, fn := range fcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fcgb.flds)+len(fcgb.fns))
+		for _, f := range *fcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FTPConfigSelect is the builder for selecting fields of FTPConfig entities.
+type FTPConfigSelect struct {
+	*FTPConfigQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fcs *FTPConfigSelect) Aggregate(fns ...AggregateFunc) *FTPConfigSelect {
+	fcs.fns = append(fcs.fns, fns...)
+	return fcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fcs *FTPConfigSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fcs.ctx, ent.OpQuerySelect)
+	if err := fcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FTPConfigQuery, *FTPConfigSelect](ctx, fcs.FTPConfigQuery, fcs, fcs.inters, v)
+}
+
+func (fcs *FTPConfigSelect) sqlScan(ctx context.Context, root *FTPConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fcs.fns))
+	for _, fn := range fcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// FTPConfigUpdate is the builder for updating FTPConfig entities.
+type FTPConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FTPConfigMutation
+}
+
+// Where appends a list predicates to the FTPConfigUpdate builder.
+func (fcu *FTPConfigUpdate) Where(ps ...predicate.FTPConfig) *FTPConfigUpdate {
+	fcu.mutation.Where(ps...)
+	return fcu
+}
+
+// SetHost sets the "host" field.
+func (fcu *FTPConfigUpdate) SetHost(s string) *FTPConfigUpdate {
+	fcu.mutation.SetHost(s)
+	return fcu
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillableHost(s *string) *FTPConfigUpdate {
+	if s != nil {
+		fcu.SetHost(*s)
+	}
+	return fcu
+}
+
+// SetPort sets the "port" field.
+func (fcu *FTPConfigUpdate) SetPort(i int) *FTPConfigUpdate {
+	fcu.mutation.ResetPort()
+	fcu.mutation.SetPort(i)
+	return fcu
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillablePort(i *int) *FTPConfigUpdate {
+	if i != nil {
+		fcu.SetPort(*i)
+	}
+	return fcu
+}
+
+// AddPort adds i to the "port" field.
+func (fcu *FTPConfigUpdate) AddPort(i int) *FTPConfigUpdate {
+	fcu.mutation.AddPort(i)
+	return fcu
+}
+
+// SetUsername sets the "username" field.
+func (fcu *FTPConfigUpdate) SetUsername(s string) *FTPConfigUpdate {
+	fcu.mutation.SetUsername(s)
+	return fcu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillableUsername(s *string) *FTPConfigUpdate {
+	if s != nil {
+		fcu.SetUsername(*s)
+	}
+	return fcu
+}
+
+// SetPassword sets the "password" field.
+func (fcu *FTPConfigUpdate) SetPassword(s string) *FTPConfigUpdate {
+	fcu.mutation.SetPassword(s)
+	return fcu
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillablePassword(s *string) *FTPConfigUpdate {
+	if s != nil {
+		fcu.SetPassword(*s)
+	}
+	return fcu
+}
+
+// ClearPassword clears the value of the "password" field.
+func (fcu *FTPConfigUpdate) ClearPassword() *FTPConfigUpdate {
+	fcu.mutation.ClearPassword()
+	return fcu
+}
+
+// SetTLSMode sets the "tls_mode" field.
+func (fcu *FTPConfigUpdate) SetTLSMode(fm ftpconfig.TLSMode) *FTPConfigUpdate {
+	fcu.mutation.SetTLSMode(fm)
+	return fcu
+}
+
+// SetNillableTLSMode sets the "tls_mode" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillableTLSMode(fm *ftpconfig.TLSMode) *FTPConfigUpdate {
+	if fm != nil {
+		fcu.SetTLSMode(*fm)
+	}
+	return fcu
+}
+
+// SetSkipTLSVerify sets the "skip_tls_verify" field.
+func (fcu *FTPConfigUpdate) SetSkipTLSVerify(b bool) *FTPConfigUpdate {
+	fcu.mutation.SetSkipTLSVerify(b)
+	return fcu
+}
+
+// SetNillableSkipTLSVerify sets the "skip_tls_verify" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillableSkipTLSVerify(b *bool) *FTPConfigUpdate {
+	if b != nil {
+		fcu.SetSkipTLSVerify(*b)
+	}
+	return fcu
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (fcu *FTPConfigUpdate) SetBaseDir(s string) *FTPConfigUpdate {
+	fcu.mutation.SetBaseDir(s)
+	return fcu
+}
+
+// SetNillableBaseDir sets the "base_dir" field if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillableBaseDir(s *string) *FTPConfigUpdate {
+	if s != nil {
+		fcu.SetBaseDir(*s)
+	}
+	return fcu
+}
+
+// ClearBaseDir clears the value of the "base_dir" field.
+func (fcu *FTPConfigUpdate) ClearBaseDir() *FTPConfigUpdate {
+	fcu.mutation.ClearBaseDir()
+	return fcu
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (fcu *FTPConfigUpdate) SetStorageID(id int) *FTPConfigUpdate {
+	fcu.mutation.SetStorageID(id)
+	return fcu
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (fcu *FTPConfigUpdate) SetNillableStorageID(id *int) *FTPConfigUpdate {
+	if id != nil {
+		fcu = fcu.SetStorageID(*id)
+	}
+	return fcu
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (fcu *FTPConfigUpdate) SetStorage(s *Storage) *FTPConfigUpdate {
+	return fcu.SetStorageID(s.ID)
+}
+
+// Mutation returns the FTPConfigMutation object of the builder.
+func (fcu *FTPConfigUpdate) Mutation() *FTPConfigMutation {
+	return fcu.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (fcu *FTPConfigUpdate) ClearStorage() *FTPConfigUpdate {
+	fcu.mutation.ClearStorage()
+	return fcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fcu *FTPConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fcu.sqlSave, fcu.mutation, fcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcu *FTPConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := fcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fcu *FTPConfigUpdate) Exec(ctx context.Context) error {
+	_, err := fcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcu *FTPConfigUpdate) ExecX(ctx context.Context) {
+	if err := fcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fcu *FTPConfigUpdate) check() error {
+	if v, ok := fcu.mutation.Port(); ok {
+		if err := ftpconfig.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "FTPConfig.port": %w`, err)}
+		}
+	}
+	if v, ok := fcu.mutation.TLSMode(); ok {
+		if err := ftpconfig.TLSModeValidator(v); err != nil {
+			return &ValidationError{Name: "tls_mode", err: fmt.Errorf(`ent: validator failed for field "FTPConfig.tls_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fcu *FTPConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ftpconfig.Table, ftpconfig.Columns, sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt))
+	if ps := fcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fcu.mutation.Host(); ok {
+		_spec.SetField(ftpconfig.FieldHost, field.TypeString, value)
+	}
+	if value, ok := fcu.mutation.Port(); ok {
+		_spec.SetField(ftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := fcu.mutation.AddedPort(); ok {
+		_spec.AddField(ftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := fcu.mutation.Username(); ok {
+		_spec.SetField(ftpconfig.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := fcu.mutation.Password(); ok {
+		_spec.SetField(ftpconfig.FieldPassword, field.TypeString, value)
+	}
+	if fcu.mutation.PasswordCleared() {
+		_spec.ClearField(ftpconfig.FieldPassword, field.TypeString)
+	}
+	if value, ok := fcu.mutation.TLSMode(); ok {
+		_spec.SetField(ftpconfig.FieldTLSMode, field.TypeEnum, value)
+	}
+	if value, ok := fcu.mutation.SkipTLSVerify(); ok {
+		_spec.SetField(ftpconfig.FieldSkipTLSVerify, field.TypeBool, value)
+	}
+	if value, ok := fcu.mutation.BaseDir(); ok {
+		_spec.SetField(ftpconfig.FieldBaseDir, field.TypeString, value)
+	}
+	if fcu.mutation.BaseDirCleared() {
+		_spec.ClearField(ftpconfig.FieldBaseDir, field.TypeString)
+	}
+	if fcu.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ftpconfig.StorageTable,
+			Columns: []string{ftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ftpconfig.StorageTable,
+			Columns: []string{ftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ftpconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fcu.mutation.done = true
+	return n, nil
+}
+
+// FTPConfigUpdateOne is the builder for updating a single FTPConfig entity.
+type FTPConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FTPConfigMutation
+}
+
+// SetHost sets the "host" field.
+func (fcuo *FTPConfigUpdateOne) SetHost(s string) *FTPConfigUpdateOne {
+	fcuo.mutation.SetHost(s)
+	return fcuo
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillableHost(s *string) *FTPConfigUpdateOne {
+	if s != nil {
+		fcuo.SetHost(*s)
+	}
+	return fcuo
+}
+
+// SetPort sets the "port" field.
+func (fcuo *FTPConfigUpdateOne) SetPort(i int) *FTPConfigUpdateOne {
+	fcuo.mutation.ResetPort()
+	fcuo.mutation.SetPort(i)
+	return fcuo
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillablePort(i *int) *FTPConfigUpdateOne {
+	if i != nil {
+		fcuo.SetPort(*i)
+	}
+	return fcuo
+}
+
+// AddPort adds i to the "port" field.
+func (fcuo *FTPConfigUpdateOne) AddPort(i int) *FTPConfigUpdateOne {
+	fcuo.mutation.AddPort(i)
+	return fcuo
+}
+
+// SetUsername sets the "username" field.
+func (fcuo *FTPConfigUpdateOne) SetUsername(s string) *FTPConfigUpdateOne {
+	fcuo.mutation.SetUsername(s)
+	return fcuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillableUsername(s *string) *FTPConfigUpdateOne {
+	if s != nil {
+		fcuo.SetUsername(*s)
+	}
+	return fcuo
+}
+
+// SetPassword sets the "password" field.
+func (fcuo *FTPConfigUpdateOne) SetPassword(s string) *FTPConfigUpdateOne {
+	fcuo.mutation.SetPassword(s)
+	return fcuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillablePassword(s *string) *FTPConfigUpdateOne {
+	if s != nil {
+		fcuo.SetPassword(*s)
+	}
+	return fcuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (fcuo *FTPConfigUpdateOne) ClearPassword() *FTPConfigUpdateOne {
+	fcuo.mutation.ClearPassword()
+	return fcuo
+}
+
+// SetTLSMode sets the "tls_mode" field.
+func (fcuo *FTPConfigUpdateOne) SetTLSMode(fm ftpconfig.TLSMode) *FTPConfigUpdateOne {
+	fcuo.mutation.SetTLSMode(fm)
+	return fcuo
+}
+
+// SetNillableTLSMode sets the "tls_mode" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillableTLSMode(fm *ftpconfig.TLSMode) *FTPConfigUpdateOne {
+	if fm != nil {
+		fcuo.SetTLSMode(*fm)
+	}
+	return fcuo
+}
+
+// SetSkipTLSVerify sets the "skip_tls_verify" field.
+func (fcuo *FTPConfigUpdateOne) SetSkipTLSVerify(b bool) *FTPConfigUpdateOne {
+	fcuo.mutation.SetSkipTLSVerify(b)
+	return fcuo
+}
+
+// SetNillableSkipTLSVerify sets the "skip_tls_verify" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillableSkipTLSVerify(b *bool) *FTPConfigUpdateOne {
+	if b != nil {
+		fcuo.SetSkipTLSVerify(*b)
+	}
+	return fcuo
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (fcuo *FTPConfigUpdateOne) SetBaseDir(s string) *FTPConfigUpdateOne {
+	fcuo.mutation.SetBaseDir(s)
+	return fcuo
+}
+
+// SetNillableBaseDir sets the "base_dir" field if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillableBaseDir(s *string) *FTPConfigUpdateOne {
+	if s != nil {
+		fcuo.SetBaseDir(*s)
+	}
+	return fcuo
+}
+
+// ClearBaseDir clears the value of the "base_dir" field.
+func (fcuo *FTPConfigUpdateOne) ClearBaseDir() *FTPConfigUpdateOne {
+	fcuo.mutation.ClearBaseDir()
+	return fcuo
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (fcuo *FTPConfigUpdateOne) SetStorageID(id int) *FTPConfigUpdateOne {
+	fcuo.mutation.SetStorageID(id)
+	return fcuo
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (fcuo *FTPConfigUpdateOne) SetNillableStorageID(id *int) *FTPConfigUpdateOne {
+	if id != nil {
+		fcuo = fcuo.SetStorageID(*id)
+	}
+	return fcuo
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (fcuo *FTPConfigUpdateOne) SetStorage(s *Storage) *FTPConfigUpdateOne {
+	return fcuo.SetStorageID(s.ID)
+}
+
+// Mutation returns the FTPConfigMutation object of the builder.
+func (fcuo *FTPConfigUpdateOne) Mutation() *FTPConfigMutation {
+	return fcuo.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (fcuo *FTPConfigUpdateOne) ClearStorage() *FTPConfigUpdateOne {
+	fcuo.mutation.ClearStorage()
+	return fcuo
+}
+
+// Where appends a list predicates to the FTPConfigUpdate builder.
+func (fcuo *FTPConfigUpdateOne) Where(ps ...predicate.FTPConfig) *FTPConfigUpdateOne {
+	fcuo.mutation.Where(ps...)
+	return fcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fcuo *FTPConfigUpdateOne) Select(field string, fields ...string) *FTPConfigUpdateOne {
+	fcuo.fields = append([]string{field}, fields...)
+	return fcuo
+}
+
+// Save executes the query and returns the updated FTPConfig entity.
+func (fcuo *FTPConfigUpdateOne) Save(ctx context.Context) (*FTPConfig, error) {
+	return withHooks(ctx, fcuo.sqlSave, fcuo.mutation, fcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcuo *FTPConfigUpdateOne) SaveX(ctx context.Context) *FTPConfig {
+	node, err := fcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fcuo *FTPConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := fcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcuo *FTPConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := fcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fcuo *FTPConfigUpdateOne) check() error {
+	if v, ok := fcuo.mutation.Port(); ok {
+		if err := ftpconfig.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "FTPConfig.port": %w`, err)}
+		}
+	}
+	if v, ok := fcuo.mutation.TLSMode(); ok {
+		if err := ftpconfig.TLSModeValidator(v); err != nil {
+			return &ValidationError{Name: "tls_mode", err: fmt.Errorf(`ent: validator failed for field "FTPConfig.tls_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fcuo *FTPConfigUpdateOne) sqlSave(ctx context.Context) (_node *FTPConfig, err error) {
+	if err := fcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ftpconfig.Table, ftpconfig.Columns, sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt))
+	id, ok := fcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FTPConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ftpconfig.FieldID)
+		for _, f := range fields {
+			if !ftpconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ftpconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fcuo.mutation.Host(); ok {
+		_spec.SetField(ftpconfig.FieldHost, field.TypeString, value)
+	}
+	if value, ok := fcuo.mutation.Port(); ok {
+		_spec.SetField(ftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := fcuo.mutation.AddedPort(); ok {
+		_spec.AddField(ftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := fcuo.mutation.Username(); ok {
+		_spec.SetField(ftpconfig.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := fcuo.mutation.Password(); ok {
+		_spec.SetField(ftpconfig.FieldPassword, field.TypeString, value)
+	}
+	if fcuo.mutation.PasswordCleared() {
+		_spec.ClearField(ftpconfig.FieldPassword, field.TypeString)
+	}
+	if value, ok := fcuo.mutation.TLSMode(); ok {
+		_spec.SetField(ftpconfig.FieldTLSMode, field.TypeEnum, value)
+	}
+	if value, ok := fcuo.mutation.SkipTLSVerify(); ok {
+		_spec.SetField(ftpconfig.FieldSkipTLSVerify, field.TypeBool, value)
+	}
+	if value, ok := fcuo.mutation.BaseDir(); ok {
+		_spec.SetField(ftpconfig.FieldBaseDir, field.TypeString, value)
+	}
+	if fcuo.mutation.BaseDirCleared() {
+		_spec.ClearField(ftpconfig.FieldBaseDir, field.TypeString)
+	}
+	if fcuo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ftpconfig.StorageTable,
+			Columns: []string{ftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ftpconfig.StorageTable,
+			Columns: []string{ftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FTPConfig{config: fcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ftpconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// GCSConfig is the model entity for the GCSConfig schema.
+type GCSConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Bucket holds the value of the "bucket" field.
+	Bucket string `json:"bucket,omitempty"`
+	// CredentialsJSON holds the value of the "credentials_json" field.
+	CredentialsJSON string `json:"-"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// StorageClass holds the value of the "storage_class" field.
+	StorageClass gcsconfig.StorageClass `json:"storage_class,omitempty"`
+	// RetentionDays holds the value of the "retention_days" field.
+	RetentionDays int `json:"retention_days,omitempty"`
+	// RetentionMode holds the value of the "retention_mode" field.
+	RetentionMode gcsconfig.RetentionMode `json:"retention_mode,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GCSConfigQuery when eager-loading is set.
+	Edges              GCSConfigEdges `json:"edges"`
+	storage_gcs_config *int
+	selectValues       sql.SelectValues
+}
+
+// GCSConfigEdges holds the relations/edges for other nodes in the graph.
+type GCSConfigEdges struct {
+	// Storage holds the value of the storage edge.
+	Storage *Storage `json:"storage,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StorageOrErr returns the Storage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GCSConfigEdges) StorageOrErr() (*Storage, error) {
+	if e.Storage != nil {
+		return e.Storage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: storage.Label}
+	}
+	return nil, &NotLoadedError{edge: "storage"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GCSConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gcsconfig.FieldID, gcsconfig.FieldRetentionDays:
+			values[i] = new(sql.NullInt64)
+		case gcsconfig.FieldBucket, gcsconfig.FieldCredentialsJSON, gcsconfig.FieldEndpoint, gcsconfig.FieldStorageClass, gcsconfig.FieldRetentionMode:
+			values[i] = new(sql.NullString)
+		case gcsconfig.ForeignKeys[0]: // storage_gcs_config
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GCSConfig fields.
+func (gc *GCSConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gcsconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gc.ID = int(value.Int64)
+		case gcsconfig.FieldBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				gc.Bucket = value.String
+			}
+		case gcsconfig.FieldCredentialsJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credentials_json", values[i])
+			} else if value.Valid {
+				gc.CredentialsJSON = value.String
+			}
+		case gcsconfig.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				gc.Endpoint = value.String
+			}
+		case gcsconfig.FieldStorageClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_class", values[i])
+			} else if value.Valid {
+				gc.StorageClass = gcsconfig.StorageClass(value.String)
+			}
+		case gcsconfig.FieldRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_days", values[i])
+			} else if value.Valid {
+				gc.RetentionDays = int(value.Int64)
+			}
+		case gcsconfig.FieldRetentionMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_mode", values[i])
+			} else if value.Valid {
+				gc.RetentionMode = gcsconfig.RetentionMode(value.String)
+			}
+		case gcsconfig.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field storage_gcs_config", value)
+			} else if value.Valid {
+				gc.storage_gcs_config = new(int)
+				*gc.storage_gcs_config = int(value.Int64)
+			}
+		default:
+			gc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GCSConfig.
+// This includes values selected through modifiers, order, etc.
+func (gc *GCSConfig) Value(name string) (ent.Value, error) {
+	return gc.selectValues.Get(name)
+}
+
+// QueryStorage queries the "storage" edge of the GCSConfig entity.
+func (gc *GCSConfig) QueryStorage() *StorageQuery {
+	return NewGCSConfigClient(gc.config).QueryStorage(gc)
+}
+
+// Update returns a builder for updating this GCSConfig.
+// Note that you need to call GCSConfig.Unwrap() before calling this method if this GCSConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gc *GCSConfig) Update() *GCSConfigUpdateOne {
+	return NewGCSConfigClient(gc.config).UpdateOne(gc)
+}
+
+// Unwrap unwraps the GCSConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gc *GCSConfig) Unwrap() *GCSConfig {
+	_tx, ok := gc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GCSConfig is not a transactional entity")
+	}
+	gc.config.driver = _tx.drv
+	return gc
+}
+
+// String implements the fmt.Stringer.
+func (gc *GCSConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("GCSConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gc.ID))
+	builder.WriteString("bucket=")
+	builder.WriteString(gc.Bucket)
+	builder.WriteString(", ")
+	builder.WriteString("credentials_json=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(gc.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("storage_class=")
+	builder.WriteString(fmt.Sprintf("%v", gc.StorageClass))
+	builder.WriteString(", ")
+	builder.WriteString("retention_days=")
+	builder.WriteString(fmt.Sprintf("%v", gc.RetentionDays))
+	builder.WriteString(", ")
+	builder.WriteString("retention_mode=")
+	builder.WriteString(fmt.Sprintf("%v", gc.RetentionMode))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GCSConfigs is a parsable slice of GCSConfig.
+type GCSConfigs []*GCSConfig
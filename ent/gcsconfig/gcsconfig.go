@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gcsconfig
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the gcsconfig type in the database.
+	Label = "gcs_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBucket holds the string denoting the bucket field in the database.
+	FieldBucket = "bucket"
+	// FieldCredentialsJSON holds the string denoting the credentials_json field in the database.
+	FieldCredentialsJSON = "credentials_json"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldStorageClass holds the string denoting the storage_class field in the database.
+	FieldStorageClass = "storage_class"
+	// FieldRetentionDays holds the string denoting the retention_days field in the database.
+	FieldRetentionDays = "retention_days"
+	// FieldRetentionMode holds the string denoting the retention_mode field in the database.
+	FieldRetentionMode = "retention_mode"
+	// EdgeStorage holds the string denoting the storage edge name in mutations.
+	EdgeStorage = "storage"
+	// Table holds the table name of the gcsconfig in the database.
+	Table = "gcs_configs"
+	// StorageTable is the table that holds the storage relation/edge.
+	StorageTable = "gcs_configs"
+	// StorageInverseTable is the table name for the Storage entity.
+	// It exists in this package in order to avoid circular dependency with the "storage" package.
+	StorageInverseTable = "storages"
+	// StorageColumn is the table column denoting the storage relation/edge.
+	StorageColumn = "storage_gcs_config"
+)
+
+// Columns holds all SQL columns for gcsconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldBucket,
+	FieldCredentialsJSON,
+	FieldEndpoint,
+	FieldStorageClass,
+	FieldRetentionDays,
+	FieldRetentionMode,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "gcs_configs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"storage_gcs_config",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRetentionDays holds the default value on creation for the "retention_days" field.
+	DefaultRetentionDays int
+	// RetentionDaysValidator is a validator for the "retention_days" field. It is called by the builders before save.
+	RetentionDaysValidator func(int) error
+)
+
+// StorageClass defines the type for the "storage_class" enum field.
+type StorageClass string
+
+// StorageClass values.
+const (
+	StorageClassSTANDARD StorageClass = "STANDARD"
+	StorageClassNEARLINE StorageClass = "NEARLINE"
+	StorageClassCOLDLINE StorageClass = "COLDLINE"
+	StorageClassARCHIVE  StorageClass = "ARCHIVE"
+)
+
+func (sc StorageClass) String() string {
+	return string(sc)
+}
+
+// StorageClassValidator is a validator for the "storage_class" field enum values. It is called by the builders before save.
+func StorageClassValidator(sc StorageClass) error {
+	switch sc {
+	case StorageClassSTANDARD, StorageClassNEARLINE, StorageClassCOLDLINE, StorageClassARCHIVE:
+		return nil
+	default:
+		return fmt.Errorf("gcsconfig: invalid enum value for storage_class field: %q", sc)
+	}
+}
+
+// RetentionMode defines the type for the "retention_mode" enum field.
+type RetentionMode string
+
+// RetentionModeUnlocked is the default value of the RetentionMode enum.
+const DefaultRetentionMode = RetentionModeUnlocked
+
+// RetentionMode values.
+const (
+	RetentionModeUnlocked RetentionMode = "Unlocked"
+	RetentionModeLocked   RetentionMode = "Locked"
+)
+
+func (rm RetentionMode) String() string {
+	return string(rm)
+}
+
+// RetentionModeValidator is a validator for the "retention_mode" field enum values. It is called by the builders before save.
+func RetentionModeValidator(rm RetentionMode) error {
+	switch rm {
+	case RetentionModeUnlocked, RetentionModeLocked:
+		return nil
+	default:
+		return fmt.Errorf("gcsconfig: invalid enum value for retention_mode field: %q", rm)
+	}
+}
+
+// OrderOption defines the ordering options for the GCSConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBucket orders the results by the bucket field.
+func ByBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucket, opts...).ToFunc()
+}
+
+// ByCredentialsJSON orders the results by the credentials_json field.
+func ByCredentialsJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredentialsJSON, opts...).ToFunc()
+}
+
+// ByEndpoint orders the results by the endpoint field.
+func ByEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpoint, opts...).ToFunc()
+}
+
+// ByStorageClass orders the results by the storage_class field.
+func ByStorageClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageClass, opts...).ToFunc()
+}
+
+// ByRetentionDays orders the results by the retention_days field.
+func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
+}
+
+// ByRetentionMode orders the results by the retention_mode field.
+func ByRetentionMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionMode, opts...).ToFunc()
+}
+
+// ByStorageField orders the results by storage field.
+func ByStorageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStorageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStorageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StorageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+	)
+}
@@ -0,0 +1,417 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gcsconfig
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLTE(FieldID, id))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldBucket, v))
+}
+
+// CredentialsJSON applies equality check predicate on the "credentials_json" field. It's identical to CredentialsJSONEQ.
+func CredentialsJSON(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldCredentialsJSON, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// RetentionDays applies equality check predicate on the "retention_days" field. It's identical to RetentionDaysEQ.
+func RetentionDays(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLTE(FieldBucket, v))
+}
+
+// BucketContains applies the Contains predicate on the "bucket" field.
+func BucketContains(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldContains(FieldBucket, v))
+}
+
+// BucketHasPrefix applies the HasPrefix predicate on the "bucket" field.
+func BucketHasPrefix(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldHasPrefix(FieldBucket, v))
+}
+
+// BucketHasSuffix applies the HasSuffix predicate on the "bucket" field.
+func BucketHasSuffix(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldHasSuffix(FieldBucket, v))
+}
+
+// BucketEqualFold applies the EqualFold predicate on the "bucket" field.
+func BucketEqualFold(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEqualFold(FieldBucket, v))
+}
+
+// BucketContainsFold applies the ContainsFold predicate on the "bucket" field.
+func BucketContainsFold(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldContainsFold(FieldBucket, v))
+}
+
+// CredentialsJSONEQ applies the EQ predicate on the "credentials_json" field.
+func CredentialsJSONEQ(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONNEQ applies the NEQ predicate on the "credentials_json" field.
+func CredentialsJSONNEQ(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONIn applies the In predicate on the "credentials_json" field.
+func CredentialsJSONIn(vs ...string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldCredentialsJSON, vs...))
+}
+
+// CredentialsJSONNotIn applies the NotIn predicate on the "credentials_json" field.
+func CredentialsJSONNotIn(vs ...string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldCredentialsJSON, vs...))
+}
+
+// CredentialsJSONGT applies the GT predicate on the "credentials_json" field.
+func CredentialsJSONGT(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGT(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONGTE applies the GTE predicate on the "credentials_json" field.
+func CredentialsJSONGTE(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGTE(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONLT applies the LT predicate on the "credentials_json" field.
+func CredentialsJSONLT(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLT(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONLTE applies the LTE predicate on the "credentials_json" field.
+func CredentialsJSONLTE(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLTE(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONContains applies the Contains predicate on the "credentials_json" field.
+func CredentialsJSONContains(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldContains(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONHasPrefix applies the HasPrefix predicate on the "credentials_json" field.
+func CredentialsJSONHasPrefix(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldHasPrefix(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONHasSuffix applies the HasSuffix predicate on the "credentials_json" field.
+func CredentialsJSONHasSuffix(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldHasSuffix(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONIsNil applies the IsNil predicate on the "credentials_json" field.
+func CredentialsJSONIsNil() predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIsNull(FieldCredentialsJSON))
+}
+
+// CredentialsJSONNotNil applies the NotNil predicate on the "credentials_json" field.
+func CredentialsJSONNotNil() predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotNull(FieldCredentialsJSON))
+}
+
+// CredentialsJSONEqualFold applies the EqualFold predicate on the "credentials_json" field.
+func CredentialsJSONEqualFold(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEqualFold(FieldCredentialsJSON, v))
+}
+
+// CredentialsJSONContainsFold applies the ContainsFold predicate on the "credentials_json" field.
+func CredentialsJSONContainsFold(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldContainsFold(FieldCredentialsJSON, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointIsNil applies the IsNil predicate on the "endpoint" field.
+func EndpointIsNil() predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIsNull(FieldEndpoint))
+}
+
+// EndpointNotNil applies the NotNil predicate on the "endpoint" field.
+func EndpointNotNil() predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotNull(FieldEndpoint))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// StorageClassEQ applies the EQ predicate on the "storage_class" field.
+func StorageClassEQ(v StorageClass) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldStorageClass, v))
+}
+
+// StorageClassNEQ applies the NEQ predicate on the "storage_class" field.
+func StorageClassNEQ(v StorageClass) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldStorageClass, v))
+}
+
+// StorageClassIn applies the In predicate on the "storage_class" field.
+func StorageClassIn(vs ...StorageClass) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldStorageClass, vs...))
+}
+
+// StorageClassNotIn applies the NotIn predicate on the "storage_class" field.
+func StorageClassNotIn(vs ...StorageClass) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldStorageClass, vs...))
+}
+
+// StorageClassIsNil applies the IsNil predicate on the "storage_class" field.
+func StorageClassIsNil() predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIsNull(FieldStorageClass))
+}
+
+// StorageClassNotNil applies the NotNil predicate on the "storage_class" field.
+func StorageClassNotNil() predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotNull(FieldStorageClass))
+}
+
+// RetentionDaysEQ applies the EQ predicate on the "retention_days" field.
+func RetentionDaysEQ(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysNEQ applies the NEQ predicate on the "retention_days" field.
+func RetentionDaysNEQ(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldRetentionDays, v))
+}
+
+// RetentionDaysIn applies the In predicate on the "retention_days" field.
+func RetentionDaysIn(vs ...int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysNotIn applies the NotIn predicate on the "retention_days" field.
+func RetentionDaysNotIn(vs ...int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldRetentionDays, vs...))
+}
+
+// RetentionDaysGT applies the GT predicate on the "retention_days" field.
+func RetentionDaysGT(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGT(FieldRetentionDays, v))
+}
+
+// RetentionDaysGTE applies the GTE predicate on the "retention_days" field.
+func RetentionDaysGTE(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldGTE(FieldRetentionDays, v))
+}
+
+// RetentionDaysLT applies the LT predicate on the "retention_days" field.
+func RetentionDaysLT(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLT(FieldRetentionDays, v))
+}
+
+// RetentionDaysLTE applies the LTE predicate on the "retention_days" field.
+func RetentionDaysLTE(v int) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldLTE(FieldRetentionDays, v))
+}
+
+// RetentionModeEQ applies the EQ predicate on the "retention_mode" field.
+func RetentionModeEQ(v RetentionMode) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldEQ(FieldRetentionMode, v))
+}
+
+// RetentionModeNEQ applies the NEQ predicate on the "retention_mode" field.
+func RetentionModeNEQ(v RetentionMode) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNEQ(FieldRetentionMode, v))
+}
+
+// RetentionModeIn applies the In predicate on the "retention_mode" field.
+func RetentionModeIn(vs ...RetentionMode) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldIn(FieldRetentionMode, vs...))
+}
+
+// RetentionModeNotIn applies the NotIn predicate on the "retention_mode" field.
+func RetentionModeNotIn(vs ...RetentionMode) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.FieldNotIn(FieldRetentionMode, vs...))
+}
+
+// HasStorage applies the HasEdge predicate on the "storage" edge.
+func HasStorage() predicate.GCSConfig {
+	return predicate.GCSConfig(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStorageWith applies the HasEdge predicate on the "storage" edge with a given conditions (other predicates).
+func HasStorageWith(preds ...predicate.Storage) predicate.GCSConfig {
+	return predicate.GCSConfig(func(s *sql.Selector) {
+		step := newStorageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GCSConfig) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GCSConfig) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GCSConfig) predicate.GCSConfig {
+	return predicate.GCSConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// GCSConfigCreate is the builder for creating a GCSConfig entity.
+type GCSConfigCreate struct {
+	config
+	mutation *GCSConfigMutation
+	hooks    []Hook
+}
+
+// SetBucket sets the "bucket" field.
+func (gcc *GCSConfigCreate) SetBucket(s string) *GCSConfigCreate {
+	gcc.mutation.SetBucket(s)
+	return gcc
+}
+
+// SetCredentialsJSON sets the "credentials_json" field.
+func (gcc *GCSConfigCreate) SetCredentialsJSON(s string) *GCSConfigCreate {
+	gcc.mutation.SetCredentialsJSON(s)
+	return gcc
+}
+
+// SetNillableCredentialsJSON sets the "credentials_json" field if the given value is not nil.
+func (gcc *GCSConfigCreate) SetNillableCredentialsJSON(s *string) *GCSConfigCreate {
+	if s != nil {
+		gcc.SetCredentialsJSON(*s)
+	}
+	return gcc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (gcc *GCSConfigCreate) SetEndpoint(s string) *GCSConfigCreate {
+	gcc.mutation.SetEndpoint(s)
+	return gcc
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (gcc *GCSConfigCreate) SetNillableEndpoint(s *string) *GCSConfigCreate {
+	if s != nil {
+		gcc.SetEndpoint(*s)
+	}
+	return gcc
+}
+
+// SetStorageClass sets the "storage_class" field.
+func (gcc *GCSConfigCreate) SetStorageClass(gc gcsconfig.StorageClass) *GCSConfigCreate {
+	gcc.mutation.SetStorageClass(gc)
+	return gcc
+}
+
+// SetNillableStorageClass sets the "storage_class" field if the given value is not nil.
+func (gcc *GCSConfigCreate) SetNillableStorageClass(gc *gcsconfig.StorageClass) *GCSConfigCreate {
+	if gc != nil {
+		gcc.SetStorageClass(*gc)
+	}
+	return gcc
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (gcc *GCSConfigCreate) SetRetentionDays(i int) *GCSConfigCreate {
+	gcc.mutation.SetRetentionDays(i)
+	return gcc
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (gcc *GCSConfigCreate) SetNillableRetentionDays(i *int) *GCSConfigCreate {
+	if i != nil {
+		gcc.SetRetentionDays(*i)
+	}
+	return gcc
+}
+
+// SetRetentionMode sets the "retention_mode" field.
+func (gcc *GCSConfigCreate) SetRetentionMode(gm gcsconfig.RetentionMode) *GCSConfigCreate {
+	gcc.mutation.SetRetentionMode(gm)
+	return gcc
+}
+
+// SetNillableRetentionMode sets the "retention_mode" field if the given value is not nil.
+func (gcc *GCSConfigCreate) SetNillableRetentionMode(gm *gcsconfig.RetentionMode) *GCSConfigCreate {
+	if gm != nil {
+		gcc.SetRetentionMode(*gm)
+	}
+	return gcc
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (gcc *GCSConfigCreate) SetStorageID(id int) *GCSConfigCreate {
+	gcc.mutation.SetStorageID(id)
+	return gcc
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (gcc *GCSConfigCreate) SetNillableStorageID(id *int) *GCSConfigCreate {
+	if id != nil {
+		gcc = gcc.SetStorageID(*id)
+	}
+	return gcc
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (gcc *GCSConfigCreate) SetStorage(s *Storage) *GCSConfigCreate {
+	return gcc.SetStorageID(s.ID)
+}
+
+// Mutation returns the GCSConfigMutation object of the builder.
+func (gcc *GCSConfigCreate) Mutation() *GCSConfigMutation {
+	return gcc.mutation
+}
+
+// Save creates the GCSConfig in the database.
+func (gcc *GCSConfigCreate) Save(ctx context.Context) (*GCSConfig, error) {
+	gcc.defaults()
+	return withHooks(ctx, gcc.sqlSave, gcc.mutation, gcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gcc *GCSConfigCreate) SaveX(ctx context.Context) *GCSConfig {
+	v, err := gcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gcc *GCSConfigCreate) Exec(ctx context.Context) error {
+	_, err := gcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcc *GCSConfigCreate) ExecX(ctx context.Context) {
+	if err := gcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gcc *GCSConfigCreate) defaults() {
+	if _, ok := gcc.mutation.RetentionDays(); !ok {
+		v := gcsconfig.DefaultRetentionDays
+		gcc.mutation.SetRetentionDays(v)
+	}
+	if _, ok := gcc.mutation.RetentionMode(); !ok {
+		v := gcsconfig.DefaultRetentionMode
+		gcc.mutation.SetRetentionMode(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gcc *GCSConfigCreate) check() error {
+	if _, ok := gcc.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "GCSConfig.bucket"`)}
+	}
+	if v, ok := gcc.mutation.StorageClass(); ok {
+		if err := gcsconfig.StorageClassValidator(v); err != nil {
+			return &ValidationError{Name: "storage_class", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.storage_class": %w`, err)}
+		}
+	}
+	if _, ok := gcc.mutation.RetentionDays(); !ok {
+		return &ValidationError{Name: "retention_days", err: errors.New(`ent: missing required field "GCSConfig.retention_days"`)}
+	}
+	if v, ok := gcc.mutation.RetentionDays(); ok {
+		if err := gcsconfig.RetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "retention_days", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.retention_days": %w`, err)}
+		}
+	}
+	if _, ok := gcc.mutation.RetentionMode(); !ok {
+		return &ValidationError{Name: "retention_mode", err: errors.New(`ent: missing required field "GCSConfig.retention_mode"`)}
+	}
+	if v, ok := gcc.mutation.RetentionMode(); ok {
+		if err := gcsconfig.RetentionModeValidator(v); err != nil {
+			return &ValidationError{Name: "retention_mode", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.retention_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gcc *GCSConfigCreate) sqlSave(ctx context.Context) (*GCSConfig, error) {
+	if err := gcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gcc.mutation.id = &_node.ID
+	gcc.mutation.done = true
+	return _node, nil
+}
+
+func (gcc *GCSConfigCreate) createSpec() (*GCSConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GCSConfig{config: gcc.config}
+		_spec = sqlgraph.NewCreateSpec(gcsconfig.Table, sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt))
+	)
+	if value, ok := gcc.mutation.Bucket(); ok {
+		_spec.SetField(gcsconfig.FieldBucket, field.TypeString, value)
+		_node.Bucket = value
+	}
+	if value, ok := gcc.mutation.CredentialsJSON(); ok {
+		_spec.SetField(gcsconfig.FieldCredentialsJSON, field.TypeString, value)
+		_node.CredentialsJSON = value
+	}
+	if value, ok := gcc.mutation.Endpoint(); ok {
+		_spec.SetField(gcsconfig.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := gcc.mutation.StorageClass(); ok {
+		_spec.SetField(gcsconfig.FieldStorageClass, field.TypeEnum, value)
+		_node.StorageClass = value
+	}
+	if value, ok := gcc.mutation.RetentionDays(); ok {
+		_spec.SetField(gcsconfig.FieldRetentionDays, field.TypeInt, value)
+		_node.RetentionDays = value
+	}
+	if value, ok := gcc.mutation.RetentionMode(); ok {
+		_spec.SetField(gcsconfig.FieldRetentionMode, field.TypeEnum, value)
+		_node.RetentionMode = value
+	}
+	if nodes := gcc.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gcsconfig.StorageTable,
+			Columns: []string{gcsconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.storage_gcs_config = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GCSConfigCreateBulk is the builder for creating many GCSConfig entities in bulk.
+type GCSConfigCreateBulk struct {
+	config
+	err      error
+	builders []*GCSConfigCreate
+}
+
+// Save creates the GCSConfig entities in the database.
+func (gccb *GCSConfigCreateBulk) Save(ctx context.Context) ([]*GCSConfig, error) {
+	if gccb.err != nil {
+		return nil, gccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gccb.builders))
+	nodes := make([]*GCSConfig, len(gccb.builders))
+	mutators := make([]Mutator, len(gccb.builders))
+	for i := range gccb.builders {
+		func(i int, root context.Context) {
+			builder := gccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GCSConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gccb *GCSConfigCreateBulk) SaveX(ctx context.Context) []*GCSConfig {
+	v, err := gccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gccb *GCSConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := gccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gccb *GCSConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := gccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// GCSConfigDelete is the builder for deleting a GCSConfig entity.
+type GCSConfigDelete struct {
+	config
+	hooks    []Hook
+	mutation *GCSConfigMutation
+}
+
+// Where appends a list predicates to the GCSConfigDelete builder.
+func (gcd *GCSConfigDelete) Where(ps ...predicate.GCSConfig) *GCSConfigDelete {
+	gcd.mutation.Where(ps...)
+	return gcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gcd *GCSConfigDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gcd.sqlExec, gcd.mutation, gcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcd *GCSConfigDelete) ExecX(ctx context.Context) int {
+	n, err := gcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gcd *GCSConfigDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gcsconfig.Table, sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt))
+	if ps := gcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gcd.mutation.done = true
+	return affected, err
+}
+
+// GCSConfigDeleteOne is the builder for deleting a single GCSConfig entity.
+type GCSConfigDeleteOne struct {
+	gcd *GCSConfigDelete
+}
+
+// Where appends a list predicates to the GCSConfigDelete builder.
+func (gcdo *GCSConfigDeleteOne) Where(ps ...predicate.GCSConfig) *GCSConfigDeleteOne {
+	gcdo.gcd.mutation.Where(ps...)
+	return gcdo
+}
+
+// Exec executes the deletion query.
+func (gcdo *GCSConfigDeleteOne) Exec(ctx context.Context) error {
+	n, err := gcdo.gcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gcsconfig.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcdo *GCSConfigDeleteOne) ExecX(ctx context.Context) {
+	if err := gcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// GCSConfigQuery is the builder for querying GCSConfig entities.
+type GCSConfigQuery struct {
+	config
+	ctx         *QueryContext
+	order       []gcsconfig.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.GCSConfig
+	withStorage *StorageQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GCSConfigQuery builder.
+func (gcq *GCSConfigQuery) Where(ps ...predicate.GCSConfig) *GCSConfigQuery {
+	gcq.predicates = append(gcq.predicates, ps...)
+	return gcq
+}
+
+// Limit the number of records to be returned by this query.
+func (gcq *GCSConfigQuery) Limit(limit int) *GCSConfigQuery {
+	gcq.ctx.Limit = &limit
+	return gcq
+}
+
+// Offset to start from.
+func (gcq *GCSConfigQuery) Offset(offset int) *GCSConfigQuery {
+	gcq.ctx.Offset = &offset
+	return gcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gcq *GCSConfigQuery) Unique(unique bool) *GCSConfigQuery {
+	gcq.ctx.Unique = &unique
+	return gcq
+}
+
+// Order specifies how the records should be ordered.
+func (gcq *GCSConfigQuery) Order(o ...gcsconfig.OrderOption) *GCSConfigQuery {
+	gcq.order = append(gcq.order, o...)
+	return gcq
+}
+
+// QueryStorage chains the current query on the "storage" edge.
+func (gcq *GCSConfigQuery) QueryStorage() *StorageQuery {
+	query := (&StorageClient{config: gcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gcsconfig.Table, gcsconfig.FieldID, selector),
+			sqlgraph.To(storage.Table, storage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, gcsconfig.StorageTable, gcsconfig.StorageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GCSConfig entity from the query.
+// Returns a *NotFoundError when no GCSConfig was found.
+func (gcq *GCSConfigQuery) First(ctx context.Context) (*GCSConfig, error) {
+	nodes, err := gcq.Limit(1).All(setContextOp(ctx, gcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{gcsconfig.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gcq *GCSConfigQuery) FirstX(ctx context.Context) *GCSConfig {
+	node, err := gcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GCSConfig ID from the query.
+// Returns a *NotFoundError when no GCSConfig ID was found.
+func (gcq *GCSConfigQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gcq.Limit(1).IDs(setContextOp(ctx, gcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{gcsconfig.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gcq *GCSConfigQuery) FirstIDX(ctx context.Context) int {
+	id, err := gcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GCSConfig entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GCSConfig entity is found.
+// Returns a *NotFoundError when no GCSConfig entities are found.
+func (gcq *GCSConfigQuery) Only(ctx context.Context) (*GCSConfig, error) {
+	nodes, err := gcq.Limit(2).All(setContextOp(ctx, gcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{gcsconfig.Label}
+	default:
+		return nil, &NotSingularError{gcsconfig.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gcq *GCSConfigQuery) OnlyX(ctx context.Context) *GCSConfig {
+	node, err := gcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GCSConfig ID in the query.
+// Returns a *NotSingularError when more than one GCSConfig ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gcq *GCSConfigQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gcq.Limit(2).IDs(setContextOp(ctx, gcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{gcsconfig.Label}
+	default:
+		err = &NotSingularError{gcsconfig.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gcq *GCSConfigQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GCSConfigs.
+func (gcq *GCSConfigQuery) All(ctx context.Context) ([]*GCSConfig, error) {
+	ctx = setContextOp(ctx, gcq.ctx, ent.OpQueryAll)
+	if err := gcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GCSConfig, *GCSConfigQuery]()
+	return withInterceptors[[]*GCSConfig](ctx, gcq, qr, gcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gcq *GCSConfigQuery) AllX(ctx context.Context) []*GCSConfig {
+	nodes, err := gcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GCSConfig IDs.
+func (gcq *GCSConfigQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gcq.ctx.Unique == nil && gcq.path != nil {
+		gcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gcq.ctx, ent.OpQueryIDs)
+	if err = gcq.Select(gcsconfig.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gcq *GCSConfigQuery) IDsX(ctx context.Context) []int {
+	ids, err := gcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gcq *GCSConfigQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gcq.ctx, ent.OpQueryCount)
+	if err := gcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gcq, querierCount[*GCSConfigQuery](), gcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gcq *GCSConfigQuery) CountX(ctx context.Context) int {
+	count, err := gcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gcq *GCSConfigQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gcq.ctx, ent.OpQueryExist)
+	switch _, err := gcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gcq *GCSConfigQuery) ExistX(ctx context.Context) bool {
+	exist, err := gcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GCSConfigQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gcq *GCSConfigQuery) Clone() *GCSConfigQuery {
+	if gcq == nil {
+		return nil
+	}
+	return &GCSConfigQuery{
+		config:      gcq.config,
+		ctx:         gcq.ctx.Clone(),
+		order:       append([]gcsconfig.OrderOption{}, gcq.order...),
+		inters:      append([]Interceptor{}, gcq.inters...),
+		predicates:  append([]predicate.GCSConfig{}, gcq.predicates...),
+		withStorage: gcq.withStorage.Clone(),
+		// clone intermediate query.
+		sql:  gcq.sql.Clone(),
+		path: gcq.path,
+	}
+}
+
+// WithStorage tells the query-builder to eager-load the nodes that are connected to
+// the "storage" edge. The optional arguments are used to configure the query builder of the edge.
+func (gcq *GCSConfigQuery) WithStorage(opts ...func(*StorageQuery)) *GCSConfigQuery {
+	query := (&StorageClient{config: gcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gcq.withStorage = query
+	return gcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Bucket string `json:"bucket,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GCSConfig.Query().
+//		GroupBy(gcsconfig.FieldBucket).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gcq *GCSConfigQuery) GroupBy(field string, fields ...string) *GCSConfigGroupBy {
+	gcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GCSConfigGroupBy{build: gcq}
+	grbuild.flds = &gcq.ctx.Fields
+	grbuild.label = gcsconfig.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Bucket string `json:"bucket,omitempty"`
+//	}
+//
+//	client.GCSConfig.Query().
+//		Select(gcsconfig.FieldBucket).
+//		Scan(ctx, &v)
+func (gcq *GCSConfigQuery) Select(fields ...string) *GCSConfigSelect {
+	gcq.ctx.Fields = append(gcq.ctx.Fields, fields...)
+	sbuild := &GCSConfigSelect{GCSConfigQuery: gcq}
+	sbuild.label = gcsconfig.Label
+	sbuild.flds, sbuild.scan = &gcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GCSConfigSelect configured with the given aggregations.
+func (gcq *GCSConfigQuery) Aggregate(fns ...AggregateFunc) *GCSConfigSelect {
+	return gcq.Select().Aggregate(fns...)
+}
+
+func (gcq *GCSConfigQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gcq.ctx.Fields {
+		if !gcsconfig.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gcq.path != nil {
+		prev, err := gcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gcq.sql = prev
+	}
+	return nil
+}
+
+func (gcq *GCSConfigQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GCSConfig, error) {
+	var (
+		nodes       = []*GCSConfig{}
+		withFKs     = gcq.withFKs
+		_spec       = gcq.querySpec()
+		loadedTypes = [1]bool{
+			gcq.withStorage != nil,
+		}
+	)
+	if gcq.withStorage != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, gcsconfig.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GCSConfig).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GCSConfig{config: gcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gcq.withStorage; query != nil {
+		if err := gcq.loadStorage(ctx, query, nodes, nil,
+			func(n *GCSConfig, e *Storage) { n.Edges.Storage = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gcq *GCSConfigQuery) loadStorage(ctx context.Context, query *StorageQuery, nodes []*GCSConfig, init func(*GCSConfig), assign func(*GCSConfig, *Storage)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GCSConfig)
+	for i := range nodes {
+		if nodes[i].storage_gcs_config == nil {
+			continue
+		}
+		fk := *nodes[i].storage_gcs_config
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(storage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "storage_gcs_config" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (gcq *GCSConfigQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gcq.querySpec()
+	_spec.Node.Columns = gcq.ctx.Fields
+	if len(gcq.ctx.Fields) > 0 {
+		_spec.Unique = gcq.ctx.Unique != nil && *gcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gcq.driver, _spec)
+}
+
+func (gcq *GCSConfigQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(gcsconfig.Table, gcsconfig.Columns, sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt))
+	_spec.From = gcq.sql
+	if unique := gcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gcsconfig.FieldID)
+		for i := range fields {
+			if fields[i] != gcsconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gcq *GCSConfigQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gcq.driver.Dialect())
+	t1 := builder.Table(gcsconfig.Table)
+	columns := gcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = gcsconfig.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gcq.sql != nil {
+		selector = gcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gcq.ctx.Unique != nil && *gcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gcq.predicates {
+		p(selector)
+	}
+	for _, p := range gcq.order {
+		p(selector)
+	}
+	if offset := gcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GCSConfigGroupBy is the group-by builder for GCSConfig entities.
+type GCSConfigGroupBy struct {
+	selector
+	build *GCSConfigQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gcgb *GCSConfigGroupBy) Aggregate(fns ...AggregateFunc) *GCSConfigGroupBy {
+	gcgb.fns = append(gcgb.fns, fns...)
+	return gcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gcgb *GCSConfigGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GCSConfigQuery, *GCSConfigGroupBy](ctx, gcgb.build, gcgb, gcgb.build.inters, v)
+}
+
+func (gcgb *GCSConfigGroupBy) sqlScan(ctx context.Context, root *GCSConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gcgb.fns))
+	for _, fn := range gcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gcgb.flds)+len(gcgb.fns))
+		for _, f := range *gcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GCSConfigSelect is the builder for selecting fields of GCSConfig entities.
+type GCSConfigSelect struct {
+	*GCSConfigQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gcs *GCSConfigSelect) Aggregate(fns ...AggregateFunc) *GCSConfigSelect {
+	gcs.fns = append(gcs.fns, fns...)
+	return gcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gcs *GCSConfigSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gcs.ctx, ent.OpQuerySelect)
+	if err := gcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GCSConfigQuery, *GCSConfigSelect](ctx, gcs.GCSConfigQuery, gcs, gcs.inters, v)
+}
+
+func (gcs *GCSConfigSelect) sqlScan(ctx context.Context, root *GCSConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gcs.fns))
+	for _, fn := range gcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
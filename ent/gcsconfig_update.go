@@ -0,0 +1,608 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// GCSConfigUpdate is the builder for updating GCSConfig entities.
+type GCSConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GCSConfigMutation
+}
+
+// Where appends a list predicates to the GCSConfigUpdate builder.
+func (gcu *GCSConfigUpdate) Where(ps ...predicate.GCSConfig) *GCSConfigUpdate {
+	gcu.mutation.Where(ps...)
+	return gcu
+}
+
+// SetBucket sets the "bucket" field.
+func (gcu *GCSConfigUpdate) SetBucket(s string) *GCSConfigUpdate {
+	gcu.mutation.SetBucket(s)
+	return gcu
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableBucket(s *string) *GCSConfigUpdate {
+	if s != nil {
+		gcu.SetBucket(*s)
+	}
+	return gcu
+}
+
+// SetCredentialsJSON sets the "credentials_json" field.
+func (gcu *GCSConfigUpdate) SetCredentialsJSON(s string) *GCSConfigUpdate {
+	gcu.mutation.SetCredentialsJSON(s)
+	return gcu
+}
+
+// SetNillableCredentialsJSON sets the "credentials_json" field if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableCredentialsJSON(s *string) *GCSConfigUpdate {
+	if s != nil {
+		gcu.SetCredentialsJSON(*s)
+	}
+	return gcu
+}
+
+// ClearCredentialsJSON clears the value of the "credentials_json" field.
+func (gcu *GCSConfigUpdate) ClearCredentialsJSON() *GCSConfigUpdate {
+	gcu.mutation.ClearCredentialsJSON()
+	return gcu
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (gcu *GCSConfigUpdate) SetEndpoint(s string) *GCSConfigUpdate {
+	gcu.mutation.SetEndpoint(s)
+	return gcu
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableEndpoint(s *string) *GCSConfigUpdate {
+	if s != nil {
+		gcu.SetEndpoint(*s)
+	}
+	return gcu
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (gcu *GCSConfigUpdate) ClearEndpoint() *GCSConfigUpdate {
+	gcu.mutation.ClearEndpoint()
+	return gcu
+}
+
+// SetStorageClass sets the "storage_class" field.
+func (gcu *GCSConfigUpdate) SetStorageClass(gc gcsconfig.StorageClass) *GCSConfigUpdate {
+	gcu.mutation.SetStorageClass(gc)
+	return gcu
+}
+
+// SetNillableStorageClass sets the "storage_class" field if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableStorageClass(gc *gcsconfig.StorageClass) *GCSConfigUpdate {
+	if gc != nil {
+		gcu.SetStorageClass(*gc)
+	}
+	return gcu
+}
+
+// ClearStorageClass clears the value of the "storage_class" field.
+func (gcu *GCSConfigUpdate) ClearStorageClass() *GCSConfigUpdate {
+	gcu.mutation.ClearStorageClass()
+	return gcu
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (gcu *GCSConfigUpdate) SetRetentionDays(i int) *GCSConfigUpdate {
+	gcu.mutation.ResetRetentionDays()
+	gcu.mutation.SetRetentionDays(i)
+	return gcu
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableRetentionDays(i *int) *GCSConfigUpdate {
+	if i != nil {
+		gcu.SetRetentionDays(*i)
+	}
+	return gcu
+}
+
+// AddRetentionDays adds i to the "retention_days" field.
+func (gcu *GCSConfigUpdate) AddRetentionDays(i int) *GCSConfigUpdate {
+	gcu.mutation.AddRetentionDays(i)
+	return gcu
+}
+
+// SetRetentionMode sets the "retention_mode" field.
+func (gcu *GCSConfigUpdate) SetRetentionMode(gm gcsconfig.RetentionMode) *GCSConfigUpdate {
+	gcu.mutation.SetRetentionMode(gm)
+	return gcu
+}
+
+// SetNillableRetentionMode sets the "retention_mode" field if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableRetentionMode(gm *gcsconfig.RetentionMode) *GCSConfigUpdate {
+	if gm != nil {
+		gcu.SetRetentionMode(*gm)
+	}
+	return gcu
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (gcu *GCSConfigUpdate) SetStorageID(id int) *GCSConfigUpdate {
+	gcu.mutation.SetStorageID(id)
+	return gcu
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (gcu *GCSConfigUpdate) SetNillableStorageID(id *int) *GCSConfigUpdate {
+	if id != nil {
+		gcu = gcu.SetStorageID(*id)
+	}
+	return gcu
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (gcu *GCSConfigUpdate) SetStorage(s *Storage) *GCSConfigUpdate {
+	return gcu.SetStorageID(s.ID)
+}
+
+// Mutation returns the GCSConfigMutation object of the builder.
+func (gcu *GCSConfigUpdate) Mutation() *GCSConfigMutation {
+	return gcu.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (gcu *GCSConfigUpdate) ClearStorage() *GCSConfigUpdate {
+	gcu.mutation.ClearStorage()
+	return gcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gcu *GCSConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gcu.sqlSave, gcu.mutation, gcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcu *GCSConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := gcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gcu *GCSConfigUpdate) Exec(ctx context.Context) error {
+	_, err := gcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcu *GCSConfigUpdate) ExecX(ctx context.Context) {
+	if err := gcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gcu *GCSConfigUpdate) check() error {
+	if v, ok := gcu.mutation.StorageClass(); ok {
+		if err := gcsconfig.StorageClassValidator(v); err != nil {
+			return &ValidationError{Name: "storage_class", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.storage_class": %w`, err)}
+		}
+	}
+	if v, ok := gcu.mutation.RetentionDays(); ok {
+		if err := gcsconfig.RetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "retention_days", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.retention_days": %w`, err)}
+		}
+	}
+	if v, ok := gcu.mutation.RetentionMode(); ok {
+		if err := gcsconfig.RetentionModeValidator(v); err != nil {
+			return &ValidationError{Name: "retention_mode", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.retention_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gcu *GCSConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gcsconfig.Table, gcsconfig.Columns, sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt))
+	if ps := gcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gcu.mutation.Bucket(); ok {
+		_spec.SetField(gcsconfig.FieldBucket, field.TypeString, value)
+	}
+	if value, ok := gcu.mutation.CredentialsJSON(); ok {
+		_spec.SetField(gcsconfig.FieldCredentialsJSON, field.TypeString, value)
+	}
+	if gcu.mutation.CredentialsJSONCleared() {
+		_spec.ClearField(gcsconfig.FieldCredentialsJSON, field.TypeString)
+	}
+	if value, ok := gcu.mutation.Endpoint(); ok {
+		_spec.SetField(gcsconfig.FieldEndpoint, field.TypeString, value)
+	}
+	if gcu.mutation.EndpointCleared() {
+		_spec.ClearField(gcsconfig.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := gcu.mutation.StorageClass(); ok {
+		_spec.SetField(gcsconfig.FieldStorageClass, field.TypeEnum, value)
+	}
+	if gcu.mutation.StorageClassCleared() {
+		_spec.ClearField(gcsconfig.FieldStorageClass, field.TypeEnum)
+	}
+	if value, ok := gcu.mutation.RetentionDays(); ok {
+		_spec.SetField(gcsconfig.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := gcu.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(gcsconfig.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := gcu.mutation.RetentionMode(); ok {
+		_spec.SetField(gcsconfig.FieldRetentionMode, field.TypeEnum, value)
+	}
+	if gcu.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gcsconfig.StorageTable,
+			Columns: []string{gcsconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gcu.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gcsconfig.StorageTable,
+			Columns: []string{gcsconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gcsconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gcu.mutation.done = true
+	return n, nil
+}
+
+// GCSConfigUpdateOne is the builder for updating a single GCSConfig entity.
+type GCSConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GCSConfigMutation
+}
+
+// SetBucket sets the "bucket" field.
+func (gcuo *GCSConfigUpdateOne) SetBucket(s string) *GCSConfigUpdateOne {
+	gcuo.mutation.SetBucket(s)
+	return gcuo
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableBucket(s *string) *GCSConfigUpdateOne {
+	if s != nil {
+		gcuo.SetBucket(*s)
+	}
+	return gcuo
+}
+
+// SetCredentialsJSON sets the "credentials_json" field.
+func (gcuo *GCSConfigUpdateOne) SetCredentialsJSON(s string) *GCSConfigUpdateOne {
+	gcuo.mutation.SetCredentialsJSON(s)
+	return gcuo
+}
+
+// SetNillableCredentialsJSON sets the "credentials_json" field if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableCredentialsJSON(s *string) *GCSConfigUpdateOne {
+	if s != nil {
+		gcuo.SetCredentialsJSON(*s)
+	}
+	return gcuo
+}
+
+// ClearCredentialsJSON clears the value of the "credentials_json" field.
+func (gcuo *GCSConfigUpdateOne) ClearCredentialsJSON() *GCSConfigUpdateOne {
+	gcuo.mutation.ClearCredentialsJSON()
+	return gcuo
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (gcuo *GCSConfigUpdateOne) SetEndpoint(s string) *GCSConfigUpdateOne {
+	gcuo.mutation.SetEndpoint(s)
+	return gcuo
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableEndpoint(s *string) *GCSConfigUpdateOne {
+	if s != nil {
+		gcuo.SetEndpoint(*s)
+	}
+	return gcuo
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (gcuo *GCSConfigUpdateOne) ClearEndpoint() *GCSConfigUpdateOne {
+	gcuo.mutation.ClearEndpoint()
+	return gcuo
+}
+
+// SetStorageClass sets the "storage_class" field.
+func (gcuo *GCSConfigUpdateOne) SetStorageClass(gc gcsconfig.StorageClass) *GCSConfigUpdateOne {
+	gcuo.mutation.SetStorageClass(gc)
+	return gcuo
+}
+
+// SetNillableStorageClass sets the "storage_class" field if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableStorageClass(gc *gcsconfig.StorageClass) *GCSConfigUpdateOne {
+	if gc != nil {
+		gcuo.SetStorageClass(*gc)
+	}
+	return gcuo
+}
+
+// ClearStorageClass clears the value of the "storage_class" field.
+func (gcuo *GCSConfigUpdateOne) ClearStorageClass() *GCSConfigUpdateOne {
+	gcuo.mutation.ClearStorageClass()
+	return gcuo
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (gcuo *GCSConfigUpdateOne) SetRetentionDays(i int) *GCSConfigUpdateOne {
+	gcuo.mutation.ResetRetentionDays()
+	gcuo.mutation.SetRetentionDays(i)
+	return gcuo
+}
+
+// SetNillableRetentionDays sets the "retention_days" field if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableRetentionDays(i *int) *GCSConfigUpdateOne {
+	if i != nil {
+		gcuo.SetRetentionDays(*i)
+	}
+	return gcuo
+}
+
+// AddRetentionDays adds i to the "retention_days" field.
+func (gcuo *GCSConfigUpdateOne) AddRetentionDays(i int) *GCSConfigUpdateOne {
+	gcuo.mutation.AddRetentionDays(i)
+	return gcuo
+}
+
+// SetRetentionMode sets the "retention_mode" field.
+func (gcuo *GCSConfigUpdateOne) SetRetentionMode(gm gcsconfig.RetentionMode) *GCSConfigUpdateOne {
+	gcuo.mutation.SetRetentionMode(gm)
+	return gcuo
+}
+
+// SetNillableRetentionMode sets the "retention_mode" field if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableRetentionMode(gm *gcsconfig.RetentionMode) *GCSConfigUpdateOne {
+	if gm != nil {
+		gcuo.SetRetentionMode(*gm)
+	}
+	return gcuo
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (gcuo *GCSConfigUpdateOne) SetStorageID(id int) *GCSConfigUpdateOne {
+	gcuo.mutation.SetStorageID(id)
+	return gcuo
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (gcuo *GCSConfigUpdateOne) SetNillableStorageID(id *int) *GCSConfigUpdateOne {
+	if id != nil {
+		gcuo = gcuo.SetStorageID(*id)
+	}
+	return gcuo
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (gcuo *GCSConfigUpdateOne) SetStorage(s *Storage) *GCSConfigUpdateOne {
+	return gcuo.SetStorageID(s.ID)
+}
+
+// Mutation returns the GCSConfigMutation object of the builder.
+func (gcuo *GCSConfigUpdateOne) Mutation() *GCSConfigMutation {
+	return gcuo.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (gcuo *GCSConfigUpdateOne) ClearStorage() *GCSConfigUpdateOne {
+	gcuo.mutation.ClearStorage()
+	return gcuo
+}
+
+// Where appends a list predicates to the GCSConfigUpdate builder.
+func (gcuo *GCSConfigUpdateOne) Where(ps ...predicate.GCSConfig) *GCSConfigUpdateOne {
+	gcuo.mutation.Where(ps...)
+	return gcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gcuo *GCSConfigUpdateOne) Select(field string, fields ...string) *GCSConfigUpdateOne {
+	gcuo.fields = append([]string{field}, fields...)
+	return gcuo
+}
+
+// Save executes the query and returns the updated GCSConfig entity.
+func (gcuo *GCSConfigUpdateOne) Save(ctx context.Context) (*GCSConfig, error) {
+	return withHooks(ctx, gcuo.sqlSave, gcuo.mutation, gcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcuo *GCSConfigUpdateOne) SaveX(ctx context.Context) *GCSConfig {
+	node, err := gcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gcuo *GCSConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := gcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcuo *GCSConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := gcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gcuo *GCSConfigUpdateOne) check() error {
+	if v, ok := gcuo.mutation.StorageClass(); ok {
+		if err := gcsconfig.StorageClassValidator(v); err != nil {
+			return &ValidationError{Name: "storage_class", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.storage_class": %w`, err)}
+		}
+	}
+	if v, ok := gcuo.mutation.RetentionDays(); ok {
+		if err := gcsconfig.RetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "retention_days", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.retention_days": %w`, err)}
+		}
+	}
+	if v, ok := gcuo.mutation.RetentionMode(); ok {
+		if err := gcsconfig.RetentionModeValidator(v); err != nil {
+			return &ValidationError{Name: "retention_mode", err: fmt.Errorf(`ent: validator failed for field "GCSConfig.retention_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gcuo *GCSConfigUpdateOne) sqlSave(ctx context.Context) (_node *GCSConfig, err error) {
+	if err := gcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gcsconfig.Table, gcsconfig.Columns, sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt))
+	id, ok := gcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GCSConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gcsconfig.FieldID)
+		for _, f := range fields {
+			if !gcsconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gcsconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gcuo.mutation.Bucket(); ok {
+		_spec.SetField(gcsconfig.FieldBucket, field.TypeString, value)
+	}
+	if value, ok := gcuo.mutation.CredentialsJSON(); ok {
+		_spec.SetField(gcsconfig.FieldCredentialsJSON, field.TypeString, value)
+	}
+	if gcuo.mutation.CredentialsJSONCleared() {
+		_spec.ClearField(gcsconfig.FieldCredentialsJSON, field.TypeString)
+	}
+	if value, ok := gcuo.mutation.Endpoint(); ok {
+		_spec.SetField(gcsconfig.FieldEndpoint, field.TypeString, value)
+	}
+	if gcuo.mutation.EndpointCleared() {
+		_spec.ClearField(gcsconfig.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := gcuo.mutation.StorageClass(); ok {
+		_spec.SetField(gcsconfig.FieldStorageClass, field.TypeEnum, value)
+	}
+	if gcuo.mutation.StorageClassCleared() {
+		_spec.ClearField(gcsconfig.FieldStorageClass, field.TypeEnum)
+	}
+	if value, ok := gcuo.mutation.RetentionDays(); ok {
+		_spec.SetField(gcsconfig.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := gcuo.mutation.AddedRetentionDays(); ok {
+		_spec.AddField(gcsconfig.FieldRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := gcuo.mutation.RetentionMode(); ok {
+		_spec.SetField(gcsconfig.FieldRetentionMode, field.TypeEnum, value)
+	}
+	if gcuo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gcsconfig.StorageTable,
+			Columns: []string{gcsconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gcuo.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   gcsconfig.StorageTable,
+			Columns: []string{gcsconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GCSConfig{config: gcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gcsconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gcuo.mutation.done = true
+	return _node, nil
+}
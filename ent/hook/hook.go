@@ -9,6 +9,54 @@ import (
 	"github.com/ca-x/vaultwarden-syncer/ent"
 )
 
+// The AzureBlobConfigFunc type is an adapter to allow the use of ordinary
+// function as AzureBlobConfig mutator.
+type AzureBlobConfigFunc func(context.Context, *ent.AzureBlobConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AzureBlobConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AzureBlobConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AzureBlobConfigMutation", m)
+}
+
+// The FTPConfigFunc type is an adapter to allow the use of ordinary
+// function as FTPConfig mutator.
+type FTPConfigFunc func(context.Context, *ent.FTPConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FTPConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FTPConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FTPConfigMutation", m)
+}
+
+// The GCSConfigFunc type is an adapter to allow the use of ordinary
+// function as GCSConfig mutator.
+type GCSConfigFunc func(context.Context, *ent.GCSConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GCSConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GCSConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GCSConfigMutation", m)
+}
+
+// The LocalConfigFunc type is an adapter to allow the use of ordinary
+// function as LocalConfig mutator.
+type LocalConfigFunc func(context.Context, *ent.LocalConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LocalConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LocalConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LocalConfigMutation", m)
+}
+
 // The S3ConfigFunc type is an adapter to allow the use of ordinary
 // function as S3Config mutator.
 type S3ConfigFunc func(context.Context, *ent.S3ConfigMutation) (ent.Value, error)
@@ -21,6 +69,30 @@ func (f S3ConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.S3ConfigMutation", m)
 }
 
+// The SFTPConfigFunc type is an adapter to allow the use of ordinary
+// function as SFTPConfig mutator.
+type SFTPConfigFunc func(context.Context, *ent.SFTPConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SFTPConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SFTPConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SFTPConfigMutation", m)
+}
+
+// The SourceFunc type is an adapter to allow the use of ordinary
+// function as Source mutator.
+type SourceFunc func(context.Context, *ent.SourceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SourceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SourceMutation", m)
+}
+
 // The StorageFunc type is an adapter to allow the use of ordinary
 // function as Storage mutator.
 type StorageFunc func(context.Context, *ent.StorageMutation) (ent.Value, error)
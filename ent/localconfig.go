@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// LocalConfig is the model entity for the LocalConfig schema.
+type LocalConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// MinFreeSpaceMB holds the value of the "min_free_space_mb" field.
+	MinFreeSpaceMB int64 `json:"min_free_space_mb,omitempty"`
+	// SyncDirectory holds the value of the "sync_directory" field.
+	SyncDirectory bool `json:"sync_directory,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LocalConfigQuery when eager-loading is set.
+	Edges                LocalConfigEdges `json:"edges"`
+	storage_local_config *int
+	selectValues         sql.SelectValues
+}
+
+// LocalConfigEdges holds the relations/edges for other nodes in the graph.
+type LocalConfigEdges struct {
+	// Storage holds the value of the storage edge.
+	Storage *Storage `json:"storage,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StorageOrErr returns the Storage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LocalConfigEdges) StorageOrErr() (*Storage, error) {
+	if e.Storage != nil {
+		return e.Storage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: storage.Label}
+	}
+	return nil, &NotLoadedError{edge: "storage"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LocalConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case localconfig.FieldSyncDirectory:
+			values[i] = new(sql.NullBool)
+		case localconfig.FieldID, localconfig.FieldMinFreeSpaceMB:
+			values[i] = new(sql.NullInt64)
+		case localconfig.FieldPath:
+			values[i] = new(sql.NullString)
+		case localconfig.ForeignKeys[0]: // storage_local_config
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LocalConfig fields.
+func (lc *LocalConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case localconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lc.ID = int(value.Int64)
+		case localconfig.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				lc.Path = value.String
+			}
+		case localconfig.FieldMinFreeSpaceMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_free_space_mb", values[i])
+			} else if value.Valid {
+				lc.MinFreeSpaceMB = value.Int64
+			}
+		case localconfig.FieldSyncDirectory:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_directory", values[i])
+			} else if value.Valid {
+				lc.SyncDirectory = value.Bool
+			}
+		case localconfig.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field storage_local_config", value)
+			} else if value.Valid {
+				lc.storage_local_config = new(int)
+				*lc.storage_local_config = int(value.Int64)
+			}
+		default:
+			lc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LocalConfig.
+// This includes values selected through modifiers, order, etc.
+func (lc *LocalConfig) Value(name string) (ent.Value, error) {
+	return lc.selectValues.Get(name)
+}
+
+// QueryStorage queries the "storage" edge of the LocalConfig entity.
+func (lc *LocalConfig) QueryStorage() *StorageQuery {
+	return NewLocalConfigClient(lc.config).QueryStorage(lc)
+}
+
+// Update returns a builder for updating this LocalConfig.
+// Note that you need to call LocalConfig.Unwrap() before calling this method if this LocalConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lc *LocalConfig) Update() *LocalConfigUpdateOne {
+	return NewLocalConfigClient(lc.config).UpdateOne(lc)
+}
+
+// Unwrap unwraps the LocalConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lc *LocalConfig) Unwrap() *LocalConfig {
+	_tx, ok := lc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LocalConfig is not a transactional entity")
+	}
+	lc.config.driver = _tx.drv
+	return lc
+}
+
+// String implements the fmt.Stringer.
+func (lc *LocalConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("LocalConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lc.ID))
+	builder.WriteString("path=")
+	builder.WriteString(lc.Path)
+	builder.WriteString(", ")
+	builder.WriteString("min_free_space_mb=")
+	builder.WriteString(fmt.Sprintf("%v", lc.MinFreeSpaceMB))
+	builder.WriteString(", ")
+	builder.WriteString("sync_directory=")
+	builder.WriteString(fmt.Sprintf("%v", lc.SyncDirectory))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LocalConfigs is a parsable slice of LocalConfig.
+type LocalConfigs []*LocalConfig
@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package localconfig
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the localconfig type in the database.
+	Label = "local_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldMinFreeSpaceMB holds the string denoting the min_free_space_mb field in the database.
+	FieldMinFreeSpaceMB = "min_free_space_mb"
+	// FieldSyncDirectory holds the string denoting the sync_directory field in the database.
+	FieldSyncDirectory = "sync_directory"
+	// EdgeStorage holds the string denoting the storage edge name in mutations.
+	EdgeStorage = "storage"
+	// Table holds the table name of the localconfig in the database.
+	Table = "local_configs"
+	// StorageTable is the table that holds the storage relation/edge.
+	StorageTable = "local_configs"
+	// StorageInverseTable is the table name for the Storage entity.
+	// It exists in this package in order to avoid circular dependency with the "storage" package.
+	StorageInverseTable = "storages"
+	// StorageColumn is the table column denoting the storage relation/edge.
+	StorageColumn = "storage_local_config"
+)
+
+// Columns holds all SQL columns for localconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldPath,
+	FieldMinFreeSpaceMB,
+	FieldSyncDirectory,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "local_configs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"storage_local_config",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMinFreeSpaceMB holds the default value on creation for the "min_free_space_mb" field.
+	DefaultMinFreeSpaceMB int64
+	// MinFreeSpaceMBValidator is a validator for the "min_free_space_mb" field. It is called by the builders before save.
+	MinFreeSpaceMBValidator func(int64) error
+	// DefaultSyncDirectory holds the default value on creation for the "sync_directory" field.
+	DefaultSyncDirectory bool
+)
+
+// OrderOption defines the ordering options for the LocalConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByMinFreeSpaceMB orders the results by the min_free_space_mb field.
+func ByMinFreeSpaceMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinFreeSpaceMB, opts...).ToFunc()
+}
+
+// BySyncDirectory orders the results by the sync_directory field.
+func BySyncDirectory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncDirectory, opts...).ToFunc()
+}
+
+// ByStorageField orders the results by storage field.
+func ByStorageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStorageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStorageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StorageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+	)
+}
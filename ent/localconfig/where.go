@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package localconfig
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldLTE(FieldID, id))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldPath, v))
+}
+
+// MinFreeSpaceMB applies equality check predicate on the "min_free_space_mb" field. It's identical to MinFreeSpaceMBEQ.
+func MinFreeSpaceMB(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldMinFreeSpaceMB, v))
+}
+
+// SyncDirectory applies equality check predicate on the "sync_directory" field. It's identical to SyncDirectoryEQ.
+func SyncDirectory(v bool) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldSyncDirectory, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldContainsFold(FieldPath, v))
+}
+
+// MinFreeSpaceMBEQ applies the EQ predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBEQ(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldMinFreeSpaceMB, v))
+}
+
+// MinFreeSpaceMBNEQ applies the NEQ predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBNEQ(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNEQ(FieldMinFreeSpaceMB, v))
+}
+
+// MinFreeSpaceMBIn applies the In predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBIn(vs ...int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldIn(FieldMinFreeSpaceMB, vs...))
+}
+
+// MinFreeSpaceMBNotIn applies the NotIn predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBNotIn(vs ...int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNotIn(FieldMinFreeSpaceMB, vs...))
+}
+
+// MinFreeSpaceMBGT applies the GT predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBGT(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldGT(FieldMinFreeSpaceMB, v))
+}
+
+// MinFreeSpaceMBGTE applies the GTE predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBGTE(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldGTE(FieldMinFreeSpaceMB, v))
+}
+
+// MinFreeSpaceMBLT applies the LT predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBLT(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldLT(FieldMinFreeSpaceMB, v))
+}
+
+// MinFreeSpaceMBLTE applies the LTE predicate on the "min_free_space_mb" field.
+func MinFreeSpaceMBLTE(v int64) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldLTE(FieldMinFreeSpaceMB, v))
+}
+
+// SyncDirectoryEQ applies the EQ predicate on the "sync_directory" field.
+func SyncDirectoryEQ(v bool) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldEQ(FieldSyncDirectory, v))
+}
+
+// SyncDirectoryNEQ applies the NEQ predicate on the "sync_directory" field.
+func SyncDirectoryNEQ(v bool) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.FieldNEQ(FieldSyncDirectory, v))
+}
+
+// HasStorage applies the HasEdge predicate on the "storage" edge.
+func HasStorage() predicate.LocalConfig {
+	return predicate.LocalConfig(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStorageWith applies the HasEdge predicate on the "storage" edge with a given conditions (other predicates).
+func HasStorageWith(preds ...predicate.Storage) predicate.LocalConfig {
+	return predicate.LocalConfig(func(s *sql.Selector) {
+		step := newStorageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LocalConfig) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LocalConfig) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LocalConfig) predicate.LocalConfig {
+	return predicate.LocalConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// LocalConfigCreate is the builder for creating a LocalConfig entity.
+type LocalConfigCreate struct {
+	config
+	mutation *LocalConfigMutation
+	hooks    []Hook
+}
+
+// SetPath sets the "path" field.
+func (lcc *LocalConfigCreate) SetPath(s string) *LocalConfigCreate {
+	lcc.mutation.SetPath(s)
+	return lcc
+}
+
+// SetMinFreeSpaceMB sets the "min_free_space_mb" field.
+func (lcc *LocalConfigCreate) SetMinFreeSpaceMB(i int64) *LocalConfigCreate {
+	lcc.mutation.SetMinFreeSpaceMB(i)
+	return lcc
+}
+
+// SetNillableMinFreeSpaceMB sets the "min_free_space_mb" field if the given value is not nil.
+func (lcc *LocalConfigCreate) SetNillableMinFreeSpaceMB(i *int64) *LocalConfigCreate {
+	if i != nil {
+		lcc.SetMinFreeSpaceMB(*i)
+	}
+	return lcc
+}
+
+// SetSyncDirectory sets the "sync_directory" field.
+func (lcc *LocalConfigCreate) SetSyncDirectory(b bool) *LocalConfigCreate {
+	lcc.mutation.SetSyncDirectory(b)
+	return lcc
+}
+
+// SetNillableSyncDirectory sets the "sync_directory" field if the given value is not nil.
+func (lcc *LocalConfigCreate) SetNillableSyncDirectory(b *bool) *LocalConfigCreate {
+	if b != nil {
+		lcc.SetSyncDirectory(*b)
+	}
+	return lcc
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (lcc *LocalConfigCreate) SetStorageID(id int) *LocalConfigCreate {
+	lcc.mutation.SetStorageID(id)
+	return lcc
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (lcc *LocalConfigCreate) SetNillableStorageID(id *int) *LocalConfigCreate {
+	if id != nil {
+		lcc = lcc.SetStorageID(*id)
+	}
+	return lcc
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (lcc *LocalConfigCreate) SetStorage(s *Storage) *LocalConfigCreate {
+	return lcc.SetStorageID(s.ID)
+}
+
+// Mutation returns the LocalConfigMutation object of the builder.
+func (lcc *LocalConfigCreate) Mutation() *LocalConfigMutation {
+	return lcc.mutation
+}
+
+// Save creates the LocalConfig in the database.
+func (lcc *LocalConfigCreate) Save(ctx context.Context) (*LocalConfig, error) {
+	lcc.defaults()
+	return withHooks(ctx, lcc.sqlSave, lcc.mutation, lcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lcc *LocalConfigCreate) SaveX(ctx context.Context) *LocalConfig {
+	v, err := lcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcc *LocalConfigCreate) Exec(ctx context.Context) error {
+	_, err := lcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcc *LocalConfigCreate) ExecX(ctx context.Context) {
+	if err := lcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lcc *LocalConfigCreate) defaults() {
+	if _, ok := lcc.mutation.MinFreeSpaceMB(); !ok {
+		v := localconfig.DefaultMinFreeSpaceMB
+		lcc.mutation.SetMinFreeSpaceMB(v)
+	}
+	if _, ok := lcc.mutation.SyncDirectory(); !ok {
+		v := localconfig.DefaultSyncDirectory
+		lcc.mutation.SetSyncDirectory(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lcc *LocalConfigCreate) check() error {
+	if _, ok := lcc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "LocalConfig.path"`)}
+	}
+	if _, ok := lcc.mutation.MinFreeSpaceMB(); !ok {
+		return &ValidationError{Name: "min_free_space_mb", err: errors.New(`ent: missing required field "LocalConfig.min_free_space_mb"`)}
+	}
+	if v, ok := lcc.mutation.MinFreeSpaceMB(); ok {
+		if err := localconfig.MinFreeSpaceMBValidator(v); err != nil {
+			return &ValidationError{Name: "min_free_space_mb", err: fmt.Errorf(`ent: validator failed for field "LocalConfig.min_free_space_mb": %w`, err)}
+		}
+	}
+	if _, ok := lcc.mutation.SyncDirectory(); !ok {
+		return &ValidationError{Name: "sync_directory", err: errors.New(`ent: missing required field "LocalConfig.sync_directory"`)}
+	}
+	return nil
+}
+
+func (lcc *LocalConfigCreate) sqlSave(ctx context.Context) (*LocalConfig, error) {
+	if err := lcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lcc.mutation.id = &_node.ID
+	lcc.mutation.done = true
+	return _node, nil
+}
+
+func (lcc *LocalConfigCreate) createSpec() (*LocalConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LocalConfig{config: lcc.config}
+		_spec = sqlgraph.NewCreateSpec(localconfig.Table, sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt))
+	)
+	if value, ok := lcc.mutation.Path(); ok {
+		_spec.SetField(localconfig.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := lcc.mutation.MinFreeSpaceMB(); ok {
+		_spec.SetField(localconfig.FieldMinFreeSpaceMB, field.TypeInt64, value)
+		_node.MinFreeSpaceMB = value
+	}
+	if value, ok := lcc.mutation.SyncDirectory(); ok {
+		_spec.SetField(localconfig.FieldSyncDirectory, field.TypeBool, value)
+		_node.SyncDirectory = value
+	}
+	if nodes := lcc.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   localconfig.StorageTable,
+			Columns: []string{localconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.storage_local_config = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LocalConfigCreateBulk is the builder for creating many LocalConfig entities in bulk.
+type LocalConfigCreateBulk struct {
+	config
+	err      error
+	builders []*LocalConfigCreate
+}
+
+// Save creates the LocalConfig entities in the database.
+func (lccb *LocalConfigCreateBulk) Save(ctx context.Context) ([]*LocalConfig, error) {
+	if lccb.err != nil {
+		return nil, lccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lccb.builders))
+	nodes := make([]*LocalConfig, len(lccb.builders))
+	mutators := make([]Mutator, len(lccb.builders))
+	for i := range lccb.builders {
+		func(i int, root context.Context) {
+			builder := lccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LocalConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lccb *LocalConfigCreateBulk) SaveX(ctx context.Context) []*LocalConfig {
+	v, err := lccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lccb *LocalConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := lccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lccb *LocalConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := lccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
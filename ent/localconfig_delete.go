@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// LocalConfigDelete is the builder for deleting a LocalConfig entity.
+type LocalConfigDelete struct {
+	config
+	hooks    []Hook
+	mutation *LocalConfigMutation
+}
+
+// Where appends a list predicates to the LocalConfigDelete builder.
+func (lcd *LocalConfigDelete) Where(ps ...predicate.LocalConfig) *LocalConfigDelete {
+	lcd.mutation.Where(ps...)
+	return lcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lcd *LocalConfigDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lcd.sqlExec, lcd.mutation, lcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcd *LocalConfigDelete) ExecX(ctx context.Context) int {
+	n, err := lcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lcd *LocalConfigDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(localconfig.Table, sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt))
+	if ps := lcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lcd.mutation.done = true
+	return affected, err
+}
+
+// LocalConfigDeleteOne is the builder for deleting a single LocalConfig entity.
+type LocalConfigDeleteOne struct {
+	lcd *LocalConfigDelete
+}
+
+// Where appends a list predicates to the LocalConfigDelete builder.
+func (lcdo *LocalConfigDeleteOne) Where(ps ...predicate.LocalConfig) *LocalConfigDeleteOne {
+	lcdo.lcd.mutation.Where(ps...)
+	return lcdo
+}
+
+// Exec executes the deletion query.
+func (lcdo *LocalConfigDeleteOne) Exec(ctx context.Context) error {
+	n, err := lcdo.lcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{localconfig.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcdo *LocalConfigDeleteOne) ExecX(ctx context.Context) {
+	if err := lcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
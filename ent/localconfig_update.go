@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// LocalConfigUpdate is the builder for updating LocalConfig entities.
+type LocalConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LocalConfigMutation
+}
+
+// Where appends a list predicates to the LocalConfigUpdate builder.
+func (lcu *LocalConfigUpdate) Where(ps ...predicate.LocalConfig) *LocalConfigUpdate {
+	lcu.mutation.Where(ps...)
+	return lcu
+}
+
+// SetPath sets the "path" field.
+func (lcu *LocalConfigUpdate) SetPath(s string) *LocalConfigUpdate {
+	lcu.mutation.SetPath(s)
+	return lcu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (lcu *LocalConfigUpdate) SetNillablePath(s *string) *LocalConfigUpdate {
+	if s != nil {
+		lcu.SetPath(*s)
+	}
+	return lcu
+}
+
+// SetMinFreeSpaceMB sets the "min_free_space_mb" field.
+func (lcu *LocalConfigUpdate) SetMinFreeSpaceMB(i int64) *LocalConfigUpdate {
+	lcu.mutation.ResetMinFreeSpaceMB()
+	lcu.mutation.SetMinFreeSpaceMB(i)
+	return lcu
+}
+
+// SetNillableMinFreeSpaceMB sets the "min_free_space_mb" field if the given value is not nil.
+func (lcu *LocalConfigUpdate) SetNillableMinFreeSpaceMB(i *int64) *LocalConfigUpdate {
+	if i != nil {
+		lcu.SetMinFreeSpaceMB(*i)
+	}
+	return lcu
+}
+
+// AddMinFreeSpaceMB adds i to the "min_free_space_mb" field.
+func (lcu *LocalConfigUpdate) AddMinFreeSpaceMB(i int64) *LocalConfigUpdate {
+	lcu.mutation.AddMinFreeSpaceMB(i)
+	return lcu
+}
+
+// SetSyncDirectory sets the "sync_directory" field.
+func (lcu *LocalConfigUpdate) SetSyncDirectory(b bool) *LocalConfigUpdate {
+	lcu.mutation.SetSyncDirectory(b)
+	return lcu
+}
+
+// SetNillableSyncDirectory sets the "sync_directory" field if the given value is not nil.
+func (lcu *LocalConfigUpdate) SetNillableSyncDirectory(b *bool) *LocalConfigUpdate {
+	if b != nil {
+		lcu.SetSyncDirectory(*b)
+	}
+	return lcu
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (lcu *LocalConfigUpdate) SetStorageID(id int) *LocalConfigUpdate {
+	lcu.mutation.SetStorageID(id)
+	return lcu
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (lcu *LocalConfigUpdate) SetNillableStorageID(id *int) *LocalConfigUpdate {
+	if id != nil {
+		lcu = lcu.SetStorageID(*id)
+	}
+	return lcu
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (lcu *LocalConfigUpdate) SetStorage(s *Storage) *LocalConfigUpdate {
+	return lcu.SetStorageID(s.ID)
+}
+
+// Mutation returns the LocalConfigMutation object of the builder.
+func (lcu *LocalConfigUpdate) Mutation() *LocalConfigMutation {
+	return lcu.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (lcu *LocalConfigUpdate) ClearStorage() *LocalConfigUpdate {
+	lcu.mutation.ClearStorage()
+	return lcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lcu *LocalConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lcu.sqlSave, lcu.mutation, lcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcu *LocalConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := lcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lcu *LocalConfigUpdate) Exec(ctx context.Context) error {
+	_, err := lcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcu *LocalConfigUpdate) ExecX(ctx context.Context) {
+	if err := lcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lcu *LocalConfigUpdate) check() error {
+	if v, ok := lcu.mutation.MinFreeSpaceMB(); ok {
+		if err := localconfig.MinFreeSpaceMBValidator(v); err != nil {
+			return &ValidationError{Name: "min_free_space_mb", err: fmt.Errorf(`ent: validator failed for field "LocalConfig.min_free_space_mb": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lcu *LocalConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(localconfig.Table, localconfig.Columns, sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt))
+	if ps := lcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lcu.mutation.Path(); ok {
+		_spec.SetField(localconfig.FieldPath, field.TypeString, value)
+	}
+	if value, ok := lcu.mutation.MinFreeSpaceMB(); ok {
+		_spec.SetField(localconfig.FieldMinFreeSpaceMB, field.TypeInt64, value)
+	}
+	if value, ok := lcu.mutation.AddedMinFreeSpaceMB(); ok {
+		_spec.AddField(localconfig.FieldMinFreeSpaceMB, field.TypeInt64, value)
+	}
+	if value, ok := lcu.mutation.SyncDirectory(); ok {
+		_spec.SetField(localconfig.FieldSyncDirectory, field.TypeBool, value)
+	}
+	if lcu.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   localconfig.StorageTable,
+			Columns: []string{localconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lcu.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   localconfig.StorageTable,
+			Columns: []string{localconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{localconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lcu.mutation.done = true
+	return n, nil
+}
+
+// LocalConfigUpdateOne is the builder for updating a single LocalConfig entity.
+type LocalConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LocalConfigMutation
+}
+
+// SetPath sets the "path" field.
+func (lcuo *LocalConfigUpdateOne) SetPath(s string) *LocalConfigUpdateOne {
+	lcuo.mutation.SetPath(s)
+	return lcuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (lcuo *LocalConfigUpdateOne) SetNillablePath(s *string) *LocalConfigUpdateOne {
+	if s != nil {
+		lcuo.SetPath(*s)
+	}
+	return lcuo
+}
+
+// SetMinFreeSpaceMB sets the "min_free_space_mb" field.
+func (lcuo *LocalConfigUpdateOne) SetMinFreeSpaceMB(i int64) *LocalConfigUpdateOne {
+	lcuo.mutation.ResetMinFreeSpaceMB()
+	lcuo.mutation.SetMinFreeSpaceMB(i)
+	return lcuo
+}
+
+// SetNillableMinFreeSpaceMB sets the "min_free_space_mb" field if the given value is not nil.
+func (lcuo *LocalConfigUpdateOne) SetNillableMinFreeSpaceMB(i *int64) *LocalConfigUpdateOne {
+	if i != nil {
+		lcuo.SetMinFreeSpaceMB(*i)
+	}
+	return lcuo
+}
+
+// AddMinFreeSpaceMB adds i to the "min_free_space_mb" field.
+func (lcuo *LocalConfigUpdateOne) AddMinFreeSpaceMB(i int64) *LocalConfigUpdateOne {
+	lcuo.mutation.AddMinFreeSpaceMB(i)
+	return lcuo
+}
+
+// SetSyncDirectory sets the "sync_directory" field.
+func (lcuo *LocalConfigUpdateOne) SetSyncDirectory(b bool) *LocalConfigUpdateOne {
+	lcuo.mutation.SetSyncDirectory(b)
+	return lcuo
+}
+
+// SetNillableSyncDirectory sets the "sync_directory" field if the given value is not nil.
+func (lcuo *LocalConfigUpdateOne) SetNillableSyncDirectory(b *bool) *LocalConfigUpdateOne {
+	if b != nil {
+		lcuo.SetSyncDirectory(*b)
+	}
+	return lcuo
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (lcuo *LocalConfigUpdateOne) SetStorageID(id int) *LocalConfigUpdateOne {
+	lcuo.mutation.SetStorageID(id)
+	return lcuo
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (lcuo *LocalConfigUpdateOne) SetNillableStorageID(id *int) *LocalConfigUpdateOne {
+	if id != nil {
+		lcuo = lcuo.SetStorageID(*id)
+	}
+	return lcuo
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (lcuo *LocalConfigUpdateOne) SetStorage(s *Storage) *LocalConfigUpdateOne {
+	return lcuo.SetStorageID(s.ID)
+}
+
+// Mutation returns the LocalConfigMutation object of the builder.
+func (lcuo *LocalConfigUpdateOne) Mutation() *LocalConfigMutation {
+	return lcuo.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (lcuo *LocalConfigUpdateOne) ClearStorage() *LocalConfigUpdateOne {
+	lcuo.mutation.ClearStorage()
+	return lcuo
+}
+
+// Where appends a list predicates to the LocalConfigUpdate builder.
+func (lcuo *LocalConfigUpdateOne) Where(ps ...predicate.LocalConfig) *LocalConfigUpdateOne {
+	lcuo.mutation.Where(ps...)
+	return lcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lcuo *LocalConfigUpdateOne) Select(field string, fields ...string) *LocalConfigUpdateOne {
+	lcuo.fields = append([]string{field}, fields...)
+	return lcuo
+}
+
+// Save executes the query and returns the updated LocalConfig entity.
+func (lcuo *LocalConfigUpdateOne) Save(ctx context.Context) (*LocalConfig, error) {
+	return withHooks(ctx, lcuo.sqlSave, lcuo.mutation, lcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcuo *LocalConfigUpdateOne) SaveX(ctx context.Context) *LocalConfig {
+	node, err := lcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lcuo *LocalConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := lcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcuo *LocalConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := lcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lcuo *LocalConfigUpdateOne) check() error {
+	if v, ok := lcuo.mutation.MinFreeSpaceMB(); ok {
+		if err := localconfig.MinFreeSpaceMBValidator(v); err != nil {
+			return &ValidationError{Name: "min_free_space_mb", err: fmt.Errorf(`ent: validator failed for field "LocalConfig.min_free_space_mb": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lcuo *LocalConfigUpdateOne) sqlSave(ctx context.Context) (_node *LocalConfig, err error) {
+	if err := lcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(localconfig.Table, localconfig.Columns, sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt))
+	id, ok := lcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LocalConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, localconfig.FieldID)
+		for _, f := range fields {
+			if !localconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != localconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lcuo.mutation.Path(); ok {
+		_spec.SetField(localconfig.FieldPath, field.TypeString, value)
+	}
+	if value, ok := lcuo.mutation.MinFreeSpaceMB(); ok {
+		_spec.SetField(localconfig.FieldMinFreeSpaceMB, field.TypeInt64, value)
+	}
+	if value, ok := lcuo.mutation.AddedMinFreeSpaceMB(); ok {
+		_spec.AddField(localconfig.FieldMinFreeSpaceMB, field.TypeInt64, value)
+	}
+	if value, ok := lcuo.mutation.SyncDirectory(); ok {
+		_spec.SetField(localconfig.FieldSyncDirectory, field.TypeBool, value)
+	}
+	if lcuo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   localconfig.StorageTable,
+			Columns: []string{localconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lcuo.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   localconfig.StorageTable,
+			Columns: []string{localconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LocalConfig{config: lcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{localconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lcuo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,104 @@ import (
 )
 
 var (
+	// AzureBlobConfigsColumns holds the columns for the "azure_blob_configs" table.
+	AzureBlobConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "account_name", Type: field.TypeString},
+		{Name: "account_key", Type: field.TypeString, Nullable: true},
+		{Name: "sas_token", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "container", Type: field.TypeString},
+		{Name: "endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "access_tier", Type: field.TypeEnum, Nullable: true, Enums: []string{"Hot", "Cool", "Archive"}},
+		{Name: "storage_azure_blob_config", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// AzureBlobConfigsTable holds the schema information for the "azure_blob_configs" table.
+	AzureBlobConfigsTable = &schema.Table{
+		Name:       "azure_blob_configs",
+		Columns:    AzureBlobConfigsColumns,
+		PrimaryKey: []*schema.Column{AzureBlobConfigsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "azure_blob_configs_storages_azure_blob_config",
+				Columns:    []*schema.Column{AzureBlobConfigsColumns[7]},
+				RefColumns: []*schema.Column{StoragesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// FtpConfigsColumns holds the columns for the "ftp_configs" table.
+	FtpConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "host", Type: field.TypeString},
+		{Name: "port", Type: field.TypeInt, Default: 21},
+		{Name: "username", Type: field.TypeString},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "tls_mode", Type: field.TypeEnum, Enums: []string{"none", "explicit", "implicit"}, Default: "none"},
+		{Name: "skip_tls_verify", Type: field.TypeBool, Default: false},
+		{Name: "base_dir", Type: field.TypeString, Nullable: true},
+		{Name: "storage_ftp_config", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// FtpConfigsTable holds the schema information for the "ftp_configs" table.
+	FtpConfigsTable = &schema.Table{
+		Name:       "ftp_configs",
+		Columns:    FtpConfigsColumns,
+		PrimaryKey: []*schema.Column{FtpConfigsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ftp_configs_storages_ftp_config",
+				Columns:    []*schema.Column{FtpConfigsColumns[8]},
+				RefColumns: []*schema.Column{StoragesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// GcsConfigsColumns holds the columns for the "gcs_configs" table.
+	GcsConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "bucket", Type: field.TypeString},
+		{Name: "credentials_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "storage_class", Type: field.TypeEnum, Nullable: true, Enums: []string{"STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE"}},
+		{Name: "retention_days", Type: field.TypeInt, Default: 0},
+		{Name: "retention_mode", Type: field.TypeEnum, Enums: []string{"Unlocked", "Locked"}, Default: "Unlocked"},
+		{Name: "storage_gcs_config", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// GcsConfigsTable holds the schema information for the "gcs_configs" table.
+	GcsConfigsTable = &schema.Table{
+		Name:       "gcs_configs",
+		Columns:    GcsConfigsColumns,
+		PrimaryKey: []*schema.Column{GcsConfigsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "gcs_configs_storages_gcs_config",
+				Columns:    []*schema.Column{GcsConfigsColumns[7]},
+				RefColumns: []*schema.Column{StoragesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// LocalConfigsColumns holds the columns for the "local_configs" table.
+	LocalConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "min_free_space_mb", Type: field.TypeInt64, Default: 0},
+		{Name: "sync_directory", Type: field.TypeBool, Default: false},
+		{Name: "storage_local_config", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// LocalConfigsTable holds the schema information for the "local_configs" table.
+	LocalConfigsTable = &schema.Table{
+		Name:       "local_configs",
+		Columns:    LocalConfigsColumns,
+		PrimaryKey: []*schema.Column{LocalConfigsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "local_configs_storages_local_config",
+				Columns:    []*schema.Column{LocalConfigsColumns[4]},
+				RefColumns: []*schema.Column{StoragesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// S3configsColumns holds the columns for the "s3configs" table.
 	S3configsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -32,12 +130,60 @@ var (
 			},
 		},
 	}
+	// SftpConfigsColumns holds the columns for the "sftp_configs" table.
+	SftpConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "host", Type: field.TypeString},
+		{Name: "port", Type: field.TypeInt, Default: 22},
+		{Name: "username", Type: field.TypeString},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "private_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "passphrase", Type: field.TypeString, Nullable: true},
+		{Name: "host_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "base_dir", Type: field.TypeString, Nullable: true},
+		{Name: "storage_sftp_config", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// SftpConfigsTable holds the schema information for the "sftp_configs" table.
+	SftpConfigsTable = &schema.Table{
+		Name:       "sftp_configs",
+		Columns:    SftpConfigsColumns,
+		PrimaryKey: []*schema.Column{SftpConfigsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sftp_configs_storages_sftp_config",
+				Columns:    []*schema.Column{SftpConfigsColumns[9]},
+				RefColumns: []*schema.Column{StoragesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SourcesColumns holds the columns for the "sources" table.
+	SourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "data_path", Type: field.TypeString},
+		{Name: "include", Type: field.TypeJSON, Nullable: true},
+		{Name: "exclude", Type: field.TypeJSON, Nullable: true},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "recipients", Type: field.TypeJSON, Nullable: true},
+		{Name: "interval", Type: field.TypeInt, Default: 3600},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SourcesTable holds the schema information for the "sources" table.
+	SourcesTable = &schema.Table{
+		Name:       "sources",
+		Columns:    SourcesColumns,
+		PrimaryKey: []*schema.Column{SourcesColumns[0]},
+	}
 	// StoragesColumns holds the columns for the "storages" table.
 	StoragesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"webdav", "s3"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"webdav", "s3", "local", "sftp", "ftp", "azureblob", "gcs"}},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "archive_format", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -50,12 +196,29 @@ var (
 	// SyncJobsColumns holds the columns for the "sync_jobs" table.
 	SyncJobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}},
-		{Name: "operation", Type: field.TypeEnum, Enums: []string{"backup", "restore"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed", "suspicious", "skipped"}},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"backup", "restore", "verify"}},
 		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "filename", Type: field.TypeString, Nullable: true},
+		{Name: "restore_path", Type: field.TypeString, Nullable: true},
+		{Name: "rollback_path", Type: field.TypeString, Nullable: true},
+		{Name: "integrity_check", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "original_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "archive_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "compression_ratio", Type: field.TypeFloat64, Nullable: true},
+		{Name: "data_files", Type: field.TypeInt, Nullable: true},
+		{Name: "data_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "vault_users", Type: field.TypeInt64, Nullable: true},
+		{Name: "vault_organizations", Type: field.TypeInt64, Nullable: true},
+		{Name: "vault_ciphers", Type: field.TypeInt64, Nullable: true},
+		{Name: "vault_attachments", Type: field.TypeInt64, Nullable: true},
+		{Name: "vault_sends", Type: field.TypeInt64, Nullable: true},
+		{Name: "hook_output", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "source_sync_jobs", Type: field.TypeInt, Nullable: true},
 		{Name: "storage_sync_jobs", Type: field.TypeInt, Nullable: true},
 	}
 	// SyncJobsTable holds the schema information for the "sync_jobs" table.
@@ -64,9 +227,15 @@ var (
 		Columns:    SyncJobsColumns,
 		PrimaryKey: []*schema.Column{SyncJobsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sync_jobs_sources_sync_jobs",
+				Columns:    []*schema.Column{SyncJobsColumns[23]},
+				RefColumns: []*schema.Column{SourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "sync_jobs_storages_sync_jobs",
-				Columns:    []*schema.Column{SyncJobsColumns[7]},
+				Columns:    []*schema.Column{SyncJobsColumns[24]},
 				RefColumns: []*schema.Column{StoragesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -112,7 +281,13 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AzureBlobConfigsTable,
+		FtpConfigsTable,
+		GcsConfigsTable,
+		LocalConfigsTable,
 		S3configsTable,
+		SftpConfigsTable,
+		SourcesTable,
 		StoragesTable,
 		SyncJobsTable,
 		UsersTable,
@@ -121,7 +296,13 @@ var (
 )
 
 func init() {
+	AzureBlobConfigsTable.ForeignKeys[0].RefTable = StoragesTable
+	FtpConfigsTable.ForeignKeys[0].RefTable = StoragesTable
+	GcsConfigsTable.ForeignKeys[0].RefTable = StoragesTable
+	LocalConfigsTable.ForeignKeys[0].RefTable = StoragesTable
 	S3configsTable.ForeignKeys[0].RefTable = StoragesTable
-	SyncJobsTable.ForeignKeys[0].RefTable = StoragesTable
+	SftpConfigsTable.ForeignKeys[0].RefTable = StoragesTable
+	SyncJobsTable.ForeignKeys[0].RefTable = SourcesTable
+	SyncJobsTable.ForeignKeys[1].RefTable = StoragesTable
 	WebDavConfigsTable.ForeignKeys[0].RefTable = StoragesTable
 }
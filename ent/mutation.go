@@ -11,8 +11,14 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/user"
@@ -28,43 +34,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeS3Config     = "S3Config"
-	TypeStorage      = "Storage"
-	TypeSyncJob      = "SyncJob"
-	TypeUser         = "User"
-	TypeWebDAVConfig = "WebDAVConfig"
+	TypeAzureBlobConfig = "AzureBlobConfig"
+	TypeFTPConfig       = "FTPConfig"
+	TypeGCSConfig       = "GCSConfig"
+	TypeLocalConfig     = "LocalConfig"
+	TypeS3Config        = "S3Config"
+	TypeSFTPConfig      = "SFTPConfig"
+	TypeSource          = "Source"
+	TypeStorage         = "Storage"
+	TypeSyncJob         = "SyncJob"
+	TypeUser            = "User"
+	TypeWebDAVConfig    = "WebDAVConfig"
 )
 
-// S3ConfigMutation represents an operation that mutates the S3Config nodes in the graph.
-type S3ConfigMutation struct {
+// AzureBlobConfigMutation represents an operation that mutates the AzureBlobConfig nodes in the graph.
+type AzureBlobConfigMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	endpoint          *string
-	access_key_id     *string
-	secret_access_key *string
-	region            *string
-	bucket            *string
-	clearedFields     map[string]struct{}
-	storage           *int
-	clearedstorage    bool
-	done              bool
-	oldValue          func(context.Context) (*S3Config, error)
-	predicates        []predicate.S3Config
+	op             Op
+	typ            string
+	id             *int
+	account_name   *string
+	account_key    *string
+	sas_token      *string
+	container      *string
+	endpoint       *string
+	access_tier    *azureblobconfig.AccessTier
+	clearedFields  map[string]struct{}
+	storage        *int
+	clearedstorage bool
+	done           bool
+	oldValue       func(context.Context) (*AzureBlobConfig, error)
+	predicates     []predicate.AzureBlobConfig
 }
 
-var _ ent.Mutation = (*S3ConfigMutation)(nil)
+var _ ent.Mutation = (*AzureBlobConfigMutation)(nil)
 
-// s3configOption allows management of the mutation configuration using functional options.
-type s3configOption func(*S3ConfigMutation)
+// azureblobconfigOption allows management of the mutation configuration using functional options.
+type azureblobconfigOption func(*AzureBlobConfigMutation)
 
-// newS3ConfigMutation creates new mutation for the S3Config entity.
-func newS3ConfigMutation(c config, op Op, opts ...s3configOption) *S3ConfigMutation {
-	m := &S3ConfigMutation{
+// newAzureBlobConfigMutation creates new mutation for the AzureBlobConfig entity.
+func newAzureBlobConfigMutation(c config, op Op, opts ...azureblobconfigOption) *AzureBlobConfigMutation {
+	m := &AzureBlobConfigMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeS3Config,
+		typ:           TypeAzureBlobConfig,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -73,20 +86,20 @@ func newS3ConfigMutation(c config, op Op, opts ...s3configOption) *S3ConfigMutat
 	return m
 }
 
-// withS3ConfigID sets the ID field of the mutation.
-func withS3ConfigID(id int) s3configOption {
-	return func(m *S3ConfigMutation) {
+// withAzureBlobConfigID sets the ID field of the mutation.
+func withAzureBlobConfigID(id int) azureblobconfigOption {
+	return func(m *AzureBlobConfigMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *S3Config
+			value *AzureBlobConfig
 		)
-		m.oldValue = func(ctx context.Context) (*S3Config, error) {
+		m.oldValue = func(ctx context.Context) (*AzureBlobConfig, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().S3Config.Get(ctx, id)
+					value, err = m.Client().AzureBlobConfig.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -95,10 +108,10 @@ func withS3ConfigID(id int) s3configOption {
 	}
 }
 
-// withS3Config sets the old S3Config of the mutation.
-func withS3Config(node *S3Config) s3configOption {
-	return func(m *S3ConfigMutation) {
-		m.oldValue = func(context.Context) (*S3Config, error) {
+// withAzureBlobConfig sets the old AzureBlobConfig of the mutation.
+func withAzureBlobConfig(node *AzureBlobConfig) azureblobconfigOption {
+	return func(m *AzureBlobConfigMutation) {
+		m.oldValue = func(context.Context) (*AzureBlobConfig, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -107,7 +120,7 @@ func withS3Config(node *S3Config) s3configOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m S3ConfigMutation) Client() *Client {
+func (m AzureBlobConfigMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -115,7 +128,7 @@ func (m S3ConfigMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m S3ConfigMutation) Tx() (*Tx, error) {
+func (m AzureBlobConfigMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -126,7 +139,7 @@ func (m S3ConfigMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *S3ConfigMutation) ID() (id int, exists bool) {
+func (m *AzureBlobConfigMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -137,7 +150,7 @@ func (m *S3ConfigMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *S3ConfigMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AzureBlobConfigMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -146,222 +159,297 @@ func (m *S3ConfigMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().S3Config.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AzureBlobConfig.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEndpoint sets the "endpoint" field.
-func (m *S3ConfigMutation) SetEndpoint(s string) {
-	m.endpoint = &s
+// SetAccountName sets the "account_name" field.
+func (m *AzureBlobConfigMutation) SetAccountName(s string) {
+	m.account_name = &s
 }
 
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *S3ConfigMutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
+// AccountName returns the value of the "account_name" field in the mutation.
+func (m *AzureBlobConfigMutation) AccountName() (r string, exists bool) {
+	v := m.account_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpoint returns the old "endpoint" field's value of the S3Config entity.
-// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountName returns the old "account_name" field's value of the AzureBlobConfig entity.
+// If the AzureBlobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3ConfigMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+func (m *AzureBlobConfigMutation) OldAccountName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+		return v, errors.New("OldAccountName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountName: %w", err)
 	}
-	return oldValue.Endpoint, nil
+	return oldValue.AccountName, nil
 }
 
-// ClearEndpoint clears the value of the "endpoint" field.
-func (m *S3ConfigMutation) ClearEndpoint() {
-	m.endpoint = nil
-	m.clearedFields[s3config.FieldEndpoint] = struct{}{}
+// ResetAccountName resets all changes to the "account_name" field.
+func (m *AzureBlobConfigMutation) ResetAccountName() {
+	m.account_name = nil
 }
 
-// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
-func (m *S3ConfigMutation) EndpointCleared() bool {
-	_, ok := m.clearedFields[s3config.FieldEndpoint]
+// SetAccountKey sets the "account_key" field.
+func (m *AzureBlobConfigMutation) SetAccountKey(s string) {
+	m.account_key = &s
+}
+
+// AccountKey returns the value of the "account_key" field in the mutation.
+func (m *AzureBlobConfigMutation) AccountKey() (r string, exists bool) {
+	v := m.account_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountKey returns the old "account_key" field's value of the AzureBlobConfig entity.
+// If the AzureBlobConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AzureBlobConfigMutation) OldAccountKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountKey: %w", err)
+	}
+	return oldValue.AccountKey, nil
+}
+
+// ClearAccountKey clears the value of the "account_key" field.
+func (m *AzureBlobConfigMutation) ClearAccountKey() {
+	m.account_key = nil
+	m.clearedFields[azureblobconfig.FieldAccountKey] = struct{}{}
+}
+
+// AccountKeyCleared returns if the "account_key" field was cleared in this mutation.
+func (m *AzureBlobConfigMutation) AccountKeyCleared() bool {
+	_, ok := m.clearedFields[azureblobconfig.FieldAccountKey]
 	return ok
 }
 
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *S3ConfigMutation) ResetEndpoint() {
-	m.endpoint = nil
-	delete(m.clearedFields, s3config.FieldEndpoint)
+// ResetAccountKey resets all changes to the "account_key" field.
+func (m *AzureBlobConfigMutation) ResetAccountKey() {
+	m.account_key = nil
+	delete(m.clearedFields, azureblobconfig.FieldAccountKey)
 }
 
-// SetAccessKeyID sets the "access_key_id" field.
-func (m *S3ConfigMutation) SetAccessKeyID(s string) {
-	m.access_key_id = &s
+// SetSasToken sets the "sas_token" field.
+func (m *AzureBlobConfigMutation) SetSasToken(s string) {
+	m.sas_token = &s
 }
 
-// AccessKeyID returns the value of the "access_key_id" field in the mutation.
-func (m *S3ConfigMutation) AccessKeyID() (r string, exists bool) {
-	v := m.access_key_id
+// SasToken returns the value of the "sas_token" field in the mutation.
+func (m *AzureBlobConfigMutation) SasToken() (r string, exists bool) {
+	v := m.sas_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessKeyID returns the old "access_key_id" field's value of the S3Config entity.
-// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// OldSasToken returns the old "sas_token" field's value of the AzureBlobConfig entity.
+// If the AzureBlobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3ConfigMutation) OldAccessKeyID(ctx context.Context) (v string, err error) {
+func (m *AzureBlobConfigMutation) OldSasToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessKeyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSasToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessKeyID requires an ID field in the mutation")
+		return v, errors.New("OldSasToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessKeyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSasToken: %w", err)
 	}
-	return oldValue.AccessKeyID, nil
+	return oldValue.SasToken, nil
 }
 
-// ResetAccessKeyID resets all changes to the "access_key_id" field.
-func (m *S3ConfigMutation) ResetAccessKeyID() {
-	m.access_key_id = nil
+// ClearSasToken clears the value of the "sas_token" field.
+func (m *AzureBlobConfigMutation) ClearSasToken() {
+	m.sas_token = nil
+	m.clearedFields[azureblobconfig.FieldSasToken] = struct{}{}
 }
 
-// SetSecretAccessKey sets the "secret_access_key" field.
-func (m *S3ConfigMutation) SetSecretAccessKey(s string) {
-	m.secret_access_key = &s
+// SasTokenCleared returns if the "sas_token" field was cleared in this mutation.
+func (m *AzureBlobConfigMutation) SasTokenCleared() bool {
+	_, ok := m.clearedFields[azureblobconfig.FieldSasToken]
+	return ok
 }
 
-// SecretAccessKey returns the value of the "secret_access_key" field in the mutation.
-func (m *S3ConfigMutation) SecretAccessKey() (r string, exists bool) {
-	v := m.secret_access_key
+// ResetSasToken resets all changes to the "sas_token" field.
+func (m *AzureBlobConfigMutation) ResetSasToken() {
+	m.sas_token = nil
+	delete(m.clearedFields, azureblobconfig.FieldSasToken)
+}
+
+// SetContainer sets the "container" field.
+func (m *AzureBlobConfigMutation) SetContainer(s string) {
+	m.container = &s
+}
+
+// Container returns the value of the "container" field in the mutation.
+func (m *AzureBlobConfigMutation) Container() (r string, exists bool) {
+	v := m.container
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecretAccessKey returns the old "secret_access_key" field's value of the S3Config entity.
-// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// OldContainer returns the old "container" field's value of the AzureBlobConfig entity.
+// If the AzureBlobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3ConfigMutation) OldSecretAccessKey(ctx context.Context) (v string, err error) {
+func (m *AzureBlobConfigMutation) OldContainer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecretAccessKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldContainer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecretAccessKey requires an ID field in the mutation")
+		return v, errors.New("OldContainer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecretAccessKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldContainer: %w", err)
 	}
-	return oldValue.SecretAccessKey, nil
+	return oldValue.Container, nil
 }
 
-// ResetSecretAccessKey resets all changes to the "secret_access_key" field.
-func (m *S3ConfigMutation) ResetSecretAccessKey() {
-	m.secret_access_key = nil
+// ResetContainer resets all changes to the "container" field.
+func (m *AzureBlobConfigMutation) ResetContainer() {
+	m.container = nil
 }
 
-// SetRegion sets the "region" field.
-func (m *S3ConfigMutation) SetRegion(s string) {
-	m.region = &s
+// SetEndpoint sets the "endpoint" field.
+func (m *AzureBlobConfigMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// Region returns the value of the "region" field in the mutation.
-func (m *S3ConfigMutation) Region() (r string, exists bool) {
-	v := m.region
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *AzureBlobConfigMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegion returns the old "region" field's value of the S3Config entity.
-// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the AzureBlobConfig entity.
+// If the AzureBlobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3ConfigMutation) OldRegion(ctx context.Context) (v string, err error) {
+func (m *AzureBlobConfigMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegion requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.Region, nil
+	return oldValue.Endpoint, nil
 }
 
-// ResetRegion resets all changes to the "region" field.
-func (m *S3ConfigMutation) ResetRegion() {
-	m.region = nil
+// ClearEndpoint clears the value of the "endpoint" field.
+func (m *AzureBlobConfigMutation) ClearEndpoint() {
+	m.endpoint = nil
+	m.clearedFields[azureblobconfig.FieldEndpoint] = struct{}{}
 }
 
-// SetBucket sets the "bucket" field.
-func (m *S3ConfigMutation) SetBucket(s string) {
-	m.bucket = &s
+// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
+func (m *AzureBlobConfigMutation) EndpointCleared() bool {
+	_, ok := m.clearedFields[azureblobconfig.FieldEndpoint]
+	return ok
 }
 
-// Bucket returns the value of the "bucket" field in the mutation.
-func (m *S3ConfigMutation) Bucket() (r string, exists bool) {
-	v := m.bucket
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *AzureBlobConfigMutation) ResetEndpoint() {
+	m.endpoint = nil
+	delete(m.clearedFields, azureblobconfig.FieldEndpoint)
+}
+
+// SetAccessTier sets the "access_tier" field.
+func (m *AzureBlobConfigMutation) SetAccessTier(at azureblobconfig.AccessTier) {
+	m.access_tier = &at
+}
+
+// AccessTier returns the value of the "access_tier" field in the mutation.
+func (m *AzureBlobConfigMutation) AccessTier() (r azureblobconfig.AccessTier, exists bool) {
+	v := m.access_tier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBucket returns the old "bucket" field's value of the S3Config entity.
-// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessTier returns the old "access_tier" field's value of the AzureBlobConfig entity.
+// If the AzureBlobConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3ConfigMutation) OldBucket(ctx context.Context) (v string, err error) {
+func (m *AzureBlobConfigMutation) OldAccessTier(ctx context.Context) (v azureblobconfig.AccessTier, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBucket requires an ID field in the mutation")
+		return v, errors.New("OldAccessTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessTier: %w", err)
 	}
-	return oldValue.Bucket, nil
+	return oldValue.AccessTier, nil
 }
 
-// ResetBucket resets all changes to the "bucket" field.
-func (m *S3ConfigMutation) ResetBucket() {
-	m.bucket = nil
+// ClearAccessTier clears the value of the "access_tier" field.
+func (m *AzureBlobConfigMutation) ClearAccessTier() {
+	m.access_tier = nil
+	m.clearedFields[azureblobconfig.FieldAccessTier] = struct{}{}
+}
+
+// AccessTierCleared returns if the "access_tier" field was cleared in this mutation.
+func (m *AzureBlobConfigMutation) AccessTierCleared() bool {
+	_, ok := m.clearedFields[azureblobconfig.FieldAccessTier]
+	return ok
+}
+
+// ResetAccessTier resets all changes to the "access_tier" field.
+func (m *AzureBlobConfigMutation) ResetAccessTier() {
+	m.access_tier = nil
+	delete(m.clearedFields, azureblobconfig.FieldAccessTier)
 }
 
 // SetStorageID sets the "storage" edge to the Storage entity by id.
-func (m *S3ConfigMutation) SetStorageID(id int) {
+func (m *AzureBlobConfigMutation) SetStorageID(id int) {
 	m.storage = &id
 }
 
 // ClearStorage clears the "storage" edge to the Storage entity.
-func (m *S3ConfigMutation) ClearStorage() {
+func (m *AzureBlobConfigMutation) ClearStorage() {
 	m.clearedstorage = true
 }
 
 // StorageCleared reports if the "storage" edge to the Storage entity was cleared.
-func (m *S3ConfigMutation) StorageCleared() bool {
+func (m *AzureBlobConfigMutation) StorageCleared() bool {
 	return m.clearedstorage
 }
 
 // StorageID returns the "storage" edge ID in the mutation.
-func (m *S3ConfigMutation) StorageID() (id int, exists bool) {
+func (m *AzureBlobConfigMutation) StorageID() (id int, exists bool) {
 	if m.storage != nil {
 		return *m.storage, true
 	}
@@ -371,7 +459,7 @@ func (m *S3ConfigMutation) StorageID() (id int, exists bool) {
 // StorageIDs returns the "storage" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // StorageID instead. It exists only for internal usage by the builders.
-func (m *S3ConfigMutation) StorageIDs() (ids []int) {
+func (m *AzureBlobConfigMutation) StorageIDs() (ids []int) {
 	if id := m.storage; id != nil {
 		ids = append(ids, *id)
 	}
@@ -379,20 +467,20 @@ func (m *S3ConfigMutation) StorageIDs() (ids []int) {
 }
 
 // ResetStorage resets all changes to the "storage" edge.
-func (m *S3ConfigMutation) ResetStorage() {
+func (m *AzureBlobConfigMutation) ResetStorage() {
 	m.storage = nil
 	m.clearedstorage = false
 }
 
-// Where appends a list predicates to the S3ConfigMutation builder.
-func (m *S3ConfigMutation) Where(ps ...predicate.S3Config) {
+// Where appends a list predicates to the AzureBlobConfigMutation builder.
+func (m *AzureBlobConfigMutation) Where(ps ...predicate.AzureBlobConfig) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the S3ConfigMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AzureBlobConfigMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *S3ConfigMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.S3Config, len(ps))
+func (m *AzureBlobConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AzureBlobConfig, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -400,39 +488,42 @@ func (m *S3ConfigMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *S3ConfigMutation) Op() Op {
+func (m *AzureBlobConfigMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *S3ConfigMutation) SetOp(op Op) {
+func (m *AzureBlobConfigMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (S3Config).
-func (m *S3ConfigMutation) Type() string {
+// Type returns the node type of this mutation (AzureBlobConfig).
+func (m *AzureBlobConfigMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *S3ConfigMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.endpoint != nil {
-		fields = append(fields, s3config.FieldEndpoint)
+func (m *AzureBlobConfigMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.account_name != nil {
+		fields = append(fields, azureblobconfig.FieldAccountName)
 	}
-	if m.access_key_id != nil {
-		fields = append(fields, s3config.FieldAccessKeyID)
+	if m.account_key != nil {
+		fields = append(fields, azureblobconfig.FieldAccountKey)
 	}
-	if m.secret_access_key != nil {
-		fields = append(fields, s3config.FieldSecretAccessKey)
+	if m.sas_token != nil {
+		fields = append(fields, azureblobconfig.FieldSasToken)
 	}
-	if m.region != nil {
-		fields = append(fields, s3config.FieldRegion)
+	if m.container != nil {
+		fields = append(fields, azureblobconfig.FieldContainer)
 	}
-	if m.bucket != nil {
-		fields = append(fields, s3config.FieldBucket)
+	if m.endpoint != nil {
+		fields = append(fields, azureblobconfig.FieldEndpoint)
+	}
+	if m.access_tier != nil {
+		fields = append(fields, azureblobconfig.FieldAccessTier)
 	}
 	return fields
 }
@@ -440,18 +531,20 @@ func (m *S3ConfigMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *S3ConfigMutation) Field(name string) (ent.Value, bool) {
+func (m *AzureBlobConfigMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case s3config.FieldEndpoint:
+	case azureblobconfig.FieldAccountName:
+		return m.AccountName()
+	case azureblobconfig.FieldAccountKey:
+		return m.AccountKey()
+	case azureblobconfig.FieldSasToken:
+		return m.SasToken()
+	case azureblobconfig.FieldContainer:
+		return m.Container()
+	case azureblobconfig.FieldEndpoint:
 		return m.Endpoint()
-	case s3config.FieldAccessKeyID:
-		return m.AccessKeyID()
-	case s3config.FieldSecretAccessKey:
-		return m.SecretAccessKey()
-	case s3config.FieldRegion:
-		return m.Region()
-	case s3config.FieldBucket:
-		return m.Bucket()
+	case azureblobconfig.FieldAccessTier:
+		return m.AccessTier()
 	}
 	return nil, false
 }
@@ -459,153 +552,183 @@ func (m *S3ConfigMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *S3ConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AzureBlobConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case s3config.FieldEndpoint:
+	case azureblobconfig.FieldAccountName:
+		return m.OldAccountName(ctx)
+	case azureblobconfig.FieldAccountKey:
+		return m.OldAccountKey(ctx)
+	case azureblobconfig.FieldSasToken:
+		return m.OldSasToken(ctx)
+	case azureblobconfig.FieldContainer:
+		return m.OldContainer(ctx)
+	case azureblobconfig.FieldEndpoint:
 		return m.OldEndpoint(ctx)
-	case s3config.FieldAccessKeyID:
-		return m.OldAccessKeyID(ctx)
-	case s3config.FieldSecretAccessKey:
-		return m.OldSecretAccessKey(ctx)
-	case s3config.FieldRegion:
-		return m.OldRegion(ctx)
-	case s3config.FieldBucket:
-		return m.OldBucket(ctx)
+	case azureblobconfig.FieldAccessTier:
+		return m.OldAccessTier(ctx)
 	}
-	return nil, fmt.Errorf("unknown S3Config field %s", name)
+	return nil, fmt.Errorf("unknown AzureBlobConfig field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *S3ConfigMutation) SetField(name string, value ent.Value) error {
+func (m *AzureBlobConfigMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case s3config.FieldEndpoint:
+	case azureblobconfig.FieldAccountName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndpoint(v)
+		m.SetAccountName(v)
 		return nil
-	case s3config.FieldAccessKeyID:
+	case azureblobconfig.FieldAccountKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessKeyID(v)
+		m.SetAccountKey(v)
 		return nil
-	case s3config.FieldSecretAccessKey:
+	case azureblobconfig.FieldSasToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecretAccessKey(v)
+		m.SetSasToken(v)
 		return nil
-	case s3config.FieldRegion:
+	case azureblobconfig.FieldContainer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegion(v)
+		m.SetContainer(v)
 		return nil
-	case s3config.FieldBucket:
+	case azureblobconfig.FieldEndpoint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBucket(v)
+		m.SetEndpoint(v)
+		return nil
+	case azureblobconfig.FieldAccessTier:
+		v, ok := value.(azureblobconfig.AccessTier)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessTier(v)
 		return nil
 	}
-	return fmt.Errorf("unknown S3Config field %s", name)
+	return fmt.Errorf("unknown AzureBlobConfig field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *S3ConfigMutation) AddedFields() []string {
+func (m *AzureBlobConfigMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *S3ConfigMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AzureBlobConfigMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *S3ConfigMutation) AddField(name string, value ent.Value) error {
+func (m *AzureBlobConfigMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown S3Config numeric field %s", name)
+	return fmt.Errorf("unknown AzureBlobConfig numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *S3ConfigMutation) ClearedFields() []string {
+func (m *AzureBlobConfigMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(s3config.FieldEndpoint) {
-		fields = append(fields, s3config.FieldEndpoint)
+	if m.FieldCleared(azureblobconfig.FieldAccountKey) {
+		fields = append(fields, azureblobconfig.FieldAccountKey)
+	}
+	if m.FieldCleared(azureblobconfig.FieldSasToken) {
+		fields = append(fields, azureblobconfig.FieldSasToken)
+	}
+	if m.FieldCleared(azureblobconfig.FieldEndpoint) {
+		fields = append(fields, azureblobconfig.FieldEndpoint)
+	}
+	if m.FieldCleared(azureblobconfig.FieldAccessTier) {
+		fields = append(fields, azureblobconfig.FieldAccessTier)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *S3ConfigMutation) FieldCleared(name string) bool {
+func (m *AzureBlobConfigMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *S3ConfigMutation) ClearField(name string) error {
+func (m *AzureBlobConfigMutation) ClearField(name string) error {
 	switch name {
-	case s3config.FieldEndpoint:
+	case azureblobconfig.FieldAccountKey:
+		m.ClearAccountKey()
+		return nil
+	case azureblobconfig.FieldSasToken:
+		m.ClearSasToken()
+		return nil
+	case azureblobconfig.FieldEndpoint:
 		m.ClearEndpoint()
 		return nil
+	case azureblobconfig.FieldAccessTier:
+		m.ClearAccessTier()
+		return nil
 	}
-	return fmt.Errorf("unknown S3Config nullable field %s", name)
+	return fmt.Errorf("unknown AzureBlobConfig nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *S3ConfigMutation) ResetField(name string) error {
+func (m *AzureBlobConfigMutation) ResetField(name string) error {
 	switch name {
-	case s3config.FieldEndpoint:
-		m.ResetEndpoint()
+	case azureblobconfig.FieldAccountName:
+		m.ResetAccountName()
 		return nil
-	case s3config.FieldAccessKeyID:
-		m.ResetAccessKeyID()
+	case azureblobconfig.FieldAccountKey:
+		m.ResetAccountKey()
 		return nil
-	case s3config.FieldSecretAccessKey:
-		m.ResetSecretAccessKey()
+	case azureblobconfig.FieldSasToken:
+		m.ResetSasToken()
 		return nil
-	case s3config.FieldRegion:
-		m.ResetRegion()
+	case azureblobconfig.FieldContainer:
+		m.ResetContainer()
 		return nil
-	case s3config.FieldBucket:
-		m.ResetBucket()
+	case azureblobconfig.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case azureblobconfig.FieldAccessTier:
+		m.ResetAccessTier()
 		return nil
 	}
-	return fmt.Errorf("unknown S3Config field %s", name)
+	return fmt.Errorf("unknown AzureBlobConfig field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *S3ConfigMutation) AddedEdges() []string {
+func (m *AzureBlobConfigMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.storage != nil {
-		edges = append(edges, s3config.EdgeStorage)
+		edges = append(edges, azureblobconfig.EdgeStorage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *S3ConfigMutation) AddedIDs(name string) []ent.Value {
+func (m *AzureBlobConfigMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case s3config.EdgeStorage:
+	case azureblobconfig.EdgeStorage:
 		if id := m.storage; id != nil {
 			return []ent.Value{*id}
 		}
@@ -614,31 +737,31 @@ func (m *S3ConfigMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *S3ConfigMutation) RemovedEdges() []string {
+func (m *AzureBlobConfigMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *S3ConfigMutation) RemovedIDs(name string) []ent.Value {
+func (m *AzureBlobConfigMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *S3ConfigMutation) ClearedEdges() []string {
+func (m *AzureBlobConfigMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedstorage {
-		edges = append(edges, s3config.EdgeStorage)
+		edges = append(edges, azureblobconfig.EdgeStorage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *S3ConfigMutation) EdgeCleared(name string) bool {
+func (m *AzureBlobConfigMutation) EdgeCleared(name string) bool {
 	switch name {
-	case s3config.EdgeStorage:
+	case azureblobconfig.EdgeStorage:
 		return m.clearedstorage
 	}
 	return false
@@ -646,61 +769,59 @@ func (m *S3ConfigMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *S3ConfigMutation) ClearEdge(name string) error {
+func (m *AzureBlobConfigMutation) ClearEdge(name string) error {
 	switch name {
-	case s3config.EdgeStorage:
+	case azureblobconfig.EdgeStorage:
 		m.ClearStorage()
 		return nil
 	}
-	return fmt.Errorf("unknown S3Config unique edge %s", name)
+	return fmt.Errorf("unknown AzureBlobConfig unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *S3ConfigMutation) ResetEdge(name string) error {
+func (m *AzureBlobConfigMutation) ResetEdge(name string) error {
 	switch name {
-	case s3config.EdgeStorage:
+	case azureblobconfig.EdgeStorage:
 		m.ResetStorage()
 		return nil
 	}
-	return fmt.Errorf("unknown S3Config edge %s", name)
+	return fmt.Errorf("unknown AzureBlobConfig edge %s", name)
 }
 
-// StorageMutation represents an operation that mutates the Storage nodes in the graph.
-type StorageMutation struct {
+// FTPConfigMutation represents an operation that mutates the FTPConfig nodes in the graph.
+type FTPConfigMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	name                 *string
-	_type                *storage.Type
-	enabled              *bool
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	sync_jobs            map[int]struct{}
-	removedsync_jobs     map[int]struct{}
-	clearedsync_jobs     bool
-	webdav_config        *int
-	clearedwebdav_config bool
-	s3_config            *int
-	cleareds3_config     bool
-	done                 bool
-	oldValue             func(context.Context) (*Storage, error)
-	predicates           []predicate.Storage
-}
-
-var _ ent.Mutation = (*StorageMutation)(nil)
-
-// storageOption allows management of the mutation configuration using functional options.
-type storageOption func(*StorageMutation)
-
-// newStorageMutation creates new mutation for the Storage entity.
-func newStorageMutation(c config, op Op, opts ...storageOption) *StorageMutation {
-	m := &StorageMutation{
+	op              Op
+	typ             string
+	id              *int
+	host            *string
+	port            *int
+	addport         *int
+	username        *string
+	password        *string
+	tls_mode        *ftpconfig.TLSMode
+	skip_tls_verify *bool
+	base_dir        *string
+	clearedFields   map[string]struct{}
+	storage         *int
+	clearedstorage  bool
+	done            bool
+	oldValue        func(context.Context) (*FTPConfig, error)
+	predicates      []predicate.FTPConfig
+}
+
+var _ ent.Mutation = (*FTPConfigMutation)(nil)
+
+// ftpconfigOption allows management of the mutation configuration using functional options.
+type ftpconfigOption func(*FTPConfigMutation)
+
+// newFTPConfigMutation creates new mutation for the FTPConfig entity.
+func newFTPConfigMutation(c config, op Op, opts ...ftpconfigOption) *FTPConfigMutation {
+	m := &FTPConfigMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStorage,
+		typ:           TypeFTPConfig,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -709,20 +830,20 @@ func newStorageMutation(c config, op Op, opts ...storageOption) *StorageMutation
 	return m
 }
 
-// withStorageID sets the ID field of the mutation.
-func withStorageID(id int) storageOption {
-	return func(m *StorageMutation) {
+// withFTPConfigID sets the ID field of the mutation.
+func withFTPConfigID(id int) ftpconfigOption {
+	return func(m *FTPConfigMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Storage
+			value *FTPConfig
 		)
-		m.oldValue = func(ctx context.Context) (*Storage, error) {
+		m.oldValue = func(ctx context.Context) (*FTPConfig, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Storage.Get(ctx, id)
+					value, err = m.Client().FTPConfig.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -731,10 +852,10 @@ func withStorageID(id int) storageOption {
 	}
 }
 
-// withStorage sets the old Storage of the mutation.
-func withStorage(node *Storage) storageOption {
-	return func(m *StorageMutation) {
-		m.oldValue = func(context.Context) (*Storage, error) {
+// withFTPConfig sets the old FTPConfig of the mutation.
+func withFTPConfig(node *FTPConfig) ftpconfigOption {
+	return func(m *FTPConfigMutation) {
+		m.oldValue = func(context.Context) (*FTPConfig, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -743,7 +864,7 @@ func withStorage(node *Storage) storageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StorageMutation) Client() *Client {
+func (m FTPConfigMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -751,7 +872,7 @@ func (m StorageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StorageMutation) Tx() (*Tx, error) {
+func (m FTPConfigMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -762,7 +883,7 @@ func (m StorageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StorageMutation) ID() (id int, exists bool) {
+func (m *FTPConfigMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -773,7 +894,7 @@ func (m *StorageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StorageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FTPConfigMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -782,333 +903,358 @@ func (m *StorageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Storage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FTPConfig.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *StorageMutation) SetName(s string) {
-	m.name = &s
+// SetHost sets the "host" field.
+func (m *FTPConfigMutation) SetHost(s string) {
+	m.host = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *StorageMutation) Name() (r string, exists bool) {
-	v := m.name
+// Host returns the value of the "host" field in the mutation.
+func (m *FTPConfigMutation) Host() (r string, exists bool) {
+	v := m.host
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Storage entity.
-// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// OldHost returns the old "host" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StorageMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FTPConfigMutation) OldHost(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldHost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldHost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldHost: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Host, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *StorageMutation) ResetName() {
-	m.name = nil
+// ResetHost resets all changes to the "host" field.
+func (m *FTPConfigMutation) ResetHost() {
+	m.host = nil
 }
 
-// SetType sets the "type" field.
-func (m *StorageMutation) SetType(s storage.Type) {
-	m._type = &s
+// SetPort sets the "port" field.
+func (m *FTPConfigMutation) SetPort(i int) {
+	m.port = &i
+	m.addport = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *StorageMutation) GetType() (r storage.Type, exists bool) {
-	v := m._type
+// Port returns the value of the "port" field in the mutation.
+func (m *FTPConfigMutation) Port() (r int, exists bool) {
+	v := m.port
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Storage entity.
-// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// OldPort returns the old "port" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StorageMutation) OldType(ctx context.Context) (v storage.Type, err error) {
+func (m *FTPConfigMutation) OldPort(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldPort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPort: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Port, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *StorageMutation) ResetType() {
-	m._type = nil
+// AddPort adds i to the "port" field.
+func (m *FTPConfigMutation) AddPort(i int) {
+	if m.addport != nil {
+		*m.addport += i
+	} else {
+		m.addport = &i
+	}
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *StorageMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// AddedPort returns the value that was added to the "port" field in this mutation.
+func (m *FTPConfigMutation) AddedPort() (r int, exists bool) {
+	v := m.addport
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *StorageMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// ResetPort resets all changes to the "port" field.
+func (m *FTPConfigMutation) ResetPort() {
+	m.port = nil
+	m.addport = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *FTPConfigMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *FTPConfigMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the Storage entity.
-// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StorageMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *FTPConfigMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Username, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *StorageMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *FTPConfigMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StorageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPassword sets the "password" field.
+func (m *FTPConfigMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StorageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Password returns the value of the "password" field in the mutation.
+func (m *FTPConfigMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Storage entity.
-// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StorageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FTPConfigMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Password, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StorageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearPassword clears the value of the "password" field.
+func (m *FTPConfigMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[ftpconfig.FieldPassword] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StorageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *FTPConfigMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[ftpconfig.FieldPassword]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StorageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetPassword resets all changes to the "password" field.
+func (m *FTPConfigMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, ftpconfig.FieldPassword)
+}
+
+// SetTLSMode sets the "tls_mode" field.
+func (m *FTPConfigMutation) SetTLSMode(fm ftpconfig.TLSMode) {
+	m.tls_mode = &fm
+}
+
+// TLSMode returns the value of the "tls_mode" field in the mutation.
+func (m *FTPConfigMutation) TLSMode() (r ftpconfig.TLSMode, exists bool) {
+	v := m.tls_mode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Storage entity.
-// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// OldTLSMode returns the old "tls_mode" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StorageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FTPConfigMutation) OldTLSMode(ctx context.Context) (v ftpconfig.TLSMode, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTLSMode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTLSMode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTLSMode: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TLSMode, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StorageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTLSMode resets all changes to the "tls_mode" field.
+func (m *FTPConfigMutation) ResetTLSMode() {
+	m.tls_mode = nil
 }
 
-// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by ids.
-func (m *StorageMutation) AddSyncJobIDs(ids ...int) {
-	if m.sync_jobs == nil {
-		m.sync_jobs = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.sync_jobs[ids[i]] = struct{}{}
+// SetSkipTLSVerify sets the "skip_tls_verify" field.
+func (m *FTPConfigMutation) SetSkipTLSVerify(b bool) {
+	m.skip_tls_verify = &b
+}
+
+// SkipTLSVerify returns the value of the "skip_tls_verify" field in the mutation.
+func (m *FTPConfigMutation) SkipTLSVerify() (r bool, exists bool) {
+	v := m.skip_tls_verify
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSyncJobs clears the "sync_jobs" edge to the SyncJob entity.
-func (m *StorageMutation) ClearSyncJobs() {
-	m.clearedsync_jobs = true
+// OldSkipTLSVerify returns the old "skip_tls_verify" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FTPConfigMutation) OldSkipTLSVerify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipTLSVerify is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipTLSVerify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipTLSVerify: %w", err)
+	}
+	return oldValue.SkipTLSVerify, nil
 }
 
-// SyncJobsCleared reports if the "sync_jobs" edge to the SyncJob entity was cleared.
-func (m *StorageMutation) SyncJobsCleared() bool {
-	return m.clearedsync_jobs
+// ResetSkipTLSVerify resets all changes to the "skip_tls_verify" field.
+func (m *FTPConfigMutation) ResetSkipTLSVerify() {
+	m.skip_tls_verify = nil
 }
 
-// RemoveSyncJobIDs removes the "sync_jobs" edge to the SyncJob entity by IDs.
-func (m *StorageMutation) RemoveSyncJobIDs(ids ...int) {
-	if m.removedsync_jobs == nil {
-		m.removedsync_jobs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.sync_jobs, ids[i])
-		m.removedsync_jobs[ids[i]] = struct{}{}
-	}
+// SetBaseDir sets the "base_dir" field.
+func (m *FTPConfigMutation) SetBaseDir(s string) {
+	m.base_dir = &s
 }
 
-// RemovedSyncJobs returns the removed IDs of the "sync_jobs" edge to the SyncJob entity.
-func (m *StorageMutation) RemovedSyncJobsIDs() (ids []int) {
-	for id := range m.removedsync_jobs {
-		ids = append(ids, id)
+// BaseDir returns the value of the "base_dir" field in the mutation.
+func (m *FTPConfigMutation) BaseDir() (r string, exists bool) {
+	v := m.base_dir
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SyncJobsIDs returns the "sync_jobs" edge IDs in the mutation.
-func (m *StorageMutation) SyncJobsIDs() (ids []int) {
-	for id := range m.sync_jobs {
-		ids = append(ids, id)
+// OldBaseDir returns the old "base_dir" field's value of the FTPConfig entity.
+// If the FTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FTPConfigMutation) OldBaseDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseDir is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseDir requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseDir: %w", err)
+	}
+	return oldValue.BaseDir, nil
 }
 
-// ResetSyncJobs resets all changes to the "sync_jobs" edge.
-func (m *StorageMutation) ResetSyncJobs() {
-	m.sync_jobs = nil
-	m.clearedsync_jobs = false
-	m.removedsync_jobs = nil
+// ClearBaseDir clears the value of the "base_dir" field.
+func (m *FTPConfigMutation) ClearBaseDir() {
+	m.base_dir = nil
+	m.clearedFields[ftpconfig.FieldBaseDir] = struct{}{}
 }
 
-// SetWebdavConfigID sets the "webdav_config" edge to the WebDAVConfig entity by id.
-func (m *StorageMutation) SetWebdavConfigID(id int) {
-	m.webdav_config = &id
+// BaseDirCleared returns if the "base_dir" field was cleared in this mutation.
+func (m *FTPConfigMutation) BaseDirCleared() bool {
+	_, ok := m.clearedFields[ftpconfig.FieldBaseDir]
+	return ok
 }
 
-// ClearWebdavConfig clears the "webdav_config" edge to the WebDAVConfig entity.
-func (m *StorageMutation) ClearWebdavConfig() {
-	m.clearedwebdav_config = true
+// ResetBaseDir resets all changes to the "base_dir" field.
+func (m *FTPConfigMutation) ResetBaseDir() {
+	m.base_dir = nil
+	delete(m.clearedFields, ftpconfig.FieldBaseDir)
 }
 
-// WebdavConfigCleared reports if the "webdav_config" edge to the WebDAVConfig entity was cleared.
-func (m *StorageMutation) WebdavConfigCleared() bool {
-	return m.clearedwebdav_config
-}
-
-// WebdavConfigID returns the "webdav_config" edge ID in the mutation.
-func (m *StorageMutation) WebdavConfigID() (id int, exists bool) {
-	if m.webdav_config != nil {
-		return *m.webdav_config, true
-	}
-	return
-}
-
-// WebdavConfigIDs returns the "webdav_config" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WebdavConfigID instead. It exists only for internal usage by the builders.
-func (m *StorageMutation) WebdavConfigIDs() (ids []int) {
-	if id := m.webdav_config; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetWebdavConfig resets all changes to the "webdav_config" edge.
-func (m *StorageMutation) ResetWebdavConfig() {
-	m.webdav_config = nil
-	m.clearedwebdav_config = false
-}
-
-// SetS3ConfigID sets the "s3_config" edge to the S3Config entity by id.
-func (m *StorageMutation) SetS3ConfigID(id int) {
-	m.s3_config = &id
+// SetStorageID sets the "storage" edge to the Storage entity by id.
+func (m *FTPConfigMutation) SetStorageID(id int) {
+	m.storage = &id
 }
 
-// ClearS3Config clears the "s3_config" edge to the S3Config entity.
-func (m *StorageMutation) ClearS3Config() {
-	m.cleareds3_config = true
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (m *FTPConfigMutation) ClearStorage() {
+	m.clearedstorage = true
 }
 
-// S3ConfigCleared reports if the "s3_config" edge to the S3Config entity was cleared.
-func (m *StorageMutation) S3ConfigCleared() bool {
-	return m.cleareds3_config
+// StorageCleared reports if the "storage" edge to the Storage entity was cleared.
+func (m *FTPConfigMutation) StorageCleared() bool {
+	return m.clearedstorage
 }
 
-// S3ConfigID returns the "s3_config" edge ID in the mutation.
-func (m *StorageMutation) S3ConfigID() (id int, exists bool) {
-	if m.s3_config != nil {
-		return *m.s3_config, true
+// StorageID returns the "storage" edge ID in the mutation.
+func (m *FTPConfigMutation) StorageID() (id int, exists bool) {
+	if m.storage != nil {
+		return *m.storage, true
 	}
 	return
 }
 
-// S3ConfigIDs returns the "s3_config" edge IDs in the mutation.
+// StorageIDs returns the "storage" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// S3ConfigID instead. It exists only for internal usage by the builders.
-func (m *StorageMutation) S3ConfigIDs() (ids []int) {
-	if id := m.s3_config; id != nil {
+// StorageID instead. It exists only for internal usage by the builders.
+func (m *FTPConfigMutation) StorageIDs() (ids []int) {
+	if id := m.storage; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetS3Config resets all changes to the "s3_config" edge.
-func (m *StorageMutation) ResetS3Config() {
-	m.s3_config = nil
-	m.cleareds3_config = false
+// ResetStorage resets all changes to the "storage" edge.
+func (m *FTPConfigMutation) ResetStorage() {
+	m.storage = nil
+	m.clearedstorage = false
 }
 
-// Where appends a list predicates to the StorageMutation builder.
-func (m *StorageMutation) Where(ps ...predicate.Storage) {
+// Where appends a list predicates to the FTPConfigMutation builder.
+func (m *FTPConfigMutation) Where(ps ...predicate.FTPConfig) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StorageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FTPConfigMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StorageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Storage, len(ps))
+func (m *FTPConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FTPConfig, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1116,39 +1262,45 @@ func (m *StorageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StorageMutation) Op() Op {
+func (m *FTPConfigMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StorageMutation) SetOp(op Op) {
+func (m *FTPConfigMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Storage).
-func (m *StorageMutation) Type() string {
+// Type returns the node type of this mutation (FTPConfig).
+func (m *FTPConfigMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StorageMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, storage.FieldName)
+func (m *FTPConfigMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.host != nil {
+		fields = append(fields, ftpconfig.FieldHost)
 	}
-	if m._type != nil {
-		fields = append(fields, storage.FieldType)
+	if m.port != nil {
+		fields = append(fields, ftpconfig.FieldPort)
 	}
-	if m.enabled != nil {
-		fields = append(fields, storage.FieldEnabled)
+	if m.username != nil {
+		fields = append(fields, ftpconfig.FieldUsername)
 	}
-	if m.created_at != nil {
-		fields = append(fields, storage.FieldCreatedAt)
+	if m.password != nil {
+		fields = append(fields, ftpconfig.FieldPassword)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, storage.FieldUpdatedAt)
+	if m.tls_mode != nil {
+		fields = append(fields, ftpconfig.FieldTLSMode)
+	}
+	if m.skip_tls_verify != nil {
+		fields = append(fields, ftpconfig.FieldSkipTLSVerify)
+	}
+	if m.base_dir != nil {
+		fields = append(fields, ftpconfig.FieldBaseDir)
 	}
 	return fields
 }
@@ -1156,18 +1308,22 @@ func (m *StorageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StorageMutation) Field(name string) (ent.Value, bool) {
+func (m *FTPConfigMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case storage.FieldName:
-		return m.Name()
-	case storage.FieldType:
-		return m.GetType()
-	case storage.FieldEnabled:
-		return m.Enabled()
-	case storage.FieldCreatedAt:
-		return m.CreatedAt()
-	case storage.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case ftpconfig.FieldHost:
+		return m.Host()
+	case ftpconfig.FieldPort:
+		return m.Port()
+	case ftpconfig.FieldUsername:
+		return m.Username()
+	case ftpconfig.FieldPassword:
+		return m.Password()
+	case ftpconfig.FieldTLSMode:
+		return m.TLSMode()
+	case ftpconfig.FieldSkipTLSVerify:
+		return m.SkipTLSVerify()
+	case ftpconfig.FieldBaseDir:
+		return m.BaseDir()
 	}
 	return nil, false
 }
@@ -1175,161 +1331,199 @@ func (m *StorageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StorageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FTPConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case storage.FieldName:
-		return m.OldName(ctx)
-	case storage.FieldType:
-		return m.OldType(ctx)
-	case storage.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case storage.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case storage.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case ftpconfig.FieldHost:
+		return m.OldHost(ctx)
+	case ftpconfig.FieldPort:
+		return m.OldPort(ctx)
+	case ftpconfig.FieldUsername:
+		return m.OldUsername(ctx)
+	case ftpconfig.FieldPassword:
+		return m.OldPassword(ctx)
+	case ftpconfig.FieldTLSMode:
+		return m.OldTLSMode(ctx)
+	case ftpconfig.FieldSkipTLSVerify:
+		return m.OldSkipTLSVerify(ctx)
+	case ftpconfig.FieldBaseDir:
+		return m.OldBaseDir(ctx)
 	}
-	return nil, fmt.Errorf("unknown Storage field %s", name)
+	return nil, fmt.Errorf("unknown FTPConfig field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StorageMutation) SetField(name string, value ent.Value) error {
+func (m *FTPConfigMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case storage.FieldName:
+	case ftpconfig.FieldHost:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetHost(v)
 		return nil
-	case storage.FieldType:
-		v, ok := value.(storage.Type)
+	case ftpconfig.FieldPort:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetPort(v)
 		return nil
-	case storage.FieldEnabled:
-		v, ok := value.(bool)
+	case ftpconfig.FieldUsername:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetUsername(v)
 		return nil
-	case storage.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case ftpconfig.FieldPassword:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPassword(v)
 		return nil
-	case storage.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case ftpconfig.FieldTLSMode:
+		v, ok := value.(ftpconfig.TLSMode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetTLSMode(v)
+		return nil
+	case ftpconfig.FieldSkipTLSVerify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipTLSVerify(v)
+		return nil
+	case ftpconfig.FieldBaseDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseDir(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Storage field %s", name)
+	return fmt.Errorf("unknown FTPConfig field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StorageMutation) AddedFields() []string {
-	return nil
+func (m *FTPConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addport != nil {
+		fields = append(fields, ftpconfig.FieldPort)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StorageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FTPConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ftpconfig.FieldPort:
+		return m.AddedPort()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StorageMutation) AddField(name string, value ent.Value) error {
+func (m *FTPConfigMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case ftpconfig.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPort(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Storage numeric field %s", name)
+	return fmt.Errorf("unknown FTPConfig numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StorageMutation) ClearedFields() []string {
-	return nil
+func (m *FTPConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ftpconfig.FieldPassword) {
+		fields = append(fields, ftpconfig.FieldPassword)
+	}
+	if m.FieldCleared(ftpconfig.FieldBaseDir) {
+		fields = append(fields, ftpconfig.FieldBaseDir)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StorageMutation) FieldCleared(name string) bool {
+func (m *FTPConfigMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StorageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Storage nullable field %s", name)
+func (m *FTPConfigMutation) ClearField(name string) error {
+	switch name {
+	case ftpconfig.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case ftpconfig.FieldBaseDir:
+		m.ClearBaseDir()
+		return nil
+	}
+	return fmt.Errorf("unknown FTPConfig nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StorageMutation) ResetField(name string) error {
+func (m *FTPConfigMutation) ResetField(name string) error {
 	switch name {
-	case storage.FieldName:
-		m.ResetName()
+	case ftpconfig.FieldHost:
+		m.ResetHost()
 		return nil
-	case storage.FieldType:
-		m.ResetType()
+	case ftpconfig.FieldPort:
+		m.ResetPort()
 		return nil
-	case storage.FieldEnabled:
-		m.ResetEnabled()
+	case ftpconfig.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case storage.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case ftpconfig.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case storage.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case ftpconfig.FieldTLSMode:
+		m.ResetTLSMode()
+		return nil
+	case ftpconfig.FieldSkipTLSVerify:
+		m.ResetSkipTLSVerify()
+		return nil
+	case ftpconfig.FieldBaseDir:
+		m.ResetBaseDir()
 		return nil
 	}
-	return fmt.Errorf("unknown Storage field %s", name)
+	return fmt.Errorf("unknown FTPConfig field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StorageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.sync_jobs != nil {
-		edges = append(edges, storage.EdgeSyncJobs)
-	}
-	if m.webdav_config != nil {
-		edges = append(edges, storage.EdgeWebdavConfig)
-	}
-	if m.s3_config != nil {
-		edges = append(edges, storage.EdgeS3Config)
+func (m *FTPConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.storage != nil {
+		edges = append(edges, ftpconfig.EdgeStorage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StorageMutation) AddedIDs(name string) []ent.Value {
+func (m *FTPConfigMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case storage.EdgeSyncJobs:
-		ids := make([]ent.Value, 0, len(m.sync_jobs))
-		for id := range m.sync_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case storage.EdgeWebdavConfig:
-		if id := m.webdav_config; id != nil {
-			return []ent.Value{*id}
-		}
-	case storage.EdgeS3Config:
-		if id := m.s3_config; id != nil {
+	case ftpconfig.EdgeStorage:
+		if id := m.storage; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1337,119 +1531,90 @@ func (m *StorageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StorageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedsync_jobs != nil {
-		edges = append(edges, storage.EdgeSyncJobs)
-	}
+func (m *FTPConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StorageMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case storage.EdgeSyncJobs:
-		ids := make([]ent.Value, 0, len(m.removedsync_jobs))
-		for id := range m.removedsync_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *FTPConfigMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StorageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedsync_jobs {
-		edges = append(edges, storage.EdgeSyncJobs)
-	}
-	if m.clearedwebdav_config {
-		edges = append(edges, storage.EdgeWebdavConfig)
-	}
-	if m.cleareds3_config {
-		edges = append(edges, storage.EdgeS3Config)
+func (m *FTPConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstorage {
+		edges = append(edges, ftpconfig.EdgeStorage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StorageMutation) EdgeCleared(name string) bool {
+func (m *FTPConfigMutation) EdgeCleared(name string) bool {
 	switch name {
-	case storage.EdgeSyncJobs:
-		return m.clearedsync_jobs
-	case storage.EdgeWebdavConfig:
-		return m.clearedwebdav_config
-	case storage.EdgeS3Config:
-		return m.cleareds3_config
+	case ftpconfig.EdgeStorage:
+		return m.clearedstorage
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StorageMutation) ClearEdge(name string) error {
+func (m *FTPConfigMutation) ClearEdge(name string) error {
 	switch name {
-	case storage.EdgeWebdavConfig:
-		m.ClearWebdavConfig()
-		return nil
-	case storage.EdgeS3Config:
-		m.ClearS3Config()
+	case ftpconfig.EdgeStorage:
+		m.ClearStorage()
 		return nil
 	}
-	return fmt.Errorf("unknown Storage unique edge %s", name)
+	return fmt.Errorf("unknown FTPConfig unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StorageMutation) ResetEdge(name string) error {
+func (m *FTPConfigMutation) ResetEdge(name string) error {
 	switch name {
-	case storage.EdgeSyncJobs:
-		m.ResetSyncJobs()
-		return nil
-	case storage.EdgeWebdavConfig:
-		m.ResetWebdavConfig()
-		return nil
-	case storage.EdgeS3Config:
-		m.ResetS3Config()
+	case ftpconfig.EdgeStorage:
+		m.ResetStorage()
 		return nil
 	}
-	return fmt.Errorf("unknown Storage edge %s", name)
+	return fmt.Errorf("unknown FTPConfig edge %s", name)
 }
 
-// SyncJobMutation represents an operation that mutates the SyncJob nodes in the graph.
-type SyncJobMutation struct {
+// GCSConfigMutation represents an operation that mutates the GCSConfig nodes in the graph.
+type GCSConfigMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	status         *syncjob.Status
-	operation      *syncjob.Operation
-	message        *string
-	started_at     *time.Time
-	completed_at   *time.Time
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	storage        *int
-	clearedstorage bool
-	done           bool
-	oldValue       func(context.Context) (*SyncJob, error)
-	predicates     []predicate.SyncJob
+	op                Op
+	typ               string
+	id                *int
+	bucket            *string
+	credentials_json  *string
+	endpoint          *string
+	storage_class     *gcsconfig.StorageClass
+	retention_days    *int
+	addretention_days *int
+	retention_mode    *gcsconfig.RetentionMode
+	clearedFields     map[string]struct{}
+	storage           *int
+	clearedstorage    bool
+	done              bool
+	oldValue          func(context.Context) (*GCSConfig, error)
+	predicates        []predicate.GCSConfig
 }
 
-var _ ent.Mutation = (*SyncJobMutation)(nil)
+var _ ent.Mutation = (*GCSConfigMutation)(nil)
 
-// syncjobOption allows management of the mutation configuration using functional options.
-type syncjobOption func(*SyncJobMutation)
+// gcsconfigOption allows management of the mutation configuration using functional options.
+type gcsconfigOption func(*GCSConfigMutation)
 
-// newSyncJobMutation creates new mutation for the SyncJob entity.
-func newSyncJobMutation(c config, op Op, opts ...syncjobOption) *SyncJobMutation {
-	m := &SyncJobMutation{
+// newGCSConfigMutation creates new mutation for the GCSConfig entity.
+func newGCSConfigMutation(c config, op Op, opts ...gcsconfigOption) *GCSConfigMutation {
+	m := &GCSConfigMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSyncJob,
+		typ:           TypeGCSConfig,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1458,20 +1623,20 @@ func newSyncJobMutation(c config, op Op, opts ...syncjobOption) *SyncJobMutation
 	return m
 }
 
-// withSyncJobID sets the ID field of the mutation.
-func withSyncJobID(id int) syncjobOption {
-	return func(m *SyncJobMutation) {
+// withGCSConfigID sets the ID field of the mutation.
+func withGCSConfigID(id int) gcsconfigOption {
+	return func(m *GCSConfigMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SyncJob
+			value *GCSConfig
 		)
-		m.oldValue = func(ctx context.Context) (*SyncJob, error) {
+		m.oldValue = func(ctx context.Context) (*GCSConfig, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SyncJob.Get(ctx, id)
+					value, err = m.Client().GCSConfig.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1480,10 +1645,10 @@ func withSyncJobID(id int) syncjobOption {
 	}
 }
 
-// withSyncJob sets the old SyncJob of the mutation.
-func withSyncJob(node *SyncJob) syncjobOption {
-	return func(m *SyncJobMutation) {
-		m.oldValue = func(context.Context) (*SyncJob, error) {
+// withGCSConfig sets the old GCSConfig of the mutation.
+func withGCSConfig(node *GCSConfig) gcsconfigOption {
+	return func(m *GCSConfigMutation) {
+		m.oldValue = func(context.Context) (*GCSConfig, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1492,7 +1657,7 @@ func withSyncJob(node *SyncJob) syncjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SyncJobMutation) Client() *Client {
+func (m GCSConfigMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1500,7 +1665,7 @@ func (m SyncJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SyncJobMutation) Tx() (*Tx, error) {
+func (m GCSConfigMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1511,7 +1676,7 @@ func (m SyncJobMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SyncJobMutation) ID() (id int, exists bool) {
+func (m *GCSConfigMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1522,7 +1687,7 @@ func (m *SyncJobMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SyncJobMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GCSConfigMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1531,131 +1696,6160 @@ func (m *SyncJobMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SyncJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GCSConfig.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *SyncJobMutation) SetStatus(s syncjob.Status) {
-	m.status = &s
+// SetBucket sets the "bucket" field.
+func (m *GCSConfigMutation) SetBucket(s string) {
+	m.bucket = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SyncJobMutation) Status() (r syncjob.Status, exists bool) {
-	v := m.status
+// Bucket returns the value of the "bucket" field in the mutation.
+func (m *GCSConfigMutation) Bucket() (r string, exists bool) {
+	v := m.bucket
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the SyncJob entity.
-// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// OldBucket returns the old "bucket" field's value of the GCSConfig entity.
+// If the GCSConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncJobMutation) OldStatus(ctx context.Context) (v syncjob.Status, err error) {
+func (m *GCSConfigMutation) OldBucket(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldBucket requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Bucket, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SyncJobMutation) ResetStatus() {
-	m.status = nil
+// ResetBucket resets all changes to the "bucket" field.
+func (m *GCSConfigMutation) ResetBucket() {
+	m.bucket = nil
 }
 
-// SetOperation sets the "operation" field.
-func (m *SyncJobMutation) SetOperation(s syncjob.Operation) {
-	m.operation = &s
+// SetCredentialsJSON sets the "credentials_json" field.
+func (m *GCSConfigMutation) SetCredentialsJSON(s string) {
+	m.credentials_json = &s
 }
 
-// Operation returns the value of the "operation" field in the mutation.
-func (m *SyncJobMutation) Operation() (r syncjob.Operation, exists bool) {
-	v := m.operation
+// CredentialsJSON returns the value of the "credentials_json" field in the mutation.
+func (m *GCSConfigMutation) CredentialsJSON() (r string, exists bool) {
+	v := m.credentials_json
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOperation returns the old "operation" field's value of the SyncJob entity.
-// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialsJSON returns the old "credentials_json" field's value of the GCSConfig entity.
+// If the GCSConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncJobMutation) OldOperation(ctx context.Context) (v syncjob.Operation, err error) {
+func (m *GCSConfigMutation) OldCredentialsJSON(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialsJSON is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperation requires an ID field in the mutation")
+		return v, errors.New("OldCredentialsJSON requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialsJSON: %w", err)
+	}
+	return oldValue.CredentialsJSON, nil
+}
+
+// ClearCredentialsJSON clears the value of the "credentials_json" field.
+func (m *GCSConfigMutation) ClearCredentialsJSON() {
+	m.credentials_json = nil
+	m.clearedFields[gcsconfig.FieldCredentialsJSON] = struct{}{}
+}
+
+// CredentialsJSONCleared returns if the "credentials_json" field was cleared in this mutation.
+func (m *GCSConfigMutation) CredentialsJSONCleared() bool {
+	_, ok := m.clearedFields[gcsconfig.FieldCredentialsJSON]
+	return ok
+}
+
+// ResetCredentialsJSON resets all changes to the "credentials_json" field.
+func (m *GCSConfigMutation) ResetCredentialsJSON() {
+	m.credentials_json = nil
+	delete(m.clearedFields, gcsconfig.FieldCredentialsJSON)
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *GCSConfigMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *GCSConfigMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpoint returns the old "endpoint" field's value of the GCSConfig entity.
+// If the GCSConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GCSConfigMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (m *GCSConfigMutation) ClearEndpoint() {
+	m.endpoint = nil
+	m.clearedFields[gcsconfig.FieldEndpoint] = struct{}{}
+}
+
+// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
+func (m *GCSConfigMutation) EndpointCleared() bool {
+	_, ok := m.clearedFields[gcsconfig.FieldEndpoint]
+	return ok
+}
+
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *GCSConfigMutation) ResetEndpoint() {
+	m.endpoint = nil
+	delete(m.clearedFields, gcsconfig.FieldEndpoint)
+}
+
+// SetStorageClass sets the "storage_class" field.
+func (m *GCSConfigMutation) SetStorageClass(gc gcsconfig.StorageClass) {
+	m.storage_class = &gc
+}
+
+// StorageClass returns the value of the "storage_class" field in the mutation.
+func (m *GCSConfigMutation) StorageClass() (r gcsconfig.StorageClass, exists bool) {
+	v := m.storage_class
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageClass returns the old "storage_class" field's value of the GCSConfig entity.
+// If the GCSConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GCSConfigMutation) OldStorageClass(ctx context.Context) (v gcsconfig.StorageClass, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageClass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageClass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageClass: %w", err)
+	}
+	return oldValue.StorageClass, nil
+}
+
+// ClearStorageClass clears the value of the "storage_class" field.
+func (m *GCSConfigMutation) ClearStorageClass() {
+	m.storage_class = nil
+	m.clearedFields[gcsconfig.FieldStorageClass] = struct{}{}
+}
+
+// StorageClassCleared returns if the "storage_class" field was cleared in this mutation.
+func (m *GCSConfigMutation) StorageClassCleared() bool {
+	_, ok := m.clearedFields[gcsconfig.FieldStorageClass]
+	return ok
+}
+
+// ResetStorageClass resets all changes to the "storage_class" field.
+func (m *GCSConfigMutation) ResetStorageClass() {
+	m.storage_class = nil
+	delete(m.clearedFields, gcsconfig.FieldStorageClass)
+}
+
+// SetRetentionDays sets the "retention_days" field.
+func (m *GCSConfigMutation) SetRetentionDays(i int) {
+	m.retention_days = &i
+	m.addretention_days = nil
+}
+
+// RetentionDays returns the value of the "retention_days" field in the mutation.
+func (m *GCSConfigMutation) RetentionDays() (r int, exists bool) {
+	v := m.retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionDays returns the old "retention_days" field's value of the GCSConfig entity.
+// If the GCSConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GCSConfigMutation) OldRetentionDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionDays: %w", err)
+	}
+	return oldValue.RetentionDays, nil
+}
+
+// AddRetentionDays adds i to the "retention_days" field.
+func (m *GCSConfigMutation) AddRetentionDays(i int) {
+	if m.addretention_days != nil {
+		*m.addretention_days += i
+	} else {
+		m.addretention_days = &i
+	}
+}
+
+// AddedRetentionDays returns the value that was added to the "retention_days" field in this mutation.
+func (m *GCSConfigMutation) AddedRetentionDays() (r int, exists bool) {
+	v := m.addretention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetentionDays resets all changes to the "retention_days" field.
+func (m *GCSConfigMutation) ResetRetentionDays() {
+	m.retention_days = nil
+	m.addretention_days = nil
+}
+
+// SetRetentionMode sets the "retention_mode" field.
+func (m *GCSConfigMutation) SetRetentionMode(gm gcsconfig.RetentionMode) {
+	m.retention_mode = &gm
+}
+
+// RetentionMode returns the value of the "retention_mode" field in the mutation.
+func (m *GCSConfigMutation) RetentionMode() (r gcsconfig.RetentionMode, exists bool) {
+	v := m.retention_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionMode returns the old "retention_mode" field's value of the GCSConfig entity.
+// If the GCSConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GCSConfigMutation) OldRetentionMode(ctx context.Context) (v gcsconfig.RetentionMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionMode: %w", err)
+	}
+	return oldValue.RetentionMode, nil
+}
+
+// ResetRetentionMode resets all changes to the "retention_mode" field.
+func (m *GCSConfigMutation) ResetRetentionMode() {
+	m.retention_mode = nil
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by id.
+func (m *GCSConfigMutation) SetStorageID(id int) {
+	m.storage = &id
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (m *GCSConfigMutation) ClearStorage() {
+	m.clearedstorage = true
+}
+
+// StorageCleared reports if the "storage" edge to the Storage entity was cleared.
+func (m *GCSConfigMutation) StorageCleared() bool {
+	return m.clearedstorage
+}
+
+// StorageID returns the "storage" edge ID in the mutation.
+func (m *GCSConfigMutation) StorageID() (id int, exists bool) {
+	if m.storage != nil {
+		return *m.storage, true
+	}
+	return
+}
+
+// StorageIDs returns the "storage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StorageID instead. It exists only for internal usage by the builders.
+func (m *GCSConfigMutation) StorageIDs() (ids []int) {
+	if id := m.storage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStorage resets all changes to the "storage" edge.
+func (m *GCSConfigMutation) ResetStorage() {
+	m.storage = nil
+	m.clearedstorage = false
+}
+
+// Where appends a list predicates to the GCSConfigMutation builder.
+func (m *GCSConfigMutation) Where(ps ...predicate.GCSConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GCSConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GCSConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GCSConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GCSConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GCSConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GCSConfig).
+func (m *GCSConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GCSConfigMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.bucket != nil {
+		fields = append(fields, gcsconfig.FieldBucket)
+	}
+	if m.credentials_json != nil {
+		fields = append(fields, gcsconfig.FieldCredentialsJSON)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, gcsconfig.FieldEndpoint)
+	}
+	if m.storage_class != nil {
+		fields = append(fields, gcsconfig.FieldStorageClass)
+	}
+	if m.retention_days != nil {
+		fields = append(fields, gcsconfig.FieldRetentionDays)
+	}
+	if m.retention_mode != nil {
+		fields = append(fields, gcsconfig.FieldRetentionMode)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GCSConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gcsconfig.FieldBucket:
+		return m.Bucket()
+	case gcsconfig.FieldCredentialsJSON:
+		return m.CredentialsJSON()
+	case gcsconfig.FieldEndpoint:
+		return m.Endpoint()
+	case gcsconfig.FieldStorageClass:
+		return m.StorageClass()
+	case gcsconfig.FieldRetentionDays:
+		return m.RetentionDays()
+	case gcsconfig.FieldRetentionMode:
+		return m.RetentionMode()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GCSConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gcsconfig.FieldBucket:
+		return m.OldBucket(ctx)
+	case gcsconfig.FieldCredentialsJSON:
+		return m.OldCredentialsJSON(ctx)
+	case gcsconfig.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case gcsconfig.FieldStorageClass:
+		return m.OldStorageClass(ctx)
+	case gcsconfig.FieldRetentionDays:
+		return m.OldRetentionDays(ctx)
+	case gcsconfig.FieldRetentionMode:
+		return m.OldRetentionMode(ctx)
+	}
+	return nil, fmt.Errorf("unknown GCSConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GCSConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gcsconfig.FieldBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucket(v)
+		return nil
+	case gcsconfig.FieldCredentialsJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialsJSON(v)
+		return nil
+	case gcsconfig.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case gcsconfig.FieldStorageClass:
+		v, ok := value.(gcsconfig.StorageClass)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageClass(v)
+		return nil
+	case gcsconfig.FieldRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionDays(v)
+		return nil
+	case gcsconfig.FieldRetentionMode:
+		v, ok := value.(gcsconfig.RetentionMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionMode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GCSConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GCSConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addretention_days != nil {
+		fields = append(fields, gcsconfig.FieldRetentionDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GCSConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gcsconfig.FieldRetentionDays:
+		return m.AddedRetentionDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GCSConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gcsconfig.FieldRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GCSConfig numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GCSConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gcsconfig.FieldCredentialsJSON) {
+		fields = append(fields, gcsconfig.FieldCredentialsJSON)
+	}
+	if m.FieldCleared(gcsconfig.FieldEndpoint) {
+		fields = append(fields, gcsconfig.FieldEndpoint)
+	}
+	if m.FieldCleared(gcsconfig.FieldStorageClass) {
+		fields = append(fields, gcsconfig.FieldStorageClass)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GCSConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GCSConfigMutation) ClearField(name string) error {
+	switch name {
+	case gcsconfig.FieldCredentialsJSON:
+		m.ClearCredentialsJSON()
+		return nil
+	case gcsconfig.FieldEndpoint:
+		m.ClearEndpoint()
+		return nil
+	case gcsconfig.FieldStorageClass:
+		m.ClearStorageClass()
+		return nil
+	}
+	return fmt.Errorf("unknown GCSConfig nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GCSConfigMutation) ResetField(name string) error {
+	switch name {
+	case gcsconfig.FieldBucket:
+		m.ResetBucket()
+		return nil
+	case gcsconfig.FieldCredentialsJSON:
+		m.ResetCredentialsJSON()
+		return nil
+	case gcsconfig.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case gcsconfig.FieldStorageClass:
+		m.ResetStorageClass()
+		return nil
+	case gcsconfig.FieldRetentionDays:
+		m.ResetRetentionDays()
+		return nil
+	case gcsconfig.FieldRetentionMode:
+		m.ResetRetentionMode()
+		return nil
+	}
+	return fmt.Errorf("unknown GCSConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GCSConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.storage != nil {
+		edges = append(edges, gcsconfig.EdgeStorage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GCSConfigMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gcsconfig.EdgeStorage:
+		if id := m.storage; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GCSConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GCSConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GCSConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstorage {
+		edges = append(edges, gcsconfig.EdgeStorage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GCSConfigMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gcsconfig.EdgeStorage:
+		return m.clearedstorage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GCSConfigMutation) ClearEdge(name string) error {
+	switch name {
+	case gcsconfig.EdgeStorage:
+		m.ClearStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown GCSConfig unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GCSConfigMutation) ResetEdge(name string) error {
+	switch name {
+	case gcsconfig.EdgeStorage:
+		m.ResetStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown GCSConfig edge %s", name)
+}
+
+// LocalConfigMutation represents an operation that mutates the LocalConfig nodes in the graph.
+type LocalConfigMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	_path                *string
+	min_free_space_mb    *int64
+	addmin_free_space_mb *int64
+	sync_directory       *bool
+	clearedFields        map[string]struct{}
+	storage              *int
+	clearedstorage       bool
+	done                 bool
+	oldValue             func(context.Context) (*LocalConfig, error)
+	predicates           []predicate.LocalConfig
+}
+
+var _ ent.Mutation = (*LocalConfigMutation)(nil)
+
+// localconfigOption allows management of the mutation configuration using functional options.
+type localconfigOption func(*LocalConfigMutation)
+
+// newLocalConfigMutation creates new mutation for the LocalConfig entity.
+func newLocalConfigMutation(c config, op Op, opts ...localconfigOption) *LocalConfigMutation {
+	m := &LocalConfigMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocalConfig,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocalConfigID sets the ID field of the mutation.
+func withLocalConfigID(id int) localconfigOption {
+	return func(m *LocalConfigMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LocalConfig
+		)
+		m.oldValue = func(ctx context.Context) (*LocalConfig, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LocalConfig.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocalConfig sets the old LocalConfig of the mutation.
+func withLocalConfig(node *LocalConfig) localconfigOption {
+	return func(m *LocalConfigMutation) {
+		m.oldValue = func(context.Context) (*LocalConfig, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocalConfigMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocalConfigMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocalConfigMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocalConfigMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LocalConfig.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPath sets the "path" field.
+func (m *LocalConfigMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *LocalConfigMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the LocalConfig entity.
+// If the LocalConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalConfigMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *LocalConfigMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetMinFreeSpaceMB sets the "min_free_space_mb" field.
+func (m *LocalConfigMutation) SetMinFreeSpaceMB(i int64) {
+	m.min_free_space_mb = &i
+	m.addmin_free_space_mb = nil
+}
+
+// MinFreeSpaceMB returns the value of the "min_free_space_mb" field in the mutation.
+func (m *LocalConfigMutation) MinFreeSpaceMB() (r int64, exists bool) {
+	v := m.min_free_space_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinFreeSpaceMB returns the old "min_free_space_mb" field's value of the LocalConfig entity.
+// If the LocalConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalConfigMutation) OldMinFreeSpaceMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinFreeSpaceMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinFreeSpaceMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinFreeSpaceMB: %w", err)
+	}
+	return oldValue.MinFreeSpaceMB, nil
+}
+
+// AddMinFreeSpaceMB adds i to the "min_free_space_mb" field.
+func (m *LocalConfigMutation) AddMinFreeSpaceMB(i int64) {
+	if m.addmin_free_space_mb != nil {
+		*m.addmin_free_space_mb += i
+	} else {
+		m.addmin_free_space_mb = &i
+	}
+}
+
+// AddedMinFreeSpaceMB returns the value that was added to the "min_free_space_mb" field in this mutation.
+func (m *LocalConfigMutation) AddedMinFreeSpaceMB() (r int64, exists bool) {
+	v := m.addmin_free_space_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinFreeSpaceMB resets all changes to the "min_free_space_mb" field.
+func (m *LocalConfigMutation) ResetMinFreeSpaceMB() {
+	m.min_free_space_mb = nil
+	m.addmin_free_space_mb = nil
+}
+
+// SetSyncDirectory sets the "sync_directory" field.
+func (m *LocalConfigMutation) SetSyncDirectory(b bool) {
+	m.sync_directory = &b
+}
+
+// SyncDirectory returns the value of the "sync_directory" field in the mutation.
+func (m *LocalConfigMutation) SyncDirectory() (r bool, exists bool) {
+	v := m.sync_directory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSyncDirectory returns the old "sync_directory" field's value of the LocalConfig entity.
+// If the LocalConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalConfigMutation) OldSyncDirectory(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSyncDirectory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSyncDirectory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSyncDirectory: %w", err)
+	}
+	return oldValue.SyncDirectory, nil
+}
+
+// ResetSyncDirectory resets all changes to the "sync_directory" field.
+func (m *LocalConfigMutation) ResetSyncDirectory() {
+	m.sync_directory = nil
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by id.
+func (m *LocalConfigMutation) SetStorageID(id int) {
+	m.storage = &id
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (m *LocalConfigMutation) ClearStorage() {
+	m.clearedstorage = true
+}
+
+// StorageCleared reports if the "storage" edge to the Storage entity was cleared.
+func (m *LocalConfigMutation) StorageCleared() bool {
+	return m.clearedstorage
+}
+
+// StorageID returns the "storage" edge ID in the mutation.
+func (m *LocalConfigMutation) StorageID() (id int, exists bool) {
+	if m.storage != nil {
+		return *m.storage, true
+	}
+	return
+}
+
+// StorageIDs returns the "storage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StorageID instead. It exists only for internal usage by the builders.
+func (m *LocalConfigMutation) StorageIDs() (ids []int) {
+	if id := m.storage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStorage resets all changes to the "storage" edge.
+func (m *LocalConfigMutation) ResetStorage() {
+	m.storage = nil
+	m.clearedstorage = false
+}
+
+// Where appends a list predicates to the LocalConfigMutation builder.
+func (m *LocalConfigMutation) Where(ps ...predicate.LocalConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocalConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocalConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LocalConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocalConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocalConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LocalConfig).
+func (m *LocalConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocalConfigMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._path != nil {
+		fields = append(fields, localconfig.FieldPath)
+	}
+	if m.min_free_space_mb != nil {
+		fields = append(fields, localconfig.FieldMinFreeSpaceMB)
+	}
+	if m.sync_directory != nil {
+		fields = append(fields, localconfig.FieldSyncDirectory)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocalConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case localconfig.FieldPath:
+		return m.Path()
+	case localconfig.FieldMinFreeSpaceMB:
+		return m.MinFreeSpaceMB()
+	case localconfig.FieldSyncDirectory:
+		return m.SyncDirectory()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocalConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case localconfig.FieldPath:
+		return m.OldPath(ctx)
+	case localconfig.FieldMinFreeSpaceMB:
+		return m.OldMinFreeSpaceMB(ctx)
+	case localconfig.FieldSyncDirectory:
+		return m.OldSyncDirectory(ctx)
+	}
+	return nil, fmt.Errorf("unknown LocalConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocalConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case localconfig.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case localconfig.FieldMinFreeSpaceMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinFreeSpaceMB(v)
+		return nil
+	case localconfig.FieldSyncDirectory:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSyncDirectory(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocalConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocalConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_free_space_mb != nil {
+		fields = append(fields, localconfig.FieldMinFreeSpaceMB)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocalConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case localconfig.FieldMinFreeSpaceMB:
+		return m.AddedMinFreeSpaceMB()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocalConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case localconfig.FieldMinFreeSpaceMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinFreeSpaceMB(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocalConfig numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocalConfigMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocalConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocalConfigMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LocalConfig nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocalConfigMutation) ResetField(name string) error {
+	switch name {
+	case localconfig.FieldPath:
+		m.ResetPath()
+		return nil
+	case localconfig.FieldMinFreeSpaceMB:
+		m.ResetMinFreeSpaceMB()
+		return nil
+	case localconfig.FieldSyncDirectory:
+		m.ResetSyncDirectory()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocalConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.storage != nil {
+		edges = append(edges, localconfig.EdgeStorage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocalConfigMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case localconfig.EdgeStorage:
+		if id := m.storage; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocalConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocalConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocalConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstorage {
+		edges = append(edges, localconfig.EdgeStorage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocalConfigMutation) EdgeCleared(name string) bool {
+	switch name {
+	case localconfig.EdgeStorage:
+		return m.clearedstorage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocalConfigMutation) ClearEdge(name string) error {
+	switch name {
+	case localconfig.EdgeStorage:
+		m.ClearStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalConfig unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocalConfigMutation) ResetEdge(name string) error {
+	switch name {
+	case localconfig.EdgeStorage:
+		m.ResetStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalConfig edge %s", name)
+}
+
+// S3ConfigMutation represents an operation that mutates the S3Config nodes in the graph.
+type S3ConfigMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	endpoint          *string
+	access_key_id     *string
+	secret_access_key *string
+	region            *string
+	bucket            *string
+	clearedFields     map[string]struct{}
+	storage           *int
+	clearedstorage    bool
+	done              bool
+	oldValue          func(context.Context) (*S3Config, error)
+	predicates        []predicate.S3Config
+}
+
+var _ ent.Mutation = (*S3ConfigMutation)(nil)
+
+// s3configOption allows management of the mutation configuration using functional options.
+type s3configOption func(*S3ConfigMutation)
+
+// newS3ConfigMutation creates new mutation for the S3Config entity.
+func newS3ConfigMutation(c config, op Op, opts ...s3configOption) *S3ConfigMutation {
+	m := &S3ConfigMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeS3Config,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withS3ConfigID sets the ID field of the mutation.
+func withS3ConfigID(id int) s3configOption {
+	return func(m *S3ConfigMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *S3Config
+		)
+		m.oldValue = func(ctx context.Context) (*S3Config, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().S3Config.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withS3Config sets the old S3Config of the mutation.
+func withS3Config(node *S3Config) s3configOption {
+	return func(m *S3ConfigMutation) {
+		m.oldValue = func(context.Context) (*S3Config, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m S3ConfigMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m S3ConfigMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *S3ConfigMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *S3ConfigMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().S3Config.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *S3ConfigMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *S3ConfigMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpoint returns the old "endpoint" field's value of the S3Config entity.
+// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3ConfigMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (m *S3ConfigMutation) ClearEndpoint() {
+	m.endpoint = nil
+	m.clearedFields[s3config.FieldEndpoint] = struct{}{}
+}
+
+// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
+func (m *S3ConfigMutation) EndpointCleared() bool {
+	_, ok := m.clearedFields[s3config.FieldEndpoint]
+	return ok
+}
+
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *S3ConfigMutation) ResetEndpoint() {
+	m.endpoint = nil
+	delete(m.clearedFields, s3config.FieldEndpoint)
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (m *S3ConfigMutation) SetAccessKeyID(s string) {
+	m.access_key_id = &s
+}
+
+// AccessKeyID returns the value of the "access_key_id" field in the mutation.
+func (m *S3ConfigMutation) AccessKeyID() (r string, exists bool) {
+	v := m.access_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKeyID returns the old "access_key_id" field's value of the S3Config entity.
+// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3ConfigMutation) OldAccessKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKeyID: %w", err)
+	}
+	return oldValue.AccessKeyID, nil
+}
+
+// ResetAccessKeyID resets all changes to the "access_key_id" field.
+func (m *S3ConfigMutation) ResetAccessKeyID() {
+	m.access_key_id = nil
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (m *S3ConfigMutation) SetSecretAccessKey(s string) {
+	m.secret_access_key = &s
+}
+
+// SecretAccessKey returns the value of the "secret_access_key" field in the mutation.
+func (m *S3ConfigMutation) SecretAccessKey() (r string, exists bool) {
+	v := m.secret_access_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretAccessKey returns the old "secret_access_key" field's value of the S3Config entity.
+// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3ConfigMutation) OldSecretAccessKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretAccessKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretAccessKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretAccessKey: %w", err)
+	}
+	return oldValue.SecretAccessKey, nil
+}
+
+// ResetSecretAccessKey resets all changes to the "secret_access_key" field.
+func (m *S3ConfigMutation) ResetSecretAccessKey() {
+	m.secret_access_key = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *S3ConfigMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *S3ConfigMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the S3Config entity.
+// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3ConfigMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *S3ConfigMutation) ResetRegion() {
+	m.region = nil
+}
+
+// SetBucket sets the "bucket" field.
+func (m *S3ConfigMutation) SetBucket(s string) {
+	m.bucket = &s
+}
+
+// Bucket returns the value of the "bucket" field in the mutation.
+func (m *S3ConfigMutation) Bucket() (r string, exists bool) {
+	v := m.bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucket returns the old "bucket" field's value of the S3Config entity.
+// If the S3Config object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3ConfigMutation) OldBucket(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
+	}
+	return oldValue.Bucket, nil
+}
+
+// ResetBucket resets all changes to the "bucket" field.
+func (m *S3ConfigMutation) ResetBucket() {
+	m.bucket = nil
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by id.
+func (m *S3ConfigMutation) SetStorageID(id int) {
+	m.storage = &id
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (m *S3ConfigMutation) ClearStorage() {
+	m.clearedstorage = true
+}
+
+// StorageCleared reports if the "storage" edge to the Storage entity was cleared.
+func (m *S3ConfigMutation) StorageCleared() bool {
+	return m.clearedstorage
+}
+
+// StorageID returns the "storage" edge ID in the mutation.
+func (m *S3ConfigMutation) StorageID() (id int, exists bool) {
+	if m.storage != nil {
+		return *m.storage, true
+	}
+	return
+}
+
+// StorageIDs returns the "storage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StorageID instead. It exists only for internal usage by the builders.
+func (m *S3ConfigMutation) StorageIDs() (ids []int) {
+	if id := m.storage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStorage resets all changes to the "storage" edge.
+func (m *S3ConfigMutation) ResetStorage() {
+	m.storage = nil
+	m.clearedstorage = false
+}
+
+// Where appends a list predicates to the S3ConfigMutation builder.
+func (m *S3ConfigMutation) Where(ps ...predicate.S3Config) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the S3ConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *S3ConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.S3Config, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *S3ConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *S3ConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (S3Config).
+func (m *S3ConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *S3ConfigMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.endpoint != nil {
+		fields = append(fields, s3config.FieldEndpoint)
+	}
+	if m.access_key_id != nil {
+		fields = append(fields, s3config.FieldAccessKeyID)
+	}
+	if m.secret_access_key != nil {
+		fields = append(fields, s3config.FieldSecretAccessKey)
+	}
+	if m.region != nil {
+		fields = append(fields, s3config.FieldRegion)
+	}
+	if m.bucket != nil {
+		fields = append(fields, s3config.FieldBucket)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *S3ConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case s3config.FieldEndpoint:
+		return m.Endpoint()
+	case s3config.FieldAccessKeyID:
+		return m.AccessKeyID()
+	case s3config.FieldSecretAccessKey:
+		return m.SecretAccessKey()
+	case s3config.FieldRegion:
+		return m.Region()
+	case s3config.FieldBucket:
+		return m.Bucket()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *S3ConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case s3config.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case s3config.FieldAccessKeyID:
+		return m.OldAccessKeyID(ctx)
+	case s3config.FieldSecretAccessKey:
+		return m.OldSecretAccessKey(ctx)
+	case s3config.FieldRegion:
+		return m.OldRegion(ctx)
+	case s3config.FieldBucket:
+		return m.OldBucket(ctx)
+	}
+	return nil, fmt.Errorf("unknown S3Config field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *S3ConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case s3config.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case s3config.FieldAccessKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKeyID(v)
+		return nil
+	case s3config.FieldSecretAccessKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretAccessKey(v)
+		return nil
+	case s3config.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case s3config.FieldBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucket(v)
+		return nil
+	}
+	return fmt.Errorf("unknown S3Config field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *S3ConfigMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *S3ConfigMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *S3ConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown S3Config numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *S3ConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(s3config.FieldEndpoint) {
+		fields = append(fields, s3config.FieldEndpoint)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *S3ConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *S3ConfigMutation) ClearField(name string) error {
+	switch name {
+	case s3config.FieldEndpoint:
+		m.ClearEndpoint()
+		return nil
+	}
+	return fmt.Errorf("unknown S3Config nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *S3ConfigMutation) ResetField(name string) error {
+	switch name {
+	case s3config.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case s3config.FieldAccessKeyID:
+		m.ResetAccessKeyID()
+		return nil
+	case s3config.FieldSecretAccessKey:
+		m.ResetSecretAccessKey()
+		return nil
+	case s3config.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case s3config.FieldBucket:
+		m.ResetBucket()
+		return nil
+	}
+	return fmt.Errorf("unknown S3Config field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *S3ConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.storage != nil {
+		edges = append(edges, s3config.EdgeStorage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *S3ConfigMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case s3config.EdgeStorage:
+		if id := m.storage; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *S3ConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *S3ConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *S3ConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstorage {
+		edges = append(edges, s3config.EdgeStorage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *S3ConfigMutation) EdgeCleared(name string) bool {
+	switch name {
+	case s3config.EdgeStorage:
+		return m.clearedstorage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *S3ConfigMutation) ClearEdge(name string) error {
+	switch name {
+	case s3config.EdgeStorage:
+		m.ClearStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown S3Config unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *S3ConfigMutation) ResetEdge(name string) error {
+	switch name {
+	case s3config.EdgeStorage:
+		m.ResetStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown S3Config edge %s", name)
+}
+
+// SFTPConfigMutation represents an operation that mutates the SFTPConfig nodes in the graph.
+type SFTPConfigMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	host           *string
+	port           *int
+	addport        *int
+	username       *string
+	password       *string
+	private_key    *string
+	passphrase     *string
+	host_key       *string
+	base_dir       *string
+	clearedFields  map[string]struct{}
+	storage        *int
+	clearedstorage bool
+	done           bool
+	oldValue       func(context.Context) (*SFTPConfig, error)
+	predicates     []predicate.SFTPConfig
+}
+
+var _ ent.Mutation = (*SFTPConfigMutation)(nil)
+
+// sftpconfigOption allows management of the mutation configuration using functional options.
+type sftpconfigOption func(*SFTPConfigMutation)
+
+// newSFTPConfigMutation creates new mutation for the SFTPConfig entity.
+func newSFTPConfigMutation(c config, op Op, opts ...sftpconfigOption) *SFTPConfigMutation {
+	m := &SFTPConfigMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSFTPConfig,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSFTPConfigID sets the ID field of the mutation.
+func withSFTPConfigID(id int) sftpconfigOption {
+	return func(m *SFTPConfigMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SFTPConfig
+		)
+		m.oldValue = func(ctx context.Context) (*SFTPConfig, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SFTPConfig.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSFTPConfig sets the old SFTPConfig of the mutation.
+func withSFTPConfig(node *SFTPConfig) sftpconfigOption {
+	return func(m *SFTPConfigMutation) {
+		m.oldValue = func(context.Context) (*SFTPConfig, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SFTPConfigMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SFTPConfigMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SFTPConfigMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SFTPConfigMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SFTPConfig.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHost sets the "host" field.
+func (m *SFTPConfigMutation) SetHost(s string) {
+	m.host = &s
+}
+
+// Host returns the value of the "host" field in the mutation.
+func (m *SFTPConfigMutation) Host() (r string, exists bool) {
+	v := m.host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHost returns the old "host" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHost: %w", err)
+	}
+	return oldValue.Host, nil
+}
+
+// ResetHost resets all changes to the "host" field.
+func (m *SFTPConfigMutation) ResetHost() {
+	m.host = nil
+}
+
+// SetPort sets the "port" field.
+func (m *SFTPConfigMutation) SetPort(i int) {
+	m.port = &i
+	m.addport = nil
+}
+
+// Port returns the value of the "port" field in the mutation.
+func (m *SFTPConfigMutation) Port() (r int, exists bool) {
+	v := m.port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPort returns the old "port" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+	}
+	return oldValue.Port, nil
+}
+
+// AddPort adds i to the "port" field.
+func (m *SFTPConfigMutation) AddPort(i int) {
+	if m.addport != nil {
+		*m.addport += i
+	} else {
+		m.addport = &i
+	}
+}
+
+// AddedPort returns the value that was added to the "port" field in this mutation.
+func (m *SFTPConfigMutation) AddedPort() (r int, exists bool) {
+	v := m.addport
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPort resets all changes to the "port" field.
+func (m *SFTPConfigMutation) ResetPort() {
+	m.port = nil
+	m.addport = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *SFTPConfigMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *SFTPConfigMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *SFTPConfigMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *SFTPConfigMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *SFTPConfigMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *SFTPConfigMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[sftpconfig.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *SFTPConfigMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[sftpconfig.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *SFTPConfigMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, sftpconfig.FieldPassword)
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *SFTPConfigMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *SFTPConfigMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ClearPrivateKey clears the value of the "private_key" field.
+func (m *SFTPConfigMutation) ClearPrivateKey() {
+	m.private_key = nil
+	m.clearedFields[sftpconfig.FieldPrivateKey] = struct{}{}
+}
+
+// PrivateKeyCleared returns if the "private_key" field was cleared in this mutation.
+func (m *SFTPConfigMutation) PrivateKeyCleared() bool {
+	_, ok := m.clearedFields[sftpconfig.FieldPrivateKey]
+	return ok
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *SFTPConfigMutation) ResetPrivateKey() {
+	m.private_key = nil
+	delete(m.clearedFields, sftpconfig.FieldPrivateKey)
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (m *SFTPConfigMutation) SetPassphrase(s string) {
+	m.passphrase = &s
+}
+
+// Passphrase returns the value of the "passphrase" field in the mutation.
+func (m *SFTPConfigMutation) Passphrase() (r string, exists bool) {
+	v := m.passphrase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassphrase returns the old "passphrase" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldPassphrase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassphrase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassphrase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassphrase: %w", err)
+	}
+	return oldValue.Passphrase, nil
+}
+
+// ClearPassphrase clears the value of the "passphrase" field.
+func (m *SFTPConfigMutation) ClearPassphrase() {
+	m.passphrase = nil
+	m.clearedFields[sftpconfig.FieldPassphrase] = struct{}{}
+}
+
+// PassphraseCleared returns if the "passphrase" field was cleared in this mutation.
+func (m *SFTPConfigMutation) PassphraseCleared() bool {
+	_, ok := m.clearedFields[sftpconfig.FieldPassphrase]
+	return ok
+}
+
+// ResetPassphrase resets all changes to the "passphrase" field.
+func (m *SFTPConfigMutation) ResetPassphrase() {
+	m.passphrase = nil
+	delete(m.clearedFields, sftpconfig.FieldPassphrase)
+}
+
+// SetHostKey sets the "host_key" field.
+func (m *SFTPConfigMutation) SetHostKey(s string) {
+	m.host_key = &s
+}
+
+// HostKey returns the value of the "host_key" field in the mutation.
+func (m *SFTPConfigMutation) HostKey() (r string, exists bool) {
+	v := m.host_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostKey returns the old "host_key" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldHostKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostKey: %w", err)
+	}
+	return oldValue.HostKey, nil
+}
+
+// ResetHostKey resets all changes to the "host_key" field.
+func (m *SFTPConfigMutation) ResetHostKey() {
+	m.host_key = nil
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (m *SFTPConfigMutation) SetBaseDir(s string) {
+	m.base_dir = &s
+}
+
+// BaseDir returns the value of the "base_dir" field in the mutation.
+func (m *SFTPConfigMutation) BaseDir() (r string, exists bool) {
+	v := m.base_dir
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseDir returns the old "base_dir" field's value of the SFTPConfig entity.
+// If the SFTPConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SFTPConfigMutation) OldBaseDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseDir is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseDir requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseDir: %w", err)
+	}
+	return oldValue.BaseDir, nil
+}
+
+// ClearBaseDir clears the value of the "base_dir" field.
+func (m *SFTPConfigMutation) ClearBaseDir() {
+	m.base_dir = nil
+	m.clearedFields[sftpconfig.FieldBaseDir] = struct{}{}
+}
+
+// BaseDirCleared returns if the "base_dir" field was cleared in this mutation.
+func (m *SFTPConfigMutation) BaseDirCleared() bool {
+	_, ok := m.clearedFields[sftpconfig.FieldBaseDir]
+	return ok
+}
+
+// ResetBaseDir resets all changes to the "base_dir" field.
+func (m *SFTPConfigMutation) ResetBaseDir() {
+	m.base_dir = nil
+	delete(m.clearedFields, sftpconfig.FieldBaseDir)
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by id.
+func (m *SFTPConfigMutation) SetStorageID(id int) {
+	m.storage = &id
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (m *SFTPConfigMutation) ClearStorage() {
+	m.clearedstorage = true
+}
+
+// StorageCleared reports if the "storage" edge to the Storage entity was cleared.
+func (m *SFTPConfigMutation) StorageCleared() bool {
+	return m.clearedstorage
+}
+
+// StorageID returns the "storage" edge ID in the mutation.
+func (m *SFTPConfigMutation) StorageID() (id int, exists bool) {
+	if m.storage != nil {
+		return *m.storage, true
+	}
+	return
+}
+
+// StorageIDs returns the "storage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StorageID instead. It exists only for internal usage by the builders.
+func (m *SFTPConfigMutation) StorageIDs() (ids []int) {
+	if id := m.storage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStorage resets all changes to the "storage" edge.
+func (m *SFTPConfigMutation) ResetStorage() {
+	m.storage = nil
+	m.clearedstorage = false
+}
+
+// Where appends a list predicates to the SFTPConfigMutation builder.
+func (m *SFTPConfigMutation) Where(ps ...predicate.SFTPConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SFTPConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SFTPConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SFTPConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SFTPConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SFTPConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SFTPConfig).
+func (m *SFTPConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SFTPConfigMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.host != nil {
+		fields = append(fields, sftpconfig.FieldHost)
+	}
+	if m.port != nil {
+		fields = append(fields, sftpconfig.FieldPort)
+	}
+	if m.username != nil {
+		fields = append(fields, sftpconfig.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, sftpconfig.FieldPassword)
+	}
+	if m.private_key != nil {
+		fields = append(fields, sftpconfig.FieldPrivateKey)
+	}
+	if m.passphrase != nil {
+		fields = append(fields, sftpconfig.FieldPassphrase)
+	}
+	if m.host_key != nil {
+		fields = append(fields, sftpconfig.FieldHostKey)
+	}
+	if m.base_dir != nil {
+		fields = append(fields, sftpconfig.FieldBaseDir)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SFTPConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sftpconfig.FieldHost:
+		return m.Host()
+	case sftpconfig.FieldPort:
+		return m.Port()
+	case sftpconfig.FieldUsername:
+		return m.Username()
+	case sftpconfig.FieldPassword:
+		return m.Password()
+	case sftpconfig.FieldPrivateKey:
+		return m.PrivateKey()
+	case sftpconfig.FieldPassphrase:
+		return m.Passphrase()
+	case sftpconfig.FieldHostKey:
+		return m.HostKey()
+	case sftpconfig.FieldBaseDir:
+		return m.BaseDir()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SFTPConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sftpconfig.FieldHost:
+		return m.OldHost(ctx)
+	case sftpconfig.FieldPort:
+		return m.OldPort(ctx)
+	case sftpconfig.FieldUsername:
+		return m.OldUsername(ctx)
+	case sftpconfig.FieldPassword:
+		return m.OldPassword(ctx)
+	case sftpconfig.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case sftpconfig.FieldPassphrase:
+		return m.OldPassphrase(ctx)
+	case sftpconfig.FieldHostKey:
+		return m.OldHostKey(ctx)
+	case sftpconfig.FieldBaseDir:
+		return m.OldBaseDir(ctx)
+	}
+	return nil, fmt.Errorf("unknown SFTPConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SFTPConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sftpconfig.FieldHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHost(v)
+		return nil
+	case sftpconfig.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPort(v)
+		return nil
+	case sftpconfig.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case sftpconfig.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case sftpconfig.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case sftpconfig.FieldPassphrase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassphrase(v)
+		return nil
+	case sftpconfig.FieldHostKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostKey(v)
+		return nil
+	case sftpconfig.FieldBaseDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseDir(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SFTPConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SFTPConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addport != nil {
+		fields = append(fields, sftpconfig.FieldPort)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SFTPConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sftpconfig.FieldPort:
+		return m.AddedPort()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SFTPConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sftpconfig.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPort(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SFTPConfig numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SFTPConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sftpconfig.FieldPassword) {
+		fields = append(fields, sftpconfig.FieldPassword)
+	}
+	if m.FieldCleared(sftpconfig.FieldPrivateKey) {
+		fields = append(fields, sftpconfig.FieldPrivateKey)
+	}
+	if m.FieldCleared(sftpconfig.FieldPassphrase) {
+		fields = append(fields, sftpconfig.FieldPassphrase)
+	}
+	if m.FieldCleared(sftpconfig.FieldBaseDir) {
+		fields = append(fields, sftpconfig.FieldBaseDir)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SFTPConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SFTPConfigMutation) ClearField(name string) error {
+	switch name {
+	case sftpconfig.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case sftpconfig.FieldPrivateKey:
+		m.ClearPrivateKey()
+		return nil
+	case sftpconfig.FieldPassphrase:
+		m.ClearPassphrase()
+		return nil
+	case sftpconfig.FieldBaseDir:
+		m.ClearBaseDir()
+		return nil
+	}
+	return fmt.Errorf("unknown SFTPConfig nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SFTPConfigMutation) ResetField(name string) error {
+	switch name {
+	case sftpconfig.FieldHost:
+		m.ResetHost()
+		return nil
+	case sftpconfig.FieldPort:
+		m.ResetPort()
+		return nil
+	case sftpconfig.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case sftpconfig.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case sftpconfig.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case sftpconfig.FieldPassphrase:
+		m.ResetPassphrase()
+		return nil
+	case sftpconfig.FieldHostKey:
+		m.ResetHostKey()
+		return nil
+	case sftpconfig.FieldBaseDir:
+		m.ResetBaseDir()
+		return nil
+	}
+	return fmt.Errorf("unknown SFTPConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SFTPConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.storage != nil {
+		edges = append(edges, sftpconfig.EdgeStorage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SFTPConfigMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sftpconfig.EdgeStorage:
+		if id := m.storage; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SFTPConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SFTPConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SFTPConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstorage {
+		edges = append(edges, sftpconfig.EdgeStorage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SFTPConfigMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sftpconfig.EdgeStorage:
+		return m.clearedstorage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SFTPConfigMutation) ClearEdge(name string) error {
+	switch name {
+	case sftpconfig.EdgeStorage:
+		m.ClearStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown SFTPConfig unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SFTPConfigMutation) ResetEdge(name string) error {
+	switch name {
+	case sftpconfig.EdgeStorage:
+		m.ResetStorage()
+		return nil
+	}
+	return fmt.Errorf("unknown SFTPConfig edge %s", name)
+}
+
+// SourceMutation represents an operation that mutates the Source nodes in the graph.
+type SourceMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	data_path        *string
+	include          *[]string
+	appendinclude    []string
+	exclude          *[]string
+	appendexclude    []string
+	password         *string
+	recipients       *[]string
+	appendrecipients []string
+	interval         *int
+	addinterval      *int
+	enabled          *bool
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	sync_jobs        map[int]struct{}
+	removedsync_jobs map[int]struct{}
+	clearedsync_jobs bool
+	done             bool
+	oldValue         func(context.Context) (*Source, error)
+	predicates       []predicate.Source
+}
+
+var _ ent.Mutation = (*SourceMutation)(nil)
+
+// sourceOption allows management of the mutation configuration using functional options.
+type sourceOption func(*SourceMutation)
+
+// newSourceMutation creates new mutation for the Source entity.
+func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
+	m := &SourceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSource,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceID sets the ID field of the mutation.
+func withSourceID(id int) sourceOption {
+	return func(m *SourceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Source
+		)
+		m.oldValue = func(ctx context.Context) (*Source, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Source.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSource sets the old Source of the mutation.
+func withSource(node *Source) sourceOption {
+	return func(m *SourceMutation) {
+		m.oldValue = func(context.Context) (*Source, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Source.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SourceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SourceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SourceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDataPath sets the "data_path" field.
+func (m *SourceMutation) SetDataPath(s string) {
+	m.data_path = &s
+}
+
+// DataPath returns the value of the "data_path" field in the mutation.
+func (m *SourceMutation) DataPath() (r string, exists bool) {
+	v := m.data_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataPath returns the old "data_path" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldDataPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataPath: %w", err)
+	}
+	return oldValue.DataPath, nil
+}
+
+// ResetDataPath resets all changes to the "data_path" field.
+func (m *SourceMutation) ResetDataPath() {
+	m.data_path = nil
+}
+
+// SetInclude sets the "include" field.
+func (m *SourceMutation) SetInclude(s []string) {
+	m.include = &s
+	m.appendinclude = nil
+}
+
+// Include returns the value of the "include" field in the mutation.
+func (m *SourceMutation) Include() (r []string, exists bool) {
+	v := m.include
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInclude returns the old "include" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldInclude(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInclude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInclude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInclude: %w", err)
+	}
+	return oldValue.Include, nil
+}
+
+// AppendInclude adds s to the "include" field.
+func (m *SourceMutation) AppendInclude(s []string) {
+	m.appendinclude = append(m.appendinclude, s...)
+}
+
+// AppendedInclude returns the list of values that were appended to the "include" field in this mutation.
+func (m *SourceMutation) AppendedInclude() ([]string, bool) {
+	if len(m.appendinclude) == 0 {
+		return nil, false
+	}
+	return m.appendinclude, true
+}
+
+// ClearInclude clears the value of the "include" field.
+func (m *SourceMutation) ClearInclude() {
+	m.include = nil
+	m.appendinclude = nil
+	m.clearedFields[source.FieldInclude] = struct{}{}
+}
+
+// IncludeCleared returns if the "include" field was cleared in this mutation.
+func (m *SourceMutation) IncludeCleared() bool {
+	_, ok := m.clearedFields[source.FieldInclude]
+	return ok
+}
+
+// ResetInclude resets all changes to the "include" field.
+func (m *SourceMutation) ResetInclude() {
+	m.include = nil
+	m.appendinclude = nil
+	delete(m.clearedFields, source.FieldInclude)
+}
+
+// SetExclude sets the "exclude" field.
+func (m *SourceMutation) SetExclude(s []string) {
+	m.exclude = &s
+	m.appendexclude = nil
+}
+
+// Exclude returns the value of the "exclude" field in the mutation.
+func (m *SourceMutation) Exclude() (r []string, exists bool) {
+	v := m.exclude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExclude returns the old "exclude" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldExclude(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExclude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExclude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExclude: %w", err)
+	}
+	return oldValue.Exclude, nil
+}
+
+// AppendExclude adds s to the "exclude" field.
+func (m *SourceMutation) AppendExclude(s []string) {
+	m.appendexclude = append(m.appendexclude, s...)
+}
+
+// AppendedExclude returns the list of values that were appended to the "exclude" field in this mutation.
+func (m *SourceMutation) AppendedExclude() ([]string, bool) {
+	if len(m.appendexclude) == 0 {
+		return nil, false
+	}
+	return m.appendexclude, true
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (m *SourceMutation) ClearExclude() {
+	m.exclude = nil
+	m.appendexclude = nil
+	m.clearedFields[source.FieldExclude] = struct{}{}
+}
+
+// ExcludeCleared returns if the "exclude" field was cleared in this mutation.
+func (m *SourceMutation) ExcludeCleared() bool {
+	_, ok := m.clearedFields[source.FieldExclude]
+	return ok
+}
+
+// ResetExclude resets all changes to the "exclude" field.
+func (m *SourceMutation) ResetExclude() {
+	m.exclude = nil
+	m.appendexclude = nil
+	delete(m.clearedFields, source.FieldExclude)
+}
+
+// SetPassword sets the "password" field.
+func (m *SourceMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *SourceMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *SourceMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[source.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *SourceMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[source.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *SourceMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, source.FieldPassword)
+}
+
+// SetRecipients sets the "recipients" field.
+func (m *SourceMutation) SetRecipients(s []string) {
+	m.recipients = &s
+	m.appendrecipients = nil
+}
+
+// Recipients returns the value of the "recipients" field in the mutation.
+func (m *SourceMutation) Recipients() (r []string, exists bool) {
+	v := m.recipients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipients returns the old "recipients" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldRecipients(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipients: %w", err)
+	}
+	return oldValue.Recipients, nil
+}
+
+// AppendRecipients adds s to the "recipients" field.
+func (m *SourceMutation) AppendRecipients(s []string) {
+	m.appendrecipients = append(m.appendrecipients, s...)
+}
+
+// AppendedRecipients returns the list of values that were appended to the "recipients" field in this mutation.
+func (m *SourceMutation) AppendedRecipients() ([]string, bool) {
+	if len(m.appendrecipients) == 0 {
+		return nil, false
+	}
+	return m.appendrecipients, true
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (m *SourceMutation) ClearRecipients() {
+	m.recipients = nil
+	m.appendrecipients = nil
+	m.clearedFields[source.FieldRecipients] = struct{}{}
+}
+
+// RecipientsCleared returns if the "recipients" field was cleared in this mutation.
+func (m *SourceMutation) RecipientsCleared() bool {
+	_, ok := m.clearedFields[source.FieldRecipients]
+	return ok
+}
+
+// ResetRecipients resets all changes to the "recipients" field.
+func (m *SourceMutation) ResetRecipients() {
+	m.recipients = nil
+	m.appendrecipients = nil
+	delete(m.clearedFields, source.FieldRecipients)
+}
+
+// SetInterval sets the "interval" field.
+func (m *SourceMutation) SetInterval(i int) {
+	m.interval = &i
+	m.addinterval = nil
+}
+
+// Interval returns the value of the "interval" field in the mutation.
+func (m *SourceMutation) Interval() (r int, exists bool) {
+	v := m.interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterval returns the old "interval" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+	}
+	return oldValue.Interval, nil
+}
+
+// AddInterval adds i to the "interval" field.
+func (m *SourceMutation) AddInterval(i int) {
+	if m.addinterval != nil {
+		*m.addinterval += i
+	} else {
+		m.addinterval = &i
+	}
+}
+
+// AddedInterval returns the value that was added to the "interval" field in this mutation.
+func (m *SourceMutation) AddedInterval() (r int, exists bool) {
+	v := m.addinterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInterval resets all changes to the "interval" field.
+func (m *SourceMutation) ResetInterval() {
+	m.interval = nil
+	m.addinterval = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *SourceMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *SourceMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *SourceMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SourceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SourceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SourceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SourceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SourceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SourceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by ids.
+func (m *SourceMutation) AddSyncJobIDs(ids ...int) {
+	if m.sync_jobs == nil {
+		m.sync_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sync_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSyncJobs clears the "sync_jobs" edge to the SyncJob entity.
+func (m *SourceMutation) ClearSyncJobs() {
+	m.clearedsync_jobs = true
+}
+
+// SyncJobsCleared reports if the "sync_jobs" edge to the SyncJob entity was cleared.
+func (m *SourceMutation) SyncJobsCleared() bool {
+	return m.clearedsync_jobs
+}
+
+// RemoveSyncJobIDs removes the "sync_jobs" edge to the SyncJob entity by IDs.
+func (m *SourceMutation) RemoveSyncJobIDs(ids ...int) {
+	if m.removedsync_jobs == nil {
+		m.removedsync_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sync_jobs, ids[i])
+		m.removedsync_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSyncJobs returns the removed IDs of the "sync_jobs" edge to the SyncJob entity.
+func (m *SourceMutation) RemovedSyncJobsIDs() (ids []int) {
+	for id := range m.removedsync_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SyncJobsIDs returns the "sync_jobs" edge IDs in the mutation.
+func (m *SourceMutation) SyncJobsIDs() (ids []int) {
+	for id := range m.sync_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSyncJobs resets all changes to the "sync_jobs" edge.
+func (m *SourceMutation) ResetSyncJobs() {
+	m.sync_jobs = nil
+	m.clearedsync_jobs = false
+	m.removedsync_jobs = nil
+}
+
+// Where appends a list predicates to the SourceMutation builder.
+func (m *SourceMutation) Where(ps ...predicate.Source) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Source, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Source).
+func (m *SourceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, source.FieldName)
+	}
+	if m.data_path != nil {
+		fields = append(fields, source.FieldDataPath)
+	}
+	if m.include != nil {
+		fields = append(fields, source.FieldInclude)
+	}
+	if m.exclude != nil {
+		fields = append(fields, source.FieldExclude)
+	}
+	if m.password != nil {
+		fields = append(fields, source.FieldPassword)
+	}
+	if m.recipients != nil {
+		fields = append(fields, source.FieldRecipients)
+	}
+	if m.interval != nil {
+		fields = append(fields, source.FieldInterval)
+	}
+	if m.enabled != nil {
+		fields = append(fields, source.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, source.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, source.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case source.FieldName:
+		return m.Name()
+	case source.FieldDataPath:
+		return m.DataPath()
+	case source.FieldInclude:
+		return m.Include()
+	case source.FieldExclude:
+		return m.Exclude()
+	case source.FieldPassword:
+		return m.Password()
+	case source.FieldRecipients:
+		return m.Recipients()
+	case source.FieldInterval:
+		return m.Interval()
+	case source.FieldEnabled:
+		return m.Enabled()
+	case source.FieldCreatedAt:
+		return m.CreatedAt()
+	case source.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case source.FieldName:
+		return m.OldName(ctx)
+	case source.FieldDataPath:
+		return m.OldDataPath(ctx)
+	case source.FieldInclude:
+		return m.OldInclude(ctx)
+	case source.FieldExclude:
+		return m.OldExclude(ctx)
+	case source.FieldPassword:
+		return m.OldPassword(ctx)
+	case source.FieldRecipients:
+		return m.OldRecipients(ctx)
+	case source.FieldInterval:
+		return m.OldInterval(ctx)
+	case source.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case source.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case source.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Source field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case source.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case source.FieldDataPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataPath(v)
+		return nil
+	case source.FieldInclude:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInclude(v)
+		return nil
+	case source.FieldExclude:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExclude(v)
+		return nil
+	case source.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case source.FieldRecipients:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipients(v)
+		return nil
+	case source.FieldInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterval(v)
+		return nil
+	case source.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case source.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case source.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Source field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceMutation) AddedFields() []string {
+	var fields []string
+	if m.addinterval != nil {
+		fields = append(fields, source.FieldInterval)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case source.FieldInterval:
+		return m.AddedInterval()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case source.FieldInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterval(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Source numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(source.FieldInclude) {
+		fields = append(fields, source.FieldInclude)
+	}
+	if m.FieldCleared(source.FieldExclude) {
+		fields = append(fields, source.FieldExclude)
+	}
+	if m.FieldCleared(source.FieldPassword) {
+		fields = append(fields, source.FieldPassword)
+	}
+	if m.FieldCleared(source.FieldRecipients) {
+		fields = append(fields, source.FieldRecipients)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceMutation) ClearField(name string) error {
+	switch name {
+	case source.FieldInclude:
+		m.ClearInclude()
+		return nil
+	case source.FieldExclude:
+		m.ClearExclude()
+		return nil
+	case source.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case source.FieldRecipients:
+		m.ClearRecipients()
+		return nil
+	}
+	return fmt.Errorf("unknown Source nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceMutation) ResetField(name string) error {
+	switch name {
+	case source.FieldName:
+		m.ResetName()
+		return nil
+	case source.FieldDataPath:
+		m.ResetDataPath()
+		return nil
+	case source.FieldInclude:
+		m.ResetInclude()
+		return nil
+	case source.FieldExclude:
+		m.ResetExclude()
+		return nil
+	case source.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case source.FieldRecipients:
+		m.ResetRecipients()
+		return nil
+	case source.FieldInterval:
+		m.ResetInterval()
+		return nil
+	case source.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case source.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case source.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Source field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.sync_jobs != nil {
+		edges = append(edges, source.EdgeSyncJobs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case source.EdgeSyncJobs:
+		ids := make([]ent.Value, 0, len(m.sync_jobs))
+		for id := range m.sync_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsync_jobs != nil {
+		edges = append(edges, source.EdgeSyncJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case source.EdgeSyncJobs:
+		ids := make([]ent.Value, 0, len(m.removedsync_jobs))
+		for id := range m.removedsync_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsync_jobs {
+		edges = append(edges, source.EdgeSyncJobs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case source.EdgeSyncJobs:
+		return m.clearedsync_jobs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Source unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceMutation) ResetEdge(name string) error {
+	switch name {
+	case source.EdgeSyncJobs:
+		m.ResetSyncJobs()
+		return nil
+	}
+	return fmt.Errorf("unknown Source edge %s", name)
+}
+
+// StorageMutation represents an operation that mutates the Storage nodes in the graph.
+type StorageMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	name                     *string
+	_type                    *storage.Type
+	enabled                  *bool
+	archive_format           *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	sync_jobs                map[int]struct{}
+	removedsync_jobs         map[int]struct{}
+	clearedsync_jobs         bool
+	webdav_config            *int
+	clearedwebdav_config     bool
+	s3_config                *int
+	cleareds3_config         bool
+	local_config             *int
+	clearedlocal_config      bool
+	sftp_config              *int
+	clearedsftp_config       bool
+	ftp_config               *int
+	clearedftp_config        bool
+	azure_blob_config        *int
+	clearedazure_blob_config bool
+	gcs_config               *int
+	clearedgcs_config        bool
+	done                     bool
+	oldValue                 func(context.Context) (*Storage, error)
+	predicates               []predicate.Storage
+}
+
+var _ ent.Mutation = (*StorageMutation)(nil)
+
+// storageOption allows management of the mutation configuration using functional options.
+type storageOption func(*StorageMutation)
+
+// newStorageMutation creates new mutation for the Storage entity.
+func newStorageMutation(c config, op Op, opts ...storageOption) *StorageMutation {
+	m := &StorageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStorage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStorageID sets the ID field of the mutation.
+func withStorageID(id int) storageOption {
+	return func(m *StorageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Storage
+		)
+		m.oldValue = func(ctx context.Context) (*Storage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Storage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStorage sets the old Storage of the mutation.
+func withStorage(node *Storage) storageOption {
+	return func(m *StorageMutation) {
+		m.oldValue = func(context.Context) (*Storage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StorageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StorageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StorageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StorageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Storage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *StorageMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StorageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Storage entity.
+// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StorageMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *StorageMutation) SetType(s storage.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *StorageMutation) GetType() (r storage.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Storage entity.
+// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageMutation) OldType(ctx context.Context) (v storage.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *StorageMutation) ResetType() {
+	m._type = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *StorageMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *StorageMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Storage entity.
+// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *StorageMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetArchiveFormat sets the "archive_format" field.
+func (m *StorageMutation) SetArchiveFormat(s string) {
+	m.archive_format = &s
+}
+
+// ArchiveFormat returns the value of the "archive_format" field in the mutation.
+func (m *StorageMutation) ArchiveFormat() (r string, exists bool) {
+	v := m.archive_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchiveFormat returns the old "archive_format" field's value of the Storage entity.
+// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageMutation) OldArchiveFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchiveFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchiveFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchiveFormat: %w", err)
+	}
+	return oldValue.ArchiveFormat, nil
+}
+
+// ClearArchiveFormat clears the value of the "archive_format" field.
+func (m *StorageMutation) ClearArchiveFormat() {
+	m.archive_format = nil
+	m.clearedFields[storage.FieldArchiveFormat] = struct{}{}
+}
+
+// ArchiveFormatCleared returns if the "archive_format" field was cleared in this mutation.
+func (m *StorageMutation) ArchiveFormatCleared() bool {
+	_, ok := m.clearedFields[storage.FieldArchiveFormat]
+	return ok
+}
+
+// ResetArchiveFormat resets all changes to the "archive_format" field.
+func (m *StorageMutation) ResetArchiveFormat() {
+	m.archive_format = nil
+	delete(m.clearedFields, storage.FieldArchiveFormat)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StorageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StorageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Storage entity.
+// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StorageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StorageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StorageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Storage entity.
+// If the Storage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StorageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by ids.
+func (m *StorageMutation) AddSyncJobIDs(ids ...int) {
+	if m.sync_jobs == nil {
+		m.sync_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sync_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSyncJobs clears the "sync_jobs" edge to the SyncJob entity.
+func (m *StorageMutation) ClearSyncJobs() {
+	m.clearedsync_jobs = true
+}
+
+// SyncJobsCleared reports if the "sync_jobs" edge to the SyncJob entity was cleared.
+func (m *StorageMutation) SyncJobsCleared() bool {
+	return m.clearedsync_jobs
+}
+
+// RemoveSyncJobIDs removes the "sync_jobs" edge to the SyncJob entity by IDs.
+func (m *StorageMutation) RemoveSyncJobIDs(ids ...int) {
+	if m.removedsync_jobs == nil {
+		m.removedsync_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sync_jobs, ids[i])
+		m.removedsync_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSyncJobs returns the removed IDs of the "sync_jobs" edge to the SyncJob entity.
+func (m *StorageMutation) RemovedSyncJobsIDs() (ids []int) {
+	for id := range m.removedsync_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SyncJobsIDs returns the "sync_jobs" edge IDs in the mutation.
+func (m *StorageMutation) SyncJobsIDs() (ids []int) {
+	for id := range m.sync_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSyncJobs resets all changes to the "sync_jobs" edge.
+func (m *StorageMutation) ResetSyncJobs() {
+	m.sync_jobs = nil
+	m.clearedsync_jobs = false
+	m.removedsync_jobs = nil
+}
+
+// SetWebdavConfigID sets the "webdav_config" edge to the WebDAVConfig entity by id.
+func (m *StorageMutation) SetWebdavConfigID(id int) {
+	m.webdav_config = &id
+}
+
+// ClearWebdavConfig clears the "webdav_config" edge to the WebDAVConfig entity.
+func (m *StorageMutation) ClearWebdavConfig() {
+	m.clearedwebdav_config = true
+}
+
+// WebdavConfigCleared reports if the "webdav_config" edge to the WebDAVConfig entity was cleared.
+func (m *StorageMutation) WebdavConfigCleared() bool {
+	return m.clearedwebdav_config
+}
+
+// WebdavConfigID returns the "webdav_config" edge ID in the mutation.
+func (m *StorageMutation) WebdavConfigID() (id int, exists bool) {
+	if m.webdav_config != nil {
+		return *m.webdav_config, true
+	}
+	return
+}
+
+// WebdavConfigIDs returns the "webdav_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebdavConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) WebdavConfigIDs() (ids []int) {
+	if id := m.webdav_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWebdavConfig resets all changes to the "webdav_config" edge.
+func (m *StorageMutation) ResetWebdavConfig() {
+	m.webdav_config = nil
+	m.clearedwebdav_config = false
+}
+
+// SetS3ConfigID sets the "s3_config" edge to the S3Config entity by id.
+func (m *StorageMutation) SetS3ConfigID(id int) {
+	m.s3_config = &id
+}
+
+// ClearS3Config clears the "s3_config" edge to the S3Config entity.
+func (m *StorageMutation) ClearS3Config() {
+	m.cleareds3_config = true
+}
+
+// S3ConfigCleared reports if the "s3_config" edge to the S3Config entity was cleared.
+func (m *StorageMutation) S3ConfigCleared() bool {
+	return m.cleareds3_config
+}
+
+// S3ConfigID returns the "s3_config" edge ID in the mutation.
+func (m *StorageMutation) S3ConfigID() (id int, exists bool) {
+	if m.s3_config != nil {
+		return *m.s3_config, true
+	}
+	return
+}
+
+// S3ConfigIDs returns the "s3_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// S3ConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) S3ConfigIDs() (ids []int) {
+	if id := m.s3_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetS3Config resets all changes to the "s3_config" edge.
+func (m *StorageMutation) ResetS3Config() {
+	m.s3_config = nil
+	m.cleareds3_config = false
+}
+
+// SetLocalConfigID sets the "local_config" edge to the LocalConfig entity by id.
+func (m *StorageMutation) SetLocalConfigID(id int) {
+	m.local_config = &id
+}
+
+// ClearLocalConfig clears the "local_config" edge to the LocalConfig entity.
+func (m *StorageMutation) ClearLocalConfig() {
+	m.clearedlocal_config = true
+}
+
+// LocalConfigCleared reports if the "local_config" edge to the LocalConfig entity was cleared.
+func (m *StorageMutation) LocalConfigCleared() bool {
+	return m.clearedlocal_config
+}
+
+// LocalConfigID returns the "local_config" edge ID in the mutation.
+func (m *StorageMutation) LocalConfigID() (id int, exists bool) {
+	if m.local_config != nil {
+		return *m.local_config, true
+	}
+	return
+}
+
+// LocalConfigIDs returns the "local_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocalConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) LocalConfigIDs() (ids []int) {
+	if id := m.local_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocalConfig resets all changes to the "local_config" edge.
+func (m *StorageMutation) ResetLocalConfig() {
+	m.local_config = nil
+	m.clearedlocal_config = false
+}
+
+// SetSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by id.
+func (m *StorageMutation) SetSftpConfigID(id int) {
+	m.sftp_config = &id
+}
+
+// ClearSftpConfig clears the "sftp_config" edge to the SFTPConfig entity.
+func (m *StorageMutation) ClearSftpConfig() {
+	m.clearedsftp_config = true
+}
+
+// SftpConfigCleared reports if the "sftp_config" edge to the SFTPConfig entity was cleared.
+func (m *StorageMutation) SftpConfigCleared() bool {
+	return m.clearedsftp_config
+}
+
+// SftpConfigID returns the "sftp_config" edge ID in the mutation.
+func (m *StorageMutation) SftpConfigID() (id int, exists bool) {
+	if m.sftp_config != nil {
+		return *m.sftp_config, true
+	}
+	return
+}
+
+// SftpConfigIDs returns the "sftp_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SftpConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) SftpConfigIDs() (ids []int) {
+	if id := m.sftp_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSftpConfig resets all changes to the "sftp_config" edge.
+func (m *StorageMutation) ResetSftpConfig() {
+	m.sftp_config = nil
+	m.clearedsftp_config = false
+}
+
+// SetFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by id.
+func (m *StorageMutation) SetFtpConfigID(id int) {
+	m.ftp_config = &id
+}
+
+// ClearFtpConfig clears the "ftp_config" edge to the FTPConfig entity.
+func (m *StorageMutation) ClearFtpConfig() {
+	m.clearedftp_config = true
+}
+
+// FtpConfigCleared reports if the "ftp_config" edge to the FTPConfig entity was cleared.
+func (m *StorageMutation) FtpConfigCleared() bool {
+	return m.clearedftp_config
+}
+
+// FtpConfigID returns the "ftp_config" edge ID in the mutation.
+func (m *StorageMutation) FtpConfigID() (id int, exists bool) {
+	if m.ftp_config != nil {
+		return *m.ftp_config, true
+	}
+	return
+}
+
+// FtpConfigIDs returns the "ftp_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FtpConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) FtpConfigIDs() (ids []int) {
+	if id := m.ftp_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFtpConfig resets all changes to the "ftp_config" edge.
+func (m *StorageMutation) ResetFtpConfig() {
+	m.ftp_config = nil
+	m.clearedftp_config = false
+}
+
+// SetAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by id.
+func (m *StorageMutation) SetAzureBlobConfigID(id int) {
+	m.azure_blob_config = &id
+}
+
+// ClearAzureBlobConfig clears the "azure_blob_config" edge to the AzureBlobConfig entity.
+func (m *StorageMutation) ClearAzureBlobConfig() {
+	m.clearedazure_blob_config = true
+}
+
+// AzureBlobConfigCleared reports if the "azure_blob_config" edge to the AzureBlobConfig entity was cleared.
+func (m *StorageMutation) AzureBlobConfigCleared() bool {
+	return m.clearedazure_blob_config
+}
+
+// AzureBlobConfigID returns the "azure_blob_config" edge ID in the mutation.
+func (m *StorageMutation) AzureBlobConfigID() (id int, exists bool) {
+	if m.azure_blob_config != nil {
+		return *m.azure_blob_config, true
+	}
+	return
+}
+
+// AzureBlobConfigIDs returns the "azure_blob_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AzureBlobConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) AzureBlobConfigIDs() (ids []int) {
+	if id := m.azure_blob_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAzureBlobConfig resets all changes to the "azure_blob_config" edge.
+func (m *StorageMutation) ResetAzureBlobConfig() {
+	m.azure_blob_config = nil
+	m.clearedazure_blob_config = false
+}
+
+// SetGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by id.
+func (m *StorageMutation) SetGcsConfigID(id int) {
+	m.gcs_config = &id
+}
+
+// ClearGcsConfig clears the "gcs_config" edge to the GCSConfig entity.
+func (m *StorageMutation) ClearGcsConfig() {
+	m.clearedgcs_config = true
+}
+
+// GcsConfigCleared reports if the "gcs_config" edge to the GCSConfig entity was cleared.
+func (m *StorageMutation) GcsConfigCleared() bool {
+	return m.clearedgcs_config
+}
+
+// GcsConfigID returns the "gcs_config" edge ID in the mutation.
+func (m *StorageMutation) GcsConfigID() (id int, exists bool) {
+	if m.gcs_config != nil {
+		return *m.gcs_config, true
+	}
+	return
+}
+
+// GcsConfigIDs returns the "gcs_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GcsConfigID instead. It exists only for internal usage by the builders.
+func (m *StorageMutation) GcsConfigIDs() (ids []int) {
+	if id := m.gcs_config; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGcsConfig resets all changes to the "gcs_config" edge.
+func (m *StorageMutation) ResetGcsConfig() {
+	m.gcs_config = nil
+	m.clearedgcs_config = false
+}
+
+// Where appends a list predicates to the StorageMutation builder.
+func (m *StorageMutation) Where(ps ...predicate.Storage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StorageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StorageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Storage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StorageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StorageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Storage).
+func (m *StorageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StorageMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, storage.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, storage.FieldType)
+	}
+	if m.enabled != nil {
+		fields = append(fields, storage.FieldEnabled)
+	}
+	if m.archive_format != nil {
+		fields = append(fields, storage.FieldArchiveFormat)
+	}
+	if m.created_at != nil {
+		fields = append(fields, storage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, storage.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StorageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case storage.FieldName:
+		return m.Name()
+	case storage.FieldType:
+		return m.GetType()
+	case storage.FieldEnabled:
+		return m.Enabled()
+	case storage.FieldArchiveFormat:
+		return m.ArchiveFormat()
+	case storage.FieldCreatedAt:
+		return m.CreatedAt()
+	case storage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StorageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case storage.FieldName:
+		return m.OldName(ctx)
+	case storage.FieldType:
+		return m.OldType(ctx)
+	case storage.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case storage.FieldArchiveFormat:
+		return m.OldArchiveFormat(ctx)
+	case storage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case storage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Storage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StorageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case storage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case storage.FieldType:
+		v, ok := value.(storage.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case storage.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case storage.FieldArchiveFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchiveFormat(v)
+		return nil
+	case storage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case storage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Storage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StorageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StorageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StorageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Storage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StorageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(storage.FieldArchiveFormat) {
+		fields = append(fields, storage.FieldArchiveFormat)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StorageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StorageMutation) ClearField(name string) error {
+	switch name {
+	case storage.FieldArchiveFormat:
+		m.ClearArchiveFormat()
+		return nil
+	}
+	return fmt.Errorf("unknown Storage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StorageMutation) ResetField(name string) error {
+	switch name {
+	case storage.FieldName:
+		m.ResetName()
+		return nil
+	case storage.FieldType:
+		m.ResetType()
+		return nil
+	case storage.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case storage.FieldArchiveFormat:
+		m.ResetArchiveFormat()
+		return nil
+	case storage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case storage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Storage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StorageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.sync_jobs != nil {
+		edges = append(edges, storage.EdgeSyncJobs)
+	}
+	if m.webdav_config != nil {
+		edges = append(edges, storage.EdgeWebdavConfig)
+	}
+	if m.s3_config != nil {
+		edges = append(edges, storage.EdgeS3Config)
+	}
+	if m.local_config != nil {
+		edges = append(edges, storage.EdgeLocalConfig)
+	}
+	if m.sftp_config != nil {
+		edges = append(edges, storage.EdgeSftpConfig)
+	}
+	if m.ftp_config != nil {
+		edges = append(edges, storage.EdgeFtpConfig)
+	}
+	if m.azure_blob_config != nil {
+		edges = append(edges, storage.EdgeAzureBlobConfig)
+	}
+	if m.gcs_config != nil {
+		edges = append(edges, storage.EdgeGcsConfig)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StorageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case storage.EdgeSyncJobs:
+		ids := make([]ent.Value, 0, len(m.sync_jobs))
+		for id := range m.sync_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case storage.EdgeWebdavConfig:
+		if id := m.webdav_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case storage.EdgeS3Config:
+		if id := m.s3_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case storage.EdgeLocalConfig:
+		if id := m.local_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case storage.EdgeSftpConfig:
+		if id := m.sftp_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case storage.EdgeFtpConfig:
+		if id := m.ftp_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case storage.EdgeAzureBlobConfig:
+		if id := m.azure_blob_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case storage.EdgeGcsConfig:
+		if id := m.gcs_config; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StorageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedsync_jobs != nil {
+		edges = append(edges, storage.EdgeSyncJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StorageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case storage.EdgeSyncJobs:
+		ids := make([]ent.Value, 0, len(m.removedsync_jobs))
+		for id := range m.removedsync_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StorageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedsync_jobs {
+		edges = append(edges, storage.EdgeSyncJobs)
+	}
+	if m.clearedwebdav_config {
+		edges = append(edges, storage.EdgeWebdavConfig)
+	}
+	if m.cleareds3_config {
+		edges = append(edges, storage.EdgeS3Config)
+	}
+	if m.clearedlocal_config {
+		edges = append(edges, storage.EdgeLocalConfig)
+	}
+	if m.clearedsftp_config {
+		edges = append(edges, storage.EdgeSftpConfig)
+	}
+	if m.clearedftp_config {
+		edges = append(edges, storage.EdgeFtpConfig)
+	}
+	if m.clearedazure_blob_config {
+		edges = append(edges, storage.EdgeAzureBlobConfig)
+	}
+	if m.clearedgcs_config {
+		edges = append(edges, storage.EdgeGcsConfig)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StorageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case storage.EdgeSyncJobs:
+		return m.clearedsync_jobs
+	case storage.EdgeWebdavConfig:
+		return m.clearedwebdav_config
+	case storage.EdgeS3Config:
+		return m.cleareds3_config
+	case storage.EdgeLocalConfig:
+		return m.clearedlocal_config
+	case storage.EdgeSftpConfig:
+		return m.clearedsftp_config
+	case storage.EdgeFtpConfig:
+		return m.clearedftp_config
+	case storage.EdgeAzureBlobConfig:
+		return m.clearedazure_blob_config
+	case storage.EdgeGcsConfig:
+		return m.clearedgcs_config
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StorageMutation) ClearEdge(name string) error {
+	switch name {
+	case storage.EdgeWebdavConfig:
+		m.ClearWebdavConfig()
+		return nil
+	case storage.EdgeS3Config:
+		m.ClearS3Config()
+		return nil
+	case storage.EdgeLocalConfig:
+		m.ClearLocalConfig()
+		return nil
+	case storage.EdgeSftpConfig:
+		m.ClearSftpConfig()
+		return nil
+	case storage.EdgeFtpConfig:
+		m.ClearFtpConfig()
+		return nil
+	case storage.EdgeAzureBlobConfig:
+		m.ClearAzureBlobConfig()
+		return nil
+	case storage.EdgeGcsConfig:
+		m.ClearGcsConfig()
+		return nil
+	}
+	return fmt.Errorf("unknown Storage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StorageMutation) ResetEdge(name string) error {
+	switch name {
+	case storage.EdgeSyncJobs:
+		m.ResetSyncJobs()
+		return nil
+	case storage.EdgeWebdavConfig:
+		m.ResetWebdavConfig()
+		return nil
+	case storage.EdgeS3Config:
+		m.ResetS3Config()
+		return nil
+	case storage.EdgeLocalConfig:
+		m.ResetLocalConfig()
+		return nil
+	case storage.EdgeSftpConfig:
+		m.ResetSftpConfig()
+		return nil
+	case storage.EdgeFtpConfig:
+		m.ResetFtpConfig()
+		return nil
+	case storage.EdgeAzureBlobConfig:
+		m.ResetAzureBlobConfig()
+		return nil
+	case storage.EdgeGcsConfig:
+		m.ResetGcsConfig()
+		return nil
+	}
+	return fmt.Errorf("unknown Storage edge %s", name)
+}
+
+// SyncJobMutation represents an operation that mutates the SyncJob nodes in the graph.
+type SyncJobMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	status                 *syncjob.Status
+	operation              *syncjob.Operation
+	message                *string
+	filename               *string
+	restore_path           *string
+	rollback_path          *string
+	integrity_check        *string
+	original_size          *int64
+	addoriginal_size       *int64
+	archive_size           *int64
+	addarchive_size        *int64
+	compression_ratio      *float64
+	addcompression_ratio   *float64
+	data_files             *int
+	adddata_files          *int
+	data_size              *int64
+	adddata_size           *int64
+	fingerprint            *string
+	vault_users            *int64
+	addvault_users         *int64
+	vault_organizations    *int64
+	addvault_organizations *int64
+	vault_ciphers          *int64
+	addvault_ciphers       *int64
+	vault_attachments      *int64
+	addvault_attachments   *int64
+	vault_sends            *int64
+	addvault_sends         *int64
+	hook_output            *string
+	started_at             *time.Time
+	completed_at           *time.Time
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	storage                *int
+	clearedstorage         bool
+	source                 *int
+	clearedsource          bool
+	done                   bool
+	oldValue               func(context.Context) (*SyncJob, error)
+	predicates             []predicate.SyncJob
+}
+
+var _ ent.Mutation = (*SyncJobMutation)(nil)
+
+// syncjobOption allows management of the mutation configuration using functional options.
+type syncjobOption func(*SyncJobMutation)
+
+// newSyncJobMutation creates new mutation for the SyncJob entity.
+func newSyncJobMutation(c config, op Op, opts ...syncjobOption) *SyncJobMutation {
+	m := &SyncJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSyncJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSyncJobID sets the ID field of the mutation.
+func withSyncJobID(id int) syncjobOption {
+	return func(m *SyncJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SyncJob
+		)
+		m.oldValue = func(ctx context.Context) (*SyncJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SyncJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSyncJob sets the old SyncJob of the mutation.
+func withSyncJob(node *SyncJob) syncjobOption {
+	return func(m *SyncJobMutation) {
+		m.oldValue = func(context.Context) (*SyncJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SyncJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SyncJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SyncJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SyncJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SyncJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *SyncJobMutation) SetStatus(s syncjob.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SyncJobMutation) Status() (r syncjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldStatus(ctx context.Context) (v syncjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SyncJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *SyncJobMutation) SetOperation(s syncjob.Operation) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *SyncJobMutation) Operation() (r syncjob.Operation, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldOperation(ctx context.Context) (v syncjob.Operation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *SyncJobMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *SyncJobMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *SyncJobMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *SyncJobMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[syncjob.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *SyncJobMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *SyncJobMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, syncjob.FieldMessage)
+}
+
+// SetFilename sets the "filename" field.
+func (m *SyncJobMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *SyncJobMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (m *SyncJobMutation) ClearFilename() {
+	m.filename = nil
+	m.clearedFields[syncjob.FieldFilename] = struct{}{}
+}
+
+// FilenameCleared returns if the "filename" field was cleared in this mutation.
+func (m *SyncJobMutation) FilenameCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldFilename]
+	return ok
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *SyncJobMutation) ResetFilename() {
+	m.filename = nil
+	delete(m.clearedFields, syncjob.FieldFilename)
+}
+
+// SetRestorePath sets the "restore_path" field.
+func (m *SyncJobMutation) SetRestorePath(s string) {
+	m.restore_path = &s
+}
+
+// RestorePath returns the value of the "restore_path" field in the mutation.
+func (m *SyncJobMutation) RestorePath() (r string, exists bool) {
+	v := m.restore_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestorePath returns the old "restore_path" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldRestorePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestorePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestorePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestorePath: %w", err)
+	}
+	return oldValue.RestorePath, nil
+}
+
+// ClearRestorePath clears the value of the "restore_path" field.
+func (m *SyncJobMutation) ClearRestorePath() {
+	m.restore_path = nil
+	m.clearedFields[syncjob.FieldRestorePath] = struct{}{}
+}
+
+// RestorePathCleared returns if the "restore_path" field was cleared in this mutation.
+func (m *SyncJobMutation) RestorePathCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldRestorePath]
+	return ok
+}
+
+// ResetRestorePath resets all changes to the "restore_path" field.
+func (m *SyncJobMutation) ResetRestorePath() {
+	m.restore_path = nil
+	delete(m.clearedFields, syncjob.FieldRestorePath)
+}
+
+// SetRollbackPath sets the "rollback_path" field.
+func (m *SyncJobMutation) SetRollbackPath(s string) {
+	m.rollback_path = &s
+}
+
+// RollbackPath returns the value of the "rollback_path" field in the mutation.
+func (m *SyncJobMutation) RollbackPath() (r string, exists bool) {
+	v := m.rollback_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRollbackPath returns the old "rollback_path" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldRollbackPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRollbackPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRollbackPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRollbackPath: %w", err)
+	}
+	return oldValue.RollbackPath, nil
+}
+
+// ClearRollbackPath clears the value of the "rollback_path" field.
+func (m *SyncJobMutation) ClearRollbackPath() {
+	m.rollback_path = nil
+	m.clearedFields[syncjob.FieldRollbackPath] = struct{}{}
+}
+
+// RollbackPathCleared returns if the "rollback_path" field was cleared in this mutation.
+func (m *SyncJobMutation) RollbackPathCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldRollbackPath]
+	return ok
+}
+
+// ResetRollbackPath resets all changes to the "rollback_path" field.
+func (m *SyncJobMutation) ResetRollbackPath() {
+	m.rollback_path = nil
+	delete(m.clearedFields, syncjob.FieldRollbackPath)
+}
+
+// SetIntegrityCheck sets the "integrity_check" field.
+func (m *SyncJobMutation) SetIntegrityCheck(s string) {
+	m.integrity_check = &s
+}
+
+// IntegrityCheck returns the value of the "integrity_check" field in the mutation.
+func (m *SyncJobMutation) IntegrityCheck() (r string, exists bool) {
+	v := m.integrity_check
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntegrityCheck returns the old "integrity_check" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldIntegrityCheck(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntegrityCheck is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntegrityCheck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntegrityCheck: %w", err)
+	}
+	return oldValue.IntegrityCheck, nil
+}
+
+// ClearIntegrityCheck clears the value of the "integrity_check" field.
+func (m *SyncJobMutation) ClearIntegrityCheck() {
+	m.integrity_check = nil
+	m.clearedFields[syncjob.FieldIntegrityCheck] = struct{}{}
+}
+
+// IntegrityCheckCleared returns if the "integrity_check" field was cleared in this mutation.
+func (m *SyncJobMutation) IntegrityCheckCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldIntegrityCheck]
+	return ok
+}
+
+// ResetIntegrityCheck resets all changes to the "integrity_check" field.
+func (m *SyncJobMutation) ResetIntegrityCheck() {
+	m.integrity_check = nil
+	delete(m.clearedFields, syncjob.FieldIntegrityCheck)
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (m *SyncJobMutation) SetOriginalSize(i int64) {
+	m.original_size = &i
+	m.addoriginal_size = nil
+}
+
+// OriginalSize returns the value of the "original_size" field in the mutation.
+func (m *SyncJobMutation) OriginalSize() (r int64, exists bool) {
+	v := m.original_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalSize returns the old "original_size" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldOriginalSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalSize: %w", err)
+	}
+	return oldValue.OriginalSize, nil
+}
+
+// AddOriginalSize adds i to the "original_size" field.
+func (m *SyncJobMutation) AddOriginalSize(i int64) {
+	if m.addoriginal_size != nil {
+		*m.addoriginal_size += i
+	} else {
+		m.addoriginal_size = &i
+	}
+}
+
+// AddedOriginalSize returns the value that was added to the "original_size" field in this mutation.
+func (m *SyncJobMutation) AddedOriginalSize() (r int64, exists bool) {
+	v := m.addoriginal_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOriginalSize clears the value of the "original_size" field.
+func (m *SyncJobMutation) ClearOriginalSize() {
+	m.original_size = nil
+	m.addoriginal_size = nil
+	m.clearedFields[syncjob.FieldOriginalSize] = struct{}{}
+}
+
+// OriginalSizeCleared returns if the "original_size" field was cleared in this mutation.
+func (m *SyncJobMutation) OriginalSizeCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldOriginalSize]
+	return ok
+}
+
+// ResetOriginalSize resets all changes to the "original_size" field.
+func (m *SyncJobMutation) ResetOriginalSize() {
+	m.original_size = nil
+	m.addoriginal_size = nil
+	delete(m.clearedFields, syncjob.FieldOriginalSize)
+}
+
+// SetArchiveSize sets the "archive_size" field.
+func (m *SyncJobMutation) SetArchiveSize(i int64) {
+	m.archive_size = &i
+	m.addarchive_size = nil
+}
+
+// ArchiveSize returns the value of the "archive_size" field in the mutation.
+func (m *SyncJobMutation) ArchiveSize() (r int64, exists bool) {
+	v := m.archive_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchiveSize returns the old "archive_size" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldArchiveSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchiveSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchiveSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchiveSize: %w", err)
+	}
+	return oldValue.ArchiveSize, nil
+}
+
+// AddArchiveSize adds i to the "archive_size" field.
+func (m *SyncJobMutation) AddArchiveSize(i int64) {
+	if m.addarchive_size != nil {
+		*m.addarchive_size += i
+	} else {
+		m.addarchive_size = &i
+	}
+}
+
+// AddedArchiveSize returns the value that was added to the "archive_size" field in this mutation.
+func (m *SyncJobMutation) AddedArchiveSize() (r int64, exists bool) {
+	v := m.addarchive_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearArchiveSize clears the value of the "archive_size" field.
+func (m *SyncJobMutation) ClearArchiveSize() {
+	m.archive_size = nil
+	m.addarchive_size = nil
+	m.clearedFields[syncjob.FieldArchiveSize] = struct{}{}
+}
+
+// ArchiveSizeCleared returns if the "archive_size" field was cleared in this mutation.
+func (m *SyncJobMutation) ArchiveSizeCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldArchiveSize]
+	return ok
+}
+
+// ResetArchiveSize resets all changes to the "archive_size" field.
+func (m *SyncJobMutation) ResetArchiveSize() {
+	m.archive_size = nil
+	m.addarchive_size = nil
+	delete(m.clearedFields, syncjob.FieldArchiveSize)
+}
+
+// SetCompressionRatio sets the "compression_ratio" field.
+func (m *SyncJobMutation) SetCompressionRatio(f float64) {
+	m.compression_ratio = &f
+	m.addcompression_ratio = nil
+}
+
+// CompressionRatio returns the value of the "compression_ratio" field in the mutation.
+func (m *SyncJobMutation) CompressionRatio() (r float64, exists bool) {
+	v := m.compression_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompressionRatio returns the old "compression_ratio" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldCompressionRatio(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompressionRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompressionRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompressionRatio: %w", err)
+	}
+	return oldValue.CompressionRatio, nil
+}
+
+// AddCompressionRatio adds f to the "compression_ratio" field.
+func (m *SyncJobMutation) AddCompressionRatio(f float64) {
+	if m.addcompression_ratio != nil {
+		*m.addcompression_ratio += f
+	} else {
+		m.addcompression_ratio = &f
+	}
+}
+
+// AddedCompressionRatio returns the value that was added to the "compression_ratio" field in this mutation.
+func (m *SyncJobMutation) AddedCompressionRatio() (r float64, exists bool) {
+	v := m.addcompression_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompressionRatio clears the value of the "compression_ratio" field.
+func (m *SyncJobMutation) ClearCompressionRatio() {
+	m.compression_ratio = nil
+	m.addcompression_ratio = nil
+	m.clearedFields[syncjob.FieldCompressionRatio] = struct{}{}
+}
+
+// CompressionRatioCleared returns if the "compression_ratio" field was cleared in this mutation.
+func (m *SyncJobMutation) CompressionRatioCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldCompressionRatio]
+	return ok
+}
+
+// ResetCompressionRatio resets all changes to the "compression_ratio" field.
+func (m *SyncJobMutation) ResetCompressionRatio() {
+	m.compression_ratio = nil
+	m.addcompression_ratio = nil
+	delete(m.clearedFields, syncjob.FieldCompressionRatio)
+}
+
+// SetDataFiles sets the "data_files" field.
+func (m *SyncJobMutation) SetDataFiles(i int) {
+	m.data_files = &i
+	m.adddata_files = nil
+}
+
+// DataFiles returns the value of the "data_files" field in the mutation.
+func (m *SyncJobMutation) DataFiles() (r int, exists bool) {
+	v := m.data_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataFiles returns the old "data_files" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldDataFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataFiles: %w", err)
+	}
+	return oldValue.DataFiles, nil
+}
+
+// AddDataFiles adds i to the "data_files" field.
+func (m *SyncJobMutation) AddDataFiles(i int) {
+	if m.adddata_files != nil {
+		*m.adddata_files += i
+	} else {
+		m.adddata_files = &i
+	}
+}
+
+// AddedDataFiles returns the value that was added to the "data_files" field in this mutation.
+func (m *SyncJobMutation) AddedDataFiles() (r int, exists bool) {
+	v := m.adddata_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDataFiles clears the value of the "data_files" field.
+func (m *SyncJobMutation) ClearDataFiles() {
+	m.data_files = nil
+	m.adddata_files = nil
+	m.clearedFields[syncjob.FieldDataFiles] = struct{}{}
+}
+
+// DataFilesCleared returns if the "data_files" field was cleared in this mutation.
+func (m *SyncJobMutation) DataFilesCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldDataFiles]
+	return ok
+}
+
+// ResetDataFiles resets all changes to the "data_files" field.
+func (m *SyncJobMutation) ResetDataFiles() {
+	m.data_files = nil
+	m.adddata_files = nil
+	delete(m.clearedFields, syncjob.FieldDataFiles)
+}
+
+// SetDataSize sets the "data_size" field.
+func (m *SyncJobMutation) SetDataSize(i int64) {
+	m.data_size = &i
+	m.adddata_size = nil
+}
+
+// DataSize returns the value of the "data_size" field in the mutation.
+func (m *SyncJobMutation) DataSize() (r int64, exists bool) {
+	v := m.data_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataSize returns the old "data_size" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldDataSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataSize: %w", err)
+	}
+	return oldValue.DataSize, nil
+}
+
+// AddDataSize adds i to the "data_size" field.
+func (m *SyncJobMutation) AddDataSize(i int64) {
+	if m.adddata_size != nil {
+		*m.adddata_size += i
+	} else {
+		m.adddata_size = &i
+	}
+}
+
+// AddedDataSize returns the value that was added to the "data_size" field in this mutation.
+func (m *SyncJobMutation) AddedDataSize() (r int64, exists bool) {
+	v := m.adddata_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDataSize clears the value of the "data_size" field.
+func (m *SyncJobMutation) ClearDataSize() {
+	m.data_size = nil
+	m.adddata_size = nil
+	m.clearedFields[syncjob.FieldDataSize] = struct{}{}
+}
+
+// DataSizeCleared returns if the "data_size" field was cleared in this mutation.
+func (m *SyncJobMutation) DataSizeCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldDataSize]
+	return ok
+}
+
+// ResetDataSize resets all changes to the "data_size" field.
+func (m *SyncJobMutation) ResetDataSize() {
+	m.data_size = nil
+	m.adddata_size = nil
+	delete(m.clearedFields, syncjob.FieldDataSize)
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *SyncJobMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *SyncJobMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (m *SyncJobMutation) ClearFingerprint() {
+	m.fingerprint = nil
+	m.clearedFields[syncjob.FieldFingerprint] = struct{}{}
+}
+
+// FingerprintCleared returns if the "fingerprint" field was cleared in this mutation.
+func (m *SyncJobMutation) FingerprintCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldFingerprint]
+	return ok
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *SyncJobMutation) ResetFingerprint() {
+	m.fingerprint = nil
+	delete(m.clearedFields, syncjob.FieldFingerprint)
+}
+
+// SetVaultUsers sets the "vault_users" field.
+func (m *SyncJobMutation) SetVaultUsers(i int64) {
+	m.vault_users = &i
+	m.addvault_users = nil
+}
+
+// VaultUsers returns the value of the "vault_users" field in the mutation.
+func (m *SyncJobMutation) VaultUsers() (r int64, exists bool) {
+	v := m.vault_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultUsers returns the old "vault_users" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldVaultUsers(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultUsers: %w", err)
+	}
+	return oldValue.VaultUsers, nil
+}
+
+// AddVaultUsers adds i to the "vault_users" field.
+func (m *SyncJobMutation) AddVaultUsers(i int64) {
+	if m.addvault_users != nil {
+		*m.addvault_users += i
+	} else {
+		m.addvault_users = &i
+	}
+}
+
+// AddedVaultUsers returns the value that was added to the "vault_users" field in this mutation.
+func (m *SyncJobMutation) AddedVaultUsers() (r int64, exists bool) {
+	v := m.addvault_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVaultUsers clears the value of the "vault_users" field.
+func (m *SyncJobMutation) ClearVaultUsers() {
+	m.vault_users = nil
+	m.addvault_users = nil
+	m.clearedFields[syncjob.FieldVaultUsers] = struct{}{}
+}
+
+// VaultUsersCleared returns if the "vault_users" field was cleared in this mutation.
+func (m *SyncJobMutation) VaultUsersCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldVaultUsers]
+	return ok
+}
+
+// ResetVaultUsers resets all changes to the "vault_users" field.
+func (m *SyncJobMutation) ResetVaultUsers() {
+	m.vault_users = nil
+	m.addvault_users = nil
+	delete(m.clearedFields, syncjob.FieldVaultUsers)
+}
+
+// SetVaultOrganizations sets the "vault_organizations" field.
+func (m *SyncJobMutation) SetVaultOrganizations(i int64) {
+	m.vault_organizations = &i
+	m.addvault_organizations = nil
+}
+
+// VaultOrganizations returns the value of the "vault_organizations" field in the mutation.
+func (m *SyncJobMutation) VaultOrganizations() (r int64, exists bool) {
+	v := m.vault_organizations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultOrganizations returns the old "vault_organizations" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldVaultOrganizations(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultOrganizations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultOrganizations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultOrganizations: %w", err)
+	}
+	return oldValue.VaultOrganizations, nil
+}
+
+// AddVaultOrganizations adds i to the "vault_organizations" field.
+func (m *SyncJobMutation) AddVaultOrganizations(i int64) {
+	if m.addvault_organizations != nil {
+		*m.addvault_organizations += i
+	} else {
+		m.addvault_organizations = &i
+	}
+}
+
+// AddedVaultOrganizations returns the value that was added to the "vault_organizations" field in this mutation.
+func (m *SyncJobMutation) AddedVaultOrganizations() (r int64, exists bool) {
+	v := m.addvault_organizations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVaultOrganizations clears the value of the "vault_organizations" field.
+func (m *SyncJobMutation) ClearVaultOrganizations() {
+	m.vault_organizations = nil
+	m.addvault_organizations = nil
+	m.clearedFields[syncjob.FieldVaultOrganizations] = struct{}{}
+}
+
+// VaultOrganizationsCleared returns if the "vault_organizations" field was cleared in this mutation.
+func (m *SyncJobMutation) VaultOrganizationsCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldVaultOrganizations]
+	return ok
+}
+
+// ResetVaultOrganizations resets all changes to the "vault_organizations" field.
+func (m *SyncJobMutation) ResetVaultOrganizations() {
+	m.vault_organizations = nil
+	m.addvault_organizations = nil
+	delete(m.clearedFields, syncjob.FieldVaultOrganizations)
+}
+
+// SetVaultCiphers sets the "vault_ciphers" field.
+func (m *SyncJobMutation) SetVaultCiphers(i int64) {
+	m.vault_ciphers = &i
+	m.addvault_ciphers = nil
+}
+
+// VaultCiphers returns the value of the "vault_ciphers" field in the mutation.
+func (m *SyncJobMutation) VaultCiphers() (r int64, exists bool) {
+	v := m.vault_ciphers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultCiphers returns the old "vault_ciphers" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldVaultCiphers(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultCiphers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultCiphers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultCiphers: %w", err)
+	}
+	return oldValue.VaultCiphers, nil
+}
+
+// AddVaultCiphers adds i to the "vault_ciphers" field.
+func (m *SyncJobMutation) AddVaultCiphers(i int64) {
+	if m.addvault_ciphers != nil {
+		*m.addvault_ciphers += i
+	} else {
+		m.addvault_ciphers = &i
+	}
+}
+
+// AddedVaultCiphers returns the value that was added to the "vault_ciphers" field in this mutation.
+func (m *SyncJobMutation) AddedVaultCiphers() (r int64, exists bool) {
+	v := m.addvault_ciphers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVaultCiphers clears the value of the "vault_ciphers" field.
+func (m *SyncJobMutation) ClearVaultCiphers() {
+	m.vault_ciphers = nil
+	m.addvault_ciphers = nil
+	m.clearedFields[syncjob.FieldVaultCiphers] = struct{}{}
+}
+
+// VaultCiphersCleared returns if the "vault_ciphers" field was cleared in this mutation.
+func (m *SyncJobMutation) VaultCiphersCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldVaultCiphers]
+	return ok
+}
+
+// ResetVaultCiphers resets all changes to the "vault_ciphers" field.
+func (m *SyncJobMutation) ResetVaultCiphers() {
+	m.vault_ciphers = nil
+	m.addvault_ciphers = nil
+	delete(m.clearedFields, syncjob.FieldVaultCiphers)
+}
+
+// SetVaultAttachments sets the "vault_attachments" field.
+func (m *SyncJobMutation) SetVaultAttachments(i int64) {
+	m.vault_attachments = &i
+	m.addvault_attachments = nil
+}
+
+// VaultAttachments returns the value of the "vault_attachments" field in the mutation.
+func (m *SyncJobMutation) VaultAttachments() (r int64, exists bool) {
+	v := m.vault_attachments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultAttachments returns the old "vault_attachments" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldVaultAttachments(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultAttachments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultAttachments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultAttachments: %w", err)
+	}
+	return oldValue.VaultAttachments, nil
+}
+
+// AddVaultAttachments adds i to the "vault_attachments" field.
+func (m *SyncJobMutation) AddVaultAttachments(i int64) {
+	if m.addvault_attachments != nil {
+		*m.addvault_attachments += i
+	} else {
+		m.addvault_attachments = &i
+	}
+}
+
+// AddedVaultAttachments returns the value that was added to the "vault_attachments" field in this mutation.
+func (m *SyncJobMutation) AddedVaultAttachments() (r int64, exists bool) {
+	v := m.addvault_attachments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVaultAttachments clears the value of the "vault_attachments" field.
+func (m *SyncJobMutation) ClearVaultAttachments() {
+	m.vault_attachments = nil
+	m.addvault_attachments = nil
+	m.clearedFields[syncjob.FieldVaultAttachments] = struct{}{}
+}
+
+// VaultAttachmentsCleared returns if the "vault_attachments" field was cleared in this mutation.
+func (m *SyncJobMutation) VaultAttachmentsCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldVaultAttachments]
+	return ok
+}
+
+// ResetVaultAttachments resets all changes to the "vault_attachments" field.
+func (m *SyncJobMutation) ResetVaultAttachments() {
+	m.vault_attachments = nil
+	m.addvault_attachments = nil
+	delete(m.clearedFields, syncjob.FieldVaultAttachments)
+}
+
+// SetVaultSends sets the "vault_sends" field.
+func (m *SyncJobMutation) SetVaultSends(i int64) {
+	m.vault_sends = &i
+	m.addvault_sends = nil
+}
+
+// VaultSends returns the value of the "vault_sends" field in the mutation.
+func (m *SyncJobMutation) VaultSends() (r int64, exists bool) {
+	v := m.vault_sends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVaultSends returns the old "vault_sends" field's value of the SyncJob entity.
+// If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncJobMutation) OldVaultSends(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVaultSends is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVaultSends requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVaultSends: %w", err)
+	}
+	return oldValue.VaultSends, nil
+}
+
+// AddVaultSends adds i to the "vault_sends" field.
+func (m *SyncJobMutation) AddVaultSends(i int64) {
+	if m.addvault_sends != nil {
+		*m.addvault_sends += i
+	} else {
+		m.addvault_sends = &i
 	}
-	return oldValue.Operation, nil
 }
 
-// ResetOperation resets all changes to the "operation" field.
-func (m *SyncJobMutation) ResetOperation() {
-	m.operation = nil
+// AddedVaultSends returns the value that was added to the "vault_sends" field in this mutation.
+func (m *SyncJobMutation) AddedVaultSends() (r int64, exists bool) {
+	v := m.addvault_sends
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetMessage sets the "message" field.
-func (m *SyncJobMutation) SetMessage(s string) {
-	m.message = &s
+// ClearVaultSends clears the value of the "vault_sends" field.
+func (m *SyncJobMutation) ClearVaultSends() {
+	m.vault_sends = nil
+	m.addvault_sends = nil
+	m.clearedFields[syncjob.FieldVaultSends] = struct{}{}
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *SyncJobMutation) Message() (r string, exists bool) {
-	v := m.message
+// VaultSendsCleared returns if the "vault_sends" field was cleared in this mutation.
+func (m *SyncJobMutation) VaultSendsCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldVaultSends]
+	return ok
+}
+
+// ResetVaultSends resets all changes to the "vault_sends" field.
+func (m *SyncJobMutation) ResetVaultSends() {
+	m.vault_sends = nil
+	m.addvault_sends = nil
+	delete(m.clearedFields, syncjob.FieldVaultSends)
+}
+
+// SetHookOutput sets the "hook_output" field.
+func (m *SyncJobMutation) SetHookOutput(s string) {
+	m.hook_output = &s
+}
+
+// HookOutput returns the value of the "hook_output" field in the mutation.
+func (m *SyncJobMutation) HookOutput() (r string, exists bool) {
+	v := m.hook_output
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the SyncJob entity.
+// OldHookOutput returns the old "hook_output" field's value of the SyncJob entity.
 // If the SyncJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncJobMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *SyncJobMutation) OldHookOutput(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldHookOutput is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldHookOutput requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldHookOutput: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.HookOutput, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *SyncJobMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[syncjob.FieldMessage] = struct{}{}
+// ClearHookOutput clears the value of the "hook_output" field.
+func (m *SyncJobMutation) ClearHookOutput() {
+	m.hook_output = nil
+	m.clearedFields[syncjob.FieldHookOutput] = struct{}{}
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *SyncJobMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[syncjob.FieldMessage]
+// HookOutputCleared returns if the "hook_output" field was cleared in this mutation.
+func (m *SyncJobMutation) HookOutputCleared() bool {
+	_, ok := m.clearedFields[syncjob.FieldHookOutput]
 	return ok
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *SyncJobMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, syncjob.FieldMessage)
+// ResetHookOutput resets all changes to the "hook_output" field.
+func (m *SyncJobMutation) ResetHookOutput() {
+	m.hook_output = nil
+	delete(m.clearedFields, syncjob.FieldHookOutput)
 }
 
 // SetStartedAt sets the "started_at" field.
@@ -1831,6 +8025,45 @@ func (m *SyncJobMutation) ResetStorage() {
 	m.clearedstorage = false
 }
 
+// SetSourceID sets the "source" edge to the Source entity by id.
+func (m *SyncJobMutation) SetSourceID(id int) {
+	m.source = &id
+}
+
+// ClearSource clears the "source" edge to the Source entity.
+func (m *SyncJobMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the Source entity was cleared.
+func (m *SyncJobMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceID returns the "source" edge ID in the mutation.
+func (m *SyncJobMutation) SourceID() (id int, exists bool) {
+	if m.source != nil {
+		return *m.source, true
+	}
+	return
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *SyncJobMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *SyncJobMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
 // Where appends a list predicates to the SyncJobMutation builder.
 func (m *SyncJobMutation) Where(ps ...predicate.SyncJob) {
 	m.predicates = append(m.predicates, ps...)
@@ -1865,7 +8098,7 @@ func (m *SyncJobMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SyncJobMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 22)
 	if m.status != nil {
 		fields = append(fields, syncjob.FieldStatus)
 	}
@@ -1875,6 +8108,54 @@ func (m *SyncJobMutation) Fields() []string {
 	if m.message != nil {
 		fields = append(fields, syncjob.FieldMessage)
 	}
+	if m.filename != nil {
+		fields = append(fields, syncjob.FieldFilename)
+	}
+	if m.restore_path != nil {
+		fields = append(fields, syncjob.FieldRestorePath)
+	}
+	if m.rollback_path != nil {
+		fields = append(fields, syncjob.FieldRollbackPath)
+	}
+	if m.integrity_check != nil {
+		fields = append(fields, syncjob.FieldIntegrityCheck)
+	}
+	if m.original_size != nil {
+		fields = append(fields, syncjob.FieldOriginalSize)
+	}
+	if m.archive_size != nil {
+		fields = append(fields, syncjob.FieldArchiveSize)
+	}
+	if m.compression_ratio != nil {
+		fields = append(fields, syncjob.FieldCompressionRatio)
+	}
+	if m.data_files != nil {
+		fields = append(fields, syncjob.FieldDataFiles)
+	}
+	if m.data_size != nil {
+		fields = append(fields, syncjob.FieldDataSize)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, syncjob.FieldFingerprint)
+	}
+	if m.vault_users != nil {
+		fields = append(fields, syncjob.FieldVaultUsers)
+	}
+	if m.vault_organizations != nil {
+		fields = append(fields, syncjob.FieldVaultOrganizations)
+	}
+	if m.vault_ciphers != nil {
+		fields = append(fields, syncjob.FieldVaultCiphers)
+	}
+	if m.vault_attachments != nil {
+		fields = append(fields, syncjob.FieldVaultAttachments)
+	}
+	if m.vault_sends != nil {
+		fields = append(fields, syncjob.FieldVaultSends)
+	}
+	if m.hook_output != nil {
+		fields = append(fields, syncjob.FieldHookOutput)
+	}
 	if m.started_at != nil {
 		fields = append(fields, syncjob.FieldStartedAt)
 	}
@@ -1898,6 +8179,38 @@ func (m *SyncJobMutation) Field(name string) (ent.Value, bool) {
 		return m.Operation()
 	case syncjob.FieldMessage:
 		return m.Message()
+	case syncjob.FieldFilename:
+		return m.Filename()
+	case syncjob.FieldRestorePath:
+		return m.RestorePath()
+	case syncjob.FieldRollbackPath:
+		return m.RollbackPath()
+	case syncjob.FieldIntegrityCheck:
+		return m.IntegrityCheck()
+	case syncjob.FieldOriginalSize:
+		return m.OriginalSize()
+	case syncjob.FieldArchiveSize:
+		return m.ArchiveSize()
+	case syncjob.FieldCompressionRatio:
+		return m.CompressionRatio()
+	case syncjob.FieldDataFiles:
+		return m.DataFiles()
+	case syncjob.FieldDataSize:
+		return m.DataSize()
+	case syncjob.FieldFingerprint:
+		return m.Fingerprint()
+	case syncjob.FieldVaultUsers:
+		return m.VaultUsers()
+	case syncjob.FieldVaultOrganizations:
+		return m.VaultOrganizations()
+	case syncjob.FieldVaultCiphers:
+		return m.VaultCiphers()
+	case syncjob.FieldVaultAttachments:
+		return m.VaultAttachments()
+	case syncjob.FieldVaultSends:
+		return m.VaultSends()
+	case syncjob.FieldHookOutput:
+		return m.HookOutput()
 	case syncjob.FieldStartedAt:
 		return m.StartedAt()
 	case syncjob.FieldCompletedAt:
@@ -1919,6 +8232,38 @@ func (m *SyncJobMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldOperation(ctx)
 	case syncjob.FieldMessage:
 		return m.OldMessage(ctx)
+	case syncjob.FieldFilename:
+		return m.OldFilename(ctx)
+	case syncjob.FieldRestorePath:
+		return m.OldRestorePath(ctx)
+	case syncjob.FieldRollbackPath:
+		return m.OldRollbackPath(ctx)
+	case syncjob.FieldIntegrityCheck:
+		return m.OldIntegrityCheck(ctx)
+	case syncjob.FieldOriginalSize:
+		return m.OldOriginalSize(ctx)
+	case syncjob.FieldArchiveSize:
+		return m.OldArchiveSize(ctx)
+	case syncjob.FieldCompressionRatio:
+		return m.OldCompressionRatio(ctx)
+	case syncjob.FieldDataFiles:
+		return m.OldDataFiles(ctx)
+	case syncjob.FieldDataSize:
+		return m.OldDataSize(ctx)
+	case syncjob.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case syncjob.FieldVaultUsers:
+		return m.OldVaultUsers(ctx)
+	case syncjob.FieldVaultOrganizations:
+		return m.OldVaultOrganizations(ctx)
+	case syncjob.FieldVaultCiphers:
+		return m.OldVaultCiphers(ctx)
+	case syncjob.FieldVaultAttachments:
+		return m.OldVaultAttachments(ctx)
+	case syncjob.FieldVaultSends:
+		return m.OldVaultSends(ctx)
+	case syncjob.FieldHookOutput:
+		return m.OldHookOutput(ctx)
 	case syncjob.FieldStartedAt:
 		return m.OldStartedAt(ctx)
 	case syncjob.FieldCompletedAt:
@@ -1955,6 +8300,118 @@ func (m *SyncJobMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetMessage(v)
 		return nil
+	case syncjob.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case syncjob.FieldRestorePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestorePath(v)
+		return nil
+	case syncjob.FieldRollbackPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRollbackPath(v)
+		return nil
+	case syncjob.FieldIntegrityCheck:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntegrityCheck(v)
+		return nil
+	case syncjob.FieldOriginalSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalSize(v)
+		return nil
+	case syncjob.FieldArchiveSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchiveSize(v)
+		return nil
+	case syncjob.FieldCompressionRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompressionRatio(v)
+		return nil
+	case syncjob.FieldDataFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataFiles(v)
+		return nil
+	case syncjob.FieldDataSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataSize(v)
+		return nil
+	case syncjob.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case syncjob.FieldVaultUsers:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultUsers(v)
+		return nil
+	case syncjob.FieldVaultOrganizations:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultOrganizations(v)
+		return nil
+	case syncjob.FieldVaultCiphers:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultCiphers(v)
+		return nil
+	case syncjob.FieldVaultAttachments:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultAttachments(v)
+		return nil
+	case syncjob.FieldVaultSends:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVaultSends(v)
+		return nil
+	case syncjob.FieldHookOutput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHookOutput(v)
+		return nil
 	case syncjob.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -1983,13 +8440,66 @@ func (m *SyncJobMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *SyncJobMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addoriginal_size != nil {
+		fields = append(fields, syncjob.FieldOriginalSize)
+	}
+	if m.addarchive_size != nil {
+		fields = append(fields, syncjob.FieldArchiveSize)
+	}
+	if m.addcompression_ratio != nil {
+		fields = append(fields, syncjob.FieldCompressionRatio)
+	}
+	if m.adddata_files != nil {
+		fields = append(fields, syncjob.FieldDataFiles)
+	}
+	if m.adddata_size != nil {
+		fields = append(fields, syncjob.FieldDataSize)
+	}
+	if m.addvault_users != nil {
+		fields = append(fields, syncjob.FieldVaultUsers)
+	}
+	if m.addvault_organizations != nil {
+		fields = append(fields, syncjob.FieldVaultOrganizations)
+	}
+	if m.addvault_ciphers != nil {
+		fields = append(fields, syncjob.FieldVaultCiphers)
+	}
+	if m.addvault_attachments != nil {
+		fields = append(fields, syncjob.FieldVaultAttachments)
+	}
+	if m.addvault_sends != nil {
+		fields = append(fields, syncjob.FieldVaultSends)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *SyncJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case syncjob.FieldOriginalSize:
+		return m.AddedOriginalSize()
+	case syncjob.FieldArchiveSize:
+		return m.AddedArchiveSize()
+	case syncjob.FieldCompressionRatio:
+		return m.AddedCompressionRatio()
+	case syncjob.FieldDataFiles:
+		return m.AddedDataFiles()
+	case syncjob.FieldDataSize:
+		return m.AddedDataSize()
+	case syncjob.FieldVaultUsers:
+		return m.AddedVaultUsers()
+	case syncjob.FieldVaultOrganizations:
+		return m.AddedVaultOrganizations()
+	case syncjob.FieldVaultCiphers:
+		return m.AddedVaultCiphers()
+	case syncjob.FieldVaultAttachments:
+		return m.AddedVaultAttachments()
+	case syncjob.FieldVaultSends:
+		return m.AddedVaultSends()
+	}
 	return nil, false
 }
 
@@ -1998,6 +8508,76 @@ func (m *SyncJobMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *SyncJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case syncjob.FieldOriginalSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOriginalSize(v)
+		return nil
+	case syncjob.FieldArchiveSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddArchiveSize(v)
+		return nil
+	case syncjob.FieldCompressionRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompressionRatio(v)
+		return nil
+	case syncjob.FieldDataFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDataFiles(v)
+		return nil
+	case syncjob.FieldDataSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDataSize(v)
+		return nil
+	case syncjob.FieldVaultUsers:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVaultUsers(v)
+		return nil
+	case syncjob.FieldVaultOrganizations:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVaultOrganizations(v)
+		return nil
+	case syncjob.FieldVaultCiphers:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVaultCiphers(v)
+		return nil
+	case syncjob.FieldVaultAttachments:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVaultAttachments(v)
+		return nil
+	case syncjob.FieldVaultSends:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVaultSends(v)
+		return nil
 	}
 	return fmt.Errorf("unknown SyncJob numeric field %s", name)
 }
@@ -2009,6 +8589,54 @@ func (m *SyncJobMutation) ClearedFields() []string {
 	if m.FieldCleared(syncjob.FieldMessage) {
 		fields = append(fields, syncjob.FieldMessage)
 	}
+	if m.FieldCleared(syncjob.FieldFilename) {
+		fields = append(fields, syncjob.FieldFilename)
+	}
+	if m.FieldCleared(syncjob.FieldRestorePath) {
+		fields = append(fields, syncjob.FieldRestorePath)
+	}
+	if m.FieldCleared(syncjob.FieldRollbackPath) {
+		fields = append(fields, syncjob.FieldRollbackPath)
+	}
+	if m.FieldCleared(syncjob.FieldIntegrityCheck) {
+		fields = append(fields, syncjob.FieldIntegrityCheck)
+	}
+	if m.FieldCleared(syncjob.FieldOriginalSize) {
+		fields = append(fields, syncjob.FieldOriginalSize)
+	}
+	if m.FieldCleared(syncjob.FieldArchiveSize) {
+		fields = append(fields, syncjob.FieldArchiveSize)
+	}
+	if m.FieldCleared(syncjob.FieldCompressionRatio) {
+		fields = append(fields, syncjob.FieldCompressionRatio)
+	}
+	if m.FieldCleared(syncjob.FieldDataFiles) {
+		fields = append(fields, syncjob.FieldDataFiles)
+	}
+	if m.FieldCleared(syncjob.FieldDataSize) {
+		fields = append(fields, syncjob.FieldDataSize)
+	}
+	if m.FieldCleared(syncjob.FieldFingerprint) {
+		fields = append(fields, syncjob.FieldFingerprint)
+	}
+	if m.FieldCleared(syncjob.FieldVaultUsers) {
+		fields = append(fields, syncjob.FieldVaultUsers)
+	}
+	if m.FieldCleared(syncjob.FieldVaultOrganizations) {
+		fields = append(fields, syncjob.FieldVaultOrganizations)
+	}
+	if m.FieldCleared(syncjob.FieldVaultCiphers) {
+		fields = append(fields, syncjob.FieldVaultCiphers)
+	}
+	if m.FieldCleared(syncjob.FieldVaultAttachments) {
+		fields = append(fields, syncjob.FieldVaultAttachments)
+	}
+	if m.FieldCleared(syncjob.FieldVaultSends) {
+		fields = append(fields, syncjob.FieldVaultSends)
+	}
+	if m.FieldCleared(syncjob.FieldHookOutput) {
+		fields = append(fields, syncjob.FieldHookOutput)
+	}
 	if m.FieldCleared(syncjob.FieldStartedAt) {
 		fields = append(fields, syncjob.FieldStartedAt)
 	}
@@ -2032,6 +8660,54 @@ func (m *SyncJobMutation) ClearField(name string) error {
 	case syncjob.FieldMessage:
 		m.ClearMessage()
 		return nil
+	case syncjob.FieldFilename:
+		m.ClearFilename()
+		return nil
+	case syncjob.FieldRestorePath:
+		m.ClearRestorePath()
+		return nil
+	case syncjob.FieldRollbackPath:
+		m.ClearRollbackPath()
+		return nil
+	case syncjob.FieldIntegrityCheck:
+		m.ClearIntegrityCheck()
+		return nil
+	case syncjob.FieldOriginalSize:
+		m.ClearOriginalSize()
+		return nil
+	case syncjob.FieldArchiveSize:
+		m.ClearArchiveSize()
+		return nil
+	case syncjob.FieldCompressionRatio:
+		m.ClearCompressionRatio()
+		return nil
+	case syncjob.FieldDataFiles:
+		m.ClearDataFiles()
+		return nil
+	case syncjob.FieldDataSize:
+		m.ClearDataSize()
+		return nil
+	case syncjob.FieldFingerprint:
+		m.ClearFingerprint()
+		return nil
+	case syncjob.FieldVaultUsers:
+		m.ClearVaultUsers()
+		return nil
+	case syncjob.FieldVaultOrganizations:
+		m.ClearVaultOrganizations()
+		return nil
+	case syncjob.FieldVaultCiphers:
+		m.ClearVaultCiphers()
+		return nil
+	case syncjob.FieldVaultAttachments:
+		m.ClearVaultAttachments()
+		return nil
+	case syncjob.FieldVaultSends:
+		m.ClearVaultSends()
+		return nil
+	case syncjob.FieldHookOutput:
+		m.ClearHookOutput()
+		return nil
 	case syncjob.FieldStartedAt:
 		m.ClearStartedAt()
 		return nil
@@ -2055,6 +8731,54 @@ func (m *SyncJobMutation) ResetField(name string) error {
 	case syncjob.FieldMessage:
 		m.ResetMessage()
 		return nil
+	case syncjob.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case syncjob.FieldRestorePath:
+		m.ResetRestorePath()
+		return nil
+	case syncjob.FieldRollbackPath:
+		m.ResetRollbackPath()
+		return nil
+	case syncjob.FieldIntegrityCheck:
+		m.ResetIntegrityCheck()
+		return nil
+	case syncjob.FieldOriginalSize:
+		m.ResetOriginalSize()
+		return nil
+	case syncjob.FieldArchiveSize:
+		m.ResetArchiveSize()
+		return nil
+	case syncjob.FieldCompressionRatio:
+		m.ResetCompressionRatio()
+		return nil
+	case syncjob.FieldDataFiles:
+		m.ResetDataFiles()
+		return nil
+	case syncjob.FieldDataSize:
+		m.ResetDataSize()
+		return nil
+	case syncjob.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case syncjob.FieldVaultUsers:
+		m.ResetVaultUsers()
+		return nil
+	case syncjob.FieldVaultOrganizations:
+		m.ResetVaultOrganizations()
+		return nil
+	case syncjob.FieldVaultCiphers:
+		m.ResetVaultCiphers()
+		return nil
+	case syncjob.FieldVaultAttachments:
+		m.ResetVaultAttachments()
+		return nil
+	case syncjob.FieldVaultSends:
+		m.ResetVaultSends()
+		return nil
+	case syncjob.FieldHookOutput:
+		m.ResetHookOutput()
+		return nil
 	case syncjob.FieldStartedAt:
 		m.ResetStartedAt()
 		return nil
@@ -2070,10 +8794,13 @@ func (m *SyncJobMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *SyncJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.storage != nil {
 		edges = append(edges, syncjob.EdgeStorage)
 	}
+	if m.source != nil {
+		edges = append(edges, syncjob.EdgeSource)
+	}
 	return edges
 }
 
@@ -2085,13 +8812,17 @@ func (m *SyncJobMutation) AddedIDs(name string) []ent.Value {
 		if id := m.storage; id != nil {
 			return []ent.Value{*id}
 		}
+	case syncjob.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *SyncJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	return edges
 }
 
@@ -2103,10 +8834,13 @@ func (m *SyncJobMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *SyncJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.clearedstorage {
 		edges = append(edges, syncjob.EdgeStorage)
 	}
+	if m.clearedsource {
+		edges = append(edges, syncjob.EdgeSource)
+	}
 	return edges
 }
 
@@ -2116,6 +8850,8 @@ func (m *SyncJobMutation) EdgeCleared(name string) bool {
 	switch name {
 	case syncjob.EdgeStorage:
 		return m.clearedstorage
+	case syncjob.EdgeSource:
+		return m.clearedsource
 	}
 	return false
 }
@@ -2127,6 +8863,9 @@ func (m *SyncJobMutation) ClearEdge(name string) error {
 	case syncjob.EdgeStorage:
 		m.ClearStorage()
 		return nil
+	case syncjob.EdgeSource:
+		m.ClearSource()
+		return nil
 	}
 	return fmt.Errorf("unknown SyncJob unique edge %s", name)
 }
@@ -2138,6 +8877,9 @@ func (m *SyncJobMutation) ResetEdge(name string) error {
 	case syncjob.EdgeStorage:
 		m.ResetStorage()
 		return nil
+	case syncjob.EdgeSource:
+		m.ResetSource()
+		return nil
 	}
 	return fmt.Errorf("unknown SyncJob edge %s", name)
 }
@@ -6,9 +6,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AzureBlobConfig is the predicate function for azureblobconfig builders.
+type AzureBlobConfig func(*sql.Selector)
+
+// FTPConfig is the predicate function for ftpconfig builders.
+type FTPConfig func(*sql.Selector)
+
+// GCSConfig is the predicate function for gcsconfig builders.
+type GCSConfig func(*sql.Selector)
+
+// LocalConfig is the predicate function for localconfig builders.
+type LocalConfig func(*sql.Selector)
+
 // S3Config is the predicate function for s3config builders.
 type S3Config func(*sql.Selector)
 
+// SFTPConfig is the predicate function for sftpconfig builders.
+type SFTPConfig func(*sql.Selector)
+
+// Source is the predicate function for source builders.
+type Source func(*sql.Selector)
+
 // Storage is the predicate function for storage builders.
 type Storage func(*sql.Selector)
 
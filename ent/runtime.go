@@ -5,7 +5,12 @@ package ent
 import (
 	"time"
 
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/schema"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/user"
@@ -15,6 +20,68 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	ftpconfigFields := schema.FTPConfig{}.Fields()
+	_ = ftpconfigFields
+	// ftpconfigDescPort is the schema descriptor for port field.
+	ftpconfigDescPort := ftpconfigFields[1].Descriptor()
+	// ftpconfig.DefaultPort holds the default value on creation for the port field.
+	ftpconfig.DefaultPort = ftpconfigDescPort.Default.(int)
+	// ftpconfig.PortValidator is a validator for the "port" field. It is called by the builders before save.
+	ftpconfig.PortValidator = ftpconfigDescPort.Validators[0].(func(int) error)
+	// ftpconfigDescSkipTLSVerify is the schema descriptor for skip_tls_verify field.
+	ftpconfigDescSkipTLSVerify := ftpconfigFields[5].Descriptor()
+	// ftpconfig.DefaultSkipTLSVerify holds the default value on creation for the skip_tls_verify field.
+	ftpconfig.DefaultSkipTLSVerify = ftpconfigDescSkipTLSVerify.Default.(bool)
+	gcsconfigFields := schema.GCSConfig{}.Fields()
+	_ = gcsconfigFields
+	// gcsconfigDescRetentionDays is the schema descriptor for retention_days field.
+	gcsconfigDescRetentionDays := gcsconfigFields[4].Descriptor()
+	// gcsconfig.DefaultRetentionDays holds the default value on creation for the retention_days field.
+	gcsconfig.DefaultRetentionDays = gcsconfigDescRetentionDays.Default.(int)
+	// gcsconfig.RetentionDaysValidator is a validator for the "retention_days" field. It is called by the builders before save.
+	gcsconfig.RetentionDaysValidator = gcsconfigDescRetentionDays.Validators[0].(func(int) error)
+	localconfigFields := schema.LocalConfig{}.Fields()
+	_ = localconfigFields
+	// localconfigDescMinFreeSpaceMB is the schema descriptor for min_free_space_mb field.
+	localconfigDescMinFreeSpaceMB := localconfigFields[1].Descriptor()
+	// localconfig.DefaultMinFreeSpaceMB holds the default value on creation for the min_free_space_mb field.
+	localconfig.DefaultMinFreeSpaceMB = localconfigDescMinFreeSpaceMB.Default.(int64)
+	// localconfig.MinFreeSpaceMBValidator is a validator for the "min_free_space_mb" field. It is called by the builders before save.
+	localconfig.MinFreeSpaceMBValidator = localconfigDescMinFreeSpaceMB.Validators[0].(func(int64) error)
+	// localconfigDescSyncDirectory is the schema descriptor for sync_directory field.
+	localconfigDescSyncDirectory := localconfigFields[2].Descriptor()
+	// localconfig.DefaultSyncDirectory holds the default value on creation for the sync_directory field.
+	localconfig.DefaultSyncDirectory = localconfigDescSyncDirectory.Default.(bool)
+	sftpconfigFields := schema.SFTPConfig{}.Fields()
+	_ = sftpconfigFields
+	// sftpconfigDescPort is the schema descriptor for port field.
+	sftpconfigDescPort := sftpconfigFields[1].Descriptor()
+	// sftpconfig.DefaultPort holds the default value on creation for the port field.
+	sftpconfig.DefaultPort = sftpconfigDescPort.Default.(int)
+	// sftpconfig.PortValidator is a validator for the "port" field. It is called by the builders before save.
+	sftpconfig.PortValidator = sftpconfigDescPort.Validators[0].(func(int) error)
+	sourceFields := schema.Source{}.Fields()
+	_ = sourceFields
+	// sourceDescInterval is the schema descriptor for interval field.
+	sourceDescInterval := sourceFields[6].Descriptor()
+	// source.DefaultInterval holds the default value on creation for the interval field.
+	source.DefaultInterval = sourceDescInterval.Default.(int)
+	// source.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	source.IntervalValidator = sourceDescInterval.Validators[0].(func(int) error)
+	// sourceDescEnabled is the schema descriptor for enabled field.
+	sourceDescEnabled := sourceFields[7].Descriptor()
+	// source.DefaultEnabled holds the default value on creation for the enabled field.
+	source.DefaultEnabled = sourceDescEnabled.Default.(bool)
+	// sourceDescCreatedAt is the schema descriptor for created_at field.
+	sourceDescCreatedAt := sourceFields[8].Descriptor()
+	// source.DefaultCreatedAt holds the default value on creation for the created_at field.
+	source.DefaultCreatedAt = sourceDescCreatedAt.Default.(func() time.Time)
+	// sourceDescUpdatedAt is the schema descriptor for updated_at field.
+	sourceDescUpdatedAt := sourceFields[9].Descriptor()
+	// source.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	source.DefaultUpdatedAt = sourceDescUpdatedAt.Default.(func() time.Time)
+	// source.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	source.UpdateDefaultUpdatedAt = sourceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	storageFields := schema.Storage{}.Fields()
 	_ = storageFields
 	// storageDescEnabled is the schema descriptor for enabled field.
@@ -22,11 +89,11 @@ func init() {
 	// storage.DefaultEnabled holds the default value on creation for the enabled field.
 	storage.DefaultEnabled = storageDescEnabled.Default.(bool)
 	// storageDescCreatedAt is the schema descriptor for created_at field.
-	storageDescCreatedAt := storageFields[3].Descriptor()
+	storageDescCreatedAt := storageFields[4].Descriptor()
 	// storage.DefaultCreatedAt holds the default value on creation for the created_at field.
 	storage.DefaultCreatedAt = storageDescCreatedAt.Default.(func() time.Time)
 	// storageDescUpdatedAt is the schema descriptor for updated_at field.
-	storageDescUpdatedAt := storageFields[4].Descriptor()
+	storageDescUpdatedAt := storageFields[5].Descriptor()
 	// storage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	storage.DefaultUpdatedAt = storageDescUpdatedAt.Default.(func() time.Time)
 	// storage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -34,7 +101,7 @@ func init() {
 	syncjobFields := schema.SyncJob{}.Fields()
 	_ = syncjobFields
 	// syncjobDescCreatedAt is the schema descriptor for created_at field.
-	syncjobDescCreatedAt := syncjobFields[5].Descriptor()
+	syncjobDescCreatedAt := syncjobFields[21].Descriptor()
 	// syncjob.DefaultCreatedAt holds the default value on creation for the created_at field.
 	syncjob.DefaultCreatedAt = syncjobDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AzureBlobConfig holds the schema definition for the AzureBlobConfig entity.
+type AzureBlobConfig struct {
+	ent.Schema
+}
+
+// Fields of the AzureBlobConfig.
+func (AzureBlobConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("account_name"),
+		// account_key 和 sas_token 二选一
+		field.String("account_key").Optional().Sensitive(),
+		field.Text("sas_token").Optional().Sensitive(),
+		field.String("container"),
+		// endpoint 为空时使用 https://<account>.blob.core.windows.net，Azurite 等模拟器需要填写
+		field.String("endpoint").Optional(),
+		// access_tier 为空时使用账户的默认访问层
+		field.Enum("access_tier").Values("Hot", "Cool", "Archive").Optional(),
+	}
+}
+
+// Edges of the AzureBlobConfig.
+func (AzureBlobConfig) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("storage", Storage.Type).
+			Ref("azure_blob_config").
+			Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// FTPConfig holds the schema definition for the FTPConfig entity.
+type FTPConfig struct {
+	ent.Schema
+}
+
+// Fields of the FTPConfig.
+func (FTPConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("host"),
+		field.Int("port").Default(21).Range(1, 65535),
+		field.String("username"),
+		field.String("password").Optional().Sensitive(),
+		// tls_mode 为 none（明文）、explicit（AUTH TLS）或 implicit（连接即使用 TLS）
+		field.Enum("tls_mode").Values("none", "explicit", "implicit").Default("none"),
+		// skip_tls_verify 为 true 时不校验服务器证书，用于自签名证书
+		field.Bool("skip_tls_verify").Default(false),
+		// base_dir 为保存备份的远程目录，为空时使用登录后的目录
+		field.String("base_dir").Optional(),
+	}
+}
+
+// Edges of the FTPConfig.
+func (FTPConfig) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("storage", Storage.Type).
+			Ref("ftp_config").
+			Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// GCSConfig holds the schema definition for the GCSConfig entity.
+type GCSConfig struct {
+	ent.Schema
+}
+
+// Fields of the GCSConfig.
+func (GCSConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("bucket"),
+		// credentials_json 为服务账号的 JSON 密钥，只有填写 endpoint 的模拟服务可以留空
+		field.Text("credentials_json").Optional().Sensitive(),
+		// endpoint 为空时使用 https://storage.googleapis.com，fake-gcs-server 等模拟服务需要填写
+		field.String("endpoint").Optional(),
+		// storage_class 为空时使用存储桶的默认存储类别
+		field.Enum("storage_class").Values("STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE").Optional(),
+		// retention_days 大于 0 时为上传的对象设置保留期
+		field.Int("retention_days").Default(0).NonNegative(),
+		field.Enum("retention_mode").Values("Unlocked", "Locked").Default("Unlocked"),
+	}
+}
+
+// Edges of the GCSConfig.
+func (GCSConfig) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("storage", Storage.Type).
+			Ref("gcs_config").
+			Unique(),
+	}
+}
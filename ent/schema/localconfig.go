@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LocalConfig holds the schema definition for the LocalConfig entity.
+type LocalConfig struct {
+	ent.Schema
+}
+
+// Fields of the LocalConfig.
+func (LocalConfig) Fields() []ent.Field {
+	return []ent.Field{
+		// path 为保存备份的绝对路径，例如挂载的 NFS 或 USB 磁盘
+		field.String("path"),
+		// min_free_space_mb 为上传后目标文件系统至少要保留的可用空间（MB）
+		field.Int64("min_free_space_mb").Default(0).NonNegative(),
+		// sync_directory 为 true 时在重命名后对目录执行 fsync
+		field.Bool("sync_directory").Default(false),
+	}
+}
+
+// Edges of the LocalConfig.
+func (LocalConfig) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("storage", Storage.Type).
+			Ref("local_config").
+			Unique(),
+	}
+}
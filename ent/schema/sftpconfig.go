@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SFTPConfig holds the schema definition for the SFTPConfig entity.
+type SFTPConfig struct {
+	ent.Schema
+}
+
+// Fields of the SFTPConfig.
+func (SFTPConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("host"),
+		field.Int("port").Default(22).Range(1, 65535),
+		field.String("username"),
+		// password 和 private_key 至少配置一个
+		field.String("password").Optional().Sensitive(),
+		field.Text("private_key").Optional().Sensitive(),
+		// passphrase 为加密私钥的密码
+		field.String("passphrase").Optional().Sensitive(),
+		// host_key 为固定的服务器主机密钥，每行一个 SHA256 指纹、known_hosts 或 authorized_keys 格式的公钥
+		field.Text("host_key"),
+		// base_dir 为保存备份的远程目录，为空时使用登录用户的主目录
+		field.String("base_dir").Optional(),
+	}
+}
+
+// Edges of the SFTPConfig.
+func (SFTPConfig) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("storage", Storage.Type).
+			Ref("sftp_config").
+			Unique(),
+	}
+}
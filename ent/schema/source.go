@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Source holds the schema definition for the Source entity.
+// 每个 Source 为一个需要备份的 Vaultwarden 实例，配置文件中的 vaultwarden.data_path 为默认实例，不在此表中。
+type Source struct {
+	ent.Schema
+}
+
+// Fields of the Source.
+func (Source) Fields() []ent.Field {
+	return []ent.Field{
+		// name 写入备份文件名和去重仓库目录，用于区分同一存储中不同实例的备份
+		field.String("name").Unique(),
+		field.String("data_path"),
+		// 数据目录的 include/exclude 规则，为空时使用全局配置
+		field.JSON("include", []string{}).Optional(),
+		field.JSON("exclude", []string{}).Optional(),
+		// 加密设置，password 和 recipients 都为空时使用全局配置
+		field.String("password").Optional().Sensitive(),
+		field.JSON("recipients", []string{}).Optional(),
+		// interval 为定时备份的间隔（秒），0 表示只手动备份
+		field.Int("interval").Default(3600).NonNegative(),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Source.
+func (Source) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("sync_jobs", SyncJob.Type),
+	}
+}
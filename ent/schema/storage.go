@@ -17,8 +17,10 @@ type Storage struct {
 func (Storage) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Unique(),
-		field.Enum("type").Values("webdav", "s3"),
+		field.Enum("type").Values("webdav", "s3", "local", "sftp", "ftp", "azureblob", "gcs"),
 		field.Bool("enabled").Default(true),
+		// archive_format 为该存储使用的归档格式（zip、tar.gz、tar.zst），为空时使用全局配置
+		field.String("archive_format").Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -30,5 +32,10 @@ func (Storage) Edges() []ent.Edge {
 		edge.To("sync_jobs", SyncJob.Type),
 		edge.To("webdav_config", WebDAVConfig.Type).Unique(),
 		edge.To("s3_config", S3Config.Type).Unique(),
+		edge.To("local_config", LocalConfig.Type).Unique(),
+		edge.To("sftp_config", SFTPConfig.Type).Unique(),
+		edge.To("ftp_config", FTPConfig.Type).Unique(),
+		edge.To("azure_blob_config", AzureBlobConfig.Type).Unique(),
+		edge.To("gcs_config", GCSConfig.Type).Unique(),
 	}
 }
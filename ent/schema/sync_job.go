@@ -14,9 +14,35 @@ type SyncJob struct {
 
 func (SyncJob) Fields() []ent.Field {
 	return []ent.Field{
-		field.Enum("status").Values("pending", "running", "completed", "failed"),
-		field.Enum("operation").Values("backup", "restore"),
+		// suspicious 表示备份已上传到隔离目录，但与最近的备份相比出现了异常；
+		// skipped 表示数据自上一次成功备份以来没有变化，定时任务没有上传新的备份
+		field.Enum("status").Values("pending", "running", "completed", "failed", "suspicious", "skipped"),
+		field.Enum("operation").Values("backup", "restore", "verify"),
 		field.Text("message").Optional(),
+		// 备份任务上传的或校验任务检查的备份文件名
+		field.String("filename").Optional(),
+		// 恢复任务的目标目录，以及恢复前的数据被移到的目录，用于撤销恢复
+		field.String("restore_path").Optional(),
+		field.String("rollback_path").Optional(),
+		// 创建备份前数据库完整性检查的结果：ok、skipped，或检查失败时的错误信息
+		field.Text("integrity_check").Optional(),
+		// 备份文件的原始大小、归档大小以及两者之比（归档大小 / 原始大小）
+		field.Int64("original_size").Optional(),
+		field.Int64("archive_size").Optional(),
+		field.Float("compression_ratio").Optional(),
+		// 备份时数据目录中的文件数和总大小，用于与之后的备份比较
+		field.Int("data_files").Optional(),
+		field.Int64("data_size").Optional(),
+		// 备份时数据目录的指纹，与上一次成功备份相同时跳过定时备份
+		field.String("fingerprint").Optional(),
+		// 备份中 Vaultwarden 数据库的用户、组织、密码项、附件和 Send 的条数
+		field.Int64("vault_users").Optional(),
+		field.Int64("vault_organizations").Optional(),
+		field.Int64("vault_ciphers").Optional(),
+		field.Int64("vault_attachments").Optional(),
+		field.Int64("vault_sends").Optional(),
+		// 备份前后 hook 命令的输出
+		field.Text("hook_output").Optional(),
 		field.Time("started_at").Optional(),
 		field.Time("completed_at").Optional(),
 		field.Time("created_at").Default(time.Now),
@@ -26,5 +52,7 @@ func (SyncJob) Fields() []ent.Field {
 func (SyncJob) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("storage", Storage.Type).Ref("sync_jobs").Unique(),
+		// source 为空表示配置文件中的默认实例
+		edge.From("source", Source.Type).Ref("sync_jobs").Unique(),
 	}
 }
\ No newline at end of file
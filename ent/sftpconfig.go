@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// SFTPConfig is the model entity for the SFTPConfig schema.
+type SFTPConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Host holds the value of the "host" field.
+	Host string `json:"host,omitempty"`
+	// Port holds the value of the "port" field.
+	Port int `json:"port,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// PrivateKey holds the value of the "private_key" field.
+	PrivateKey string `json:"-"`
+	// Passphrase holds the value of the "passphrase" field.
+	Passphrase string `json:"-"`
+	// HostKey holds the value of the "host_key" field.
+	HostKey string `json:"host_key,omitempty"`
+	// BaseDir holds the value of the "base_dir" field.
+	BaseDir string `json:"base_dir,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SFTPConfigQuery when eager-loading is set.
+	Edges               SFTPConfigEdges `json:"edges"`
+	storage_sftp_config *int
+	selectValues        sql.SelectValues
+}
+
+// SFTPConfigEdges holds the relations/edges for other nodes in the graph.
+type SFTPConfigEdges struct {
+	// Storage holds the value of the storage edge.
+	Storage *Storage `json:"storage,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StorageOrErr returns the Storage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SFTPConfigEdges) StorageOrErr() (*Storage, error) {
+	if e.Storage != nil {
+		return e.Storage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: storage.Label}
+	}
+	return nil, &NotLoadedError{edge: "storage"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SFTPConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sftpconfig.FieldID, sftpconfig.FieldPort:
+			values[i] = new(sql.NullInt64)
+		case sftpconfig.FieldHost, sftpconfig.FieldUsername, sftpconfig.FieldPassword, sftpconfig.FieldPrivateKey, sftpconfig.FieldPassphrase, sftpconfig.FieldHostKey, sftpconfig.FieldBaseDir:
+			values[i] = new(sql.NullString)
+		case sftpconfig.ForeignKeys[0]: // storage_sftp_config
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SFTPConfig fields.
+func (sc *SFTPConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sftpconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = int(value.Int64)
+		case sftpconfig.FieldHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host", values[i])
+			} else if value.Valid {
+				sc.Host = value.String
+			}
+		case sftpconfig.FieldPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field port", values[i])
+			} else if value.Valid {
+				sc.Port = int(value.Int64)
+			}
+		case sftpconfig.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				sc.Username = value.String
+			}
+		case sftpconfig.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				sc.Password = value.String
+			}
+		case sftpconfig.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				sc.PrivateKey = value.String
+			}
+		case sftpconfig.FieldPassphrase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field passphrase", values[i])
+			} else if value.Valid {
+				sc.Passphrase = value.String
+			}
+		case sftpconfig.FieldHostKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_key", values[i])
+			} else if value.Valid {
+				sc.HostKey = value.String
+			}
+		case sftpconfig.FieldBaseDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_dir", values[i])
+			} else if value.Valid {
+				sc.BaseDir = value.String
+			}
+		case sftpconfig.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field storage_sftp_config", value)
+			} else if value.Valid {
+				sc.storage_sftp_config = new(int)
+				*sc.storage_sftp_config = int(value.Int64)
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SFTPConfig.
+// This includes values selected through modifiers, order, etc.
+func (sc *SFTPConfig) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryStorage queries the "storage" edge of the SFTPConfig entity.
+func (sc *SFTPConfig) QueryStorage() *StorageQuery {
+	return NewSFTPConfigClient(sc.config).QueryStorage(sc)
+}
+
+// Update returns a builder for updating this SFTPConfig.
+// Note that you need to call SFTPConfig.Unwrap() before calling this method if this SFTPConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SFTPConfig) Update() *SFTPConfigUpdateOne {
+	return NewSFTPConfigClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SFTPConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SFTPConfig) Unwrap() *SFTPConfig {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SFTPConfig is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SFTPConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("SFTPConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("host=")
+	builder.WriteString(sc.Host)
+	builder.WriteString(", ")
+	builder.WriteString("port=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Port))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(sc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("passphrase=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("host_key=")
+	builder.WriteString(sc.HostKey)
+	builder.WriteString(", ")
+	builder.WriteString("base_dir=")
+	builder.WriteString(sc.BaseDir)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SFTPConfigs is a parsable slice of SFTPConfig.
+type SFTPConfigs []*SFTPConfig
@@ -0,0 +1,667 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sftpconfig
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldID, id))
+}
+
+// Host applies equality check predicate on the "host" field. It's identical to HostEQ.
+func Host(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldHost, v))
+}
+
+// Port applies equality check predicate on the "port" field. It's identical to PortEQ.
+func Port(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPort, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldUsername, v))
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPassword, v))
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// Passphrase applies equality check predicate on the "passphrase" field. It's identical to PassphraseEQ.
+func Passphrase(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPassphrase, v))
+}
+
+// HostKey applies equality check predicate on the "host_key" field. It's identical to HostKeyEQ.
+func HostKey(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldHostKey, v))
+}
+
+// BaseDir applies equality check predicate on the "base_dir" field. It's identical to BaseDirEQ.
+func BaseDir(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldBaseDir, v))
+}
+
+// HostEQ applies the EQ predicate on the "host" field.
+func HostEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldHost, v))
+}
+
+// HostNEQ applies the NEQ predicate on the "host" field.
+func HostNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldHost, v))
+}
+
+// HostIn applies the In predicate on the "host" field.
+func HostIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldHost, vs...))
+}
+
+// HostNotIn applies the NotIn predicate on the "host" field.
+func HostNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldHost, vs...))
+}
+
+// HostGT applies the GT predicate on the "host" field.
+func HostGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldHost, v))
+}
+
+// HostGTE applies the GTE predicate on the "host" field.
+func HostGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldHost, v))
+}
+
+// HostLT applies the LT predicate on the "host" field.
+func HostLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldHost, v))
+}
+
+// HostLTE applies the LTE predicate on the "host" field.
+func HostLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldHost, v))
+}
+
+// HostContains applies the Contains predicate on the "host" field.
+func HostContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldHost, v))
+}
+
+// HostHasPrefix applies the HasPrefix predicate on the "host" field.
+func HostHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldHost, v))
+}
+
+// HostHasSuffix applies the HasSuffix predicate on the "host" field.
+func HostHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldHost, v))
+}
+
+// HostEqualFold applies the EqualFold predicate on the "host" field.
+func HostEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldHost, v))
+}
+
+// HostContainsFold applies the ContainsFold predicate on the "host" field.
+func HostContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldHost, v))
+}
+
+// PortEQ applies the EQ predicate on the "port" field.
+func PortEQ(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPort, v))
+}
+
+// PortNEQ applies the NEQ predicate on the "port" field.
+func PortNEQ(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldPort, v))
+}
+
+// PortIn applies the In predicate on the "port" field.
+func PortIn(vs ...int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldPort, vs...))
+}
+
+// PortNotIn applies the NotIn predicate on the "port" field.
+func PortNotIn(vs ...int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldPort, vs...))
+}
+
+// PortGT applies the GT predicate on the "port" field.
+func PortGT(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldPort, v))
+}
+
+// PortGTE applies the GTE predicate on the "port" field.
+func PortGTE(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldPort, v))
+}
+
+// PortLT applies the LT predicate on the "port" field.
+func PortLT(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldPort, v))
+}
+
+// PortLTE applies the LTE predicate on the "port" field.
+func PortLTE(v int) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldPort, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldPrivateKey, v))
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldPrivateKey, v))
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyContains applies the Contains predicate on the "private_key" field.
+func PrivateKeyContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasPrefix applies the HasPrefix predicate on the "private_key" field.
+func PrivateKeyHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasSuffix applies the HasSuffix predicate on the "private_key" field.
+func PrivateKeyHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldPrivateKey, v))
+}
+
+// PrivateKeyIsNil applies the IsNil predicate on the "private_key" field.
+func PrivateKeyIsNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIsNull(FieldPrivateKey))
+}
+
+// PrivateKeyNotNil applies the NotNil predicate on the "private_key" field.
+func PrivateKeyNotNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotNull(FieldPrivateKey))
+}
+
+// PrivateKeyEqualFold applies the EqualFold predicate on the "private_key" field.
+func PrivateKeyEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldPrivateKey, v))
+}
+
+// PrivateKeyContainsFold applies the ContainsFold predicate on the "private_key" field.
+func PrivateKeyContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldPrivateKey, v))
+}
+
+// PassphraseEQ applies the EQ predicate on the "passphrase" field.
+func PassphraseEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldPassphrase, v))
+}
+
+// PassphraseNEQ applies the NEQ predicate on the "passphrase" field.
+func PassphraseNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldPassphrase, v))
+}
+
+// PassphraseIn applies the In predicate on the "passphrase" field.
+func PassphraseIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldPassphrase, vs...))
+}
+
+// PassphraseNotIn applies the NotIn predicate on the "passphrase" field.
+func PassphraseNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldPassphrase, vs...))
+}
+
+// PassphraseGT applies the GT predicate on the "passphrase" field.
+func PassphraseGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldPassphrase, v))
+}
+
+// PassphraseGTE applies the GTE predicate on the "passphrase" field.
+func PassphraseGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldPassphrase, v))
+}
+
+// PassphraseLT applies the LT predicate on the "passphrase" field.
+func PassphraseLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldPassphrase, v))
+}
+
+// PassphraseLTE applies the LTE predicate on the "passphrase" field.
+func PassphraseLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldPassphrase, v))
+}
+
+// PassphraseContains applies the Contains predicate on the "passphrase" field.
+func PassphraseContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldPassphrase, v))
+}
+
+// PassphraseHasPrefix applies the HasPrefix predicate on the "passphrase" field.
+func PassphraseHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldPassphrase, v))
+}
+
+// PassphraseHasSuffix applies the HasSuffix predicate on the "passphrase" field.
+func PassphraseHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldPassphrase, v))
+}
+
+// PassphraseIsNil applies the IsNil predicate on the "passphrase" field.
+func PassphraseIsNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIsNull(FieldPassphrase))
+}
+
+// PassphraseNotNil applies the NotNil predicate on the "passphrase" field.
+func PassphraseNotNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotNull(FieldPassphrase))
+}
+
+// PassphraseEqualFold applies the EqualFold predicate on the "passphrase" field.
+func PassphraseEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldPassphrase, v))
+}
+
+// PassphraseContainsFold applies the ContainsFold predicate on the "passphrase" field.
+func PassphraseContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldPassphrase, v))
+}
+
+// HostKeyEQ applies the EQ predicate on the "host_key" field.
+func HostKeyEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldHostKey, v))
+}
+
+// HostKeyNEQ applies the NEQ predicate on the "host_key" field.
+func HostKeyNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldHostKey, v))
+}
+
+// HostKeyIn applies the In predicate on the "host_key" field.
+func HostKeyIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldHostKey, vs...))
+}
+
+// HostKeyNotIn applies the NotIn predicate on the "host_key" field.
+func HostKeyNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldHostKey, vs...))
+}
+
+// HostKeyGT applies the GT predicate on the "host_key" field.
+func HostKeyGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldHostKey, v))
+}
+
+// HostKeyGTE applies the GTE predicate on the "host_key" field.
+func HostKeyGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldHostKey, v))
+}
+
+// HostKeyLT applies the LT predicate on the "host_key" field.
+func HostKeyLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldHostKey, v))
+}
+
+// HostKeyLTE applies the LTE predicate on the "host_key" field.
+func HostKeyLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldHostKey, v))
+}
+
+// HostKeyContains applies the Contains predicate on the "host_key" field.
+func HostKeyContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldHostKey, v))
+}
+
+// HostKeyHasPrefix applies the HasPrefix predicate on the "host_key" field.
+func HostKeyHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldHostKey, v))
+}
+
+// HostKeyHasSuffix applies the HasSuffix predicate on the "host_key" field.
+func HostKeyHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldHostKey, v))
+}
+
+// HostKeyEqualFold applies the EqualFold predicate on the "host_key" field.
+func HostKeyEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldHostKey, v))
+}
+
+// HostKeyContainsFold applies the ContainsFold predicate on the "host_key" field.
+func HostKeyContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldHostKey, v))
+}
+
+// BaseDirEQ applies the EQ predicate on the "base_dir" field.
+func BaseDirEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEQ(FieldBaseDir, v))
+}
+
+// BaseDirNEQ applies the NEQ predicate on the "base_dir" field.
+func BaseDirNEQ(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNEQ(FieldBaseDir, v))
+}
+
+// BaseDirIn applies the In predicate on the "base_dir" field.
+func BaseDirIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIn(FieldBaseDir, vs...))
+}
+
+// BaseDirNotIn applies the NotIn predicate on the "base_dir" field.
+func BaseDirNotIn(vs ...string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotIn(FieldBaseDir, vs...))
+}
+
+// BaseDirGT applies the GT predicate on the "base_dir" field.
+func BaseDirGT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGT(FieldBaseDir, v))
+}
+
+// BaseDirGTE applies the GTE predicate on the "base_dir" field.
+func BaseDirGTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldGTE(FieldBaseDir, v))
+}
+
+// BaseDirLT applies the LT predicate on the "base_dir" field.
+func BaseDirLT(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLT(FieldBaseDir, v))
+}
+
+// BaseDirLTE applies the LTE predicate on the "base_dir" field.
+func BaseDirLTE(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldLTE(FieldBaseDir, v))
+}
+
+// BaseDirContains applies the Contains predicate on the "base_dir" field.
+func BaseDirContains(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContains(FieldBaseDir, v))
+}
+
+// BaseDirHasPrefix applies the HasPrefix predicate on the "base_dir" field.
+func BaseDirHasPrefix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasPrefix(FieldBaseDir, v))
+}
+
+// BaseDirHasSuffix applies the HasSuffix predicate on the "base_dir" field.
+func BaseDirHasSuffix(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldHasSuffix(FieldBaseDir, v))
+}
+
+// BaseDirIsNil applies the IsNil predicate on the "base_dir" field.
+func BaseDirIsNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldIsNull(FieldBaseDir))
+}
+
+// BaseDirNotNil applies the NotNil predicate on the "base_dir" field.
+func BaseDirNotNil() predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldNotNull(FieldBaseDir))
+}
+
+// BaseDirEqualFold applies the EqualFold predicate on the "base_dir" field.
+func BaseDirEqualFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldEqualFold(FieldBaseDir, v))
+}
+
+// BaseDirContainsFold applies the ContainsFold predicate on the "base_dir" field.
+func BaseDirContainsFold(v string) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.FieldContainsFold(FieldBaseDir, v))
+}
+
+// HasStorage applies the HasEdge predicate on the "storage" edge.
+func HasStorage() predicate.SFTPConfig {
+	return predicate.SFTPConfig(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, StorageTable, StorageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStorageWith applies the HasEdge predicate on the "storage" edge with a given conditions (other predicates).
+func HasStorageWith(preds ...predicate.Storage) predicate.SFTPConfig {
+	return predicate.SFTPConfig(func(s *sql.Selector) {
+		step := newStorageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SFTPConfig) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SFTPConfig) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SFTPConfig) predicate.SFTPConfig {
+	return predicate.SFTPConfig(sql.NotPredicates(p))
+}
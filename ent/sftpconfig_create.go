@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// SFTPConfigCreate is the builder for creating a SFTPConfig entity.
+type SFTPConfigCreate struct {
+	config
+	mutation *SFTPConfigMutation
+	hooks    []Hook
+}
+
+// SetHost sets the "host" field.
+func (scc *SFTPConfigCreate) SetHost(s string) *SFTPConfigCreate {
+	scc.mutation.SetHost(s)
+	return scc
+}
+
+// SetPort sets the "port" field.
+func (scc *SFTPConfigCreate) SetPort(i int) *SFTPConfigCreate {
+	scc.mutation.SetPort(i)
+	return scc
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (scc *SFTPConfigCreate) SetNillablePort(i *int) *SFTPConfigCreate {
+	if i != nil {
+		scc.SetPort(*i)
+	}
+	return scc
+}
+
+// SetUsername sets the "username" field.
+func (scc *SFTPConfigCreate) SetUsername(s string) *SFTPConfigCreate {
+	scc.mutation.SetUsername(s)
+	return scc
+}
+
+// SetPassword sets the "password" field.
+func (scc *SFTPConfigCreate) SetPassword(s string) *SFTPConfigCreate {
+	scc.mutation.SetPassword(s)
+	return scc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (scc *SFTPConfigCreate) SetNillablePassword(s *string) *SFTPConfigCreate {
+	if s != nil {
+		scc.SetPassword(*s)
+	}
+	return scc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (scc *SFTPConfigCreate) SetPrivateKey(s string) *SFTPConfigCreate {
+	scc.mutation.SetPrivateKey(s)
+	return scc
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (scc *SFTPConfigCreate) SetNillablePrivateKey(s *string) *SFTPConfigCreate {
+	if s != nil {
+		scc.SetPrivateKey(*s)
+	}
+	return scc
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (scc *SFTPConfigCreate) SetPassphrase(s string) *SFTPConfigCreate {
+	scc.mutation.SetPassphrase(s)
+	return scc
+}
+
+// SetNillablePassphrase sets the "passphrase" field if the given value is not nil.
+func (scc *SFTPConfigCreate) SetNillablePassphrase(s *string) *SFTPConfigCreate {
+	if s != nil {
+		scc.SetPassphrase(*s)
+	}
+	return scc
+}
+
+// SetHostKey sets the "host_key" field.
+func (scc *SFTPConfigCreate) SetHostKey(s string) *SFTPConfigCreate {
+	scc.mutation.SetHostKey(s)
+	return scc
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (scc *SFTPConfigCreate) SetBaseDir(s string) *SFTPConfigCreate {
+	scc.mutation.SetBaseDir(s)
+	return scc
+}
+
+// SetNillableBaseDir sets the "base_dir" field if the given value is not nil.
+func (scc *SFTPConfigCreate) SetNillableBaseDir(s *string) *SFTPConfigCreate {
+	if s != nil {
+		scc.SetBaseDir(*s)
+	}
+	return scc
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (scc *SFTPConfigCreate) SetStorageID(id int) *SFTPConfigCreate {
+	scc.mutation.SetStorageID(id)
+	return scc
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (scc *SFTPConfigCreate) SetNillableStorageID(id *int) *SFTPConfigCreate {
+	if id != nil {
+		scc = scc.SetStorageID(*id)
+	}
+	return scc
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (scc *SFTPConfigCreate) SetStorage(s *Storage) *SFTPConfigCreate {
+	return scc.SetStorageID(s.ID)
+}
+
+// Mutation returns the SFTPConfigMutation object of the builder.
+func (scc *SFTPConfigCreate) Mutation() *SFTPConfigMutation {
+	return scc.mutation
+}
+
+// Save creates the SFTPConfig in the database.
+func (scc *SFTPConfigCreate) Save(ctx context.Context) (*SFTPConfig, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SFTPConfigCreate) SaveX(ctx context.Context) *SFTPConfig {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SFTPConfigCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SFTPConfigCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SFTPConfigCreate) defaults() {
+	if _, ok := scc.mutation.Port(); !ok {
+		v := sftpconfig.DefaultPort
+		scc.mutation.SetPort(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SFTPConfigCreate) check() error {
+	if _, ok := scc.mutation.Host(); !ok {
+		return &ValidationError{Name: "host", err: errors.New(`ent: missing required field "SFTPConfig.host"`)}
+	}
+	if _, ok := scc.mutation.Port(); !ok {
+		return &ValidationError{Name: "port", err: errors.New(`ent: missing required field "SFTPConfig.port"`)}
+	}
+	if v, ok := scc.mutation.Port(); ok {
+		if err := sftpconfig.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "SFTPConfig.port": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "SFTPConfig.username"`)}
+	}
+	if _, ok := scc.mutation.HostKey(); !ok {
+		return &ValidationError{Name: "host_key", err: errors.New(`ent: missing required field "SFTPConfig.host_key"`)}
+	}
+	return nil
+}
+
+func (scc *SFTPConfigCreate) sqlSave(ctx context.Context) (*SFTPConfig, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SFTPConfigCreate) createSpec() (*SFTPConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SFTPConfig{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(sftpconfig.Table, sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt))
+	)
+	if value, ok := scc.mutation.Host(); ok {
+		_spec.SetField(sftpconfig.FieldHost, field.TypeString, value)
+		_node.Host = value
+	}
+	if value, ok := scc.mutation.Port(); ok {
+		_spec.SetField(sftpconfig.FieldPort, field.TypeInt, value)
+		_node.Port = value
+	}
+	if value, ok := scc.mutation.Username(); ok {
+		_spec.SetField(sftpconfig.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := scc.mutation.Password(); ok {
+		_spec.SetField(sftpconfig.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := scc.mutation.PrivateKey(); ok {
+		_spec.SetField(sftpconfig.FieldPrivateKey, field.TypeString, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := scc.mutation.Passphrase(); ok {
+		_spec.SetField(sftpconfig.FieldPassphrase, field.TypeString, value)
+		_node.Passphrase = value
+	}
+	if value, ok := scc.mutation.HostKey(); ok {
+		_spec.SetField(sftpconfig.FieldHostKey, field.TypeString, value)
+		_node.HostKey = value
+	}
+	if value, ok := scc.mutation.BaseDir(); ok {
+		_spec.SetField(sftpconfig.FieldBaseDir, field.TypeString, value)
+		_node.BaseDir = value
+	}
+	if nodes := scc.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sftpconfig.StorageTable,
+			Columns: []string{sftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.storage_sftp_config = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SFTPConfigCreateBulk is the builder for creating many SFTPConfig entities in bulk.
+type SFTPConfigCreateBulk struct {
+	config
+	err      error
+	builders []*SFTPConfigCreate
+}
+
+// Save creates the SFTPConfig entities in the database.
+func (sccb *SFTPConfigCreateBulk) Save(ctx context.Context) ([]*SFTPConfig, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SFTPConfig, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SFTPConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SFTPConfigCreateBulk) SaveX(ctx context.Context) []*SFTPConfig {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SFTPConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SFTPConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
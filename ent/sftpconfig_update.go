@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/storage"
+)
+
+// SFTPConfigUpdate is the builder for updating SFTPConfig entities.
+type SFTPConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SFTPConfigMutation
+}
+
+// Where appends a list predicates to the SFTPConfigUpdate builder.
+func (scu *SFTPConfigUpdate) Where(ps ...predicate.SFTPConfig) *SFTPConfigUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetHost sets the "host" field.
+func (scu *SFTPConfigUpdate) SetHost(s string) *SFTPConfigUpdate {
+	scu.mutation.SetHost(s)
+	return scu
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillableHost(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetHost(*s)
+	}
+	return scu
+}
+
+// SetPort sets the "port" field.
+func (scu *SFTPConfigUpdate) SetPort(i int) *SFTPConfigUpdate {
+	scu.mutation.ResetPort()
+	scu.mutation.SetPort(i)
+	return scu
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillablePort(i *int) *SFTPConfigUpdate {
+	if i != nil {
+		scu.SetPort(*i)
+	}
+	return scu
+}
+
+// AddPort adds i to the "port" field.
+func (scu *SFTPConfigUpdate) AddPort(i int) *SFTPConfigUpdate {
+	scu.mutation.AddPort(i)
+	return scu
+}
+
+// SetUsername sets the "username" field.
+func (scu *SFTPConfigUpdate) SetUsername(s string) *SFTPConfigUpdate {
+	scu.mutation.SetUsername(s)
+	return scu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillableUsername(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetUsername(*s)
+	}
+	return scu
+}
+
+// SetPassword sets the "password" field.
+func (scu *SFTPConfigUpdate) SetPassword(s string) *SFTPConfigUpdate {
+	scu.mutation.SetPassword(s)
+	return scu
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillablePassword(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetPassword(*s)
+	}
+	return scu
+}
+
+// ClearPassword clears the value of the "password" field.
+func (scu *SFTPConfigUpdate) ClearPassword() *SFTPConfigUpdate {
+	scu.mutation.ClearPassword()
+	return scu
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (scu *SFTPConfigUpdate) SetPrivateKey(s string) *SFTPConfigUpdate {
+	scu.mutation.SetPrivateKey(s)
+	return scu
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillablePrivateKey(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetPrivateKey(*s)
+	}
+	return scu
+}
+
+// ClearPrivateKey clears the value of the "private_key" field.
+func (scu *SFTPConfigUpdate) ClearPrivateKey() *SFTPConfigUpdate {
+	scu.mutation.ClearPrivateKey()
+	return scu
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (scu *SFTPConfigUpdate) SetPassphrase(s string) *SFTPConfigUpdate {
+	scu.mutation.SetPassphrase(s)
+	return scu
+}
+
+// SetNillablePassphrase sets the "passphrase" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillablePassphrase(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetPassphrase(*s)
+	}
+	return scu
+}
+
+// ClearPassphrase clears the value of the "passphrase" field.
+func (scu *SFTPConfigUpdate) ClearPassphrase() *SFTPConfigUpdate {
+	scu.mutation.ClearPassphrase()
+	return scu
+}
+
+// SetHostKey sets the "host_key" field.
+func (scu *SFTPConfigUpdate) SetHostKey(s string) *SFTPConfigUpdate {
+	scu.mutation.SetHostKey(s)
+	return scu
+}
+
+// SetNillableHostKey sets the "host_key" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillableHostKey(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetHostKey(*s)
+	}
+	return scu
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (scu *SFTPConfigUpdate) SetBaseDir(s string) *SFTPConfigUpdate {
+	scu.mutation.SetBaseDir(s)
+	return scu
+}
+
+// SetNillableBaseDir sets the "base_dir" field if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillableBaseDir(s *string) *SFTPConfigUpdate {
+	if s != nil {
+		scu.SetBaseDir(*s)
+	}
+	return scu
+}
+
+// ClearBaseDir clears the value of the "base_dir" field.
+func (scu *SFTPConfigUpdate) ClearBaseDir() *SFTPConfigUpdate {
+	scu.mutation.ClearBaseDir()
+	return scu
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (scu *SFTPConfigUpdate) SetStorageID(id int) *SFTPConfigUpdate {
+	scu.mutation.SetStorageID(id)
+	return scu
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (scu *SFTPConfigUpdate) SetNillableStorageID(id *int) *SFTPConfigUpdate {
+	if id != nil {
+		scu = scu.SetStorageID(*id)
+	}
+	return scu
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (scu *SFTPConfigUpdate) SetStorage(s *Storage) *SFTPConfigUpdate {
+	return scu.SetStorageID(s.ID)
+}
+
+// Mutation returns the SFTPConfigMutation object of the builder.
+func (scu *SFTPConfigUpdate) Mutation() *SFTPConfigMutation {
+	return scu.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (scu *SFTPConfigUpdate) ClearStorage() *SFTPConfigUpdate {
+	scu.mutation.ClearStorage()
+	return scu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SFTPConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SFTPConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SFTPConfigUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SFTPConfigUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SFTPConfigUpdate) check() error {
+	if v, ok := scu.mutation.Port(); ok {
+		if err := sftpconfig.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "SFTPConfig.port": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scu *SFTPConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sftpconfig.Table, sftpconfig.Columns, sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.Host(); ok {
+		_spec.SetField(sftpconfig.FieldHost, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Port(); ok {
+		_spec.SetField(sftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.AddedPort(); ok {
+		_spec.AddField(sftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.Username(); ok {
+		_spec.SetField(sftpconfig.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Password(); ok {
+		_spec.SetField(sftpconfig.FieldPassword, field.TypeString, value)
+	}
+	if scu.mutation.PasswordCleared() {
+		_spec.ClearField(sftpconfig.FieldPassword, field.TypeString)
+	}
+	if value, ok := scu.mutation.PrivateKey(); ok {
+		_spec.SetField(sftpconfig.FieldPrivateKey, field.TypeString, value)
+	}
+	if scu.mutation.PrivateKeyCleared() {
+		_spec.ClearField(sftpconfig.FieldPrivateKey, field.TypeString)
+	}
+	if value, ok := scu.mutation.Passphrase(); ok {
+		_spec.SetField(sftpconfig.FieldPassphrase, field.TypeString, value)
+	}
+	if scu.mutation.PassphraseCleared() {
+		_spec.ClearField(sftpconfig.FieldPassphrase, field.TypeString)
+	}
+	if value, ok := scu.mutation.HostKey(); ok {
+		_spec.SetField(sftpconfig.FieldHostKey, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.BaseDir(); ok {
+		_spec.SetField(sftpconfig.FieldBaseDir, field.TypeString, value)
+	}
+	if scu.mutation.BaseDirCleared() {
+		_spec.ClearField(sftpconfig.FieldBaseDir, field.TypeString)
+	}
+	if scu.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sftpconfig.StorageTable,
+			Columns: []string{sftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sftpconfig.StorageTable,
+			Columns: []string{sftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sftpconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SFTPConfigUpdateOne is the builder for updating a single SFTPConfig entity.
+type SFTPConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SFTPConfigMutation
+}
+
+// SetHost sets the "host" field.
+func (scuo *SFTPConfigUpdateOne) SetHost(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetHost(s)
+	return scuo
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillableHost(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetHost(*s)
+	}
+	return scuo
+}
+
+// SetPort sets the "port" field.
+func (scuo *SFTPConfigUpdateOne) SetPort(i int) *SFTPConfigUpdateOne {
+	scuo.mutation.ResetPort()
+	scuo.mutation.SetPort(i)
+	return scuo
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillablePort(i *int) *SFTPConfigUpdateOne {
+	if i != nil {
+		scuo.SetPort(*i)
+	}
+	return scuo
+}
+
+// AddPort adds i to the "port" field.
+func (scuo *SFTPConfigUpdateOne) AddPort(i int) *SFTPConfigUpdateOne {
+	scuo.mutation.AddPort(i)
+	return scuo
+}
+
+// SetUsername sets the "username" field.
+func (scuo *SFTPConfigUpdateOne) SetUsername(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetUsername(s)
+	return scuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillableUsername(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetUsername(*s)
+	}
+	return scuo
+}
+
+// SetPassword sets the "password" field.
+func (scuo *SFTPConfigUpdateOne) SetPassword(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetPassword(s)
+	return scuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillablePassword(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetPassword(*s)
+	}
+	return scuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (scuo *SFTPConfigUpdateOne) ClearPassword() *SFTPConfigUpdateOne {
+	scuo.mutation.ClearPassword()
+	return scuo
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (scuo *SFTPConfigUpdateOne) SetPrivateKey(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetPrivateKey(s)
+	return scuo
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillablePrivateKey(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetPrivateKey(*s)
+	}
+	return scuo
+}
+
+// ClearPrivateKey clears the value of the "private_key" field.
+func (scuo *SFTPConfigUpdateOne) ClearPrivateKey() *SFTPConfigUpdateOne {
+	scuo.mutation.ClearPrivateKey()
+	return scuo
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (scuo *SFTPConfigUpdateOne) SetPassphrase(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetPassphrase(s)
+	return scuo
+}
+
+// SetNillablePassphrase sets the "passphrase" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillablePassphrase(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetPassphrase(*s)
+	}
+	return scuo
+}
+
+// ClearPassphrase clears the value of the "passphrase" field.
+func (scuo *SFTPConfigUpdateOne) ClearPassphrase() *SFTPConfigUpdateOne {
+	scuo.mutation.ClearPassphrase()
+	return scuo
+}
+
+// SetHostKey sets the "host_key" field.
+func (scuo *SFTPConfigUpdateOne) SetHostKey(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetHostKey(s)
+	return scuo
+}
+
+// SetNillableHostKey sets the "host_key" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillableHostKey(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetHostKey(*s)
+	}
+	return scuo
+}
+
+// SetBaseDir sets the "base_dir" field.
+func (scuo *SFTPConfigUpdateOne) SetBaseDir(s string) *SFTPConfigUpdateOne {
+	scuo.mutation.SetBaseDir(s)
+	return scuo
+}
+
+// SetNillableBaseDir sets the "base_dir" field if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillableBaseDir(s *string) *SFTPConfigUpdateOne {
+	if s != nil {
+		scuo.SetBaseDir(*s)
+	}
+	return scuo
+}
+
+// ClearBaseDir clears the value of the "base_dir" field.
+func (scuo *SFTPConfigUpdateOne) ClearBaseDir() *SFTPConfigUpdateOne {
+	scuo.mutation.ClearBaseDir()
+	return scuo
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (scuo *SFTPConfigUpdateOne) SetStorageID(id int) *SFTPConfigUpdateOne {
+	scuo.mutation.SetStorageID(id)
+	return scuo
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (scuo *SFTPConfigUpdateOne) SetNillableStorageID(id *int) *SFTPConfigUpdateOne {
+	if id != nil {
+		scuo = scuo.SetStorageID(*id)
+	}
+	return scuo
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (scuo *SFTPConfigUpdateOne) SetStorage(s *Storage) *SFTPConfigUpdateOne {
+	return scuo.SetStorageID(s.ID)
+}
+
+// Mutation returns the SFTPConfigMutation object of the builder.
+func (scuo *SFTPConfigUpdateOne) Mutation() *SFTPConfigMutation {
+	return scuo.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (scuo *SFTPConfigUpdateOne) ClearStorage() *SFTPConfigUpdateOne {
+	scuo.mutation.ClearStorage()
+	return scuo
+}
+
+// Where appends a list predicates to the SFTPConfigUpdate builder.
+func (scuo *SFTPConfigUpdateOne) Where(ps ...predicate.SFTPConfig) *SFTPConfigUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SFTPConfigUpdateOne) Select(field string, fields ...string) *SFTPConfigUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SFTPConfig entity.
+func (scuo *SFTPConfigUpdateOne) Save(ctx context.Context) (*SFTPConfig, error) {
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SFTPConfigUpdateOne) SaveX(ctx context.Context) *SFTPConfig {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SFTPConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SFTPConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SFTPConfigUpdateOne) check() error {
+	if v, ok := scuo.mutation.Port(); ok {
+		if err := sftpconfig.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "SFTPConfig.port": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scuo *SFTPConfigUpdateOne) sqlSave(ctx context.Context) (_node *SFTPConfig, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sftpconfig.Table, sftpconfig.Columns, sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SFTPConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sftpconfig.FieldID)
+		for _, f := range fields {
+			if !sftpconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sftpconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.Host(); ok {
+		_spec.SetField(sftpconfig.FieldHost, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Port(); ok {
+		_spec.SetField(sftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.AddedPort(); ok {
+		_spec.AddField(sftpconfig.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.Username(); ok {
+		_spec.SetField(sftpconfig.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Password(); ok {
+		_spec.SetField(sftpconfig.FieldPassword, field.TypeString, value)
+	}
+	if scuo.mutation.PasswordCleared() {
+		_spec.ClearField(sftpconfig.FieldPassword, field.TypeString)
+	}
+	if value, ok := scuo.mutation.PrivateKey(); ok {
+		_spec.SetField(sftpconfig.FieldPrivateKey, field.TypeString, value)
+	}
+	if scuo.mutation.PrivateKeyCleared() {
+		_spec.ClearField(sftpconfig.FieldPrivateKey, field.TypeString)
+	}
+	if value, ok := scuo.mutation.Passphrase(); ok {
+		_spec.SetField(sftpconfig.FieldPassphrase, field.TypeString, value)
+	}
+	if scuo.mutation.PassphraseCleared() {
+		_spec.ClearField(sftpconfig.FieldPassphrase, field.TypeString)
+	}
+	if value, ok := scuo.mutation.HostKey(); ok {
+		_spec.SetField(sftpconfig.FieldHostKey, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.BaseDir(); ok {
+		_spec.SetField(sftpconfig.FieldBaseDir, field.TypeString, value)
+	}
+	if scuo.mutation.BaseDirCleared() {
+		_spec.ClearField(sftpconfig.FieldBaseDir, field.TypeString)
+	}
+	if scuo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sftpconfig.StorageTable,
+			Columns: []string{sftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sftpconfig.StorageTable,
+			Columns: []string{sftpconfig.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SFTPConfig{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sftpconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
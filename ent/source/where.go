@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package source
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldName, v))
+}
+
+// DataPath applies equality check predicate on the "data_path" field. It's identical to DataPathEQ.
+func DataPath(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldDataPath, v))
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldPassword, v))
+}
+
+// Interval applies equality check predicate on the "interval" field. It's identical to IntervalEQ.
+func Interval(v int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldInterval, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Source {
+	return predicate.Source(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldContainsFold(FieldName, v))
+}
+
+// DataPathEQ applies the EQ predicate on the "data_path" field.
+func DataPathEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldDataPath, v))
+}
+
+// DataPathNEQ applies the NEQ predicate on the "data_path" field.
+func DataPathNEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldDataPath, v))
+}
+
+// DataPathIn applies the In predicate on the "data_path" field.
+func DataPathIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldDataPath, vs...))
+}
+
+// DataPathNotIn applies the NotIn predicate on the "data_path" field.
+func DataPathNotIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldDataPath, vs...))
+}
+
+// DataPathGT applies the GT predicate on the "data_path" field.
+func DataPathGT(v string) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldDataPath, v))
+}
+
+// DataPathGTE applies the GTE predicate on the "data_path" field.
+func DataPathGTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldDataPath, v))
+}
+
+// DataPathLT applies the LT predicate on the "data_path" field.
+func DataPathLT(v string) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldDataPath, v))
+}
+
+// DataPathLTE applies the LTE predicate on the "data_path" field.
+func DataPathLTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldDataPath, v))
+}
+
+// DataPathContains applies the Contains predicate on the "data_path" field.
+func DataPathContains(v string) predicate.Source {
+	return predicate.Source(sql.FieldContains(FieldDataPath, v))
+}
+
+// DataPathHasPrefix applies the HasPrefix predicate on the "data_path" field.
+func DataPathHasPrefix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasPrefix(FieldDataPath, v))
+}
+
+// DataPathHasSuffix applies the HasSuffix predicate on the "data_path" field.
+func DataPathHasSuffix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasSuffix(FieldDataPath, v))
+}
+
+// DataPathEqualFold applies the EqualFold predicate on the "data_path" field.
+func DataPathEqualFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldEqualFold(FieldDataPath, v))
+}
+
+// DataPathContainsFold applies the ContainsFold predicate on the "data_path" field.
+func DataPathContainsFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldContainsFold(FieldDataPath, v))
+}
+
+// IncludeIsNil applies the IsNil predicate on the "include" field.
+func IncludeIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldInclude))
+}
+
+// IncludeNotNil applies the NotNil predicate on the "include" field.
+func IncludeNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldInclude))
+}
+
+// ExcludeIsNil applies the IsNil predicate on the "exclude" field.
+func ExcludeIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldExclude))
+}
+
+// ExcludeNotNil applies the NotNil predicate on the "exclude" field.
+func ExcludeNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldExclude))
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldPassword, v))
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldPassword, v))
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldPassword, vs...))
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldPassword, vs...))
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldPassword, v))
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldPassword, v))
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldPassword, v))
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldPassword, v))
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.Source {
+	return predicate.Source(sql.FieldContains(FieldPassword, v))
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasPrefix(FieldPassword, v))
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.Source {
+	return predicate.Source(sql.FieldHasSuffix(FieldPassword, v))
+}
+
+// PasswordIsNil applies the IsNil predicate on the "password" field.
+func PasswordIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldPassword))
+}
+
+// PasswordNotNil applies the NotNil predicate on the "password" field.
+func PasswordNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldPassword))
+}
+
+// PasswordEqualFold applies the EqualFold predicate on the "password" field.
+func PasswordEqualFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldEqualFold(FieldPassword, v))
+}
+
+// PasswordContainsFold applies the ContainsFold predicate on the "password" field.
+func PasswordContainsFold(v string) predicate.Source {
+	return predicate.Source(sql.FieldContainsFold(FieldPassword, v))
+}
+
+// RecipientsIsNil applies the IsNil predicate on the "recipients" field.
+func RecipientsIsNil() predicate.Source {
+	return predicate.Source(sql.FieldIsNull(FieldRecipients))
+}
+
+// RecipientsNotNil applies the NotNil predicate on the "recipients" field.
+func RecipientsNotNil() predicate.Source {
+	return predicate.Source(sql.FieldNotNull(FieldRecipients))
+}
+
+// IntervalEQ applies the EQ predicate on the "interval" field.
+func IntervalEQ(v int) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldInterval, v))
+}
+
+// IntervalNEQ applies the NEQ predicate on the "interval" field.
+func IntervalNEQ(v int) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldInterval, v))
+}
+
+// IntervalIn applies the In predicate on the "interval" field.
+func IntervalIn(vs ...int) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldInterval, vs...))
+}
+
+// IntervalNotIn applies the NotIn predicate on the "interval" field.
+func IntervalNotIn(vs ...int) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldInterval, vs...))
+}
+
+// IntervalGT applies the GT predicate on the "interval" field.
+func IntervalGT(v int) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldInterval, v))
+}
+
+// IntervalGTE applies the GTE predicate on the "interval" field.
+func IntervalGTE(v int) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldInterval, v))
+}
+
+// IntervalLT applies the LT predicate on the "interval" field.
+func IntervalLT(v int) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldInterval, v))
+}
+
+// IntervalLTE applies the LTE predicate on the "interval" field.
+func IntervalLTE(v int) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldInterval, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Source {
+	return predicate.Source(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Source {
+	return predicate.Source(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSyncJobs applies the HasEdge predicate on the "sync_jobs" edge.
+func HasSyncJobs() predicate.Source {
+	return predicate.Source(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SyncJobsTable, SyncJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncJobsWith applies the HasEdge predicate on the "sync_jobs" edge with a given conditions (other predicates).
+func HasSyncJobsWith(preds ...predicate.SyncJob) predicate.Source {
+	return predicate.Source(func(s *sql.Selector) {
+		step := newSyncJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Source) predicate.Source {
+	return predicate.Source(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Source) predicate.Source {
+	return predicate.Source(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Source) predicate.Source {
+	return predicate.Source(sql.NotPredicates(p))
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+)
+
+// SourceCreate is the builder for creating a Source entity.
+type SourceCreate struct {
+	config
+	mutation *SourceMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sc *SourceCreate) SetName(s string) *SourceCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetDataPath sets the "data_path" field.
+func (sc *SourceCreate) SetDataPath(s string) *SourceCreate {
+	sc.mutation.SetDataPath(s)
+	return sc
+}
+
+// SetInclude sets the "include" field.
+func (sc *SourceCreate) SetInclude(s []string) *SourceCreate {
+	sc.mutation.SetInclude(s)
+	return sc
+}
+
+// SetExclude sets the "exclude" field.
+func (sc *SourceCreate) SetExclude(s []string) *SourceCreate {
+	sc.mutation.SetExclude(s)
+	return sc
+}
+
+// SetPassword sets the "password" field.
+func (sc *SourceCreate) SetPassword(s string) *SourceCreate {
+	sc.mutation.SetPassword(s)
+	return sc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (sc *SourceCreate) SetNillablePassword(s *string) *SourceCreate {
+	if s != nil {
+		sc.SetPassword(*s)
+	}
+	return sc
+}
+
+// SetRecipients sets the "recipients" field.
+func (sc *SourceCreate) SetRecipients(s []string) *SourceCreate {
+	sc.mutation.SetRecipients(s)
+	return sc
+}
+
+// SetInterval sets the "interval" field.
+func (sc *SourceCreate) SetInterval(i int) *SourceCreate {
+	sc.mutation.SetInterval(i)
+	return sc
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableInterval(i *int) *SourceCreate {
+	if i != nil {
+		sc.SetInterval(*i)
+	}
+	return sc
+}
+
+// SetEnabled sets the "enabled" field.
+func (sc *SourceCreate) SetEnabled(b bool) *SourceCreate {
+	sc.mutation.SetEnabled(b)
+	return sc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableEnabled(b *bool) *SourceCreate {
+	if b != nil {
+		sc.SetEnabled(*b)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SourceCreate) SetCreatedAt(t time.Time) *SourceCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableCreatedAt(t *time.Time) *SourceCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SourceCreate) SetUpdatedAt(t time.Time) *SourceCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SourceCreate) SetNillableUpdatedAt(t *time.Time) *SourceCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by IDs.
+func (sc *SourceCreate) AddSyncJobIDs(ids ...int) *SourceCreate {
+	sc.mutation.AddSyncJobIDs(ids...)
+	return sc
+}
+
+// AddSyncJobs adds the "sync_jobs" edges to the SyncJob entity.
+func (sc *SourceCreate) AddSyncJobs(s ...*SyncJob) *SourceCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddSyncJobIDs(ids...)
+}
+
+// Mutation returns the SourceMutation object of the builder.
+func (sc *SourceCreate) Mutation() *SourceMutation {
+	return sc.mutation
+}
+
+// Save creates the Source in the database.
+func (sc *SourceCreate) Save(ctx context.Context) (*Source, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SourceCreate) SaveX(ctx context.Context) *Source {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SourceCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SourceCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SourceCreate) defaults() {
+	if _, ok := sc.mutation.Interval(); !ok {
+		v := source.DefaultInterval
+		sc.mutation.SetInterval(v)
+	}
+	if _, ok := sc.mutation.Enabled(); !ok {
+		v := source.DefaultEnabled
+		sc.mutation.SetEnabled(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := source.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := source.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SourceCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Source.name"`)}
+	}
+	if _, ok := sc.mutation.DataPath(); !ok {
+		return &ValidationError{Name: "data_path", err: errors.New(`ent: missing required field "Source.data_path"`)}
+	}
+	if _, ok := sc.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "Source.interval"`)}
+	}
+	if v, ok := sc.mutation.Interval(); ok {
+		if err := source.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Source.interval": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Source.enabled"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Source.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Source.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *SourceCreate) sqlSave(ctx context.Context) (*Source, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SourceCreate) createSpec() (*Source, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Source{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(source.Table, sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(source.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.DataPath(); ok {
+		_spec.SetField(source.FieldDataPath, field.TypeString, value)
+		_node.DataPath = value
+	}
+	if value, ok := sc.mutation.Include(); ok {
+		_spec.SetField(source.FieldInclude, field.TypeJSON, value)
+		_node.Include = value
+	}
+	if value, ok := sc.mutation.Exclude(); ok {
+		_spec.SetField(source.FieldExclude, field.TypeJSON, value)
+		_node.Exclude = value
+	}
+	if value, ok := sc.mutation.Password(); ok {
+		_spec.SetField(source.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := sc.mutation.Recipients(); ok {
+		_spec.SetField(source.FieldRecipients, field.TypeJSON, value)
+		_node.Recipients = value
+	}
+	if value, ok := sc.mutation.Interval(); ok {
+		_spec.SetField(source.FieldInterval, field.TypeInt, value)
+		_node.Interval = value
+	}
+	if value, ok := sc.mutation.Enabled(); ok {
+		_spec.SetField(source.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(source.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(source.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.SyncJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SourceCreateBulk is the builder for creating many Source entities in bulk.
+type SourceCreateBulk struct {
+	config
+	err      error
+	builders []*SourceCreate
+}
+
+// Save creates the Source entities in the database.
+func (scb *SourceCreateBulk) Save(ctx context.Context) ([]*Source, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Source, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SourceCreateBulk) SaveX(ctx context.Context) []*Source {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SourceCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+)
+
+// SourceUpdate is the builder for updating Source entities.
+type SourceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SourceMutation
+}
+
+// Where appends a list predicates to the SourceUpdate builder.
+func (su *SourceUpdate) Where(ps ...predicate.Source) *SourceUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SourceUpdate) SetName(s string) *SourceUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableName(s *string) *SourceUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetDataPath sets the "data_path" field.
+func (su *SourceUpdate) SetDataPath(s string) *SourceUpdate {
+	su.mutation.SetDataPath(s)
+	return su
+}
+
+// SetNillableDataPath sets the "data_path" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableDataPath(s *string) *SourceUpdate {
+	if s != nil {
+		su.SetDataPath(*s)
+	}
+	return su
+}
+
+// SetInclude sets the "include" field.
+func (su *SourceUpdate) SetInclude(s []string) *SourceUpdate {
+	su.mutation.SetInclude(s)
+	return su
+}
+
+// AppendInclude appends s to the "include" field.
+func (su *SourceUpdate) AppendInclude(s []string) *SourceUpdate {
+	su.mutation.AppendInclude(s)
+	return su
+}
+
+// ClearInclude clears the value of the "include" field.
+func (su *SourceUpdate) ClearInclude() *SourceUpdate {
+	su.mutation.ClearInclude()
+	return su
+}
+
+// SetExclude sets the "exclude" field.
+func (su *SourceUpdate) SetExclude(s []string) *SourceUpdate {
+	su.mutation.SetExclude(s)
+	return su
+}
+
+// AppendExclude appends s to the "exclude" field.
+func (su *SourceUpdate) AppendExclude(s []string) *SourceUpdate {
+	su.mutation.AppendExclude(s)
+	return su
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (su *SourceUpdate) ClearExclude() *SourceUpdate {
+	su.mutation.ClearExclude()
+	return su
+}
+
+// SetPassword sets the "password" field.
+func (su *SourceUpdate) SetPassword(s string) *SourceUpdate {
+	su.mutation.SetPassword(s)
+	return su
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (su *SourceUpdate) SetNillablePassword(s *string) *SourceUpdate {
+	if s != nil {
+		su.SetPassword(*s)
+	}
+	return su
+}
+
+// ClearPassword clears the value of the "password" field.
+func (su *SourceUpdate) ClearPassword() *SourceUpdate {
+	su.mutation.ClearPassword()
+	return su
+}
+
+// SetRecipients sets the "recipients" field.
+func (su *SourceUpdate) SetRecipients(s []string) *SourceUpdate {
+	su.mutation.SetRecipients(s)
+	return su
+}
+
+// AppendRecipients appends s to the "recipients" field.
+func (su *SourceUpdate) AppendRecipients(s []string) *SourceUpdate {
+	su.mutation.AppendRecipients(s)
+	return su
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (su *SourceUpdate) ClearRecipients() *SourceUpdate {
+	su.mutation.ClearRecipients()
+	return su
+}
+
+// SetInterval sets the "interval" field.
+func (su *SourceUpdate) SetInterval(i int) *SourceUpdate {
+	su.mutation.ResetInterval()
+	su.mutation.SetInterval(i)
+	return su
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableInterval(i *int) *SourceUpdate {
+	if i != nil {
+		su.SetInterval(*i)
+	}
+	return su
+}
+
+// AddInterval adds i to the "interval" field.
+func (su *SourceUpdate) AddInterval(i int) *SourceUpdate {
+	su.mutation.AddInterval(i)
+	return su
+}
+
+// SetEnabled sets the "enabled" field.
+func (su *SourceUpdate) SetEnabled(b bool) *SourceUpdate {
+	su.mutation.SetEnabled(b)
+	return su
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableEnabled(b *bool) *SourceUpdate {
+	if b != nil {
+		su.SetEnabled(*b)
+	}
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *SourceUpdate) SetCreatedAt(t time.Time) *SourceUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *SourceUpdate) SetNillableCreatedAt(t *time.Time) *SourceUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SourceUpdate) SetUpdatedAt(t time.Time) *SourceUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by IDs.
+func (su *SourceUpdate) AddSyncJobIDs(ids ...int) *SourceUpdate {
+	su.mutation.AddSyncJobIDs(ids...)
+	return su
+}
+
+// AddSyncJobs adds the "sync_jobs" edges to the SyncJob entity.
+func (su *SourceUpdate) AddSyncJobs(s ...*SyncJob) *SourceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddSyncJobIDs(ids...)
+}
+
+// Mutation returns the SourceMutation object of the builder.
+func (su *SourceUpdate) Mutation() *SourceMutation {
+	return su.mutation
+}
+
+// ClearSyncJobs clears all "sync_jobs" edges to the SyncJob entity.
+func (su *SourceUpdate) ClearSyncJobs() *SourceUpdate {
+	su.mutation.ClearSyncJobs()
+	return su
+}
+
+// RemoveSyncJobIDs removes the "sync_jobs" edge to SyncJob entities by IDs.
+func (su *SourceUpdate) RemoveSyncJobIDs(ids ...int) *SourceUpdate {
+	su.mutation.RemoveSyncJobIDs(ids...)
+	return su
+}
+
+// RemoveSyncJobs removes "sync_jobs" edges to SyncJob entities.
+func (su *SourceUpdate) RemoveSyncJobs(s ...*SyncJob) *SourceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveSyncJobIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SourceUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SourceUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SourceUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SourceUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := source.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SourceUpdate) check() error {
+	if v, ok := su.mutation.Interval(); ok {
+		if err := source.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Source.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *SourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(source.Table, source.Columns, sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(source.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.DataPath(); ok {
+		_spec.SetField(source.FieldDataPath, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Include(); ok {
+		_spec.SetField(source.FieldInclude, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedInclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, source.FieldInclude, value)
+		})
+	}
+	if su.mutation.IncludeCleared() {
+		_spec.ClearField(source.FieldInclude, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Exclude(); ok {
+		_spec.SetField(source.FieldExclude, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedExclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, source.FieldExclude, value)
+		})
+	}
+	if su.mutation.ExcludeCleared() {
+		_spec.ClearField(source.FieldExclude, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Password(); ok {
+		_spec.SetField(source.FieldPassword, field.TypeString, value)
+	}
+	if su.mutation.PasswordCleared() {
+		_spec.ClearField(source.FieldPassword, field.TypeString)
+	}
+	if value, ok := su.mutation.Recipients(); ok {
+		_spec.SetField(source.FieldRecipients, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedRecipients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, source.FieldRecipients, value)
+		})
+	}
+	if su.mutation.RecipientsCleared() {
+		_spec.ClearField(source.FieldRecipients, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Interval(); ok {
+		_spec.SetField(source.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedInterval(); ok {
+		_spec.AddField(source.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Enabled(); ok {
+		_spec.SetField(source.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(source.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(source.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.SyncJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedSyncJobsIDs(); len(nodes) > 0 && !su.mutation.SyncJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SyncJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{source.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SourceUpdateOne is the builder for updating a single Source entity.
+type SourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SourceMutation
+}
+
+// SetName sets the "name" field.
+func (suo *SourceUpdateOne) SetName(s string) *SourceUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableName(s *string) *SourceUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetDataPath sets the "data_path" field.
+func (suo *SourceUpdateOne) SetDataPath(s string) *SourceUpdateOne {
+	suo.mutation.SetDataPath(s)
+	return suo
+}
+
+// SetNillableDataPath sets the "data_path" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableDataPath(s *string) *SourceUpdateOne {
+	if s != nil {
+		suo.SetDataPath(*s)
+	}
+	return suo
+}
+
+// SetInclude sets the "include" field.
+func (suo *SourceUpdateOne) SetInclude(s []string) *SourceUpdateOne {
+	suo.mutation.SetInclude(s)
+	return suo
+}
+
+// AppendInclude appends s to the "include" field.
+func (suo *SourceUpdateOne) AppendInclude(s []string) *SourceUpdateOne {
+	suo.mutation.AppendInclude(s)
+	return suo
+}
+
+// ClearInclude clears the value of the "include" field.
+func (suo *SourceUpdateOne) ClearInclude() *SourceUpdateOne {
+	suo.mutation.ClearInclude()
+	return suo
+}
+
+// SetExclude sets the "exclude" field.
+func (suo *SourceUpdateOne) SetExclude(s []string) *SourceUpdateOne {
+	suo.mutation.SetExclude(s)
+	return suo
+}
+
+// AppendExclude appends s to the "exclude" field.
+func (suo *SourceUpdateOne) AppendExclude(s []string) *SourceUpdateOne {
+	suo.mutation.AppendExclude(s)
+	return suo
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (suo *SourceUpdateOne) ClearExclude() *SourceUpdateOne {
+	suo.mutation.ClearExclude()
+	return suo
+}
+
+// SetPassword sets the "password" field.
+func (suo *SourceUpdateOne) SetPassword(s string) *SourceUpdateOne {
+	suo.mutation.SetPassword(s)
+	return suo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillablePassword(s *string) *SourceUpdateOne {
+	if s != nil {
+		suo.SetPassword(*s)
+	}
+	return suo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (suo *SourceUpdateOne) ClearPassword() *SourceUpdateOne {
+	suo.mutation.ClearPassword()
+	return suo
+}
+
+// SetRecipients sets the "recipients" field.
+func (suo *SourceUpdateOne) SetRecipients(s []string) *SourceUpdateOne {
+	suo.mutation.SetRecipients(s)
+	return suo
+}
+
+// AppendRecipients appends s to the "recipients" field.
+func (suo *SourceUpdateOne) AppendRecipients(s []string) *SourceUpdateOne {
+	suo.mutation.AppendRecipients(s)
+	return suo
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (suo *SourceUpdateOne) ClearRecipients() *SourceUpdateOne {
+	suo.mutation.ClearRecipients()
+	return suo
+}
+
+// SetInterval sets the "interval" field.
+func (suo *SourceUpdateOne) SetInterval(i int) *SourceUpdateOne {
+	suo.mutation.ResetInterval()
+	suo.mutation.SetInterval(i)
+	return suo
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableInterval(i *int) *SourceUpdateOne {
+	if i != nil {
+		suo.SetInterval(*i)
+	}
+	return suo
+}
+
+// AddInterval adds i to the "interval" field.
+func (suo *SourceUpdateOne) AddInterval(i int) *SourceUpdateOne {
+	suo.mutation.AddInterval(i)
+	return suo
+}
+
+// SetEnabled sets the "enabled" field.
+func (suo *SourceUpdateOne) SetEnabled(b bool) *SourceUpdateOne {
+	suo.mutation.SetEnabled(b)
+	return suo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableEnabled(b *bool) *SourceUpdateOne {
+	if b != nil {
+		suo.SetEnabled(*b)
+	}
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *SourceUpdateOne) SetCreatedAt(t time.Time) *SourceUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *SourceUpdateOne) SetNillableCreatedAt(t *time.Time) *SourceUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SourceUpdateOne) SetUpdatedAt(t time.Time) *SourceUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by IDs.
+func (suo *SourceUpdateOne) AddSyncJobIDs(ids ...int) *SourceUpdateOne {
+	suo.mutation.AddSyncJobIDs(ids...)
+	return suo
+}
+
+// AddSyncJobs adds the "sync_jobs" edges to the SyncJob entity.
+func (suo *SourceUpdateOne) AddSyncJobs(s ...*SyncJob) *SourceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddSyncJobIDs(ids...)
+}
+
+// Mutation returns the SourceMutation object of the builder.
+func (suo *SourceUpdateOne) Mutation() *SourceMutation {
+	return suo.mutation
+}
+
+// ClearSyncJobs clears all "sync_jobs" edges to the SyncJob entity.
+func (suo *SourceUpdateOne) ClearSyncJobs() *SourceUpdateOne {
+	suo.mutation.ClearSyncJobs()
+	return suo
+}
+
+// RemoveSyncJobIDs removes the "sync_jobs" edge to SyncJob entities by IDs.
+func (suo *SourceUpdateOne) RemoveSyncJobIDs(ids ...int) *SourceUpdateOne {
+	suo.mutation.RemoveSyncJobIDs(ids...)
+	return suo
+}
+
+// RemoveSyncJobs removes "sync_jobs" edges to SyncJob entities.
+func (suo *SourceUpdateOne) RemoveSyncJobs(s ...*SyncJob) *SourceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveSyncJobIDs(ids...)
+}
+
+// Where appends a list predicates to the SourceUpdate builder.
+func (suo *SourceUpdateOne) Where(ps ...predicate.Source) *SourceUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SourceUpdateOne) Select(field string, fields ...string) *SourceUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Source entity.
+func (suo *SourceUpdateOne) Save(ctx context.Context) (*Source, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SourceUpdateOne) SaveX(ctx context.Context) *Source {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SourceUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SourceUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := source.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SourceUpdateOne) check() error {
+	if v, ok := suo.mutation.Interval(); ok {
+		if err := source.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Source.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SourceUpdateOne) sqlSave(ctx context.Context) (_node *Source, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(source.Table, source.Columns, sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Source.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, source.FieldID)
+		for _, f := range fields {
+			if !source.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != source.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(source.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.DataPath(); ok {
+		_spec.SetField(source.FieldDataPath, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Include(); ok {
+		_spec.SetField(source.FieldInclude, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedInclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, source.FieldInclude, value)
+		})
+	}
+	if suo.mutation.IncludeCleared() {
+		_spec.ClearField(source.FieldInclude, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Exclude(); ok {
+		_spec.SetField(source.FieldExclude, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedExclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, source.FieldExclude, value)
+		})
+	}
+	if suo.mutation.ExcludeCleared() {
+		_spec.ClearField(source.FieldExclude, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Password(); ok {
+		_spec.SetField(source.FieldPassword, field.TypeString, value)
+	}
+	if suo.mutation.PasswordCleared() {
+		_spec.ClearField(source.FieldPassword, field.TypeString)
+	}
+	if value, ok := suo.mutation.Recipients(); ok {
+		_spec.SetField(source.FieldRecipients, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedRecipients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, source.FieldRecipients, value)
+		})
+	}
+	if suo.mutation.RecipientsCleared() {
+		_spec.ClearField(source.FieldRecipients, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Interval(); ok {
+		_spec.SetField(source.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedInterval(); ok {
+		_spec.AddField(source.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Enabled(); ok {
+		_spec.SetField(source.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(source.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(source.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.SyncJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedSyncJobsIDs(); len(nodes) > 0 && !suo.mutation.SyncJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SyncJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   source.SyncJobsTable,
+			Columns: []string{source.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Source{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{source.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -9,7 +9,12 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/webdavconfig"
 )
@@ -25,6 +30,8 @@ type Storage struct {
 	Type storage.Type `json:"type,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
+	// ArchiveFormat holds the value of the "archive_format" field.
+	ArchiveFormat string `json:"archive_format,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -43,9 +50,19 @@ type StorageEdges struct {
 	WebdavConfig *WebDAVConfig `json:"webdav_config,omitempty"`
 	// S3Config holds the value of the s3_config edge.
 	S3Config *S3Config `json:"s3_config,omitempty"`
+	// LocalConfig holds the value of the local_config edge.
+	LocalConfig *LocalConfig `json:"local_config,omitempty"`
+	// SftpConfig holds the value of the sftp_config edge.
+	SftpConfig *SFTPConfig `json:"sftp_config,omitempty"`
+	// FtpConfig holds the value of the ftp_config edge.
+	FtpConfig *FTPConfig `json:"ftp_config,omitempty"`
+	// AzureBlobConfig holds the value of the azure_blob_config edge.
+	AzureBlobConfig *AzureBlobConfig `json:"azure_blob_config,omitempty"`
+	// GcsConfig holds the value of the gcs_config edge.
+	GcsConfig *GCSConfig `json:"gcs_config,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [8]bool
 }
 
 // SyncJobsOrErr returns the SyncJobs value or an error if the edge
@@ -79,6 +96,61 @@ func (e StorageEdges) S3ConfigOrErr() (*S3Config, error) {
 	return nil, &NotLoadedError{edge: "s3_config"}
 }
 
+// LocalConfigOrErr returns the LocalConfig value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StorageEdges) LocalConfigOrErr() (*LocalConfig, error) {
+	if e.LocalConfig != nil {
+		return e.LocalConfig, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: localconfig.Label}
+	}
+	return nil, &NotLoadedError{edge: "local_config"}
+}
+
+// SftpConfigOrErr returns the SftpConfig value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StorageEdges) SftpConfigOrErr() (*SFTPConfig, error) {
+	if e.SftpConfig != nil {
+		return e.SftpConfig, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: sftpconfig.Label}
+	}
+	return nil, &NotLoadedError{edge: "sftp_config"}
+}
+
+// FtpConfigOrErr returns the FtpConfig value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StorageEdges) FtpConfigOrErr() (*FTPConfig, error) {
+	if e.FtpConfig != nil {
+		return e.FtpConfig, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: ftpconfig.Label}
+	}
+	return nil, &NotLoadedError{edge: "ftp_config"}
+}
+
+// AzureBlobConfigOrErr returns the AzureBlobConfig value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StorageEdges) AzureBlobConfigOrErr() (*AzureBlobConfig, error) {
+	if e.AzureBlobConfig != nil {
+		return e.AzureBlobConfig, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: azureblobconfig.Label}
+	}
+	return nil, &NotLoadedError{edge: "azure_blob_config"}
+}
+
+// GcsConfigOrErr returns the GcsConfig value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StorageEdges) GcsConfigOrErr() (*GCSConfig, error) {
+	if e.GcsConfig != nil {
+		return e.GcsConfig, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: gcsconfig.Label}
+	}
+	return nil, &NotLoadedError{edge: "gcs_config"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Storage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -88,7 +160,7 @@ func (*Storage) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case storage.FieldID:
 			values[i] = new(sql.NullInt64)
-		case storage.FieldName, storage.FieldType:
+		case storage.FieldName, storage.FieldType, storage.FieldArchiveFormat:
 			values[i] = new(sql.NullString)
 		case storage.FieldCreatedAt, storage.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -131,6 +203,12 @@ func (s *Storage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Enabled = value.Bool
 			}
+		case storage.FieldArchiveFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_format", values[i])
+			} else if value.Valid {
+				s.ArchiveFormat = value.String
+			}
 		case storage.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -171,6 +249,31 @@ func (s *Storage) QueryS3Config() *S3ConfigQuery {
 	return NewStorageClient(s.config).QueryS3Config(s)
 }
 
+// QueryLocalConfig queries the "local_config" edge of the Storage entity.
+func (s *Storage) QueryLocalConfig() *LocalConfigQuery {
+	return NewStorageClient(s.config).QueryLocalConfig(s)
+}
+
+// QuerySftpConfig queries the "sftp_config" edge of the Storage entity.
+func (s *Storage) QuerySftpConfig() *SFTPConfigQuery {
+	return NewStorageClient(s.config).QuerySftpConfig(s)
+}
+
+// QueryFtpConfig queries the "ftp_config" edge of the Storage entity.
+func (s *Storage) QueryFtpConfig() *FTPConfigQuery {
+	return NewStorageClient(s.config).QueryFtpConfig(s)
+}
+
+// QueryAzureBlobConfig queries the "azure_blob_config" edge of the Storage entity.
+func (s *Storage) QueryAzureBlobConfig() *AzureBlobConfigQuery {
+	return NewStorageClient(s.config).QueryAzureBlobConfig(s)
+}
+
+// QueryGcsConfig queries the "gcs_config" edge of the Storage entity.
+func (s *Storage) QueryGcsConfig() *GCSConfigQuery {
+	return NewStorageClient(s.config).QueryGcsConfig(s)
+}
+
 // Update returns a builder for updating this Storage.
 // Note that you need to call Storage.Unwrap() before calling this method if this Storage
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -203,6 +306,9 @@ func (s *Storage) String() string {
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", s.Enabled))
 	builder.WriteString(", ")
+	builder.WriteString("archive_format=")
+	builder.WriteString(s.ArchiveFormat)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
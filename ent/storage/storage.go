@@ -21,6 +21,8 @@ const (
 	FieldType = "type"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldArchiveFormat holds the string denoting the archive_format field in the database.
+	FieldArchiveFormat = "archive_format"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -31,6 +33,16 @@ const (
 	EdgeWebdavConfig = "webdav_config"
 	// EdgeS3Config holds the string denoting the s3_config edge name in mutations.
 	EdgeS3Config = "s3_config"
+	// EdgeLocalConfig holds the string denoting the local_config edge name in mutations.
+	EdgeLocalConfig = "local_config"
+	// EdgeSftpConfig holds the string denoting the sftp_config edge name in mutations.
+	EdgeSftpConfig = "sftp_config"
+	// EdgeFtpConfig holds the string denoting the ftp_config edge name in mutations.
+	EdgeFtpConfig = "ftp_config"
+	// EdgeAzureBlobConfig holds the string denoting the azure_blob_config edge name in mutations.
+	EdgeAzureBlobConfig = "azure_blob_config"
+	// EdgeGcsConfig holds the string denoting the gcs_config edge name in mutations.
+	EdgeGcsConfig = "gcs_config"
 	// Table holds the table name of the storage in the database.
 	Table = "storages"
 	// SyncJobsTable is the table that holds the sync_jobs relation/edge.
@@ -54,6 +66,41 @@ const (
 	S3ConfigInverseTable = "s3configs"
 	// S3ConfigColumn is the table column denoting the s3_config relation/edge.
 	S3ConfigColumn = "storage_s3_config"
+	// LocalConfigTable is the table that holds the local_config relation/edge.
+	LocalConfigTable = "local_configs"
+	// LocalConfigInverseTable is the table name for the LocalConfig entity.
+	// It exists in this package in order to avoid circular dependency with the "localconfig" package.
+	LocalConfigInverseTable = "local_configs"
+	// LocalConfigColumn is the table column denoting the local_config relation/edge.
+	LocalConfigColumn = "storage_local_config"
+	// SftpConfigTable is the table that holds the sftp_config relation/edge.
+	SftpConfigTable = "sftp_configs"
+	// SftpConfigInverseTable is the table name for the SFTPConfig entity.
+	// It exists in this package in order to avoid circular dependency with the "sftpconfig" package.
+	SftpConfigInverseTable = "sftp_configs"
+	// SftpConfigColumn is the table column denoting the sftp_config relation/edge.
+	SftpConfigColumn = "storage_sftp_config"
+	// FtpConfigTable is the table that holds the ftp_config relation/edge.
+	FtpConfigTable = "ftp_configs"
+	// FtpConfigInverseTable is the table name for the FTPConfig entity.
+	// It exists in this package in order to avoid circular dependency with the "ftpconfig" package.
+	FtpConfigInverseTable = "ftp_configs"
+	// FtpConfigColumn is the table column denoting the ftp_config relation/edge.
+	FtpConfigColumn = "storage_ftp_config"
+	// AzureBlobConfigTable is the table that holds the azure_blob_config relation/edge.
+	AzureBlobConfigTable = "azure_blob_configs"
+	// AzureBlobConfigInverseTable is the table name for the AzureBlobConfig entity.
+	// It exists in this package in order to avoid circular dependency with the "azureblobconfig" package.
+	AzureBlobConfigInverseTable = "azure_blob_configs"
+	// AzureBlobConfigColumn is the table column denoting the azure_blob_config relation/edge.
+	AzureBlobConfigColumn = "storage_azure_blob_config"
+	// GcsConfigTable is the table that holds the gcs_config relation/edge.
+	GcsConfigTable = "gcs_configs"
+	// GcsConfigInverseTable is the table name for the GCSConfig entity.
+	// It exists in this package in order to avoid circular dependency with the "gcsconfig" package.
+	GcsConfigInverseTable = "gcs_configs"
+	// GcsConfigColumn is the table column denoting the gcs_config relation/edge.
+	GcsConfigColumn = "storage_gcs_config"
 )
 
 // Columns holds all SQL columns for storage fields.
@@ -62,6 +109,7 @@ var Columns = []string{
 	FieldName,
 	FieldType,
 	FieldEnabled,
+	FieldArchiveFormat,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -92,8 +140,13 @@ type Type string
 
 // Type values.
 const (
-	TypeWebdav Type = "webdav"
-	TypeS3     Type = "s3"
+	TypeWebdav    Type = "webdav"
+	TypeS3        Type = "s3"
+	TypeLocal     Type = "local"
+	TypeSftp      Type = "sftp"
+	TypeFtp       Type = "ftp"
+	TypeAzureblob Type = "azureblob"
+	TypeGcs       Type = "gcs"
 )
 
 func (_type Type) String() string {
@@ -103,7 +156,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeWebdav, TypeS3:
+	case TypeWebdav, TypeS3, TypeLocal, TypeSftp, TypeFtp, TypeAzureblob, TypeGcs:
 		return nil
 	default:
 		return fmt.Errorf("storage: invalid enum value for type field: %q", _type)
@@ -133,6 +186,11 @@ func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
 
+// ByArchiveFormat orders the results by the archive_format field.
+func ByArchiveFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveFormat, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -170,6 +228,41 @@ func ByS3ConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newS3ConfigStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByLocalConfigField orders the results by local_config field.
+func ByLocalConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocalConfigStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySftpConfigField orders the results by sftp_config field.
+func BySftpConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSftpConfigStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFtpConfigField orders the results by ftp_config field.
+func ByFtpConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFtpConfigStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAzureBlobConfigField orders the results by azure_blob_config field.
+func ByAzureBlobConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAzureBlobConfigStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGcsConfigField orders the results by gcs_config field.
+func ByGcsConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGcsConfigStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newSyncJobsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -191,3 +284,38 @@ func newS3ConfigStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, S3ConfigTable, S3ConfigColumn),
 	)
 }
+func newLocalConfigStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocalConfigInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, LocalConfigTable, LocalConfigColumn),
+	)
+}
+func newSftpConfigStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SftpConfigInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, SftpConfigTable, SftpConfigColumn),
+	)
+}
+func newFtpConfigStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FtpConfigInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, FtpConfigTable, FtpConfigColumn),
+	)
+}
+func newAzureBlobConfigStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AzureBlobConfigInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AzureBlobConfigTable, AzureBlobConfigColumn),
+	)
+}
+func newGcsConfigStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GcsConfigInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, GcsConfigTable, GcsConfigColumn),
+	)
+}
@@ -65,6 +65,11 @@ func Enabled(v bool) predicate.Storage {
 	return predicate.Storage(sql.FieldEQ(FieldEnabled, v))
 }
 
+// ArchiveFormat applies equality check predicate on the "archive_format" field. It's identical to ArchiveFormatEQ.
+func ArchiveFormat(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldEQ(FieldArchiveFormat, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Storage {
 	return predicate.Storage(sql.FieldEQ(FieldCreatedAt, v))
@@ -170,6 +175,81 @@ func EnabledNEQ(v bool) predicate.Storage {
 	return predicate.Storage(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// ArchiveFormatEQ applies the EQ predicate on the "archive_format" field.
+func ArchiveFormatEQ(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldEQ(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatNEQ applies the NEQ predicate on the "archive_format" field.
+func ArchiveFormatNEQ(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldNEQ(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatIn applies the In predicate on the "archive_format" field.
+func ArchiveFormatIn(vs ...string) predicate.Storage {
+	return predicate.Storage(sql.FieldIn(FieldArchiveFormat, vs...))
+}
+
+// ArchiveFormatNotIn applies the NotIn predicate on the "archive_format" field.
+func ArchiveFormatNotIn(vs ...string) predicate.Storage {
+	return predicate.Storage(sql.FieldNotIn(FieldArchiveFormat, vs...))
+}
+
+// ArchiveFormatGT applies the GT predicate on the "archive_format" field.
+func ArchiveFormatGT(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldGT(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatGTE applies the GTE predicate on the "archive_format" field.
+func ArchiveFormatGTE(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldGTE(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatLT applies the LT predicate on the "archive_format" field.
+func ArchiveFormatLT(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldLT(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatLTE applies the LTE predicate on the "archive_format" field.
+func ArchiveFormatLTE(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldLTE(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatContains applies the Contains predicate on the "archive_format" field.
+func ArchiveFormatContains(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldContains(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatHasPrefix applies the HasPrefix predicate on the "archive_format" field.
+func ArchiveFormatHasPrefix(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldHasPrefix(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatHasSuffix applies the HasSuffix predicate on the "archive_format" field.
+func ArchiveFormatHasSuffix(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldHasSuffix(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatIsNil applies the IsNil predicate on the "archive_format" field.
+func ArchiveFormatIsNil() predicate.Storage {
+	return predicate.Storage(sql.FieldIsNull(FieldArchiveFormat))
+}
+
+// ArchiveFormatNotNil applies the NotNil predicate on the "archive_format" field.
+func ArchiveFormatNotNil() predicate.Storage {
+	return predicate.Storage(sql.FieldNotNull(FieldArchiveFormat))
+}
+
+// ArchiveFormatEqualFold applies the EqualFold predicate on the "archive_format" field.
+func ArchiveFormatEqualFold(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldEqualFold(FieldArchiveFormat, v))
+}
+
+// ArchiveFormatContainsFold applies the ContainsFold predicate on the "archive_format" field.
+func ArchiveFormatContainsFold(v string) predicate.Storage {
+	return predicate.Storage(sql.FieldContainsFold(FieldArchiveFormat, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Storage {
 	return predicate.Storage(sql.FieldEQ(FieldCreatedAt, v))
@@ -319,6 +399,121 @@ func HasS3ConfigWith(preds ...predicate.S3Config) predicate.Storage {
 	})
 }
 
+// HasLocalConfig applies the HasEdge predicate on the "local_config" edge.
+func HasLocalConfig() predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, LocalConfigTable, LocalConfigColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocalConfigWith applies the HasEdge predicate on the "local_config" edge with a given conditions (other predicates).
+func HasLocalConfigWith(preds ...predicate.LocalConfig) predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := newLocalConfigStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSftpConfig applies the HasEdge predicate on the "sftp_config" edge.
+func HasSftpConfig() predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SftpConfigTable, SftpConfigColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSftpConfigWith applies the HasEdge predicate on the "sftp_config" edge with a given conditions (other predicates).
+func HasSftpConfigWith(preds ...predicate.SFTPConfig) predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := newSftpConfigStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFtpConfig applies the HasEdge predicate on the "ftp_config" edge.
+func HasFtpConfig() predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, FtpConfigTable, FtpConfigColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFtpConfigWith applies the HasEdge predicate on the "ftp_config" edge with a given conditions (other predicates).
+func HasFtpConfigWith(preds ...predicate.FTPConfig) predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := newFtpConfigStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAzureBlobConfig applies the HasEdge predicate on the "azure_blob_config" edge.
+func HasAzureBlobConfig() predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AzureBlobConfigTable, AzureBlobConfigColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAzureBlobConfigWith applies the HasEdge predicate on the "azure_blob_config" edge with a given conditions (other predicates).
+func HasAzureBlobConfigWith(preds ...predicate.AzureBlobConfig) predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := newAzureBlobConfigStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGcsConfig applies the HasEdge predicate on the "gcs_config" edge.
+func HasGcsConfig() predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, GcsConfigTable, GcsConfigColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGcsConfigWith applies the HasEdge predicate on the "gcs_config" edge with a given conditions (other predicates).
+func HasGcsConfigWith(preds ...predicate.GCSConfig) predicate.Storage {
+	return predicate.Storage(func(s *sql.Selector) {
+		step := newGcsConfigStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Storage) predicate.Storage {
 	return predicate.Storage(sql.AndPredicates(predicates...))
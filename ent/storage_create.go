@@ -10,7 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/webdavconfig"
@@ -49,6 +54,20 @@ func (sc *StorageCreate) SetNillableEnabled(b *bool) *StorageCreate {
 	return sc
 }
 
+// SetArchiveFormat sets the "archive_format" field.
+func (sc *StorageCreate) SetArchiveFormat(s string) *StorageCreate {
+	sc.mutation.SetArchiveFormat(s)
+	return sc
+}
+
+// SetNillableArchiveFormat sets the "archive_format" field if the given value is not nil.
+func (sc *StorageCreate) SetNillableArchiveFormat(s *string) *StorageCreate {
+	if s != nil {
+		sc.SetArchiveFormat(*s)
+	}
+	return sc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (sc *StorageCreate) SetCreatedAt(t time.Time) *StorageCreate {
 	sc.mutation.SetCreatedAt(t)
@@ -130,6 +149,101 @@ func (sc *StorageCreate) SetS3Config(s *S3Config) *StorageCreate {
 	return sc.SetS3ConfigID(s.ID)
 }
 
+// SetLocalConfigID sets the "local_config" edge to the LocalConfig entity by ID.
+func (sc *StorageCreate) SetLocalConfigID(id int) *StorageCreate {
+	sc.mutation.SetLocalConfigID(id)
+	return sc
+}
+
+// SetNillableLocalConfigID sets the "local_config" edge to the LocalConfig entity by ID if the given value is not nil.
+func (sc *StorageCreate) SetNillableLocalConfigID(id *int) *StorageCreate {
+	if id != nil {
+		sc = sc.SetLocalConfigID(*id)
+	}
+	return sc
+}
+
+// SetLocalConfig sets the "local_config" edge to the LocalConfig entity.
+func (sc *StorageCreate) SetLocalConfig(l *LocalConfig) *StorageCreate {
+	return sc.SetLocalConfigID(l.ID)
+}
+
+// SetSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by ID.
+func (sc *StorageCreate) SetSftpConfigID(id int) *StorageCreate {
+	sc.mutation.SetSftpConfigID(id)
+	return sc
+}
+
+// SetNillableSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by ID if the given value is not nil.
+func (sc *StorageCreate) SetNillableSftpConfigID(id *int) *StorageCreate {
+	if id != nil {
+		sc = sc.SetSftpConfigID(*id)
+	}
+	return sc
+}
+
+// SetSftpConfig sets the "sftp_config" edge to the SFTPConfig entity.
+func (sc *StorageCreate) SetSftpConfig(s *SFTPConfig) *StorageCreate {
+	return sc.SetSftpConfigID(s.ID)
+}
+
+// SetFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by ID.
+func (sc *StorageCreate) SetFtpConfigID(id int) *StorageCreate {
+	sc.mutation.SetFtpConfigID(id)
+	return sc
+}
+
+// SetNillableFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by ID if the given value is not nil.
+func (sc *StorageCreate) SetNillableFtpConfigID(id *int) *StorageCreate {
+	if id != nil {
+		sc = sc.SetFtpConfigID(*id)
+	}
+	return sc
+}
+
+// SetFtpConfig sets the "ftp_config" edge to the FTPConfig entity.
+func (sc *StorageCreate) SetFtpConfig(f *FTPConfig) *StorageCreate {
+	return sc.SetFtpConfigID(f.ID)
+}
+
+// SetAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by ID.
+func (sc *StorageCreate) SetAzureBlobConfigID(id int) *StorageCreate {
+	sc.mutation.SetAzureBlobConfigID(id)
+	return sc
+}
+
+// SetNillableAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by ID if the given value is not nil.
+func (sc *StorageCreate) SetNillableAzureBlobConfigID(id *int) *StorageCreate {
+	if id != nil {
+		sc = sc.SetAzureBlobConfigID(*id)
+	}
+	return sc
+}
+
+// SetAzureBlobConfig sets the "azure_blob_config" edge to the AzureBlobConfig entity.
+func (sc *StorageCreate) SetAzureBlobConfig(a *AzureBlobConfig) *StorageCreate {
+	return sc.SetAzureBlobConfigID(a.ID)
+}
+
+// SetGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by ID.
+func (sc *StorageCreate) SetGcsConfigID(id int) *StorageCreate {
+	sc.mutation.SetGcsConfigID(id)
+	return sc
+}
+
+// SetNillableGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by ID if the given value is not nil.
+func (sc *StorageCreate) SetNillableGcsConfigID(id *int) *StorageCreate {
+	if id != nil {
+		sc = sc.SetGcsConfigID(*id)
+	}
+	return sc
+}
+
+// SetGcsConfig sets the "gcs_config" edge to the GCSConfig entity.
+func (sc *StorageCreate) SetGcsConfig(g *GCSConfig) *StorageCreate {
+	return sc.SetGcsConfigID(g.ID)
+}
+
 // Mutation returns the StorageMutation object of the builder.
 func (sc *StorageCreate) Mutation() *StorageMutation {
 	return sc.mutation
@@ -239,6 +353,10 @@ func (sc *StorageCreate) createSpec() (*Storage, *sqlgraph.CreateSpec) {
 		_spec.SetField(storage.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := sc.mutation.ArchiveFormat(); ok {
+		_spec.SetField(storage.FieldArchiveFormat, field.TypeString, value)
+		_node.ArchiveFormat = value
+	}
 	if value, ok := sc.mutation.CreatedAt(); ok {
 		_spec.SetField(storage.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -295,6 +413,86 @@ func (sc *StorageCreate) createSpec() (*Storage, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.LocalConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.LocalConfigTable,
+			Columns: []string{storage.LocalConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.SftpConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.SftpConfigTable,
+			Columns: []string{storage.SftpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.FtpConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.FtpConfigTable,
+			Columns: []string{storage.FtpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.AzureBlobConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.AzureBlobConfigTable,
+			Columns: []string{storage.AzureBlobConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.GcsConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.GcsConfigTable,
+			Columns: []string{storage.GcsConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
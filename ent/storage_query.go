@@ -12,8 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/webdavconfig"
@@ -22,13 +27,18 @@ import (
 // StorageQuery is the builder for querying Storage entities.
 type StorageQuery struct {
 	config
-	ctx              *QueryContext
-	order            []storage.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Storage
-	withSyncJobs     *SyncJobQuery
-	withWebdavConfig *WebDAVConfigQuery
-	withS3Config     *S3ConfigQuery
+	ctx                 *QueryContext
+	order               []storage.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Storage
+	withSyncJobs        *SyncJobQuery
+	withWebdavConfig    *WebDAVConfigQuery
+	withS3Config        *S3ConfigQuery
+	withLocalConfig     *LocalConfigQuery
+	withSftpConfig      *SFTPConfigQuery
+	withFtpConfig       *FTPConfigQuery
+	withAzureBlobConfig *AzureBlobConfigQuery
+	withGcsConfig       *GCSConfigQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +141,116 @@ func (sq *StorageQuery) QueryS3Config() *S3ConfigQuery {
 	return query
 }
 
+// QueryLocalConfig chains the current query on the "local_config" edge.
+func (sq *StorageQuery) QueryLocalConfig() *LocalConfigQuery {
+	query := (&LocalConfigClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, selector),
+			sqlgraph.To(localconfig.Table, localconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.LocalConfigTable, storage.LocalConfigColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySftpConfig chains the current query on the "sftp_config" edge.
+func (sq *StorageQuery) QuerySftpConfig() *SFTPConfigQuery {
+	query := (&SFTPConfigClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, selector),
+			sqlgraph.To(sftpconfig.Table, sftpconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.SftpConfigTable, storage.SftpConfigColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFtpConfig chains the current query on the "ftp_config" edge.
+func (sq *StorageQuery) QueryFtpConfig() *FTPConfigQuery {
+	query := (&FTPConfigClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, selector),
+			sqlgraph.To(ftpconfig.Table, ftpconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.FtpConfigTable, storage.FtpConfigColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAzureBlobConfig chains the current query on the "azure_blob_config" edge.
+func (sq *StorageQuery) QueryAzureBlobConfig() *AzureBlobConfigQuery {
+	query := (&AzureBlobConfigClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, selector),
+			sqlgraph.To(azureblobconfig.Table, azureblobconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.AzureBlobConfigTable, storage.AzureBlobConfigColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGcsConfig chains the current query on the "gcs_config" edge.
+func (sq *StorageQuery) QueryGcsConfig() *GCSConfigQuery {
+	query := (&GCSConfigClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storage.Table, storage.FieldID, selector),
+			sqlgraph.To(gcsconfig.Table, gcsconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, storage.GcsConfigTable, storage.GcsConfigColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Storage entity from the query.
 // Returns a *NotFoundError when no Storage was found.
 func (sq *StorageQuery) First(ctx context.Context) (*Storage, error) {
@@ -318,14 +438,19 @@ func (sq *StorageQuery) Clone() *StorageQuery {
 		return nil
 	}
 	return &StorageQuery{
-		config:           sq.config,
-		ctx:              sq.ctx.Clone(),
-		order:            append([]storage.OrderOption{}, sq.order...),
-		inters:           append([]Interceptor{}, sq.inters...),
-		predicates:       append([]predicate.Storage{}, sq.predicates...),
-		withSyncJobs:     sq.withSyncJobs.Clone(),
-		withWebdavConfig: sq.withWebdavConfig.Clone(),
-		withS3Config:     sq.withS3Config.Clone(),
+		config:              sq.config,
+		ctx:                 sq.ctx.Clone(),
+		order:               append([]storage.OrderOption{}, sq.order...),
+		inters:              append([]Interceptor{}, sq.inters...),
+		predicates:          append([]predicate.Storage{}, sq.predicates...),
+		withSyncJobs:        sq.withSyncJobs.Clone(),
+		withWebdavConfig:    sq.withWebdavConfig.Clone(),
+		withS3Config:        sq.withS3Config.Clone(),
+		withLocalConfig:     sq.withLocalConfig.Clone(),
+		withSftpConfig:      sq.withSftpConfig.Clone(),
+		withFtpConfig:       sq.withFtpConfig.Clone(),
+		withAzureBlobConfig: sq.withAzureBlobConfig.Clone(),
+		withGcsConfig:       sq.withGcsConfig.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -365,6 +490,61 @@ func (sq *StorageQuery) WithS3Config(opts ...func(*S3ConfigQuery)) *StorageQuery
 	return sq
 }
 
+// WithLocalConfig tells the query-builder to eager-load the nodes that are connected to
+// the "local_config" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *StorageQuery) WithLocalConfig(opts ...func(*LocalConfigQuery)) *StorageQuery {
+	query := (&LocalConfigClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withLocalConfig = query
+	return sq
+}
+
+// WithSftpConfig tells the query-builder to eager-load the nodes that are connected to
+// the "sftp_config" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *StorageQuery) WithSftpConfig(opts ...func(*SFTPConfigQuery)) *StorageQuery {
+	query := (&SFTPConfigClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withSftpConfig = query
+	return sq
+}
+
+// WithFtpConfig tells the query-builder to eager-load the nodes that are connected to
+// the "ftp_config" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *StorageQuery) WithFtpConfig(opts ...func(*FTPConfigQuery)) *StorageQuery {
+	query := (&FTPConfigClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withFtpConfig = query
+	return sq
+}
+
+// WithAzureBlobConfig tells the query-builder to eager-load the nodes that are connected to
+// the "azure_blob_config" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *StorageQuery) WithAzureBlobConfig(opts ...func(*AzureBlobConfigQuery)) *StorageQuery {
+	query := (&AzureBlobConfigClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withAzureBlobConfig = query
+	return sq
+}
+
+// WithGcsConfig tells the query-builder to eager-load the nodes that are connected to
+// the "gcs_config" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *StorageQuery) WithGcsConfig(opts ...func(*GCSConfigQuery)) *StorageQuery {
+	query := (&GCSConfigClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withGcsConfig = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +623,15 @@ func (sq *StorageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Stor
 	var (
 		nodes       = []*Storage{}
 		_spec       = sq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [8]bool{
 			sq.withSyncJobs != nil,
 			sq.withWebdavConfig != nil,
 			sq.withS3Config != nil,
+			sq.withLocalConfig != nil,
+			sq.withSftpConfig != nil,
+			sq.withFtpConfig != nil,
+			sq.withAzureBlobConfig != nil,
+			sq.withGcsConfig != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -486,6 +671,36 @@ func (sq *StorageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Stor
 			return nil, err
 		}
 	}
+	if query := sq.withLocalConfig; query != nil {
+		if err := sq.loadLocalConfig(ctx, query, nodes, nil,
+			func(n *Storage, e *LocalConfig) { n.Edges.LocalConfig = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withSftpConfig; query != nil {
+		if err := sq.loadSftpConfig(ctx, query, nodes, nil,
+			func(n *Storage, e *SFTPConfig) { n.Edges.SftpConfig = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withFtpConfig; query != nil {
+		if err := sq.loadFtpConfig(ctx, query, nodes, nil,
+			func(n *Storage, e *FTPConfig) { n.Edges.FtpConfig = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withAzureBlobConfig; query != nil {
+		if err := sq.loadAzureBlobConfig(ctx, query, nodes, nil,
+			func(n *Storage, e *AzureBlobConfig) { n.Edges.AzureBlobConfig = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withGcsConfig; query != nil {
+		if err := sq.loadGcsConfig(ctx, query, nodes, nil,
+			func(n *Storage, e *GCSConfig) { n.Edges.GcsConfig = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -576,6 +791,146 @@ func (sq *StorageQuery) loadS3Config(ctx context.Context, query *S3ConfigQuery,
 	}
 	return nil
 }
+func (sq *StorageQuery) loadLocalConfig(ctx context.Context, query *LocalConfigQuery, nodes []*Storage, init func(*Storage), assign func(*Storage, *LocalConfig)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Storage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.LocalConfig(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(storage.LocalConfigColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.storage_local_config
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "storage_local_config" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "storage_local_config" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *StorageQuery) loadSftpConfig(ctx context.Context, query *SFTPConfigQuery, nodes []*Storage, init func(*Storage), assign func(*Storage, *SFTPConfig)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Storage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.SFTPConfig(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(storage.SftpConfigColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.storage_sftp_config
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "storage_sftp_config" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "storage_sftp_config" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *StorageQuery) loadFtpConfig(ctx context.Context, query *FTPConfigQuery, nodes []*Storage, init func(*Storage), assign func(*Storage, *FTPConfig)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Storage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.FTPConfig(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(storage.FtpConfigColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.storage_ftp_config
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "storage_ftp_config" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "storage_ftp_config" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *StorageQuery) loadAzureBlobConfig(ctx context.Context, query *AzureBlobConfigQuery, nodes []*Storage, init func(*Storage), assign func(*Storage, *AzureBlobConfig)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Storage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.AzureBlobConfig(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(storage.AzureBlobConfigColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.storage_azure_blob_config
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "storage_azure_blob_config" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "storage_azure_blob_config" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *StorageQuery) loadGcsConfig(ctx context.Context, query *GCSConfigQuery, nodes []*Storage, init func(*Storage), assign func(*Storage, *GCSConfig)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Storage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.GCSConfig(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(storage.GcsConfigColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.storage_gcs_config
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "storage_gcs_config" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "storage_gcs_config" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *StorageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
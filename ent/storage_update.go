@@ -11,8 +11,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/localconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
 	"github.com/ca-x/vaultwarden-syncer/ent/s3config"
+	"github.com/ca-x/vaultwarden-syncer/ent/sftpconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/ent/webdavconfig"
@@ -73,6 +78,26 @@ func (su *StorageUpdate) SetNillableEnabled(b *bool) *StorageUpdate {
 	return su
 }
 
+// SetArchiveFormat sets the "archive_format" field.
+func (su *StorageUpdate) SetArchiveFormat(s string) *StorageUpdate {
+	su.mutation.SetArchiveFormat(s)
+	return su
+}
+
+// SetNillableArchiveFormat sets the "archive_format" field if the given value is not nil.
+func (su *StorageUpdate) SetNillableArchiveFormat(s *string) *StorageUpdate {
+	if s != nil {
+		su.SetArchiveFormat(*s)
+	}
+	return su
+}
+
+// ClearArchiveFormat clears the value of the "archive_format" field.
+func (su *StorageUpdate) ClearArchiveFormat() *StorageUpdate {
+	su.mutation.ClearArchiveFormat()
+	return su
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (su *StorageUpdate) SetCreatedAt(t time.Time) *StorageUpdate {
 	su.mutation.SetCreatedAt(t)
@@ -146,6 +171,101 @@ func (su *StorageUpdate) SetS3Config(s *S3Config) *StorageUpdate {
 	return su.SetS3ConfigID(s.ID)
 }
 
+// SetLocalConfigID sets the "local_config" edge to the LocalConfig entity by ID.
+func (su *StorageUpdate) SetLocalConfigID(id int) *StorageUpdate {
+	su.mutation.SetLocalConfigID(id)
+	return su
+}
+
+// SetNillableLocalConfigID sets the "local_config" edge to the LocalConfig entity by ID if the given value is not nil.
+func (su *StorageUpdate) SetNillableLocalConfigID(id *int) *StorageUpdate {
+	if id != nil {
+		su = su.SetLocalConfigID(*id)
+	}
+	return su
+}
+
+// SetLocalConfig sets the "local_config" edge to the LocalConfig entity.
+func (su *StorageUpdate) SetLocalConfig(l *LocalConfig) *StorageUpdate {
+	return su.SetLocalConfigID(l.ID)
+}
+
+// SetSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by ID.
+func (su *StorageUpdate) SetSftpConfigID(id int) *StorageUpdate {
+	su.mutation.SetSftpConfigID(id)
+	return su
+}
+
+// SetNillableSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by ID if the given value is not nil.
+func (su *StorageUpdate) SetNillableSftpConfigID(id *int) *StorageUpdate {
+	if id != nil {
+		su = su.SetSftpConfigID(*id)
+	}
+	return su
+}
+
+// SetSftpConfig sets the "sftp_config" edge to the SFTPConfig entity.
+func (su *StorageUpdate) SetSftpConfig(s *SFTPConfig) *StorageUpdate {
+	return su.SetSftpConfigID(s.ID)
+}
+
+// SetFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by ID.
+func (su *StorageUpdate) SetFtpConfigID(id int) *StorageUpdate {
+	su.mutation.SetFtpConfigID(id)
+	return su
+}
+
+// SetNillableFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by ID if the given value is not nil.
+func (su *StorageUpdate) SetNillableFtpConfigID(id *int) *StorageUpdate {
+	if id != nil {
+		su = su.SetFtpConfigID(*id)
+	}
+	return su
+}
+
+// SetFtpConfig sets the "ftp_config" edge to the FTPConfig entity.
+func (su *StorageUpdate) SetFtpConfig(f *FTPConfig) *StorageUpdate {
+	return su.SetFtpConfigID(f.ID)
+}
+
+// SetAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by ID.
+func (su *StorageUpdate) SetAzureBlobConfigID(id int) *StorageUpdate {
+	su.mutation.SetAzureBlobConfigID(id)
+	return su
+}
+
+// SetNillableAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by ID if the given value is not nil.
+func (su *StorageUpdate) SetNillableAzureBlobConfigID(id *int) *StorageUpdate {
+	if id != nil {
+		su = su.SetAzureBlobConfigID(*id)
+	}
+	return su
+}
+
+// SetAzureBlobConfig sets the "azure_blob_config" edge to the AzureBlobConfig entity.
+func (su *StorageUpdate) SetAzureBlobConfig(a *AzureBlobConfig) *StorageUpdate {
+	return su.SetAzureBlobConfigID(a.ID)
+}
+
+// SetGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by ID.
+func (su *StorageUpdate) SetGcsConfigID(id int) *StorageUpdate {
+	su.mutation.SetGcsConfigID(id)
+	return su
+}
+
+// SetNillableGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by ID if the given value is not nil.
+func (su *StorageUpdate) SetNillableGcsConfigID(id *int) *StorageUpdate {
+	if id != nil {
+		su = su.SetGcsConfigID(*id)
+	}
+	return su
+}
+
+// SetGcsConfig sets the "gcs_config" edge to the GCSConfig entity.
+func (su *StorageUpdate) SetGcsConfig(g *GCSConfig) *StorageUpdate {
+	return su.SetGcsConfigID(g.ID)
+}
+
 // Mutation returns the StorageMutation object of the builder.
 func (su *StorageUpdate) Mutation() *StorageMutation {
 	return su.mutation
@@ -184,6 +304,36 @@ func (su *StorageUpdate) ClearS3Config() *StorageUpdate {
 	return su
 }
 
+// ClearLocalConfig clears the "local_config" edge to the LocalConfig entity.
+func (su *StorageUpdate) ClearLocalConfig() *StorageUpdate {
+	su.mutation.ClearLocalConfig()
+	return su
+}
+
+// ClearSftpConfig clears the "sftp_config" edge to the SFTPConfig entity.
+func (su *StorageUpdate) ClearSftpConfig() *StorageUpdate {
+	su.mutation.ClearSftpConfig()
+	return su
+}
+
+// ClearFtpConfig clears the "ftp_config" edge to the FTPConfig entity.
+func (su *StorageUpdate) ClearFtpConfig() *StorageUpdate {
+	su.mutation.ClearFtpConfig()
+	return su
+}
+
+// ClearAzureBlobConfig clears the "azure_blob_config" edge to the AzureBlobConfig entity.
+func (su *StorageUpdate) ClearAzureBlobConfig() *StorageUpdate {
+	su.mutation.ClearAzureBlobConfig()
+	return su
+}
+
+// ClearGcsConfig clears the "gcs_config" edge to the GCSConfig entity.
+func (su *StorageUpdate) ClearGcsConfig() *StorageUpdate {
+	su.mutation.ClearGcsConfig()
+	return su
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *StorageUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -251,6 +401,12 @@ func (su *StorageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.Enabled(); ok {
 		_spec.SetField(storage.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := su.mutation.ArchiveFormat(); ok {
+		_spec.SetField(storage.FieldArchiveFormat, field.TypeString, value)
+	}
+	if su.mutation.ArchiveFormatCleared() {
+		_spec.ClearField(storage.FieldArchiveFormat, field.TypeString)
+	}
 	if value, ok := su.mutation.CreatedAt(); ok {
 		_spec.SetField(storage.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -360,6 +516,151 @@ func (su *StorageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.LocalConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.LocalConfigTable,
+			Columns: []string{storage.LocalConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.LocalConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.LocalConfigTable,
+			Columns: []string{storage.LocalConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.SftpConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.SftpConfigTable,
+			Columns: []string{storage.SftpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SftpConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.SftpConfigTable,
+			Columns: []string{storage.SftpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.FtpConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.FtpConfigTable,
+			Columns: []string{storage.FtpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.FtpConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.FtpConfigTable,
+			Columns: []string{storage.FtpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.AzureBlobConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.AzureBlobConfigTable,
+			Columns: []string{storage.AzureBlobConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.AzureBlobConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.AzureBlobConfigTable,
+			Columns: []string{storage.AzureBlobConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.GcsConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.GcsConfigTable,
+			Columns: []string{storage.GcsConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.GcsConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.GcsConfigTable,
+			Columns: []string{storage.GcsConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{storage.Label}
@@ -422,6 +723,26 @@ func (suo *StorageUpdateOne) SetNillableEnabled(b *bool) *StorageUpdateOne {
 	return suo
 }
 
+// SetArchiveFormat sets the "archive_format" field.
+func (suo *StorageUpdateOne) SetArchiveFormat(s string) *StorageUpdateOne {
+	suo.mutation.SetArchiveFormat(s)
+	return suo
+}
+
+// SetNillableArchiveFormat sets the "archive_format" field if the given value is not nil.
+func (suo *StorageUpdateOne) SetNillableArchiveFormat(s *string) *StorageUpdateOne {
+	if s != nil {
+		suo.SetArchiveFormat(*s)
+	}
+	return suo
+}
+
+// ClearArchiveFormat clears the value of the "archive_format" field.
+func (suo *StorageUpdateOne) ClearArchiveFormat() *StorageUpdateOne {
+	suo.mutation.ClearArchiveFormat()
+	return suo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (suo *StorageUpdateOne) SetCreatedAt(t time.Time) *StorageUpdateOne {
 	suo.mutation.SetCreatedAt(t)
@@ -495,6 +816,101 @@ func (suo *StorageUpdateOne) SetS3Config(s *S3Config) *StorageUpdateOne {
 	return suo.SetS3ConfigID(s.ID)
 }
 
+// SetLocalConfigID sets the "local_config" edge to the LocalConfig entity by ID.
+func (suo *StorageUpdateOne) SetLocalConfigID(id int) *StorageUpdateOne {
+	suo.mutation.SetLocalConfigID(id)
+	return suo
+}
+
+// SetNillableLocalConfigID sets the "local_config" edge to the LocalConfig entity by ID if the given value is not nil.
+func (suo *StorageUpdateOne) SetNillableLocalConfigID(id *int) *StorageUpdateOne {
+	if id != nil {
+		suo = suo.SetLocalConfigID(*id)
+	}
+	return suo
+}
+
+// SetLocalConfig sets the "local_config" edge to the LocalConfig entity.
+func (suo *StorageUpdateOne) SetLocalConfig(l *LocalConfig) *StorageUpdateOne {
+	return suo.SetLocalConfigID(l.ID)
+}
+
+// SetSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by ID.
+func (suo *StorageUpdateOne) SetSftpConfigID(id int) *StorageUpdateOne {
+	suo.mutation.SetSftpConfigID(id)
+	return suo
+}
+
+// SetNillableSftpConfigID sets the "sftp_config" edge to the SFTPConfig entity by ID if the given value is not nil.
+func (suo *StorageUpdateOne) SetNillableSftpConfigID(id *int) *StorageUpdateOne {
+	if id != nil {
+		suo = suo.SetSftpConfigID(*id)
+	}
+	return suo
+}
+
+// SetSftpConfig sets the "sftp_config" edge to the SFTPConfig entity.
+func (suo *StorageUpdateOne) SetSftpConfig(s *SFTPConfig) *StorageUpdateOne {
+	return suo.SetSftpConfigID(s.ID)
+}
+
+// SetFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by ID.
+func (suo *StorageUpdateOne) SetFtpConfigID(id int) *StorageUpdateOne {
+	suo.mutation.SetFtpConfigID(id)
+	return suo
+}
+
+// SetNillableFtpConfigID sets the "ftp_config" edge to the FTPConfig entity by ID if the given value is not nil.
+func (suo *StorageUpdateOne) SetNillableFtpConfigID(id *int) *StorageUpdateOne {
+	if id != nil {
+		suo = suo.SetFtpConfigID(*id)
+	}
+	return suo
+}
+
+// SetFtpConfig sets the "ftp_config" edge to the FTPConfig entity.
+func (suo *StorageUpdateOne) SetFtpConfig(f *FTPConfig) *StorageUpdateOne {
+	return suo.SetFtpConfigID(f.ID)
+}
+
+// SetAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by ID.
+func (suo *StorageUpdateOne) SetAzureBlobConfigID(id int) *StorageUpdateOne {
+	suo.mutation.SetAzureBlobConfigID(id)
+	return suo
+}
+
+// SetNillableAzureBlobConfigID sets the "azure_blob_config" edge to the AzureBlobConfig entity by ID if the given value is not nil.
+func (suo *StorageUpdateOne) SetNillableAzureBlobConfigID(id *int) *StorageUpdateOne {
+	if id != nil {
+		suo = suo.SetAzureBlobConfigID(*id)
+	}
+	return suo
+}
+
+// SetAzureBlobConfig sets the "azure_blob_config" edge to the AzureBlobConfig entity.
+func (suo *StorageUpdateOne) SetAzureBlobConfig(a *AzureBlobConfig) *StorageUpdateOne {
+	return suo.SetAzureBlobConfigID(a.ID)
+}
+
+// SetGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by ID.
+func (suo *StorageUpdateOne) SetGcsConfigID(id int) *StorageUpdateOne {
+	suo.mutation.SetGcsConfigID(id)
+	return suo
+}
+
+// SetNillableGcsConfigID sets the "gcs_config" edge to the GCSConfig entity by ID if the given value is not nil.
+func (suo *StorageUpdateOne) SetNillableGcsConfigID(id *int) *StorageUpdateOne {
+	if id != nil {
+		suo = suo.SetGcsConfigID(*id)
+	}
+	return suo
+}
+
+// SetGcsConfig sets the "gcs_config" edge to the GCSConfig entity.
+func (suo *StorageUpdateOne) SetGcsConfig(g *GCSConfig) *StorageUpdateOne {
+	return suo.SetGcsConfigID(g.ID)
+}
+
 // Mutation returns the StorageMutation object of the builder.
 func (suo *StorageUpdateOne) Mutation() *StorageMutation {
 	return suo.mutation
@@ -533,6 +949,36 @@ func (suo *StorageUpdateOne) ClearS3Config() *StorageUpdateOne {
 	return suo
 }
 
+// ClearLocalConfig clears the "local_config" edge to the LocalConfig entity.
+func (suo *StorageUpdateOne) ClearLocalConfig() *StorageUpdateOne {
+	suo.mutation.ClearLocalConfig()
+	return suo
+}
+
+// ClearSftpConfig clears the "sftp_config" edge to the SFTPConfig entity.
+func (suo *StorageUpdateOne) ClearSftpConfig() *StorageUpdateOne {
+	suo.mutation.ClearSftpConfig()
+	return suo
+}
+
+// ClearFtpConfig clears the "ftp_config" edge to the FTPConfig entity.
+func (suo *StorageUpdateOne) ClearFtpConfig() *StorageUpdateOne {
+	suo.mutation.ClearFtpConfig()
+	return suo
+}
+
+// ClearAzureBlobConfig clears the "azure_blob_config" edge to the AzureBlobConfig entity.
+func (suo *StorageUpdateOne) ClearAzureBlobConfig() *StorageUpdateOne {
+	suo.mutation.ClearAzureBlobConfig()
+	return suo
+}
+
+// ClearGcsConfig clears the "gcs_config" edge to the GCSConfig entity.
+func (suo *StorageUpdateOne) ClearGcsConfig() *StorageUpdateOne {
+	suo.mutation.ClearGcsConfig()
+	return suo
+}
+
 // Where appends a list predicates to the StorageUpdate builder.
 func (suo *StorageUpdateOne) Where(ps ...predicate.Storage) *StorageUpdateOne {
 	suo.mutation.Where(ps...)
@@ -630,6 +1076,12 @@ func (suo *StorageUpdateOne) sqlSave(ctx context.Context) (_node *Storage, err e
 	if value, ok := suo.mutation.Enabled(); ok {
 		_spec.SetField(storage.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := suo.mutation.ArchiveFormat(); ok {
+		_spec.SetField(storage.FieldArchiveFormat, field.TypeString, value)
+	}
+	if suo.mutation.ArchiveFormatCleared() {
+		_spec.ClearField(storage.FieldArchiveFormat, field.TypeString)
+	}
 	if value, ok := suo.mutation.CreatedAt(); ok {
 		_spec.SetField(storage.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -739,6 +1191,151 @@ func (suo *StorageUpdateOne) sqlSave(ctx context.Context) (_node *Storage, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.LocalConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.LocalConfigTable,
+			Columns: []string{storage.LocalConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.LocalConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.LocalConfigTable,
+			Columns: []string{storage.LocalConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(localconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.SftpConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.SftpConfigTable,
+			Columns: []string{storage.SftpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SftpConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.SftpConfigTable,
+			Columns: []string{storage.SftpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.FtpConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.FtpConfigTable,
+			Columns: []string{storage.FtpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.FtpConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.FtpConfigTable,
+			Columns: []string{storage.FtpConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ftpconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.AzureBlobConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.AzureBlobConfigTable,
+			Columns: []string{storage.AzureBlobConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.AzureBlobConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.AzureBlobConfigTable,
+			Columns: []string{storage.AzureBlobConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(azureblobconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.GcsConfigCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.GcsConfigTable,
+			Columns: []string{storage.GcsConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.GcsConfigIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   storage.GcsConfigTable,
+			Columns: []string{storage.GcsConfigColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gcsconfig.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Storage{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 )
@@ -24,6 +25,38 @@ type SyncJob struct {
 	Operation syncjob.Operation `json:"operation,omitempty"`
 	// Message holds the value of the "message" field.
 	Message string `json:"message,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// RestorePath holds the value of the "restore_path" field.
+	RestorePath string `json:"restore_path,omitempty"`
+	// RollbackPath holds the value of the "rollback_path" field.
+	RollbackPath string `json:"rollback_path,omitempty"`
+	// IntegrityCheck holds the value of the "integrity_check" field.
+	IntegrityCheck string `json:"integrity_check,omitempty"`
+	// OriginalSize holds the value of the "original_size" field.
+	OriginalSize int64 `json:"original_size,omitempty"`
+	// ArchiveSize holds the value of the "archive_size" field.
+	ArchiveSize int64 `json:"archive_size,omitempty"`
+	// CompressionRatio holds the value of the "compression_ratio" field.
+	CompressionRatio float64 `json:"compression_ratio,omitempty"`
+	// DataFiles holds the value of the "data_files" field.
+	DataFiles int `json:"data_files,omitempty"`
+	// DataSize holds the value of the "data_size" field.
+	DataSize int64 `json:"data_size,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// VaultUsers holds the value of the "vault_users" field.
+	VaultUsers int64 `json:"vault_users,omitempty"`
+	// VaultOrganizations holds the value of the "vault_organizations" field.
+	VaultOrganizations int64 `json:"vault_organizations,omitempty"`
+	// VaultCiphers holds the value of the "vault_ciphers" field.
+	VaultCiphers int64 `json:"vault_ciphers,omitempty"`
+	// VaultAttachments holds the value of the "vault_attachments" field.
+	VaultAttachments int64 `json:"vault_attachments,omitempty"`
+	// VaultSends holds the value of the "vault_sends" field.
+	VaultSends int64 `json:"vault_sends,omitempty"`
+	// HookOutput holds the value of the "hook_output" field.
+	HookOutput string `json:"hook_output,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt time.Time `json:"started_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
@@ -33,6 +66,7 @@ type SyncJob struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SyncJobQuery when eager-loading is set.
 	Edges             SyncJobEdges `json:"edges"`
+	source_sync_jobs  *int
 	storage_sync_jobs *int
 	selectValues      sql.SelectValues
 }
@@ -41,9 +75,11 @@ type SyncJob struct {
 type SyncJobEdges struct {
 	// Storage holds the value of the storage edge.
 	Storage *Storage `json:"storage,omitempty"`
+	// Source holds the value of the source edge.
+	Source *Source `json:"source,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // StorageOrErr returns the Storage value or an error if the edge
@@ -57,18 +93,33 @@ func (e SyncJobEdges) StorageOrErr() (*Storage, error) {
 	return nil, &NotLoadedError{edge: "storage"}
 }
 
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SyncJobEdges) SourceOrErr() (*Source, error) {
+	if e.Source != nil {
+		return e.Source, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: source.Label}
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SyncJob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case syncjob.FieldID:
+		case syncjob.FieldCompressionRatio:
+			values[i] = new(sql.NullFloat64)
+		case syncjob.FieldID, syncjob.FieldOriginalSize, syncjob.FieldArchiveSize, syncjob.FieldDataFiles, syncjob.FieldDataSize, syncjob.FieldVaultUsers, syncjob.FieldVaultOrganizations, syncjob.FieldVaultCiphers, syncjob.FieldVaultAttachments, syncjob.FieldVaultSends:
 			values[i] = new(sql.NullInt64)
-		case syncjob.FieldStatus, syncjob.FieldOperation, syncjob.FieldMessage:
+		case syncjob.FieldStatus, syncjob.FieldOperation, syncjob.FieldMessage, syncjob.FieldFilename, syncjob.FieldRestorePath, syncjob.FieldRollbackPath, syncjob.FieldIntegrityCheck, syncjob.FieldFingerprint, syncjob.FieldHookOutput:
 			values[i] = new(sql.NullString)
 		case syncjob.FieldStartedAt, syncjob.FieldCompletedAt, syncjob.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
-		case syncjob.ForeignKeys[0]: // storage_sync_jobs
+		case syncjob.ForeignKeys[0]: // source_sync_jobs
+			values[i] = new(sql.NullInt64)
+		case syncjob.ForeignKeys[1]: // storage_sync_jobs
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -109,6 +160,102 @@ func (sj *SyncJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sj.Message = value.String
 			}
+		case syncjob.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				sj.Filename = value.String
+			}
+		case syncjob.FieldRestorePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restore_path", values[i])
+			} else if value.Valid {
+				sj.RestorePath = value.String
+			}
+		case syncjob.FieldRollbackPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rollback_path", values[i])
+			} else if value.Valid {
+				sj.RollbackPath = value.String
+			}
+		case syncjob.FieldIntegrityCheck:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field integrity_check", values[i])
+			} else if value.Valid {
+				sj.IntegrityCheck = value.String
+			}
+		case syncjob.FieldOriginalSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_size", values[i])
+			} else if value.Valid {
+				sj.OriginalSize = value.Int64
+			}
+		case syncjob.FieldArchiveSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_size", values[i])
+			} else if value.Valid {
+				sj.ArchiveSize = value.Int64
+			}
+		case syncjob.FieldCompressionRatio:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field compression_ratio", values[i])
+			} else if value.Valid {
+				sj.CompressionRatio = value.Float64
+			}
+		case syncjob.FieldDataFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field data_files", values[i])
+			} else if value.Valid {
+				sj.DataFiles = int(value.Int64)
+			}
+		case syncjob.FieldDataSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field data_size", values[i])
+			} else if value.Valid {
+				sj.DataSize = value.Int64
+			}
+		case syncjob.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				sj.Fingerprint = value.String
+			}
+		case syncjob.FieldVaultUsers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vault_users", values[i])
+			} else if value.Valid {
+				sj.VaultUsers = value.Int64
+			}
+		case syncjob.FieldVaultOrganizations:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vault_organizations", values[i])
+			} else if value.Valid {
+				sj.VaultOrganizations = value.Int64
+			}
+		case syncjob.FieldVaultCiphers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vault_ciphers", values[i])
+			} else if value.Valid {
+				sj.VaultCiphers = value.Int64
+			}
+		case syncjob.FieldVaultAttachments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vault_attachments", values[i])
+			} else if value.Valid {
+				sj.VaultAttachments = value.Int64
+			}
+		case syncjob.FieldVaultSends:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vault_sends", values[i])
+			} else if value.Valid {
+				sj.VaultSends = value.Int64
+			}
+		case syncjob.FieldHookOutput:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hook_output", values[i])
+			} else if value.Valid {
+				sj.HookOutput = value.String
+			}
 		case syncjob.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -128,6 +275,13 @@ func (sj *SyncJob) assignValues(columns []string, values []any) error {
 				sj.CreatedAt = value.Time
 			}
 		case syncjob.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field source_sync_jobs", value)
+			} else if value.Valid {
+				sj.source_sync_jobs = new(int)
+				*sj.source_sync_jobs = int(value.Int64)
+			}
+		case syncjob.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field storage_sync_jobs", value)
 			} else if value.Valid {
@@ -152,6 +306,11 @@ func (sj *SyncJob) QueryStorage() *StorageQuery {
 	return NewSyncJobClient(sj.config).QueryStorage(sj)
 }
 
+// QuerySource queries the "source" edge of the SyncJob entity.
+func (sj *SyncJob) QuerySource() *SourceQuery {
+	return NewSyncJobClient(sj.config).QuerySource(sj)
+}
+
 // Update returns a builder for updating this SyncJob.
 // Note that you need to call SyncJob.Unwrap() before calling this method if this SyncJob
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -184,6 +343,54 @@ func (sj *SyncJob) String() string {
 	builder.WriteString("message=")
 	builder.WriteString(sj.Message)
 	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(sj.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("restore_path=")
+	builder.WriteString(sj.RestorePath)
+	builder.WriteString(", ")
+	builder.WriteString("rollback_path=")
+	builder.WriteString(sj.RollbackPath)
+	builder.WriteString(", ")
+	builder.WriteString("integrity_check=")
+	builder.WriteString(sj.IntegrityCheck)
+	builder.WriteString(", ")
+	builder.WriteString("original_size=")
+	builder.WriteString(fmt.Sprintf("%v", sj.OriginalSize))
+	builder.WriteString(", ")
+	builder.WriteString("archive_size=")
+	builder.WriteString(fmt.Sprintf("%v", sj.ArchiveSize))
+	builder.WriteString(", ")
+	builder.WriteString("compression_ratio=")
+	builder.WriteString(fmt.Sprintf("%v", sj.CompressionRatio))
+	builder.WriteString(", ")
+	builder.WriteString("data_files=")
+	builder.WriteString(fmt.Sprintf("%v", sj.DataFiles))
+	builder.WriteString(", ")
+	builder.WriteString("data_size=")
+	builder.WriteString(fmt.Sprintf("%v", sj.DataSize))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(sj.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("vault_users=")
+	builder.WriteString(fmt.Sprintf("%v", sj.VaultUsers))
+	builder.WriteString(", ")
+	builder.WriteString("vault_organizations=")
+	builder.WriteString(fmt.Sprintf("%v", sj.VaultOrganizations))
+	builder.WriteString(", ")
+	builder.WriteString("vault_ciphers=")
+	builder.WriteString(fmt.Sprintf("%v", sj.VaultCiphers))
+	builder.WriteString(", ")
+	builder.WriteString("vault_attachments=")
+	builder.WriteString(fmt.Sprintf("%v", sj.VaultAttachments))
+	builder.WriteString(", ")
+	builder.WriteString("vault_sends=")
+	builder.WriteString(fmt.Sprintf("%v", sj.VaultSends))
+	builder.WriteString(", ")
+	builder.WriteString("hook_output=")
+	builder.WriteString(sj.HookOutput)
+	builder.WriteString(", ")
 	builder.WriteString("started_at=")
 	builder.WriteString(sj.StartedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
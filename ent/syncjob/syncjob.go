@@ -21,6 +21,38 @@ const (
 	FieldOperation = "operation"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
+	// FieldFilename holds the string denoting the filename field in the database.
+	FieldFilename = "filename"
+	// FieldRestorePath holds the string denoting the restore_path field in the database.
+	FieldRestorePath = "restore_path"
+	// FieldRollbackPath holds the string denoting the rollback_path field in the database.
+	FieldRollbackPath = "rollback_path"
+	// FieldIntegrityCheck holds the string denoting the integrity_check field in the database.
+	FieldIntegrityCheck = "integrity_check"
+	// FieldOriginalSize holds the string denoting the original_size field in the database.
+	FieldOriginalSize = "original_size"
+	// FieldArchiveSize holds the string denoting the archive_size field in the database.
+	FieldArchiveSize = "archive_size"
+	// FieldCompressionRatio holds the string denoting the compression_ratio field in the database.
+	FieldCompressionRatio = "compression_ratio"
+	// FieldDataFiles holds the string denoting the data_files field in the database.
+	FieldDataFiles = "data_files"
+	// FieldDataSize holds the string denoting the data_size field in the database.
+	FieldDataSize = "data_size"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldVaultUsers holds the string denoting the vault_users field in the database.
+	FieldVaultUsers = "vault_users"
+	// FieldVaultOrganizations holds the string denoting the vault_organizations field in the database.
+	FieldVaultOrganizations = "vault_organizations"
+	// FieldVaultCiphers holds the string denoting the vault_ciphers field in the database.
+	FieldVaultCiphers = "vault_ciphers"
+	// FieldVaultAttachments holds the string denoting the vault_attachments field in the database.
+	FieldVaultAttachments = "vault_attachments"
+	// FieldVaultSends holds the string denoting the vault_sends field in the database.
+	FieldVaultSends = "vault_sends"
+	// FieldHookOutput holds the string denoting the hook_output field in the database.
+	FieldHookOutput = "hook_output"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
@@ -29,6 +61,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// EdgeStorage holds the string denoting the storage edge name in mutations.
 	EdgeStorage = "storage"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
 	// Table holds the table name of the syncjob in the database.
 	Table = "sync_jobs"
 	// StorageTable is the table that holds the storage relation/edge.
@@ -38,6 +72,13 @@ const (
 	StorageInverseTable = "storages"
 	// StorageColumn is the table column denoting the storage relation/edge.
 	StorageColumn = "storage_sync_jobs"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "sync_jobs"
+	// SourceInverseTable is the table name for the Source entity.
+	// It exists in this package in order to avoid circular dependency with the "source" package.
+	SourceInverseTable = "sources"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "source_sync_jobs"
 )
 
 // Columns holds all SQL columns for syncjob fields.
@@ -46,6 +87,22 @@ var Columns = []string{
 	FieldStatus,
 	FieldOperation,
 	FieldMessage,
+	FieldFilename,
+	FieldRestorePath,
+	FieldRollbackPath,
+	FieldIntegrityCheck,
+	FieldOriginalSize,
+	FieldArchiveSize,
+	FieldCompressionRatio,
+	FieldDataFiles,
+	FieldDataSize,
+	FieldFingerprint,
+	FieldVaultUsers,
+	FieldVaultOrganizations,
+	FieldVaultCiphers,
+	FieldVaultAttachments,
+	FieldVaultSends,
+	FieldHookOutput,
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldCreatedAt,
@@ -54,6 +111,7 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "sync_jobs"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"source_sync_jobs",
 	"storage_sync_jobs",
 }
 
@@ -82,10 +140,12 @@ type Status string
 
 // Status values.
 const (
-	StatusPending   Status = "pending"
-	StatusRunning   Status = "running"
-	StatusCompleted Status = "completed"
-	StatusFailed    Status = "failed"
+	StatusPending    Status = "pending"
+	StatusRunning    Status = "running"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+	StatusSuspicious Status = "suspicious"
+	StatusSkipped    Status = "skipped"
 )
 
 func (s Status) String() string {
@@ -95,7 +155,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusRunning, StatusCompleted, StatusFailed:
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed, StatusSuspicious, StatusSkipped:
 		return nil
 	default:
 		return fmt.Errorf("syncjob: invalid enum value for status field: %q", s)
@@ -109,6 +169,7 @@ type Operation string
 const (
 	OperationBackup  Operation = "backup"
 	OperationRestore Operation = "restore"
+	OperationVerify  Operation = "verify"
 )
 
 func (o Operation) String() string {
@@ -118,7 +179,7 @@ func (o Operation) String() string {
 // OperationValidator is a validator for the "operation" field enum values. It is called by the builders before save.
 func OperationValidator(o Operation) error {
 	switch o {
-	case OperationBackup, OperationRestore:
+	case OperationBackup, OperationRestore, OperationVerify:
 		return nil
 	default:
 		return fmt.Errorf("syncjob: invalid enum value for operation field: %q", o)
@@ -148,6 +209,86 @@ func ByMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessage, opts...).ToFunc()
 }
 
+// ByFilename orders the results by the filename field.
+func ByFilename(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilename, opts...).ToFunc()
+}
+
+// ByRestorePath orders the results by the restore_path field.
+func ByRestorePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestorePath, opts...).ToFunc()
+}
+
+// ByRollbackPath orders the results by the rollback_path field.
+func ByRollbackPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRollbackPath, opts...).ToFunc()
+}
+
+// ByIntegrityCheck orders the results by the integrity_check field.
+func ByIntegrityCheck(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntegrityCheck, opts...).ToFunc()
+}
+
+// ByOriginalSize orders the results by the original_size field.
+func ByOriginalSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalSize, opts...).ToFunc()
+}
+
+// ByArchiveSize orders the results by the archive_size field.
+func ByArchiveSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveSize, opts...).ToFunc()
+}
+
+// ByCompressionRatio orders the results by the compression_ratio field.
+func ByCompressionRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompressionRatio, opts...).ToFunc()
+}
+
+// ByDataFiles orders the results by the data_files field.
+func ByDataFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataFiles, opts...).ToFunc()
+}
+
+// ByDataSize orders the results by the data_size field.
+func ByDataSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataSize, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByVaultUsers orders the results by the vault_users field.
+func ByVaultUsers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVaultUsers, opts...).ToFunc()
+}
+
+// ByVaultOrganizations orders the results by the vault_organizations field.
+func ByVaultOrganizations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVaultOrganizations, opts...).ToFunc()
+}
+
+// ByVaultCiphers orders the results by the vault_ciphers field.
+func ByVaultCiphers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVaultCiphers, opts...).ToFunc()
+}
+
+// ByVaultAttachments orders the results by the vault_attachments field.
+func ByVaultAttachments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVaultAttachments, opts...).ToFunc()
+}
+
+// ByVaultSends orders the results by the vault_sends field.
+func ByVaultSends(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVaultSends, opts...).ToFunc()
+}
+
+// ByHookOutput orders the results by the hook_output field.
+func ByHookOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHookOutput, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
@@ -169,6 +310,13 @@ func ByStorageField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newStorageStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySourceField orders the results by source field.
+func BySourceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourceStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newStorageStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -176,3 +324,10 @@ func newStorageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, StorageTable, StorageColumn),
 	)
 }
+func newSourceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceTable, SourceColumn),
+	)
+}
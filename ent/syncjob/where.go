@@ -60,6 +60,86 @@ func Message(v string) predicate.SyncJob {
 	return predicate.SyncJob(sql.FieldEQ(FieldMessage, v))
 }
 
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldFilename, v))
+}
+
+// RestorePath applies equality check predicate on the "restore_path" field. It's identical to RestorePathEQ.
+func RestorePath(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldRestorePath, v))
+}
+
+// RollbackPath applies equality check predicate on the "rollback_path" field. It's identical to RollbackPathEQ.
+func RollbackPath(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldRollbackPath, v))
+}
+
+// IntegrityCheck applies equality check predicate on the "integrity_check" field. It's identical to IntegrityCheckEQ.
+func IntegrityCheck(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldIntegrityCheck, v))
+}
+
+// OriginalSize applies equality check predicate on the "original_size" field. It's identical to OriginalSizeEQ.
+func OriginalSize(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldOriginalSize, v))
+}
+
+// ArchiveSize applies equality check predicate on the "archive_size" field. It's identical to ArchiveSizeEQ.
+func ArchiveSize(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldArchiveSize, v))
+}
+
+// CompressionRatio applies equality check predicate on the "compression_ratio" field. It's identical to CompressionRatioEQ.
+func CompressionRatio(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldCompressionRatio, v))
+}
+
+// DataFiles applies equality check predicate on the "data_files" field. It's identical to DataFilesEQ.
+func DataFiles(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDataFiles, v))
+}
+
+// DataSize applies equality check predicate on the "data_size" field. It's identical to DataSizeEQ.
+func DataSize(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDataSize, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// VaultUsers applies equality check predicate on the "vault_users" field. It's identical to VaultUsersEQ.
+func VaultUsers(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultUsers, v))
+}
+
+// VaultOrganizations applies equality check predicate on the "vault_organizations" field. It's identical to VaultOrganizationsEQ.
+func VaultOrganizations(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultOrganizations, v))
+}
+
+// VaultCiphers applies equality check predicate on the "vault_ciphers" field. It's identical to VaultCiphersEQ.
+func VaultCiphers(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultCiphers, v))
+}
+
+// VaultAttachments applies equality check predicate on the "vault_attachments" field. It's identical to VaultAttachmentsEQ.
+func VaultAttachments(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultAttachments, v))
+}
+
+// VaultSends applies equality check predicate on the "vault_sends" field. It's identical to VaultSendsEQ.
+func VaultSends(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultSends, v))
+}
+
+// HookOutput applies equality check predicate on the "hook_output" field. It's identical to HookOutputEQ.
+func HookOutput(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldHookOutput, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.SyncJob {
 	return predicate.SyncJob(sql.FieldEQ(FieldStartedAt, v))
@@ -190,6 +270,956 @@ func MessageContainsFold(v string) predicate.SyncJob {
 	return predicate.SyncJob(sql.FieldContainsFold(FieldMessage, v))
 }
 
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameIsNil applies the IsNil predicate on the "filename" field.
+func FilenameIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldFilename))
+}
+
+// FilenameNotNil applies the NotNil predicate on the "filename" field.
+func FilenameNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldFilename))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// RestorePathEQ applies the EQ predicate on the "restore_path" field.
+func RestorePathEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldRestorePath, v))
+}
+
+// RestorePathNEQ applies the NEQ predicate on the "restore_path" field.
+func RestorePathNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldRestorePath, v))
+}
+
+// RestorePathIn applies the In predicate on the "restore_path" field.
+func RestorePathIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldRestorePath, vs...))
+}
+
+// RestorePathNotIn applies the NotIn predicate on the "restore_path" field.
+func RestorePathNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldRestorePath, vs...))
+}
+
+// RestorePathGT applies the GT predicate on the "restore_path" field.
+func RestorePathGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldRestorePath, v))
+}
+
+// RestorePathGTE applies the GTE predicate on the "restore_path" field.
+func RestorePathGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldRestorePath, v))
+}
+
+// RestorePathLT applies the LT predicate on the "restore_path" field.
+func RestorePathLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldRestorePath, v))
+}
+
+// RestorePathLTE applies the LTE predicate on the "restore_path" field.
+func RestorePathLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldRestorePath, v))
+}
+
+// RestorePathContains applies the Contains predicate on the "restore_path" field.
+func RestorePathContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldRestorePath, v))
+}
+
+// RestorePathHasPrefix applies the HasPrefix predicate on the "restore_path" field.
+func RestorePathHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldRestorePath, v))
+}
+
+// RestorePathHasSuffix applies the HasSuffix predicate on the "restore_path" field.
+func RestorePathHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldRestorePath, v))
+}
+
+// RestorePathIsNil applies the IsNil predicate on the "restore_path" field.
+func RestorePathIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldRestorePath))
+}
+
+// RestorePathNotNil applies the NotNil predicate on the "restore_path" field.
+func RestorePathNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldRestorePath))
+}
+
+// RestorePathEqualFold applies the EqualFold predicate on the "restore_path" field.
+func RestorePathEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldRestorePath, v))
+}
+
+// RestorePathContainsFold applies the ContainsFold predicate on the "restore_path" field.
+func RestorePathContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldRestorePath, v))
+}
+
+// RollbackPathEQ applies the EQ predicate on the "rollback_path" field.
+func RollbackPathEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldRollbackPath, v))
+}
+
+// RollbackPathNEQ applies the NEQ predicate on the "rollback_path" field.
+func RollbackPathNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldRollbackPath, v))
+}
+
+// RollbackPathIn applies the In predicate on the "rollback_path" field.
+func RollbackPathIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldRollbackPath, vs...))
+}
+
+// RollbackPathNotIn applies the NotIn predicate on the "rollback_path" field.
+func RollbackPathNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldRollbackPath, vs...))
+}
+
+// RollbackPathGT applies the GT predicate on the "rollback_path" field.
+func RollbackPathGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldRollbackPath, v))
+}
+
+// RollbackPathGTE applies the GTE predicate on the "rollback_path" field.
+func RollbackPathGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldRollbackPath, v))
+}
+
+// RollbackPathLT applies the LT predicate on the "rollback_path" field.
+func RollbackPathLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldRollbackPath, v))
+}
+
+// RollbackPathLTE applies the LTE predicate on the "rollback_path" field.
+func RollbackPathLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldRollbackPath, v))
+}
+
+// RollbackPathContains applies the Contains predicate on the "rollback_path" field.
+func RollbackPathContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldRollbackPath, v))
+}
+
+// RollbackPathHasPrefix applies the HasPrefix predicate on the "rollback_path" field.
+func RollbackPathHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldRollbackPath, v))
+}
+
+// RollbackPathHasSuffix applies the HasSuffix predicate on the "rollback_path" field.
+func RollbackPathHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldRollbackPath, v))
+}
+
+// RollbackPathIsNil applies the IsNil predicate on the "rollback_path" field.
+func RollbackPathIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldRollbackPath))
+}
+
+// RollbackPathNotNil applies the NotNil predicate on the "rollback_path" field.
+func RollbackPathNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldRollbackPath))
+}
+
+// RollbackPathEqualFold applies the EqualFold predicate on the "rollback_path" field.
+func RollbackPathEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldRollbackPath, v))
+}
+
+// RollbackPathContainsFold applies the ContainsFold predicate on the "rollback_path" field.
+func RollbackPathContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldRollbackPath, v))
+}
+
+// IntegrityCheckEQ applies the EQ predicate on the "integrity_check" field.
+func IntegrityCheckEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckNEQ applies the NEQ predicate on the "integrity_check" field.
+func IntegrityCheckNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckIn applies the In predicate on the "integrity_check" field.
+func IntegrityCheckIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldIntegrityCheck, vs...))
+}
+
+// IntegrityCheckNotIn applies the NotIn predicate on the "integrity_check" field.
+func IntegrityCheckNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldIntegrityCheck, vs...))
+}
+
+// IntegrityCheckGT applies the GT predicate on the "integrity_check" field.
+func IntegrityCheckGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckGTE applies the GTE predicate on the "integrity_check" field.
+func IntegrityCheckGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckLT applies the LT predicate on the "integrity_check" field.
+func IntegrityCheckLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckLTE applies the LTE predicate on the "integrity_check" field.
+func IntegrityCheckLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckContains applies the Contains predicate on the "integrity_check" field.
+func IntegrityCheckContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckHasPrefix applies the HasPrefix predicate on the "integrity_check" field.
+func IntegrityCheckHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckHasSuffix applies the HasSuffix predicate on the "integrity_check" field.
+func IntegrityCheckHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckIsNil applies the IsNil predicate on the "integrity_check" field.
+func IntegrityCheckIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldIntegrityCheck))
+}
+
+// IntegrityCheckNotNil applies the NotNil predicate on the "integrity_check" field.
+func IntegrityCheckNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldIntegrityCheck))
+}
+
+// IntegrityCheckEqualFold applies the EqualFold predicate on the "integrity_check" field.
+func IntegrityCheckEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldIntegrityCheck, v))
+}
+
+// IntegrityCheckContainsFold applies the ContainsFold predicate on the "integrity_check" field.
+func IntegrityCheckContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldIntegrityCheck, v))
+}
+
+// OriginalSizeEQ applies the EQ predicate on the "original_size" field.
+func OriginalSizeEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldOriginalSize, v))
+}
+
+// OriginalSizeNEQ applies the NEQ predicate on the "original_size" field.
+func OriginalSizeNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldOriginalSize, v))
+}
+
+// OriginalSizeIn applies the In predicate on the "original_size" field.
+func OriginalSizeIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldOriginalSize, vs...))
+}
+
+// OriginalSizeNotIn applies the NotIn predicate on the "original_size" field.
+func OriginalSizeNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldOriginalSize, vs...))
+}
+
+// OriginalSizeGT applies the GT predicate on the "original_size" field.
+func OriginalSizeGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldOriginalSize, v))
+}
+
+// OriginalSizeGTE applies the GTE predicate on the "original_size" field.
+func OriginalSizeGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldOriginalSize, v))
+}
+
+// OriginalSizeLT applies the LT predicate on the "original_size" field.
+func OriginalSizeLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldOriginalSize, v))
+}
+
+// OriginalSizeLTE applies the LTE predicate on the "original_size" field.
+func OriginalSizeLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldOriginalSize, v))
+}
+
+// OriginalSizeIsNil applies the IsNil predicate on the "original_size" field.
+func OriginalSizeIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldOriginalSize))
+}
+
+// OriginalSizeNotNil applies the NotNil predicate on the "original_size" field.
+func OriginalSizeNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldOriginalSize))
+}
+
+// ArchiveSizeEQ applies the EQ predicate on the "archive_size" field.
+func ArchiveSizeEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldArchiveSize, v))
+}
+
+// ArchiveSizeNEQ applies the NEQ predicate on the "archive_size" field.
+func ArchiveSizeNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldArchiveSize, v))
+}
+
+// ArchiveSizeIn applies the In predicate on the "archive_size" field.
+func ArchiveSizeIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldArchiveSize, vs...))
+}
+
+// ArchiveSizeNotIn applies the NotIn predicate on the "archive_size" field.
+func ArchiveSizeNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldArchiveSize, vs...))
+}
+
+// ArchiveSizeGT applies the GT predicate on the "archive_size" field.
+func ArchiveSizeGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldArchiveSize, v))
+}
+
+// ArchiveSizeGTE applies the GTE predicate on the "archive_size" field.
+func ArchiveSizeGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldArchiveSize, v))
+}
+
+// ArchiveSizeLT applies the LT predicate on the "archive_size" field.
+func ArchiveSizeLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldArchiveSize, v))
+}
+
+// ArchiveSizeLTE applies the LTE predicate on the "archive_size" field.
+func ArchiveSizeLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldArchiveSize, v))
+}
+
+// ArchiveSizeIsNil applies the IsNil predicate on the "archive_size" field.
+func ArchiveSizeIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldArchiveSize))
+}
+
+// ArchiveSizeNotNil applies the NotNil predicate on the "archive_size" field.
+func ArchiveSizeNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldArchiveSize))
+}
+
+// CompressionRatioEQ applies the EQ predicate on the "compression_ratio" field.
+func CompressionRatioEQ(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldCompressionRatio, v))
+}
+
+// CompressionRatioNEQ applies the NEQ predicate on the "compression_ratio" field.
+func CompressionRatioNEQ(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldCompressionRatio, v))
+}
+
+// CompressionRatioIn applies the In predicate on the "compression_ratio" field.
+func CompressionRatioIn(vs ...float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldCompressionRatio, vs...))
+}
+
+// CompressionRatioNotIn applies the NotIn predicate on the "compression_ratio" field.
+func CompressionRatioNotIn(vs ...float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldCompressionRatio, vs...))
+}
+
+// CompressionRatioGT applies the GT predicate on the "compression_ratio" field.
+func CompressionRatioGT(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldCompressionRatio, v))
+}
+
+// CompressionRatioGTE applies the GTE predicate on the "compression_ratio" field.
+func CompressionRatioGTE(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldCompressionRatio, v))
+}
+
+// CompressionRatioLT applies the LT predicate on the "compression_ratio" field.
+func CompressionRatioLT(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldCompressionRatio, v))
+}
+
+// CompressionRatioLTE applies the LTE predicate on the "compression_ratio" field.
+func CompressionRatioLTE(v float64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldCompressionRatio, v))
+}
+
+// CompressionRatioIsNil applies the IsNil predicate on the "compression_ratio" field.
+func CompressionRatioIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldCompressionRatio))
+}
+
+// CompressionRatioNotNil applies the NotNil predicate on the "compression_ratio" field.
+func CompressionRatioNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldCompressionRatio))
+}
+
+// DataFilesEQ applies the EQ predicate on the "data_files" field.
+func DataFilesEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDataFiles, v))
+}
+
+// DataFilesNEQ applies the NEQ predicate on the "data_files" field.
+func DataFilesNEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldDataFiles, v))
+}
+
+// DataFilesIn applies the In predicate on the "data_files" field.
+func DataFilesIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldDataFiles, vs...))
+}
+
+// DataFilesNotIn applies the NotIn predicate on the "data_files" field.
+func DataFilesNotIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldDataFiles, vs...))
+}
+
+// DataFilesGT applies the GT predicate on the "data_files" field.
+func DataFilesGT(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldDataFiles, v))
+}
+
+// DataFilesGTE applies the GTE predicate on the "data_files" field.
+func DataFilesGTE(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldDataFiles, v))
+}
+
+// DataFilesLT applies the LT predicate on the "data_files" field.
+func DataFilesLT(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldDataFiles, v))
+}
+
+// DataFilesLTE applies the LTE predicate on the "data_files" field.
+func DataFilesLTE(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldDataFiles, v))
+}
+
+// DataFilesIsNil applies the IsNil predicate on the "data_files" field.
+func DataFilesIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldDataFiles))
+}
+
+// DataFilesNotNil applies the NotNil predicate on the "data_files" field.
+func DataFilesNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldDataFiles))
+}
+
+// DataSizeEQ applies the EQ predicate on the "data_size" field.
+func DataSizeEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDataSize, v))
+}
+
+// DataSizeNEQ applies the NEQ predicate on the "data_size" field.
+func DataSizeNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldDataSize, v))
+}
+
+// DataSizeIn applies the In predicate on the "data_size" field.
+func DataSizeIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldDataSize, vs...))
+}
+
+// DataSizeNotIn applies the NotIn predicate on the "data_size" field.
+func DataSizeNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldDataSize, vs...))
+}
+
+// DataSizeGT applies the GT predicate on the "data_size" field.
+func DataSizeGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldDataSize, v))
+}
+
+// DataSizeGTE applies the GTE predicate on the "data_size" field.
+func DataSizeGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldDataSize, v))
+}
+
+// DataSizeLT applies the LT predicate on the "data_size" field.
+func DataSizeLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldDataSize, v))
+}
+
+// DataSizeLTE applies the LTE predicate on the "data_size" field.
+func DataSizeLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldDataSize, v))
+}
+
+// DataSizeIsNil applies the IsNil predicate on the "data_size" field.
+func DataSizeIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldDataSize))
+}
+
+// DataSizeNotNil applies the NotNil predicate on the "data_size" field.
+func DataSizeNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldDataSize))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldFingerprint))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// VaultUsersEQ applies the EQ predicate on the "vault_users" field.
+func VaultUsersEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultUsers, v))
+}
+
+// VaultUsersNEQ applies the NEQ predicate on the "vault_users" field.
+func VaultUsersNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldVaultUsers, v))
+}
+
+// VaultUsersIn applies the In predicate on the "vault_users" field.
+func VaultUsersIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldVaultUsers, vs...))
+}
+
+// VaultUsersNotIn applies the NotIn predicate on the "vault_users" field.
+func VaultUsersNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldVaultUsers, vs...))
+}
+
+// VaultUsersGT applies the GT predicate on the "vault_users" field.
+func VaultUsersGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldVaultUsers, v))
+}
+
+// VaultUsersGTE applies the GTE predicate on the "vault_users" field.
+func VaultUsersGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldVaultUsers, v))
+}
+
+// VaultUsersLT applies the LT predicate on the "vault_users" field.
+func VaultUsersLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldVaultUsers, v))
+}
+
+// VaultUsersLTE applies the LTE predicate on the "vault_users" field.
+func VaultUsersLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldVaultUsers, v))
+}
+
+// VaultUsersIsNil applies the IsNil predicate on the "vault_users" field.
+func VaultUsersIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldVaultUsers))
+}
+
+// VaultUsersNotNil applies the NotNil predicate on the "vault_users" field.
+func VaultUsersNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldVaultUsers))
+}
+
+// VaultOrganizationsEQ applies the EQ predicate on the "vault_organizations" field.
+func VaultOrganizationsEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultOrganizations, v))
+}
+
+// VaultOrganizationsNEQ applies the NEQ predicate on the "vault_organizations" field.
+func VaultOrganizationsNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldVaultOrganizations, v))
+}
+
+// VaultOrganizationsIn applies the In predicate on the "vault_organizations" field.
+func VaultOrganizationsIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldVaultOrganizations, vs...))
+}
+
+// VaultOrganizationsNotIn applies the NotIn predicate on the "vault_organizations" field.
+func VaultOrganizationsNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldVaultOrganizations, vs...))
+}
+
+// VaultOrganizationsGT applies the GT predicate on the "vault_organizations" field.
+func VaultOrganizationsGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldVaultOrganizations, v))
+}
+
+// VaultOrganizationsGTE applies the GTE predicate on the "vault_organizations" field.
+func VaultOrganizationsGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldVaultOrganizations, v))
+}
+
+// VaultOrganizationsLT applies the LT predicate on the "vault_organizations" field.
+func VaultOrganizationsLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldVaultOrganizations, v))
+}
+
+// VaultOrganizationsLTE applies the LTE predicate on the "vault_organizations" field.
+func VaultOrganizationsLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldVaultOrganizations, v))
+}
+
+// VaultOrganizationsIsNil applies the IsNil predicate on the "vault_organizations" field.
+func VaultOrganizationsIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldVaultOrganizations))
+}
+
+// VaultOrganizationsNotNil applies the NotNil predicate on the "vault_organizations" field.
+func VaultOrganizationsNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldVaultOrganizations))
+}
+
+// VaultCiphersEQ applies the EQ predicate on the "vault_ciphers" field.
+func VaultCiphersEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultCiphers, v))
+}
+
+// VaultCiphersNEQ applies the NEQ predicate on the "vault_ciphers" field.
+func VaultCiphersNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldVaultCiphers, v))
+}
+
+// VaultCiphersIn applies the In predicate on the "vault_ciphers" field.
+func VaultCiphersIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldVaultCiphers, vs...))
+}
+
+// VaultCiphersNotIn applies the NotIn predicate on the "vault_ciphers" field.
+func VaultCiphersNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldVaultCiphers, vs...))
+}
+
+// VaultCiphersGT applies the GT predicate on the "vault_ciphers" field.
+func VaultCiphersGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldVaultCiphers, v))
+}
+
+// VaultCiphersGTE applies the GTE predicate on the "vault_ciphers" field.
+func VaultCiphersGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldVaultCiphers, v))
+}
+
+// VaultCiphersLT applies the LT predicate on the "vault_ciphers" field.
+func VaultCiphersLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldVaultCiphers, v))
+}
+
+// VaultCiphersLTE applies the LTE predicate on the "vault_ciphers" field.
+func VaultCiphersLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldVaultCiphers, v))
+}
+
+// VaultCiphersIsNil applies the IsNil predicate on the "vault_ciphers" field.
+func VaultCiphersIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldVaultCiphers))
+}
+
+// VaultCiphersNotNil applies the NotNil predicate on the "vault_ciphers" field.
+func VaultCiphersNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldVaultCiphers))
+}
+
+// VaultAttachmentsEQ applies the EQ predicate on the "vault_attachments" field.
+func VaultAttachmentsEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultAttachments, v))
+}
+
+// VaultAttachmentsNEQ applies the NEQ predicate on the "vault_attachments" field.
+func VaultAttachmentsNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldVaultAttachments, v))
+}
+
+// VaultAttachmentsIn applies the In predicate on the "vault_attachments" field.
+func VaultAttachmentsIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldVaultAttachments, vs...))
+}
+
+// VaultAttachmentsNotIn applies the NotIn predicate on the "vault_attachments" field.
+func VaultAttachmentsNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldVaultAttachments, vs...))
+}
+
+// VaultAttachmentsGT applies the GT predicate on the "vault_attachments" field.
+func VaultAttachmentsGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldVaultAttachments, v))
+}
+
+// VaultAttachmentsGTE applies the GTE predicate on the "vault_attachments" field.
+func VaultAttachmentsGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldVaultAttachments, v))
+}
+
+// VaultAttachmentsLT applies the LT predicate on the "vault_attachments" field.
+func VaultAttachmentsLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldVaultAttachments, v))
+}
+
+// VaultAttachmentsLTE applies the LTE predicate on the "vault_attachments" field.
+func VaultAttachmentsLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldVaultAttachments, v))
+}
+
+// VaultAttachmentsIsNil applies the IsNil predicate on the "vault_attachments" field.
+func VaultAttachmentsIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldVaultAttachments))
+}
+
+// VaultAttachmentsNotNil applies the NotNil predicate on the "vault_attachments" field.
+func VaultAttachmentsNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldVaultAttachments))
+}
+
+// VaultSendsEQ applies the EQ predicate on the "vault_sends" field.
+func VaultSendsEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldVaultSends, v))
+}
+
+// VaultSendsNEQ applies the NEQ predicate on the "vault_sends" field.
+func VaultSendsNEQ(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldVaultSends, v))
+}
+
+// VaultSendsIn applies the In predicate on the "vault_sends" field.
+func VaultSendsIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldVaultSends, vs...))
+}
+
+// VaultSendsNotIn applies the NotIn predicate on the "vault_sends" field.
+func VaultSendsNotIn(vs ...int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldVaultSends, vs...))
+}
+
+// VaultSendsGT applies the GT predicate on the "vault_sends" field.
+func VaultSendsGT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldVaultSends, v))
+}
+
+// VaultSendsGTE applies the GTE predicate on the "vault_sends" field.
+func VaultSendsGTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldVaultSends, v))
+}
+
+// VaultSendsLT applies the LT predicate on the "vault_sends" field.
+func VaultSendsLT(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldVaultSends, v))
+}
+
+// VaultSendsLTE applies the LTE predicate on the "vault_sends" field.
+func VaultSendsLTE(v int64) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldVaultSends, v))
+}
+
+// VaultSendsIsNil applies the IsNil predicate on the "vault_sends" field.
+func VaultSendsIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldVaultSends))
+}
+
+// VaultSendsNotNil applies the NotNil predicate on the "vault_sends" field.
+func VaultSendsNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldVaultSends))
+}
+
+// HookOutputEQ applies the EQ predicate on the "hook_output" field.
+func HookOutputEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldHookOutput, v))
+}
+
+// HookOutputNEQ applies the NEQ predicate on the "hook_output" field.
+func HookOutputNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldHookOutput, v))
+}
+
+// HookOutputIn applies the In predicate on the "hook_output" field.
+func HookOutputIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldHookOutput, vs...))
+}
+
+// HookOutputNotIn applies the NotIn predicate on the "hook_output" field.
+func HookOutputNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldHookOutput, vs...))
+}
+
+// HookOutputGT applies the GT predicate on the "hook_output" field.
+func HookOutputGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldHookOutput, v))
+}
+
+// HookOutputGTE applies the GTE predicate on the "hook_output" field.
+func HookOutputGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldHookOutput, v))
+}
+
+// HookOutputLT applies the LT predicate on the "hook_output" field.
+func HookOutputLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldHookOutput, v))
+}
+
+// HookOutputLTE applies the LTE predicate on the "hook_output" field.
+func HookOutputLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldHookOutput, v))
+}
+
+// HookOutputContains applies the Contains predicate on the "hook_output" field.
+func HookOutputContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldHookOutput, v))
+}
+
+// HookOutputHasPrefix applies the HasPrefix predicate on the "hook_output" field.
+func HookOutputHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldHookOutput, v))
+}
+
+// HookOutputHasSuffix applies the HasSuffix predicate on the "hook_output" field.
+func HookOutputHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldHookOutput, v))
+}
+
+// HookOutputIsNil applies the IsNil predicate on the "hook_output" field.
+func HookOutputIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldHookOutput))
+}
+
+// HookOutputNotNil applies the NotNil predicate on the "hook_output" field.
+func HookOutputNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldHookOutput))
+}
+
+// HookOutputEqualFold applies the EqualFold predicate on the "hook_output" field.
+func HookOutputEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldHookOutput, v))
+}
+
+// HookOutputContainsFold applies the ContainsFold predicate on the "hook_output" field.
+func HookOutputContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldHookOutput, v))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.SyncJob {
 	return predicate.SyncJob(sql.FieldEQ(FieldStartedAt, v))
@@ -353,6 +1383,29 @@ func HasStorageWith(preds ...predicate.Storage) predicate.SyncJob {
 	})
 }
 
+// HasSource applies the HasEdge predicate on the "source" edge.
+func HasSource() predicate.SyncJob {
+	return predicate.SyncJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SourceTable, SourceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourceWith applies the HasEdge predicate on the "source" edge with a given conditions (other predicates).
+func HasSourceWith(preds ...predicate.Source) predicate.SyncJob {
+	return predicate.SyncJob(func(s *sql.Selector) {
+		step := newSourceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SyncJob) predicate.SyncJob {
 	return predicate.SyncJob(sql.AndPredicates(predicates...))
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 )
@@ -47,6 +48,230 @@ func (sjc *SyncJobCreate) SetNillableMessage(s *string) *SyncJobCreate {
 	return sjc
 }
 
+// SetFilename sets the "filename" field.
+func (sjc *SyncJobCreate) SetFilename(s string) *SyncJobCreate {
+	sjc.mutation.SetFilename(s)
+	return sjc
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableFilename(s *string) *SyncJobCreate {
+	if s != nil {
+		sjc.SetFilename(*s)
+	}
+	return sjc
+}
+
+// SetRestorePath sets the "restore_path" field.
+func (sjc *SyncJobCreate) SetRestorePath(s string) *SyncJobCreate {
+	sjc.mutation.SetRestorePath(s)
+	return sjc
+}
+
+// SetNillableRestorePath sets the "restore_path" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableRestorePath(s *string) *SyncJobCreate {
+	if s != nil {
+		sjc.SetRestorePath(*s)
+	}
+	return sjc
+}
+
+// SetRollbackPath sets the "rollback_path" field.
+func (sjc *SyncJobCreate) SetRollbackPath(s string) *SyncJobCreate {
+	sjc.mutation.SetRollbackPath(s)
+	return sjc
+}
+
+// SetNillableRollbackPath sets the "rollback_path" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableRollbackPath(s *string) *SyncJobCreate {
+	if s != nil {
+		sjc.SetRollbackPath(*s)
+	}
+	return sjc
+}
+
+// SetIntegrityCheck sets the "integrity_check" field.
+func (sjc *SyncJobCreate) SetIntegrityCheck(s string) *SyncJobCreate {
+	sjc.mutation.SetIntegrityCheck(s)
+	return sjc
+}
+
+// SetNillableIntegrityCheck sets the "integrity_check" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableIntegrityCheck(s *string) *SyncJobCreate {
+	if s != nil {
+		sjc.SetIntegrityCheck(*s)
+	}
+	return sjc
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (sjc *SyncJobCreate) SetOriginalSize(i int64) *SyncJobCreate {
+	sjc.mutation.SetOriginalSize(i)
+	return sjc
+}
+
+// SetNillableOriginalSize sets the "original_size" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableOriginalSize(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetOriginalSize(*i)
+	}
+	return sjc
+}
+
+// SetArchiveSize sets the "archive_size" field.
+func (sjc *SyncJobCreate) SetArchiveSize(i int64) *SyncJobCreate {
+	sjc.mutation.SetArchiveSize(i)
+	return sjc
+}
+
+// SetNillableArchiveSize sets the "archive_size" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableArchiveSize(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetArchiveSize(*i)
+	}
+	return sjc
+}
+
+// SetCompressionRatio sets the "compression_ratio" field.
+func (sjc *SyncJobCreate) SetCompressionRatio(f float64) *SyncJobCreate {
+	sjc.mutation.SetCompressionRatio(f)
+	return sjc
+}
+
+// SetNillableCompressionRatio sets the "compression_ratio" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableCompressionRatio(f *float64) *SyncJobCreate {
+	if f != nil {
+		sjc.SetCompressionRatio(*f)
+	}
+	return sjc
+}
+
+// SetDataFiles sets the "data_files" field.
+func (sjc *SyncJobCreate) SetDataFiles(i int) *SyncJobCreate {
+	sjc.mutation.SetDataFiles(i)
+	return sjc
+}
+
+// SetNillableDataFiles sets the "data_files" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableDataFiles(i *int) *SyncJobCreate {
+	if i != nil {
+		sjc.SetDataFiles(*i)
+	}
+	return sjc
+}
+
+// SetDataSize sets the "data_size" field.
+func (sjc *SyncJobCreate) SetDataSize(i int64) *SyncJobCreate {
+	sjc.mutation.SetDataSize(i)
+	return sjc
+}
+
+// SetNillableDataSize sets the "data_size" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableDataSize(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetDataSize(*i)
+	}
+	return sjc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sjc *SyncJobCreate) SetFingerprint(s string) *SyncJobCreate {
+	sjc.mutation.SetFingerprint(s)
+	return sjc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableFingerprint(s *string) *SyncJobCreate {
+	if s != nil {
+		sjc.SetFingerprint(*s)
+	}
+	return sjc
+}
+
+// SetVaultUsers sets the "vault_users" field.
+func (sjc *SyncJobCreate) SetVaultUsers(i int64) *SyncJobCreate {
+	sjc.mutation.SetVaultUsers(i)
+	return sjc
+}
+
+// SetNillableVaultUsers sets the "vault_users" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableVaultUsers(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetVaultUsers(*i)
+	}
+	return sjc
+}
+
+// SetVaultOrganizations sets the "vault_organizations" field.
+func (sjc *SyncJobCreate) SetVaultOrganizations(i int64) *SyncJobCreate {
+	sjc.mutation.SetVaultOrganizations(i)
+	return sjc
+}
+
+// SetNillableVaultOrganizations sets the "vault_organizations" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableVaultOrganizations(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetVaultOrganizations(*i)
+	}
+	return sjc
+}
+
+// SetVaultCiphers sets the "vault_ciphers" field.
+func (sjc *SyncJobCreate) SetVaultCiphers(i int64) *SyncJobCreate {
+	sjc.mutation.SetVaultCiphers(i)
+	return sjc
+}
+
+// SetNillableVaultCiphers sets the "vault_ciphers" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableVaultCiphers(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetVaultCiphers(*i)
+	}
+	return sjc
+}
+
+// SetVaultAttachments sets the "vault_attachments" field.
+func (sjc *SyncJobCreate) SetVaultAttachments(i int64) *SyncJobCreate {
+	sjc.mutation.SetVaultAttachments(i)
+	return sjc
+}
+
+// SetNillableVaultAttachments sets the "vault_attachments" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableVaultAttachments(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetVaultAttachments(*i)
+	}
+	return sjc
+}
+
+// SetVaultSends sets the "vault_sends" field.
+func (sjc *SyncJobCreate) SetVaultSends(i int64) *SyncJobCreate {
+	sjc.mutation.SetVaultSends(i)
+	return sjc
+}
+
+// SetNillableVaultSends sets the "vault_sends" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableVaultSends(i *int64) *SyncJobCreate {
+	if i != nil {
+		sjc.SetVaultSends(*i)
+	}
+	return sjc
+}
+
+// SetHookOutput sets the "hook_output" field.
+func (sjc *SyncJobCreate) SetHookOutput(s string) *SyncJobCreate {
+	sjc.mutation.SetHookOutput(s)
+	return sjc
+}
+
+// SetNillableHookOutput sets the "hook_output" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableHookOutput(s *string) *SyncJobCreate {
+	if s != nil {
+		sjc.SetHookOutput(*s)
+	}
+	return sjc
+}
+
 // SetStartedAt sets the "started_at" field.
 func (sjc *SyncJobCreate) SetStartedAt(t time.Time) *SyncJobCreate {
 	sjc.mutation.SetStartedAt(t)
@@ -108,6 +333,25 @@ func (sjc *SyncJobCreate) SetStorage(s *Storage) *SyncJobCreate {
 	return sjc.SetStorageID(s.ID)
 }
 
+// SetSourceID sets the "source" edge to the Source entity by ID.
+func (sjc *SyncJobCreate) SetSourceID(id int) *SyncJobCreate {
+	sjc.mutation.SetSourceID(id)
+	return sjc
+}
+
+// SetNillableSourceID sets the "source" edge to the Source entity by ID if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableSourceID(id *int) *SyncJobCreate {
+	if id != nil {
+		sjc = sjc.SetSourceID(*id)
+	}
+	return sjc
+}
+
+// SetSource sets the "source" edge to the Source entity.
+func (sjc *SyncJobCreate) SetSource(s *Source) *SyncJobCreate {
+	return sjc.SetSourceID(s.ID)
+}
+
 // Mutation returns the SyncJobMutation object of the builder.
 func (sjc *SyncJobCreate) Mutation() *SyncJobMutation {
 	return sjc.mutation
@@ -208,6 +452,70 @@ func (sjc *SyncJobCreate) createSpec() (*SyncJob, *sqlgraph.CreateSpec) {
 		_spec.SetField(syncjob.FieldMessage, field.TypeString, value)
 		_node.Message = value
 	}
+	if value, ok := sjc.mutation.Filename(); ok {
+		_spec.SetField(syncjob.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := sjc.mutation.RestorePath(); ok {
+		_spec.SetField(syncjob.FieldRestorePath, field.TypeString, value)
+		_node.RestorePath = value
+	}
+	if value, ok := sjc.mutation.RollbackPath(); ok {
+		_spec.SetField(syncjob.FieldRollbackPath, field.TypeString, value)
+		_node.RollbackPath = value
+	}
+	if value, ok := sjc.mutation.IntegrityCheck(); ok {
+		_spec.SetField(syncjob.FieldIntegrityCheck, field.TypeString, value)
+		_node.IntegrityCheck = value
+	}
+	if value, ok := sjc.mutation.OriginalSize(); ok {
+		_spec.SetField(syncjob.FieldOriginalSize, field.TypeInt64, value)
+		_node.OriginalSize = value
+	}
+	if value, ok := sjc.mutation.ArchiveSize(); ok {
+		_spec.SetField(syncjob.FieldArchiveSize, field.TypeInt64, value)
+		_node.ArchiveSize = value
+	}
+	if value, ok := sjc.mutation.CompressionRatio(); ok {
+		_spec.SetField(syncjob.FieldCompressionRatio, field.TypeFloat64, value)
+		_node.CompressionRatio = value
+	}
+	if value, ok := sjc.mutation.DataFiles(); ok {
+		_spec.SetField(syncjob.FieldDataFiles, field.TypeInt, value)
+		_node.DataFiles = value
+	}
+	if value, ok := sjc.mutation.DataSize(); ok {
+		_spec.SetField(syncjob.FieldDataSize, field.TypeInt64, value)
+		_node.DataSize = value
+	}
+	if value, ok := sjc.mutation.Fingerprint(); ok {
+		_spec.SetField(syncjob.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := sjc.mutation.VaultUsers(); ok {
+		_spec.SetField(syncjob.FieldVaultUsers, field.TypeInt64, value)
+		_node.VaultUsers = value
+	}
+	if value, ok := sjc.mutation.VaultOrganizations(); ok {
+		_spec.SetField(syncjob.FieldVaultOrganizations, field.TypeInt64, value)
+		_node.VaultOrganizations = value
+	}
+	if value, ok := sjc.mutation.VaultCiphers(); ok {
+		_spec.SetField(syncjob.FieldVaultCiphers, field.TypeInt64, value)
+		_node.VaultCiphers = value
+	}
+	if value, ok := sjc.mutation.VaultAttachments(); ok {
+		_spec.SetField(syncjob.FieldVaultAttachments, field.TypeInt64, value)
+		_node.VaultAttachments = value
+	}
+	if value, ok := sjc.mutation.VaultSends(); ok {
+		_spec.SetField(syncjob.FieldVaultSends, field.TypeInt64, value)
+		_node.VaultSends = value
+	}
+	if value, ok := sjc.mutation.HookOutput(); ok {
+		_spec.SetField(syncjob.FieldHookOutput, field.TypeString, value)
+		_node.HookOutput = value
+	}
 	if value, ok := sjc.mutation.StartedAt(); ok {
 		_spec.SetField(syncjob.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = value
@@ -237,6 +545,23 @@ func (sjc *SyncJobCreate) createSpec() (*SyncJob, *sqlgraph.CreateSpec) {
 		_node.storage_sync_jobs = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sjc.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.SourceTable,
+			Columns: []string{syncjob.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.source_sync_jobs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 )
@@ -24,6 +25,7 @@ type SyncJobQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.SyncJob
 	withStorage *StorageQuery
+	withSource  *SourceQuery
 	withFKs     bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -83,6 +85,28 @@ func (sjq *SyncJobQuery) QueryStorage() *StorageQuery {
 	return query
 }
 
+// QuerySource chains the current query on the "source" edge.
+func (sjq *SyncJobQuery) QuerySource() *SourceQuery {
+	query := (&SourceClient{config: sjq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sjq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sjq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(syncjob.Table, syncjob.FieldID, selector),
+			sqlgraph.To(source.Table, source.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, syncjob.SourceTable, syncjob.SourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sjq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SyncJob entity from the query.
 // Returns a *NotFoundError when no SyncJob was found.
 func (sjq *SyncJobQuery) First(ctx context.Context) (*SyncJob, error) {
@@ -276,6 +300,7 @@ func (sjq *SyncJobQuery) Clone() *SyncJobQuery {
 		inters:      append([]Interceptor{}, sjq.inters...),
 		predicates:  append([]predicate.SyncJob{}, sjq.predicates...),
 		withStorage: sjq.withStorage.Clone(),
+		withSource:  sjq.withSource.Clone(),
 		// clone intermediate query.
 		sql:  sjq.sql.Clone(),
 		path: sjq.path,
@@ -293,6 +318,17 @@ func (sjq *SyncJobQuery) WithStorage(opts ...func(*StorageQuery)) *SyncJobQuery
 	return sjq
 }
 
+// WithSource tells the query-builder to eager-load the nodes that are connected to
+// the "source" edge. The optional arguments are used to configure the query builder of the edge.
+func (sjq *SyncJobQuery) WithSource(opts ...func(*SourceQuery)) *SyncJobQuery {
+	query := (&SourceClient{config: sjq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sjq.withSource = query
+	return sjq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,11 +408,12 @@ func (sjq *SyncJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Syn
 		nodes       = []*SyncJob{}
 		withFKs     = sjq.withFKs
 		_spec       = sjq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			sjq.withStorage != nil,
+			sjq.withSource != nil,
 		}
 	)
-	if sjq.withStorage != nil {
+	if sjq.withStorage != nil || sjq.withSource != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -406,6 +443,12 @@ func (sjq *SyncJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Syn
 			return nil, err
 		}
 	}
+	if query := sjq.withSource; query != nil {
+		if err := sjq.loadSource(ctx, query, nodes, nil,
+			func(n *SyncJob, e *Source) { n.Edges.Source = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,6 +484,38 @@ func (sjq *SyncJobQuery) loadStorage(ctx context.Context, query *StorageQuery, n
 	}
 	return nil
 }
+func (sjq *SyncJobQuery) loadSource(ctx context.Context, query *SourceQuery, nodes []*SyncJob, init func(*SyncJob), assign func(*SyncJob, *Source)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SyncJob)
+	for i := range nodes {
+		if nodes[i].source_sync_jobs == nil {
+			continue
+		}
+		fk := *nodes[i].source_sync_jobs
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(source.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "source_sync_jobs" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (sjq *SyncJobQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sjq.querySpec()
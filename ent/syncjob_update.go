@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	"github.com/ca-x/vaultwarden-syncer/ent/source"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 )
@@ -77,6 +78,396 @@ func (sju *SyncJobUpdate) ClearMessage() *SyncJobUpdate {
 	return sju
 }
 
+// SetFilename sets the "filename" field.
+func (sju *SyncJobUpdate) SetFilename(s string) *SyncJobUpdate {
+	sju.mutation.SetFilename(s)
+	return sju
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableFilename(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetFilename(*s)
+	}
+	return sju
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (sju *SyncJobUpdate) ClearFilename() *SyncJobUpdate {
+	sju.mutation.ClearFilename()
+	return sju
+}
+
+// SetRestorePath sets the "restore_path" field.
+func (sju *SyncJobUpdate) SetRestorePath(s string) *SyncJobUpdate {
+	sju.mutation.SetRestorePath(s)
+	return sju
+}
+
+// SetNillableRestorePath sets the "restore_path" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableRestorePath(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetRestorePath(*s)
+	}
+	return sju
+}
+
+// ClearRestorePath clears the value of the "restore_path" field.
+func (sju *SyncJobUpdate) ClearRestorePath() *SyncJobUpdate {
+	sju.mutation.ClearRestorePath()
+	return sju
+}
+
+// SetRollbackPath sets the "rollback_path" field.
+func (sju *SyncJobUpdate) SetRollbackPath(s string) *SyncJobUpdate {
+	sju.mutation.SetRollbackPath(s)
+	return sju
+}
+
+// SetNillableRollbackPath sets the "rollback_path" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableRollbackPath(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetRollbackPath(*s)
+	}
+	return sju
+}
+
+// ClearRollbackPath clears the value of the "rollback_path" field.
+func (sju *SyncJobUpdate) ClearRollbackPath() *SyncJobUpdate {
+	sju.mutation.ClearRollbackPath()
+	return sju
+}
+
+// SetIntegrityCheck sets the "integrity_check" field.
+func (sju *SyncJobUpdate) SetIntegrityCheck(s string) *SyncJobUpdate {
+	sju.mutation.SetIntegrityCheck(s)
+	return sju
+}
+
+// SetNillableIntegrityCheck sets the "integrity_check" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableIntegrityCheck(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetIntegrityCheck(*s)
+	}
+	return sju
+}
+
+// ClearIntegrityCheck clears the value of the "integrity_check" field.
+func (sju *SyncJobUpdate) ClearIntegrityCheck() *SyncJobUpdate {
+	sju.mutation.ClearIntegrityCheck()
+	return sju
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (sju *SyncJobUpdate) SetOriginalSize(i int64) *SyncJobUpdate {
+	sju.mutation.ResetOriginalSize()
+	sju.mutation.SetOriginalSize(i)
+	return sju
+}
+
+// SetNillableOriginalSize sets the "original_size" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableOriginalSize(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetOriginalSize(*i)
+	}
+	return sju
+}
+
+// AddOriginalSize adds i to the "original_size" field.
+func (sju *SyncJobUpdate) AddOriginalSize(i int64) *SyncJobUpdate {
+	sju.mutation.AddOriginalSize(i)
+	return sju
+}
+
+// ClearOriginalSize clears the value of the "original_size" field.
+func (sju *SyncJobUpdate) ClearOriginalSize() *SyncJobUpdate {
+	sju.mutation.ClearOriginalSize()
+	return sju
+}
+
+// SetArchiveSize sets the "archive_size" field.
+func (sju *SyncJobUpdate) SetArchiveSize(i int64) *SyncJobUpdate {
+	sju.mutation.ResetArchiveSize()
+	sju.mutation.SetArchiveSize(i)
+	return sju
+}
+
+// SetNillableArchiveSize sets the "archive_size" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableArchiveSize(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetArchiveSize(*i)
+	}
+	return sju
+}
+
+// AddArchiveSize adds i to the "archive_size" field.
+func (sju *SyncJobUpdate) AddArchiveSize(i int64) *SyncJobUpdate {
+	sju.mutation.AddArchiveSize(i)
+	return sju
+}
+
+// ClearArchiveSize clears the value of the "archive_size" field.
+func (sju *SyncJobUpdate) ClearArchiveSize() *SyncJobUpdate {
+	sju.mutation.ClearArchiveSize()
+	return sju
+}
+
+// SetCompressionRatio sets the "compression_ratio" field.
+func (sju *SyncJobUpdate) SetCompressionRatio(f float64) *SyncJobUpdate {
+	sju.mutation.ResetCompressionRatio()
+	sju.mutation.SetCompressionRatio(f)
+	return sju
+}
+
+// SetNillableCompressionRatio sets the "compression_ratio" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableCompressionRatio(f *float64) *SyncJobUpdate {
+	if f != nil {
+		sju.SetCompressionRatio(*f)
+	}
+	return sju
+}
+
+// AddCompressionRatio adds f to the "compression_ratio" field.
+func (sju *SyncJobUpdate) AddCompressionRatio(f float64) *SyncJobUpdate {
+	sju.mutation.AddCompressionRatio(f)
+	return sju
+}
+
+// ClearCompressionRatio clears the value of the "compression_ratio" field.
+func (sju *SyncJobUpdate) ClearCompressionRatio() *SyncJobUpdate {
+	sju.mutation.ClearCompressionRatio()
+	return sju
+}
+
+// SetDataFiles sets the "data_files" field.
+func (sju *SyncJobUpdate) SetDataFiles(i int) *SyncJobUpdate {
+	sju.mutation.ResetDataFiles()
+	sju.mutation.SetDataFiles(i)
+	return sju
+}
+
+// SetNillableDataFiles sets the "data_files" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableDataFiles(i *int) *SyncJobUpdate {
+	if i != nil {
+		sju.SetDataFiles(*i)
+	}
+	return sju
+}
+
+// AddDataFiles adds i to the "data_files" field.
+func (sju *SyncJobUpdate) AddDataFiles(i int) *SyncJobUpdate {
+	sju.mutation.AddDataFiles(i)
+	return sju
+}
+
+// ClearDataFiles clears the value of the "data_files" field.
+func (sju *SyncJobUpdate) ClearDataFiles() *SyncJobUpdate {
+	sju.mutation.ClearDataFiles()
+	return sju
+}
+
+// SetDataSize sets the "data_size" field.
+func (sju *SyncJobUpdate) SetDataSize(i int64) *SyncJobUpdate {
+	sju.mutation.ResetDataSize()
+	sju.mutation.SetDataSize(i)
+	return sju
+}
+
+// SetNillableDataSize sets the "data_size" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableDataSize(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetDataSize(*i)
+	}
+	return sju
+}
+
+// AddDataSize adds i to the "data_size" field.
+func (sju *SyncJobUpdate) AddDataSize(i int64) *SyncJobUpdate {
+	sju.mutation.AddDataSize(i)
+	return sju
+}
+
+// ClearDataSize clears the value of the "data_size" field.
+func (sju *SyncJobUpdate) ClearDataSize() *SyncJobUpdate {
+	sju.mutation.ClearDataSize()
+	return sju
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sju *SyncJobUpdate) SetFingerprint(s string) *SyncJobUpdate {
+	sju.mutation.SetFingerprint(s)
+	return sju
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableFingerprint(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetFingerprint(*s)
+	}
+	return sju
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (sju *SyncJobUpdate) ClearFingerprint() *SyncJobUpdate {
+	sju.mutation.ClearFingerprint()
+	return sju
+}
+
+// SetVaultUsers sets the "vault_users" field.
+func (sju *SyncJobUpdate) SetVaultUsers(i int64) *SyncJobUpdate {
+	sju.mutation.ResetVaultUsers()
+	sju.mutation.SetVaultUsers(i)
+	return sju
+}
+
+// SetNillableVaultUsers sets the "vault_users" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableVaultUsers(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetVaultUsers(*i)
+	}
+	return sju
+}
+
+// AddVaultUsers adds i to the "vault_users" field.
+func (sju *SyncJobUpdate) AddVaultUsers(i int64) *SyncJobUpdate {
+	sju.mutation.AddVaultUsers(i)
+	return sju
+}
+
+// ClearVaultUsers clears the value of the "vault_users" field.
+func (sju *SyncJobUpdate) ClearVaultUsers() *SyncJobUpdate {
+	sju.mutation.ClearVaultUsers()
+	return sju
+}
+
+// SetVaultOrganizations sets the "vault_organizations" field.
+func (sju *SyncJobUpdate) SetVaultOrganizations(i int64) *SyncJobUpdate {
+	sju.mutation.ResetVaultOrganizations()
+	sju.mutation.SetVaultOrganizations(i)
+	return sju
+}
+
+// SetNillableVaultOrganizations sets the "vault_organizations" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableVaultOrganizations(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetVaultOrganizations(*i)
+	}
+	return sju
+}
+
+// AddVaultOrganizations adds i to the "vault_organizations" field.
+func (sju *SyncJobUpdate) AddVaultOrganizations(i int64) *SyncJobUpdate {
+	sju.mutation.AddVaultOrganizations(i)
+	return sju
+}
+
+// ClearVaultOrganizations clears the value of the "vault_organizations" field.
+func (sju *SyncJobUpdate) ClearVaultOrganizations() *SyncJobUpdate {
+	sju.mutation.ClearVaultOrganizations()
+	return sju
+}
+
+// SetVaultCiphers sets the "vault_ciphers" field.
+func (sju *SyncJobUpdate) SetVaultCiphers(i int64) *SyncJobUpdate {
+	sju.mutation.ResetVaultCiphers()
+	sju.mutation.SetVaultCiphers(i)
+	return sju
+}
+
+// SetNillableVaultCiphers sets the "vault_ciphers" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableVaultCiphers(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetVaultCiphers(*i)
+	}
+	return sju
+}
+
+// AddVaultCiphers adds i to the "vault_ciphers" field.
+func (sju *SyncJobUpdate) AddVaultCiphers(i int64) *SyncJobUpdate {
+	sju.mutation.AddVaultCiphers(i)
+	return sju
+}
+
+// ClearVaultCiphers clears the value of the "vault_ciphers" field.
+func (sju *SyncJobUpdate) ClearVaultCiphers() *SyncJobUpdate {
+	sju.mutation.ClearVaultCiphers()
+	return sju
+}
+
+// SetVaultAttachments sets the "vault_attachments" field.
+func (sju *SyncJobUpdate) SetVaultAttachments(i int64) *SyncJobUpdate {
+	sju.mutation.ResetVaultAttachments()
+	sju.mutation.SetVaultAttachments(i)
+	return sju
+}
+
+// SetNillableVaultAttachments sets the "vault_attachments" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableVaultAttachments(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetVaultAttachments(*i)
+	}
+	return sju
+}
+
+// AddVaultAttachments adds i to the "vault_attachments" field.
+func (sju *SyncJobUpdate) AddVaultAttachments(i int64) *SyncJobUpdate {
+	sju.mutation.AddVaultAttachments(i)
+	return sju
+}
+
+// ClearVaultAttachments clears the value of the "vault_attachments" field.
+func (sju *SyncJobUpdate) ClearVaultAttachments() *SyncJobUpdate {
+	sju.mutation.ClearVaultAttachments()
+	return sju
+}
+
+// SetVaultSends sets the "vault_sends" field.
+func (sju *SyncJobUpdate) SetVaultSends(i int64) *SyncJobUpdate {
+	sju.mutation.ResetVaultSends()
+	sju.mutation.SetVaultSends(i)
+	return sju
+}
+
+// SetNillableVaultSends sets the "vault_sends" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableVaultSends(i *int64) *SyncJobUpdate {
+	if i != nil {
+		sju.SetVaultSends(*i)
+	}
+	return sju
+}
+
+// AddVaultSends adds i to the "vault_sends" field.
+func (sju *SyncJobUpdate) AddVaultSends(i int64) *SyncJobUpdate {
+	sju.mutation.AddVaultSends(i)
+	return sju
+}
+
+// ClearVaultSends clears the value of the "vault_sends" field.
+func (sju *SyncJobUpdate) ClearVaultSends() *SyncJobUpdate {
+	sju.mutation.ClearVaultSends()
+	return sju
+}
+
+// SetHookOutput sets the "hook_output" field.
+func (sju *SyncJobUpdate) SetHookOutput(s string) *SyncJobUpdate {
+	sju.mutation.SetHookOutput(s)
+	return sju
+}
+
+// SetNillableHookOutput sets the "hook_output" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableHookOutput(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetHookOutput(*s)
+	}
+	return sju
+}
+
+// ClearHookOutput clears the value of the "hook_output" field.
+func (sju *SyncJobUpdate) ClearHookOutput() *SyncJobUpdate {
+	sju.mutation.ClearHookOutput()
+	return sju
+}
+
 // SetStartedAt sets the "started_at" field.
 func (sju *SyncJobUpdate) SetStartedAt(t time.Time) *SyncJobUpdate {
 	sju.mutation.SetStartedAt(t)
@@ -123,219 +514,789 @@ func (sju *SyncJobUpdate) SetCreatedAt(t time.Time) *SyncJobUpdate {
 	return sju
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (sju *SyncJobUpdate) SetNillableCreatedAt(t *time.Time) *SyncJobUpdate {
-	if t != nil {
-		sju.SetCreatedAt(*t)
-	}
-	return sju
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableCreatedAt(t *time.Time) *SyncJobUpdate {
+	if t != nil {
+		sju.SetCreatedAt(*t)
+	}
+	return sju
+}
+
+// SetStorageID sets the "storage" edge to the Storage entity by ID.
+func (sju *SyncJobUpdate) SetStorageID(id int) *SyncJobUpdate {
+	sju.mutation.SetStorageID(id)
+	return sju
+}
+
+// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableStorageID(id *int) *SyncJobUpdate {
+	if id != nil {
+		sju = sju.SetStorageID(*id)
+	}
+	return sju
+}
+
+// SetStorage sets the "storage" edge to the Storage entity.
+func (sju *SyncJobUpdate) SetStorage(s *Storage) *SyncJobUpdate {
+	return sju.SetStorageID(s.ID)
+}
+
+// SetSourceID sets the "source" edge to the Source entity by ID.
+func (sju *SyncJobUpdate) SetSourceID(id int) *SyncJobUpdate {
+	sju.mutation.SetSourceID(id)
+	return sju
+}
+
+// SetNillableSourceID sets the "source" edge to the Source entity by ID if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableSourceID(id *int) *SyncJobUpdate {
+	if id != nil {
+		sju = sju.SetSourceID(*id)
+	}
+	return sju
+}
+
+// SetSource sets the "source" edge to the Source entity.
+func (sju *SyncJobUpdate) SetSource(s *Source) *SyncJobUpdate {
+	return sju.SetSourceID(s.ID)
+}
+
+// Mutation returns the SyncJobMutation object of the builder.
+func (sju *SyncJobUpdate) Mutation() *SyncJobMutation {
+	return sju.mutation
+}
+
+// ClearStorage clears the "storage" edge to the Storage entity.
+func (sju *SyncJobUpdate) ClearStorage() *SyncJobUpdate {
+	sju.mutation.ClearStorage()
+	return sju
+}
+
+// ClearSource clears the "source" edge to the Source entity.
+func (sju *SyncJobUpdate) ClearSource() *SyncJobUpdate {
+	sju.mutation.ClearSource()
+	return sju
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sju *SyncJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sju.sqlSave, sju.mutation, sju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sju *SyncJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := sju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sju *SyncJobUpdate) Exec(ctx context.Context) error {
+	_, err := sju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sju *SyncJobUpdate) ExecX(ctx context.Context) {
+	if err := sju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sju *SyncJobUpdate) check() error {
+	if v, ok := sju.mutation.Status(); ok {
+		if err := syncjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SyncJob.status": %w`, err)}
+		}
+	}
+	if v, ok := sju.mutation.Operation(); ok {
+		if err := syncjob.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "SyncJob.operation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sju *SyncJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(syncjob.Table, syncjob.Columns, sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt))
+	if ps := sju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sju.mutation.Status(); ok {
+		_spec.SetField(syncjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sju.mutation.Operation(); ok {
+		_spec.SetField(syncjob.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := sju.mutation.Message(); ok {
+		_spec.SetField(syncjob.FieldMessage, field.TypeString, value)
+	}
+	if sju.mutation.MessageCleared() {
+		_spec.ClearField(syncjob.FieldMessage, field.TypeString)
+	}
+	if value, ok := sju.mutation.Filename(); ok {
+		_spec.SetField(syncjob.FieldFilename, field.TypeString, value)
+	}
+	if sju.mutation.FilenameCleared() {
+		_spec.ClearField(syncjob.FieldFilename, field.TypeString)
+	}
+	if value, ok := sju.mutation.RestorePath(); ok {
+		_spec.SetField(syncjob.FieldRestorePath, field.TypeString, value)
+	}
+	if sju.mutation.RestorePathCleared() {
+		_spec.ClearField(syncjob.FieldRestorePath, field.TypeString)
+	}
+	if value, ok := sju.mutation.RollbackPath(); ok {
+		_spec.SetField(syncjob.FieldRollbackPath, field.TypeString, value)
+	}
+	if sju.mutation.RollbackPathCleared() {
+		_spec.ClearField(syncjob.FieldRollbackPath, field.TypeString)
+	}
+	if value, ok := sju.mutation.IntegrityCheck(); ok {
+		_spec.SetField(syncjob.FieldIntegrityCheck, field.TypeString, value)
+	}
+	if sju.mutation.IntegrityCheckCleared() {
+		_spec.ClearField(syncjob.FieldIntegrityCheck, field.TypeString)
+	}
+	if value, ok := sju.mutation.OriginalSize(); ok {
+		_spec.SetField(syncjob.FieldOriginalSize, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedOriginalSize(); ok {
+		_spec.AddField(syncjob.FieldOriginalSize, field.TypeInt64, value)
+	}
+	if sju.mutation.OriginalSizeCleared() {
+		_spec.ClearField(syncjob.FieldOriginalSize, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.ArchiveSize(); ok {
+		_spec.SetField(syncjob.FieldArchiveSize, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedArchiveSize(); ok {
+		_spec.AddField(syncjob.FieldArchiveSize, field.TypeInt64, value)
+	}
+	if sju.mutation.ArchiveSizeCleared() {
+		_spec.ClearField(syncjob.FieldArchiveSize, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.CompressionRatio(); ok {
+		_spec.SetField(syncjob.FieldCompressionRatio, field.TypeFloat64, value)
+	}
+	if value, ok := sju.mutation.AddedCompressionRatio(); ok {
+		_spec.AddField(syncjob.FieldCompressionRatio, field.TypeFloat64, value)
+	}
+	if sju.mutation.CompressionRatioCleared() {
+		_spec.ClearField(syncjob.FieldCompressionRatio, field.TypeFloat64)
+	}
+	if value, ok := sju.mutation.DataFiles(); ok {
+		_spec.SetField(syncjob.FieldDataFiles, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedDataFiles(); ok {
+		_spec.AddField(syncjob.FieldDataFiles, field.TypeInt, value)
+	}
+	if sju.mutation.DataFilesCleared() {
+		_spec.ClearField(syncjob.FieldDataFiles, field.TypeInt)
+	}
+	if value, ok := sju.mutation.DataSize(); ok {
+		_spec.SetField(syncjob.FieldDataSize, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedDataSize(); ok {
+		_spec.AddField(syncjob.FieldDataSize, field.TypeInt64, value)
+	}
+	if sju.mutation.DataSizeCleared() {
+		_spec.ClearField(syncjob.FieldDataSize, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.Fingerprint(); ok {
+		_spec.SetField(syncjob.FieldFingerprint, field.TypeString, value)
+	}
+	if sju.mutation.FingerprintCleared() {
+		_spec.ClearField(syncjob.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := sju.mutation.VaultUsers(); ok {
+		_spec.SetField(syncjob.FieldVaultUsers, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedVaultUsers(); ok {
+		_spec.AddField(syncjob.FieldVaultUsers, field.TypeInt64, value)
+	}
+	if sju.mutation.VaultUsersCleared() {
+		_spec.ClearField(syncjob.FieldVaultUsers, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.VaultOrganizations(); ok {
+		_spec.SetField(syncjob.FieldVaultOrganizations, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedVaultOrganizations(); ok {
+		_spec.AddField(syncjob.FieldVaultOrganizations, field.TypeInt64, value)
+	}
+	if sju.mutation.VaultOrganizationsCleared() {
+		_spec.ClearField(syncjob.FieldVaultOrganizations, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.VaultCiphers(); ok {
+		_spec.SetField(syncjob.FieldVaultCiphers, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedVaultCiphers(); ok {
+		_spec.AddField(syncjob.FieldVaultCiphers, field.TypeInt64, value)
+	}
+	if sju.mutation.VaultCiphersCleared() {
+		_spec.ClearField(syncjob.FieldVaultCiphers, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.VaultAttachments(); ok {
+		_spec.SetField(syncjob.FieldVaultAttachments, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedVaultAttachments(); ok {
+		_spec.AddField(syncjob.FieldVaultAttachments, field.TypeInt64, value)
+	}
+	if sju.mutation.VaultAttachmentsCleared() {
+		_spec.ClearField(syncjob.FieldVaultAttachments, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.VaultSends(); ok {
+		_spec.SetField(syncjob.FieldVaultSends, field.TypeInt64, value)
+	}
+	if value, ok := sju.mutation.AddedVaultSends(); ok {
+		_spec.AddField(syncjob.FieldVaultSends, field.TypeInt64, value)
+	}
+	if sju.mutation.VaultSendsCleared() {
+		_spec.ClearField(syncjob.FieldVaultSends, field.TypeInt64)
+	}
+	if value, ok := sju.mutation.HookOutput(); ok {
+		_spec.SetField(syncjob.FieldHookOutput, field.TypeString, value)
+	}
+	if sju.mutation.HookOutputCleared() {
+		_spec.ClearField(syncjob.FieldHookOutput, field.TypeString)
+	}
+	if value, ok := sju.mutation.StartedAt(); ok {
+		_spec.SetField(syncjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if sju.mutation.StartedAtCleared() {
+		_spec.ClearField(syncjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.CompletedAt(); ok {
+		_spec.SetField(syncjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if sju.mutation.CompletedAtCleared() {
+		_spec.ClearField(syncjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.CreatedAt(); ok {
+		_spec.SetField(syncjob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sju.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.StorageTable,
+			Columns: []string{syncjob.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sju.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.StorageTable,
+			Columns: []string{syncjob.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sju.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.SourceTable,
+			Columns: []string{syncjob.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sju.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.SourceTable,
+			Columns: []string{syncjob.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sju.mutation.done = true
+	return n, nil
+}
+
+// SyncJobUpdateOne is the builder for updating a single SyncJob entity.
+type SyncJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SyncJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (sjuo *SyncJobUpdateOne) SetStatus(s syncjob.Status) *SyncJobUpdateOne {
+	sjuo.mutation.SetStatus(s)
+	return sjuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableStatus(s *syncjob.Status) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetStatus(*s)
+	}
+	return sjuo
+}
+
+// SetOperation sets the "operation" field.
+func (sjuo *SyncJobUpdateOne) SetOperation(s syncjob.Operation) *SyncJobUpdateOne {
+	sjuo.mutation.SetOperation(s)
+	return sjuo
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableOperation(s *syncjob.Operation) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetOperation(*s)
+	}
+	return sjuo
+}
+
+// SetMessage sets the "message" field.
+func (sjuo *SyncJobUpdateOne) SetMessage(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetMessage(s)
+	return sjuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableMessage(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetMessage(*s)
+	}
+	return sjuo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (sjuo *SyncJobUpdateOne) ClearMessage() *SyncJobUpdateOne {
+	sjuo.mutation.ClearMessage()
+	return sjuo
+}
+
+// SetFilename sets the "filename" field.
+func (sjuo *SyncJobUpdateOne) SetFilename(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetFilename(s)
+	return sjuo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableFilename(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetFilename(*s)
+	}
+	return sjuo
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (sjuo *SyncJobUpdateOne) ClearFilename() *SyncJobUpdateOne {
+	sjuo.mutation.ClearFilename()
+	return sjuo
+}
+
+// SetRestorePath sets the "restore_path" field.
+func (sjuo *SyncJobUpdateOne) SetRestorePath(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetRestorePath(s)
+	return sjuo
+}
+
+// SetNillableRestorePath sets the "restore_path" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableRestorePath(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetRestorePath(*s)
+	}
+	return sjuo
+}
+
+// ClearRestorePath clears the value of the "restore_path" field.
+func (sjuo *SyncJobUpdateOne) ClearRestorePath() *SyncJobUpdateOne {
+	sjuo.mutation.ClearRestorePath()
+	return sjuo
+}
+
+// SetRollbackPath sets the "rollback_path" field.
+func (sjuo *SyncJobUpdateOne) SetRollbackPath(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetRollbackPath(s)
+	return sjuo
+}
+
+// SetNillableRollbackPath sets the "rollback_path" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableRollbackPath(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetRollbackPath(*s)
+	}
+	return sjuo
+}
+
+// ClearRollbackPath clears the value of the "rollback_path" field.
+func (sjuo *SyncJobUpdateOne) ClearRollbackPath() *SyncJobUpdateOne {
+	sjuo.mutation.ClearRollbackPath()
+	return sjuo
+}
+
+// SetIntegrityCheck sets the "integrity_check" field.
+func (sjuo *SyncJobUpdateOne) SetIntegrityCheck(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetIntegrityCheck(s)
+	return sjuo
+}
+
+// SetNillableIntegrityCheck sets the "integrity_check" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableIntegrityCheck(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetIntegrityCheck(*s)
+	}
+	return sjuo
+}
+
+// ClearIntegrityCheck clears the value of the "integrity_check" field.
+func (sjuo *SyncJobUpdateOne) ClearIntegrityCheck() *SyncJobUpdateOne {
+	sjuo.mutation.ClearIntegrityCheck()
+	return sjuo
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (sjuo *SyncJobUpdateOne) SetOriginalSize(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetOriginalSize()
+	sjuo.mutation.SetOriginalSize(i)
+	return sjuo
+}
+
+// SetNillableOriginalSize sets the "original_size" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableOriginalSize(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetOriginalSize(*i)
+	}
+	return sjuo
+}
+
+// AddOriginalSize adds i to the "original_size" field.
+func (sjuo *SyncJobUpdateOne) AddOriginalSize(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddOriginalSize(i)
+	return sjuo
+}
+
+// ClearOriginalSize clears the value of the "original_size" field.
+func (sjuo *SyncJobUpdateOne) ClearOriginalSize() *SyncJobUpdateOne {
+	sjuo.mutation.ClearOriginalSize()
+	return sjuo
+}
+
+// SetArchiveSize sets the "archive_size" field.
+func (sjuo *SyncJobUpdateOne) SetArchiveSize(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetArchiveSize()
+	sjuo.mutation.SetArchiveSize(i)
+	return sjuo
+}
+
+// SetNillableArchiveSize sets the "archive_size" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableArchiveSize(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetArchiveSize(*i)
+	}
+	return sjuo
+}
+
+// AddArchiveSize adds i to the "archive_size" field.
+func (sjuo *SyncJobUpdateOne) AddArchiveSize(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddArchiveSize(i)
+	return sjuo
+}
+
+// ClearArchiveSize clears the value of the "archive_size" field.
+func (sjuo *SyncJobUpdateOne) ClearArchiveSize() *SyncJobUpdateOne {
+	sjuo.mutation.ClearArchiveSize()
+	return sjuo
+}
+
+// SetCompressionRatio sets the "compression_ratio" field.
+func (sjuo *SyncJobUpdateOne) SetCompressionRatio(f float64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetCompressionRatio()
+	sjuo.mutation.SetCompressionRatio(f)
+	return sjuo
+}
+
+// SetNillableCompressionRatio sets the "compression_ratio" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableCompressionRatio(f *float64) *SyncJobUpdateOne {
+	if f != nil {
+		sjuo.SetCompressionRatio(*f)
+	}
+	return sjuo
+}
+
+// AddCompressionRatio adds f to the "compression_ratio" field.
+func (sjuo *SyncJobUpdateOne) AddCompressionRatio(f float64) *SyncJobUpdateOne {
+	sjuo.mutation.AddCompressionRatio(f)
+	return sjuo
+}
+
+// ClearCompressionRatio clears the value of the "compression_ratio" field.
+func (sjuo *SyncJobUpdateOne) ClearCompressionRatio() *SyncJobUpdateOne {
+	sjuo.mutation.ClearCompressionRatio()
+	return sjuo
+}
+
+// SetDataFiles sets the "data_files" field.
+func (sjuo *SyncJobUpdateOne) SetDataFiles(i int) *SyncJobUpdateOne {
+	sjuo.mutation.ResetDataFiles()
+	sjuo.mutation.SetDataFiles(i)
+	return sjuo
+}
+
+// SetNillableDataFiles sets the "data_files" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableDataFiles(i *int) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetDataFiles(*i)
+	}
+	return sjuo
+}
+
+// AddDataFiles adds i to the "data_files" field.
+func (sjuo *SyncJobUpdateOne) AddDataFiles(i int) *SyncJobUpdateOne {
+	sjuo.mutation.AddDataFiles(i)
+	return sjuo
+}
+
+// ClearDataFiles clears the value of the "data_files" field.
+func (sjuo *SyncJobUpdateOne) ClearDataFiles() *SyncJobUpdateOne {
+	sjuo.mutation.ClearDataFiles()
+	return sjuo
+}
+
+// SetDataSize sets the "data_size" field.
+func (sjuo *SyncJobUpdateOne) SetDataSize(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetDataSize()
+	sjuo.mutation.SetDataSize(i)
+	return sjuo
+}
+
+// SetNillableDataSize sets the "data_size" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableDataSize(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetDataSize(*i)
+	}
+	return sjuo
+}
+
+// AddDataSize adds i to the "data_size" field.
+func (sjuo *SyncJobUpdateOne) AddDataSize(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddDataSize(i)
+	return sjuo
+}
+
+// ClearDataSize clears the value of the "data_size" field.
+func (sjuo *SyncJobUpdateOne) ClearDataSize() *SyncJobUpdateOne {
+	sjuo.mutation.ClearDataSize()
+	return sjuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sjuo *SyncJobUpdateOne) SetFingerprint(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetFingerprint(s)
+	return sjuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableFingerprint(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetFingerprint(*s)
+	}
+	return sjuo
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (sjuo *SyncJobUpdateOne) ClearFingerprint() *SyncJobUpdateOne {
+	sjuo.mutation.ClearFingerprint()
+	return sjuo
+}
+
+// SetVaultUsers sets the "vault_users" field.
+func (sjuo *SyncJobUpdateOne) SetVaultUsers(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetVaultUsers()
+	sjuo.mutation.SetVaultUsers(i)
+	return sjuo
+}
+
+// SetNillableVaultUsers sets the "vault_users" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableVaultUsers(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetVaultUsers(*i)
+	}
+	return sjuo
+}
+
+// AddVaultUsers adds i to the "vault_users" field.
+func (sjuo *SyncJobUpdateOne) AddVaultUsers(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddVaultUsers(i)
+	return sjuo
+}
+
+// ClearVaultUsers clears the value of the "vault_users" field.
+func (sjuo *SyncJobUpdateOne) ClearVaultUsers() *SyncJobUpdateOne {
+	sjuo.mutation.ClearVaultUsers()
+	return sjuo
 }
 
-// SetStorageID sets the "storage" edge to the Storage entity by ID.
-func (sju *SyncJobUpdate) SetStorageID(id int) *SyncJobUpdate {
-	sju.mutation.SetStorageID(id)
-	return sju
+// SetVaultOrganizations sets the "vault_organizations" field.
+func (sjuo *SyncJobUpdateOne) SetVaultOrganizations(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetVaultOrganizations()
+	sjuo.mutation.SetVaultOrganizations(i)
+	return sjuo
 }
 
-// SetNillableStorageID sets the "storage" edge to the Storage entity by ID if the given value is not nil.
-func (sju *SyncJobUpdate) SetNillableStorageID(id *int) *SyncJobUpdate {
-	if id != nil {
-		sju = sju.SetStorageID(*id)
+// SetNillableVaultOrganizations sets the "vault_organizations" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableVaultOrganizations(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetVaultOrganizations(*i)
 	}
-	return sju
+	return sjuo
 }
 
-// SetStorage sets the "storage" edge to the Storage entity.
-func (sju *SyncJobUpdate) SetStorage(s *Storage) *SyncJobUpdate {
-	return sju.SetStorageID(s.ID)
+// AddVaultOrganizations adds i to the "vault_organizations" field.
+func (sjuo *SyncJobUpdateOne) AddVaultOrganizations(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddVaultOrganizations(i)
+	return sjuo
 }
 
-// Mutation returns the SyncJobMutation object of the builder.
-func (sju *SyncJobUpdate) Mutation() *SyncJobMutation {
-	return sju.mutation
+// ClearVaultOrganizations clears the value of the "vault_organizations" field.
+func (sjuo *SyncJobUpdateOne) ClearVaultOrganizations() *SyncJobUpdateOne {
+	sjuo.mutation.ClearVaultOrganizations()
+	return sjuo
 }
 
-// ClearStorage clears the "storage" edge to the Storage entity.
-func (sju *SyncJobUpdate) ClearStorage() *SyncJobUpdate {
-	sju.mutation.ClearStorage()
-	return sju
+// SetVaultCiphers sets the "vault_ciphers" field.
+func (sjuo *SyncJobUpdateOne) SetVaultCiphers(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetVaultCiphers()
+	sjuo.mutation.SetVaultCiphers(i)
+	return sjuo
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (sju *SyncJobUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, sju.sqlSave, sju.mutation, sju.hooks)
+// SetNillableVaultCiphers sets the "vault_ciphers" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableVaultCiphers(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetVaultCiphers(*i)
+	}
+	return sjuo
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (sju *SyncJobUpdate) SaveX(ctx context.Context) int {
-	affected, err := sju.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// AddVaultCiphers adds i to the "vault_ciphers" field.
+func (sjuo *SyncJobUpdateOne) AddVaultCiphers(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddVaultCiphers(i)
+	return sjuo
 }
 
-// Exec executes the query.
-func (sju *SyncJobUpdate) Exec(ctx context.Context) error {
-	_, err := sju.Save(ctx)
-	return err
+// ClearVaultCiphers clears the value of the "vault_ciphers" field.
+func (sjuo *SyncJobUpdateOne) ClearVaultCiphers() *SyncJobUpdateOne {
+	sjuo.mutation.ClearVaultCiphers()
+	return sjuo
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (sju *SyncJobUpdate) ExecX(ctx context.Context) {
-	if err := sju.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetVaultAttachments sets the "vault_attachments" field.
+func (sjuo *SyncJobUpdateOne) SetVaultAttachments(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetVaultAttachments()
+	sjuo.mutation.SetVaultAttachments(i)
+	return sjuo
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (sju *SyncJobUpdate) check() error {
-	if v, ok := sju.mutation.Status(); ok {
-		if err := syncjob.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SyncJob.status": %w`, err)}
-		}
-	}
-	if v, ok := sju.mutation.Operation(); ok {
-		if err := syncjob.OperationValidator(v); err != nil {
-			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "SyncJob.operation": %w`, err)}
-		}
+// SetNillableVaultAttachments sets the "vault_attachments" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableVaultAttachments(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetVaultAttachments(*i)
 	}
-	return nil
+	return sjuo
 }
 
-func (sju *SyncJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := sju.check(); err != nil {
-		return n, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(syncjob.Table, syncjob.Columns, sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt))
-	if ps := sju.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := sju.mutation.Status(); ok {
-		_spec.SetField(syncjob.FieldStatus, field.TypeEnum, value)
-	}
-	if value, ok := sju.mutation.Operation(); ok {
-		_spec.SetField(syncjob.FieldOperation, field.TypeEnum, value)
-	}
-	if value, ok := sju.mutation.Message(); ok {
-		_spec.SetField(syncjob.FieldMessage, field.TypeString, value)
-	}
-	if sju.mutation.MessageCleared() {
-		_spec.ClearField(syncjob.FieldMessage, field.TypeString)
-	}
-	if value, ok := sju.mutation.StartedAt(); ok {
-		_spec.SetField(syncjob.FieldStartedAt, field.TypeTime, value)
-	}
-	if sju.mutation.StartedAtCleared() {
-		_spec.ClearField(syncjob.FieldStartedAt, field.TypeTime)
-	}
-	if value, ok := sju.mutation.CompletedAt(); ok {
-		_spec.SetField(syncjob.FieldCompletedAt, field.TypeTime, value)
-	}
-	if sju.mutation.CompletedAtCleared() {
-		_spec.ClearField(syncjob.FieldCompletedAt, field.TypeTime)
-	}
-	if value, ok := sju.mutation.CreatedAt(); ok {
-		_spec.SetField(syncjob.FieldCreatedAt, field.TypeTime, value)
-	}
-	if sju.mutation.StorageCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   syncjob.StorageTable,
-			Columns: []string{syncjob.StorageColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := sju.mutation.StorageIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   syncjob.StorageTable,
-			Columns: []string{syncjob.StorageColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(storage.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if n, err = sqlgraph.UpdateNodes(ctx, sju.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{syncjob.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	sju.mutation.done = true
-	return n, nil
+// AddVaultAttachments adds i to the "vault_attachments" field.
+func (sjuo *SyncJobUpdateOne) AddVaultAttachments(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddVaultAttachments(i)
+	return sjuo
 }
 
-// SyncJobUpdateOne is the builder for updating a single SyncJob entity.
-type SyncJobUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *SyncJobMutation
+// ClearVaultAttachments clears the value of the "vault_attachments" field.
+func (sjuo *SyncJobUpdateOne) ClearVaultAttachments() *SyncJobUpdateOne {
+	sjuo.mutation.ClearVaultAttachments()
+	return sjuo
 }
 
-// SetStatus sets the "status" field.
-func (sjuo *SyncJobUpdateOne) SetStatus(s syncjob.Status) *SyncJobUpdateOne {
-	sjuo.mutation.SetStatus(s)
+// SetVaultSends sets the "vault_sends" field.
+func (sjuo *SyncJobUpdateOne) SetVaultSends(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.ResetVaultSends()
+	sjuo.mutation.SetVaultSends(i)
 	return sjuo
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (sjuo *SyncJobUpdateOne) SetNillableStatus(s *syncjob.Status) *SyncJobUpdateOne {
-	if s != nil {
-		sjuo.SetStatus(*s)
+// SetNillableVaultSends sets the "vault_sends" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableVaultSends(i *int64) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetVaultSends(*i)
 	}
 	return sjuo
 }
 
-// SetOperation sets the "operation" field.
-func (sjuo *SyncJobUpdateOne) SetOperation(s syncjob.Operation) *SyncJobUpdateOne {
-	sjuo.mutation.SetOperation(s)
+// AddVaultSends adds i to the "vault_sends" field.
+func (sjuo *SyncJobUpdateOne) AddVaultSends(i int64) *SyncJobUpdateOne {
+	sjuo.mutation.AddVaultSends(i)
 	return sjuo
 }
 
-// SetNillableOperation sets the "operation" field if the given value is not nil.
-func (sjuo *SyncJobUpdateOne) SetNillableOperation(s *syncjob.Operation) *SyncJobUpdateOne {
-	if s != nil {
-		sjuo.SetOperation(*s)
-	}
+// ClearVaultSends clears the value of the "vault_sends" field.
+func (sjuo *SyncJobUpdateOne) ClearVaultSends() *SyncJobUpdateOne {
+	sjuo.mutation.ClearVaultSends()
 	return sjuo
 }
 
-// SetMessage sets the "message" field.
-func (sjuo *SyncJobUpdateOne) SetMessage(s string) *SyncJobUpdateOne {
-	sjuo.mutation.SetMessage(s)
+// SetHookOutput sets the "hook_output" field.
+func (sjuo *SyncJobUpdateOne) SetHookOutput(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetHookOutput(s)
 	return sjuo
 }
 
-// SetNillableMessage sets the "message" field if the given value is not nil.
-func (sjuo *SyncJobUpdateOne) SetNillableMessage(s *string) *SyncJobUpdateOne {
+// SetNillableHookOutput sets the "hook_output" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableHookOutput(s *string) *SyncJobUpdateOne {
 	if s != nil {
-		sjuo.SetMessage(*s)
+		sjuo.SetHookOutput(*s)
 	}
 	return sjuo
 }
 
-// ClearMessage clears the value of the "message" field.
-func (sjuo *SyncJobUpdateOne) ClearMessage() *SyncJobUpdateOne {
-	sjuo.mutation.ClearMessage()
+// ClearHookOutput clears the value of the "hook_output" field.
+func (sjuo *SyncJobUpdateOne) ClearHookOutput() *SyncJobUpdateOne {
+	sjuo.mutation.ClearHookOutput()
 	return sjuo
 }
 
@@ -412,6 +1373,25 @@ func (sjuo *SyncJobUpdateOne) SetStorage(s *Storage) *SyncJobUpdateOne {
 	return sjuo.SetStorageID(s.ID)
 }
 
+// SetSourceID sets the "source" edge to the Source entity by ID.
+func (sjuo *SyncJobUpdateOne) SetSourceID(id int) *SyncJobUpdateOne {
+	sjuo.mutation.SetSourceID(id)
+	return sjuo
+}
+
+// SetNillableSourceID sets the "source" edge to the Source entity by ID if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableSourceID(id *int) *SyncJobUpdateOne {
+	if id != nil {
+		sjuo = sjuo.SetSourceID(*id)
+	}
+	return sjuo
+}
+
+// SetSource sets the "source" edge to the Source entity.
+func (sjuo *SyncJobUpdateOne) SetSource(s *Source) *SyncJobUpdateOne {
+	return sjuo.SetSourceID(s.ID)
+}
+
 // Mutation returns the SyncJobMutation object of the builder.
 func (sjuo *SyncJobUpdateOne) Mutation() *SyncJobMutation {
 	return sjuo.mutation
@@ -423,6 +1403,12 @@ func (sjuo *SyncJobUpdateOne) ClearStorage() *SyncJobUpdateOne {
 	return sjuo
 }
 
+// ClearSource clears the "source" edge to the Source entity.
+func (sjuo *SyncJobUpdateOne) ClearSource() *SyncJobUpdateOne {
+	sjuo.mutation.ClearSource()
+	return sjuo
+}
+
 // Where appends a list predicates to the SyncJobUpdate builder.
 func (sjuo *SyncJobUpdateOne) Where(ps ...predicate.SyncJob) *SyncJobUpdateOne {
 	sjuo.mutation.Where(ps...)
@@ -519,6 +1505,132 @@ func (sjuo *SyncJobUpdateOne) sqlSave(ctx context.Context) (_node *SyncJob, err
 	if sjuo.mutation.MessageCleared() {
 		_spec.ClearField(syncjob.FieldMessage, field.TypeString)
 	}
+	if value, ok := sjuo.mutation.Filename(); ok {
+		_spec.SetField(syncjob.FieldFilename, field.TypeString, value)
+	}
+	if sjuo.mutation.FilenameCleared() {
+		_spec.ClearField(syncjob.FieldFilename, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.RestorePath(); ok {
+		_spec.SetField(syncjob.FieldRestorePath, field.TypeString, value)
+	}
+	if sjuo.mutation.RestorePathCleared() {
+		_spec.ClearField(syncjob.FieldRestorePath, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.RollbackPath(); ok {
+		_spec.SetField(syncjob.FieldRollbackPath, field.TypeString, value)
+	}
+	if sjuo.mutation.RollbackPathCleared() {
+		_spec.ClearField(syncjob.FieldRollbackPath, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.IntegrityCheck(); ok {
+		_spec.SetField(syncjob.FieldIntegrityCheck, field.TypeString, value)
+	}
+	if sjuo.mutation.IntegrityCheckCleared() {
+		_spec.ClearField(syncjob.FieldIntegrityCheck, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.OriginalSize(); ok {
+		_spec.SetField(syncjob.FieldOriginalSize, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedOriginalSize(); ok {
+		_spec.AddField(syncjob.FieldOriginalSize, field.TypeInt64, value)
+	}
+	if sjuo.mutation.OriginalSizeCleared() {
+		_spec.ClearField(syncjob.FieldOriginalSize, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.ArchiveSize(); ok {
+		_spec.SetField(syncjob.FieldArchiveSize, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedArchiveSize(); ok {
+		_spec.AddField(syncjob.FieldArchiveSize, field.TypeInt64, value)
+	}
+	if sjuo.mutation.ArchiveSizeCleared() {
+		_spec.ClearField(syncjob.FieldArchiveSize, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.CompressionRatio(); ok {
+		_spec.SetField(syncjob.FieldCompressionRatio, field.TypeFloat64, value)
+	}
+	if value, ok := sjuo.mutation.AddedCompressionRatio(); ok {
+		_spec.AddField(syncjob.FieldCompressionRatio, field.TypeFloat64, value)
+	}
+	if sjuo.mutation.CompressionRatioCleared() {
+		_spec.ClearField(syncjob.FieldCompressionRatio, field.TypeFloat64)
+	}
+	if value, ok := sjuo.mutation.DataFiles(); ok {
+		_spec.SetField(syncjob.FieldDataFiles, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedDataFiles(); ok {
+		_spec.AddField(syncjob.FieldDataFiles, field.TypeInt, value)
+	}
+	if sjuo.mutation.DataFilesCleared() {
+		_spec.ClearField(syncjob.FieldDataFiles, field.TypeInt)
+	}
+	if value, ok := sjuo.mutation.DataSize(); ok {
+		_spec.SetField(syncjob.FieldDataSize, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedDataSize(); ok {
+		_spec.AddField(syncjob.FieldDataSize, field.TypeInt64, value)
+	}
+	if sjuo.mutation.DataSizeCleared() {
+		_spec.ClearField(syncjob.FieldDataSize, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.Fingerprint(); ok {
+		_spec.SetField(syncjob.FieldFingerprint, field.TypeString, value)
+	}
+	if sjuo.mutation.FingerprintCleared() {
+		_spec.ClearField(syncjob.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.VaultUsers(); ok {
+		_spec.SetField(syncjob.FieldVaultUsers, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedVaultUsers(); ok {
+		_spec.AddField(syncjob.FieldVaultUsers, field.TypeInt64, value)
+	}
+	if sjuo.mutation.VaultUsersCleared() {
+		_spec.ClearField(syncjob.FieldVaultUsers, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.VaultOrganizations(); ok {
+		_spec.SetField(syncjob.FieldVaultOrganizations, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedVaultOrganizations(); ok {
+		_spec.AddField(syncjob.FieldVaultOrganizations, field.TypeInt64, value)
+	}
+	if sjuo.mutation.VaultOrganizationsCleared() {
+		_spec.ClearField(syncjob.FieldVaultOrganizations, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.VaultCiphers(); ok {
+		_spec.SetField(syncjob.FieldVaultCiphers, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedVaultCiphers(); ok {
+		_spec.AddField(syncjob.FieldVaultCiphers, field.TypeInt64, value)
+	}
+	if sjuo.mutation.VaultCiphersCleared() {
+		_spec.ClearField(syncjob.FieldVaultCiphers, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.VaultAttachments(); ok {
+		_spec.SetField(syncjob.FieldVaultAttachments, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedVaultAttachments(); ok {
+		_spec.AddField(syncjob.FieldVaultAttachments, field.TypeInt64, value)
+	}
+	if sjuo.mutation.VaultAttachmentsCleared() {
+		_spec.ClearField(syncjob.FieldVaultAttachments, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.VaultSends(); ok {
+		_spec.SetField(syncjob.FieldVaultSends, field.TypeInt64, value)
+	}
+	if value, ok := sjuo.mutation.AddedVaultSends(); ok {
+		_spec.AddField(syncjob.FieldVaultSends, field.TypeInt64, value)
+	}
+	if sjuo.mutation.VaultSendsCleared() {
+		_spec.ClearField(syncjob.FieldVaultSends, field.TypeInt64)
+	}
+	if value, ok := sjuo.mutation.HookOutput(); ok {
+		_spec.SetField(syncjob.FieldHookOutput, field.TypeString, value)
+	}
+	if sjuo.mutation.HookOutputCleared() {
+		_spec.ClearField(syncjob.FieldHookOutput, field.TypeString)
+	}
 	if value, ok := sjuo.mutation.StartedAt(); ok {
 		_spec.SetField(syncjob.FieldStartedAt, field.TypeTime, value)
 	}
@@ -563,6 +1675,35 @@ func (sjuo *SyncJobUpdateOne) sqlSave(ctx context.Context) (_node *SyncJob, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if sjuo.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.SourceTable,
+			Columns: []string{syncjob.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sjuo.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.SourceTable,
+			Columns: []string{syncjob.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(source.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SyncJob{config: sjuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AzureBlobConfig is the client for interacting with the AzureBlobConfig builders.
+	AzureBlobConfig *AzureBlobConfigClient
+	// FTPConfig is the client for interacting with the FTPConfig builders.
+	FTPConfig *FTPConfigClient
+	// GCSConfig is the client for interacting with the GCSConfig builders.
+	GCSConfig *GCSConfigClient
+	// LocalConfig is the client for interacting with the LocalConfig builders.
+	LocalConfig *LocalConfigClient
 	// S3Config is the client for interacting with the S3Config builders.
 	S3Config *S3ConfigClient
+	// SFTPConfig is the client for interacting with the SFTPConfig builders.
+	SFTPConfig *SFTPConfigClient
+	// Source is the client for interacting with the Source builders.
+	Source *SourceClient
 	// Storage is the client for interacting with the Storage builders.
 	Storage *StorageClient
 	// SyncJob is the client for interacting with the SyncJob builders.
@@ -153,7 +165,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AzureBlobConfig = NewAzureBlobConfigClient(tx.config)
+	tx.FTPConfig = NewFTPConfigClient(tx.config)
+	tx.GCSConfig = NewGCSConfigClient(tx.config)
+	tx.LocalConfig = NewLocalConfigClient(tx.config)
 	tx.S3Config = NewS3ConfigClient(tx.config)
+	tx.SFTPConfig = NewSFTPConfigClient(tx.config)
+	tx.Source = NewSourceClient(tx.config)
 	tx.Storage = NewStorageClient(tx.config)
 	tx.SyncJob = NewSyncJobClient(tx.config)
 	tx.User = NewUserClient(tx.config)
@@ -167,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: S3Config.QueryXXX(), the query will be executed
+// applies a query, for example: AzureBlobConfig.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,81 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"filippo.io/age"
+)
+
+// ageMagic 为 age 二进制格式的文件头
+const ageMagic = "age-encryption.org/"
+
+// ageExt 为使用 age 公钥加密的备份追加的扩展名
+const ageExt = ".age"
+
+// ErrNoIdentity 表示备份使用 age 公钥加密，而调用方没有提供私钥
+var ErrNoIdentity = errors.New("backup is encrypted to age recipients; an age identity (private key) is required to restore it")
+
+// ParseRecipients 解析 age 公钥（age1...）。空行和以 # 开头的注释会被忽略
+func ParseRecipients(keys []string) ([]age.Recipient, error) {
+	var recipients []age.Recipient
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || strings.HasPrefix(key, "#") {
+			continue
+		}
+
+		recipient, err := age.ParseX25519Recipient(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid age recipient %q: %w", key, err)
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
+
+// ParseIdentities 解析 age 私钥（AGE-SECRET-KEY-1...），格式与 age-keygen 生成的密钥文件相同
+func ParseIdentities(text string) ([]age.Identity, error) {
+	identities, err := age.ParseIdentities(strings.NewReader(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid age identity: %w", err)
+	}
+	return identities, nil
+}
+
+type identitiesKey struct{}
+
+// WithIdentities 返回携带 age 私钥的 context。私钥只在恢复时由用户提供，
+// 不会写入配置文件或保存在服务中。
+func WithIdentities(ctx context.Context, identities []age.Identity) context.Context {
+	return context.WithValue(ctx, identitiesKey{}, identities)
+}
+
+// identitiesFromContext 返回 context 中的 age 私钥
+func identitiesFromContext(ctx context.Context) []age.Identity {
+	identities, _ := ctx.Value(identitiesKey{}).([]age.Identity)
+	return identities
+}
+
+// isAgeEncrypted 判断数据是否以 age 文件头开头
+func isAgeEncrypted(header []byte) bool {
+	return bytes.HasPrefix(header, []byte(ageMagic))
+}
+
+// newAgeDecryptReader 使用 context 中的私钥解密 age 格式的备份
+func newAgeDecryptReader(ctx context.Context, r io.Reader) (io.Reader, error) {
+	identities := identitiesFromContext(ctx)
+	if len(identities) == 0 {
+		return nil, ErrNoIdentity
+	}
+
+	plain, err := age.Decrypt(r, identities...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt age backup: %w", err)
+	}
+	return plain, nil
+}
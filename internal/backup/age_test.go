@@ -0,0 +1,95 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+)
+
+func TestAgeBackupMultipleRecipients(t *testing.T) {
+	sourceDir := t.TempDir()
+	content := []byte("age encrypted vault data")
+	if err := os.WriteFile(filepath.Join(sourceDir, "config.json"), content, 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	alice, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 同时配置密码时，公钥加密优先
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: sourceDir,
+		Password:            "ignored",
+		Recipients:          []string{alice.Recipient().String(), "# offline key", "", bob.Recipient().String()},
+	})
+
+	data, filename := createBackupData(t, service)
+	if !strings.HasSuffix(filename, ".zip.age") {
+		t.Errorf("Expected .zip.age extension, got: %s", filename)
+	}
+	if !isAgeEncrypted(data) {
+		t.Fatal("Backup is not in age format")
+	}
+
+	// 没有私钥时无法恢复
+	err = service.ExtractBackup(context.Background(), bytes.NewReader(data), t.TempDir())
+	if !errors.Is(err, ErrNoIdentity) {
+		t.Fatalf("Expected ErrNoIdentity, got: %v", err)
+	}
+
+	// 任意一个接收者的私钥都可以独立恢复
+	for _, identity := range []*age.X25519Identity{alice, bob} {
+		identities, err := ParseIdentities(identity.String())
+		if err != nil {
+			t.Fatalf("Failed to parse identity: %v", err)
+		}
+		ctx := WithIdentities(context.Background(), identities)
+
+		destDir := t.TempDir()
+		if err := service.ExtractBackup(ctx, bytes.NewReader(data), destDir); err != nil {
+			t.Fatalf("Failed to extract age backup: %v", err)
+		}
+		restored, err := os.ReadFile(filepath.Join(destDir, "config.json"))
+		if err != nil {
+			t.Fatalf("Failed to read restored file: %v", err)
+		}
+		if !bytes.Equal(restored, content) {
+			t.Fatal("Restored content doesn't match original")
+		}
+	}
+
+	// 不在接收者列表中的私钥无法解密
+	other, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithIdentities(context.Background(), []age.Identity{other})
+	if err := service.ExtractBackup(ctx, bytes.NewReader(data), t.TempDir()); err == nil {
+		t.Fatal("Expected extraction with an unrelated identity to fail")
+	}
+}
+
+func TestInvalidRecipientFailsBackup(t *testing.T) {
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: t.TempDir(),
+		Password:            "password",
+		Recipients:          []string{"age1notarealkey"},
+	})
+
+	// 公钥无效时不能退化为密码加密
+	if _, err := service.PrepareBackup(context.Background()); err == nil {
+		t.Fatal("Expected backup with an invalid recipient to fail")
+	}
+}
@@ -1,36 +1,99 @@
 package backup
 
 import (
-	"archive/zip"
+	"bufio"
 	"bytes"
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
-	"strings"
+	"regexp"
+	"slices"
+	"sync"
 	"time"
 
+	"filippo.io/age"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/pbkdf2"
 )
 
 type Service struct {
+	// opts 为创建服务时的配置，用于为其他数据源创建服务，见 Options
+	opts BackupOptions
+
+	name                string
 	vaultwardenDataPath string
 	compressionLevel    int
 	password            string
+	kdf                 KDF
+	format              ArchiveFormat
 	logger              *zap.Logger
+
+	maxRestoreSize  int64
+	maxRestoreFiles int
+
+	integrityCheck IntegrityCheck
+
+	// recipients 非空时使用 age 公钥加密，服务本身不持有解密所需的私钥
+	recipients    []age.Recipient
+	recipientsErr error
+
+	mode      BackupType
+	fullEvery int
+	stateDir  string
+
+	mu      sync.RWMutex
+	filter  PathFilter
+	pending map[string]*Manifest
+	// stats 为已生成完毕的备份的压缩统计，FinishBackup 时清理
+	stats map[string]ArchiveStats
+	// sidecars 缓存已签名的 manifest 副本，多个存储上传同一备份时只需签名一次
+	sidecars map[string][]byte
 }
 
 type BackupOptions struct {
+	// Name 为数据源名称，非空时写入备份文件名，用于区分同一存储中不同实例的备份
+	Name                string
 	VaultwardenDataPath string
 	CompressionLevel    int
 	Password            string
-	Logger              *zap.Logger
+	// KDF 为加密新备份时使用的密钥派生算法，默认为 Argon2id
+	KDF KDF
+	// MaxRestoreSize 和 MaxRestoreFiles 为解压单个备份时允许写入的总字节数和文件数，
+	// 为 0 时使用 DefaultMaxRestoreSize 和 DefaultMaxRestoreFiles
+	MaxRestoreSize  int64
+	MaxRestoreFiles int
+	// IntegrityCheck 为创建备份前对数据库运行的完整性检查，默认为 quick
+	IntegrityCheck IntegrityCheck
+	// Recipients 为 age 公钥（age1...）。设置后备份使用公钥加密，优先于 Password，
+	// 恢复时需要提供对应的私钥
+	Recipients []string
+	Logger     *zap.Logger
+	// Format 为默认的归档格式，默认为 zip
+	Format ArchiveFormat
+	// Include/Exclude 为数据目录的 glob 规则，见 PathFilter
+	Include []string
+	Exclude []string
+	// Mode 为备份模式，默认为完整备份
+	Mode BackupType
+	// FullEvery 为增量/差异模式下每隔多少次备份做一次完整备份，0 表示只在没有基准时做完整备份
+	FullEvery int
+	// StateDir 保存上一次成功备份的 manifest；为空时增量/差异模式退化为完整备份
+	StateDir string
+}
+
+// sourceNamePattern 限制数据源名称。名称会写入备份文件名、存储中的目录和本地状态目录，
+// 因此不能包含路径分隔符，也不能以 "." 开头
+var sourceNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// ValidateSourceName 检查数据源名称能否安全地用作文件名和目录名
+func ValidateSourceName(name string) error {
+	if !sourceNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid source name %q: must start with a letter or digit and contain only letters, digits, '.', '_' or '-'", name)
+	}
+	return nil
 }
 
 func NewService(opts BackupOptions) *Service {
@@ -38,259 +101,755 @@ func NewService(opts BackupOptions) *Service {
 	if logger == nil {
 		logger = zap.NewNop() // Use no-op logger if none provided
 	}
+	mode := opts.Mode
+	switch mode {
+	case BackupTypeFull, BackupTypeIncremental, BackupTypeDifferential:
+	case "":
+		mode = BackupTypeFull
+	default:
+		logger.Warn("Unknown backup mode, using full backups", zap.String("mode", string(mode)))
+		mode = BackupTypeFull
+	}
+	compressionLevel, ok := normalizeCompressionLevel(opts.CompressionLevel)
+	if !ok {
+		logger.Warn("Invalid compression level, using default",
+			zap.Int("level", opts.CompressionLevel), zap.Int("default", DefaultCompressionLevel))
+	}
+	kdf, err := ParseKDF(string(opts.KDF))
+	if err != nil {
+		logger.Warn("Unknown kdf, using argon2id", zap.String("kdf", string(opts.KDF)))
+		kdf = KDFArgon2id
+	}
+	integrityCheck, err := ParseIntegrityCheck(string(opts.IntegrityCheck))
+	if err != nil {
+		logger.Warn("Unknown integrity check, using quick", zap.String("integrity_check", string(opts.IntegrityCheck)))
+		integrityCheck = IntegrityQuick
+	}
+	recipients, recipientsErr := ParseRecipients(opts.Recipients)
+	if recipientsErr != nil {
+		// 不能退化为不加密或密码加密，之后的备份都会失败
+		logger.Error("Invalid age recipients, backups will fail until fixed", zap.Error(recipientsErr))
+	}
+	format, err := ParseArchiveFormat(string(opts.Format))
+	if err != nil {
+		logger.Warn("Unknown archive format, using zip", zap.String("format", string(opts.Format)))
+		format = FormatZip
+	}
+	maxRestoreSize := opts.MaxRestoreSize
+	if maxRestoreSize <= 0 {
+		maxRestoreSize = DefaultMaxRestoreSize
+	}
+	maxRestoreFiles := opts.MaxRestoreFiles
+	if maxRestoreFiles <= 0 {
+		maxRestoreFiles = DefaultMaxRestoreFiles
+	}
 	return &Service{
+		opts:                opts,
+		name:                opts.Name,
 		vaultwardenDataPath: opts.VaultwardenDataPath,
-		compressionLevel:    opts.CompressionLevel,
+		compressionLevel:    compressionLevel,
 		password:            opts.Password,
+		kdf:                 kdf,
+		maxRestoreSize:      maxRestoreSize,
+		maxRestoreFiles:     maxRestoreFiles,
+		integrityCheck:      integrityCheck,
+		recipients:          recipients,
+		recipientsErr:       recipientsErr,
+		format:              format,
 		logger:              logger,
+		mode:                mode,
+		fullEvery:           opts.FullEvery,
+		stateDir:            opts.StateDir,
+		filter: PathFilter{
+			Include: opts.Include,
+			Exclude: opts.Exclude,
+		},
+		pending:  make(map[string]*Manifest),
+		stats:    make(map[string]ArchiveStats),
+		sidecars: make(map[string][]byte),
 	}
 }
 
-func (s *Service) CreateBackup(ctx context.Context) (io.Reader, string, error) {
-	timestamp := time.Now().Format("20060102-150405")
-	filename := fmt.Sprintf("vaultwarden-backup-%s.zip", timestamp)
+// KDF 返回从备份密码派生密钥使用的算法
+func (s *Service) KDF() KDF {
+	return s.kdf
+}
+
+// PathFilter 返回当前生效的 include/exclude 规则
+func (s *Service) PathFilter() PathFilter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return PathFilter{
+		Include: append([]string(nil), s.filter.Include...),
+		Exclude: append([]string(nil), s.filter.Exclude...),
+	}
+}
+
+// Options 返回创建服务时的配置，include/exclude 规则为当前生效的规则
+func (s *Service) Options() BackupOptions {
+	opts := s.opts
+	filter := s.PathFilter()
+	opts.Include = filter.Include
+	opts.Exclude = filter.Exclude
+	return opts
+}
+
+// SetPathFilter 更新 include/exclude 规则，对之后创建的备份生效
+func (s *Service) SetPathFilter(include, exclude []string) error {
+	if err := ValidatePatterns(include); err != nil {
+		return err
+	}
+	if err := ValidatePatterns(exclude); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.filter = PathFilter{Include: include, Exclude: exclude}
+	return nil
+}
+
+// Format 返回默认的归档格式
+func (s *Service) Format() ArchiveFormat {
+	return s.format
+}
+
+// PreparedBackup 为已经确定内容的一次备份，可以按不同的归档格式多次生成。
+// 各个格式的文件名共享同一个主干，增量备份链在不同格式之间保持一致。
+type PreparedBackup struct {
+	service *Service
+	plan    *backupPlan
+	stem    string
+	// integrity 为创建备份前数据库完整性检查的结果，见 Service.CheckDatabase
+	integrity string
+	changes   ChangeSummary
+	// fingerprint 为扫描时数据目录的指纹，见 Service.Fingerprint
+	fingerprint string
+	// vault 为快照中的数据条数，snapshot 为所有格式共用的数据库快照
+	vault    *VaultStats
+	snapshot string
+	cleanup  func()
+	// writers 为正在生成的归档，Close 等待它们结束后才删除快照
+	writers   sync.WaitGroup
+	closeOnce sync.Once
+}
 
-	s.logger.Info("Starting backup creation", zap.String("filename", filename))
+// IntegrityCheck 返回创建备份前数据库完整性检查的结果
+func (p *PreparedBackup) IntegrityCheck() string {
+	return p.integrity
+}
+
+// Fingerprint 返回扫描数据目录时计算的指纹
+func (p *PreparedBackup) Fingerprint() string {
+	return p.fingerprint
+}
+
+// Changes 返回本次备份的数据目录相对上一次成功备份的变化
+func (p *PreparedBackup) Changes() ChangeSummary {
+	return p.changes
+}
+
+// Vault 返回归档中数据库的数据条数，没有数据库或读取失败时返回 nil
+func (p *PreparedBackup) Vault() *VaultStats {
+	return p.vault
+}
+
+// Size 返回写入归档的文件的总大小。归档经过压缩和加密后通常不会超过该大小，
+// 可以用来估计生成归档所需的空间
+func (p *PreparedBackup) Size() int64 {
+	var size int64
+	for _, relPath := range p.plan.files {
+		if relPath == vaultwardenDBName && p.snapshot != "" {
+			if info, err := os.Stat(p.snapshot); err == nil {
+				size += info.Size()
+				continue
+			}
+		}
+		size += p.plan.manifest.Files[relPath].Size
+	}
+	return size
+}
+
+// Filename 返回指定格式的备份文件名，format 为空时使用默认格式
+func (p *PreparedBackup) Filename(format ArchiveFormat) string {
+	if format == "" {
+		format = p.service.format
+	}
+	return archiveFilename(p.stem, format, p.service.encryptionExt())
+}
+
+// Close 等待已打开的归档生成结束，然后删除数据库快照。
+// 调用方关闭 Open 返回的所有 reader 后必须调用 Close
+func (p *PreparedBackup) Close() error {
+	p.closeOnce.Do(func() {
+		p.writers.Wait()
+		p.cleanup()
+	})
+	return nil
+}
+
+// PrepareBackup 检查数据库的完整性，然后扫描数据目录并确定本次备份的类型和内容。
+// targets 为备份将要上传到的存储，只有它们都保存了同一个基准时才做增量/差异备份。
+// 数据库未通过检查时返回包含 ErrDatabaseCorrupt 的错误，不会生成备份。
+func (s *Service) PrepareBackup(ctx context.Context, targets ...string) (*PreparedBackup, error) {
+	if s.recipientsErr != nil {
+		return nil, s.recipientsErr
+	}
+	if s.name != "" {
+		if err := ValidateSourceName(s.name); err != nil {
+			return nil, err
+		}
+	}
 
 	// Check if data path exists
 	if _, err := os.Stat(s.vaultwardenDataPath); os.IsNotExist(err) {
 		s.logger.Error("Vaultwarden data path does not exist", zap.String("path", s.vaultwardenDataPath))
-		return nil, "", fmt.Errorf("vaultwarden data path does not exist: %s", s.vaultwardenDataPath)
+		return nil, fmt.Errorf("vaultwarden data path does not exist: %s", s.vaultwardenDataPath)
 	}
 
-	var buf bytes.Buffer
-
-	if err := s.createZipArchive(&buf); err != nil {
-		s.logger.Error("Failed to create zip archive", zap.Error(err))
-		return nil, "", fmt.Errorf("failed to create zip archive: %w", err)
+	integrity, err := s.CheckDatabase(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	data := buf.Bytes()
-	s.logger.Info("Backup archive created successfully", zap.Int("size_bytes", len(data)))
+	plan, err := s.planBackup(targets)
+	if err != nil {
+		return nil, err
+	}
 
-	if s.password != "" {
-		s.logger.Info("Encrypting backup with password")
-		encryptedData, err := s.encryptData(data)
+	// 先生成数据库的一致性快照，避免直接复制正在写入的 db.sqlite3。
+	// 各个归档格式共用同一个快照，内容和统计保持一致
+	snapshot, cleanup := "", func() {}
+	if slices.Contains(plan.files, vaultwardenDBName) {
+		snapshot, cleanup, err = s.takeDatabaseSnapshot(ctx)
 		if err != nil {
-			s.logger.Error("Failed to encrypt backup", zap.Error(err))
-			return nil, "", fmt.Errorf("failed to encrypt backup: %w", err)
+			return nil, fmt.Errorf("failed to snapshot database: %w", err)
 		}
-		filename = strings.Replace(filename, ".zip", ".enc", 1)
-		s.logger.Info("Backup encrypted successfully", zap.String("encrypted_filename", filename))
-		return bytes.NewReader(encryptedData), filename, nil
 	}
 
-	s.logger.Info("Backup created successfully", zap.String("filename", filename))
-	return bytes.NewReader(data), filename, nil
+	timestamp := time.Now().Format("20060102-150405")
+	if s.name != "" {
+		timestamp = s.name + "-" + timestamp
+	}
+	stem := fmt.Sprintf("vaultwarden-backup-%s", timestamp)
+	if plan.manifest.Type != BackupTypeFull {
+		stem = fmt.Sprintf("vaultwarden-backup-%s-%s", timestamp, plan.manifest.Type)
+	}
+
+	return &PreparedBackup{
+		service:     s,
+		plan:        plan,
+		stem:        stem,
+		integrity:   integrity,
+		changes:     s.summarizeChanges(plan.manifest),
+		fingerprint: s.fingerprint(plan.manifest.Include, plan.manifest.Exclude, plan.manifest.Files),
+		// 统计与归档中的数据库一致的数据条数，随 manifest 一起保存
+		vault:    s.vaultStats(ctx, snapshot),
+		snapshot: snapshot,
+		cleanup:  cleanup,
+	}, nil
 }
 
-func (s *Service) createZipArchive(w io.Writer) error {
-	zipWriter := zip.NewWriter(w)
-	defer zipWriter.Close()
+// CreateBackup 使用默认归档格式创建备份并以流的形式返回，见 PrepareBackup 和 PreparedBackup.Open。
+// 上传结束后调用方应调用 FinishBackup。
+func (s *Service) CreateBackup(ctx context.Context, targets ...string) (io.ReadCloser, string, error) {
+	prepared, err := s.PrepareBackup(ctx, targets...)
+	if err != nil {
+		return nil, "", err
+	}
 
-	s.logger.Debug("Creating zip archive from path", zap.String("path", s.vaultwardenDataPath))
-	filesAdded := 0
+	reader, filename, err := prepared.Open(ctx, "")
+	if err != nil {
+		prepared.Close()
+		return nil, "", err
+	}
+	return &preparedReader{ReadCloser: reader, prepared: prepared}, filename, nil
+}
 
-	err := filepath.Walk(s.vaultwardenDataPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			s.logger.Warn("Error accessing file during backup", zap.String("path", path), zap.Error(err))
-			return err
-		}
+// preparedReader 在关闭备份流时一并删除数据库快照
+type preparedReader struct {
+	io.ReadCloser
+	prepared *PreparedBackup
+}
 
-		if info.IsDir() {
-			return nil
-		}
+func (r *preparedReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.prepared.Close()
+	return err
+}
 
-		relPath, err := filepath.Rel(s.vaultwardenDataPath, path)
-		if err != nil {
-			s.logger.Error("Failed to get relative path", zap.String("path", path), zap.Error(err))
-			return err
-		}
+// Open 以指定格式生成备份并以流的形式返回，format 为空时使用默认格式。
+// 归档、压缩和加密在后台 goroutine 中边读边写完成，内存占用与数据目录大小无关；
+// 调用方读取完毕后必须关闭返回的 reader。
+func (p *PreparedBackup) Open(ctx context.Context, format ArchiveFormat) (io.ReadCloser, string, error) {
+	s := p.service
+	if format == "" {
+		format = s.format
+	}
+	if _, err := ParseArchiveFormat(string(format)); err != nil {
+		return nil, "", err
+	}
 
-		s.logger.Debug("Adding file to archive", zap.String("relative_path", relPath))
+	encExt := s.encryptionExt()
+	filename := p.Filename(format)
 
-		zipFile, err := zipWriter.Create(relPath)
+	// 每个格式使用独立的 manifest：基准备份文件名与本归档格式一致，
+	// 写入过程中消失的文件也只从本归档的 manifest 中移除
+	manifest := *p.plan.manifest
+	manifest.Files = maps.Clone(p.plan.manifest.Files)
+	if manifest.Base != "" {
+		manifest.Base = archiveFilename(archiveStem(manifest.Base), format, encExt)
+	}
+	manifest.Vault = p.vault
+	plan := &backupPlan{manifest: &manifest, files: p.plan.files, snapshot: p.snapshot}
+
+	s.logger.Info("Starting backup creation",
+		zap.String("filename", filename),
+		zap.String("format", string(format)),
+		zap.String("type", string(manifest.Type)),
+		zap.String("base", manifest.Base),
+		zap.Int("files", len(plan.files)),
+		zap.Int("deleted", len(manifest.Deleted)))
+
+	s.mu.Lock()
+	s.pending[filename] = plan.manifest
+	s.mu.Unlock()
+
+	pr, pw := io.Pipe()
+
+	p.writers.Add(1)
+	go func() {
+		defer p.writers.Done()
+		hash := sha256.New()
+		counter := &countingWriter{w: io.MultiWriter(pw, hash)}
+		stats, err := s.writeBackup(ctx, counter, plan, format)
 		if err != nil {
-			s.logger.Error("Failed to create zip file entry", zap.String("relative_path", relPath), zap.Error(err))
-			return err
+			s.logger.Error("Failed to create backup", zap.String("filename", filename), zap.Error(err))
+		} else {
+			stats.ArchiveSize = counter.n
+			stats.SHA256 = fmt.Sprintf("%x", hash.Sum(nil))
+			// 在关闭管道之前保存统计，读取方读到 EOF 后即可通过 ArchiveStats 获取
+			s.mu.Lock()
+			s.stats[filename] = stats
+			s.mu.Unlock()
+
+			s.logger.Info("Backup created successfully",
+				zap.String("filename", filename),
+				zap.Int64("original_size", stats.OriginalSize),
+				zap.Int64("archive_size", stats.ArchiveSize),
+				zap.Float64("ratio", stats.Ratio()))
 		}
+		pw.CloseWithError(err)
+	}()
 
-		file, err := os.Open(path)
-		if err != nil {
-			s.logger.Error("Failed to open file for reading", zap.String("path", path), zap.Error(err))
-			return err
-		}
-		defer file.Close()
+	return pr, filename, nil
+}
+
+// ArchiveStats 返回已生成完毕的备份的压缩统计
+func (s *Service) ArchiveStats(filename string) (ArchiveStats, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats, ok := s.stats[filename]
+	return stats, ok
+}
+
+// encryptionExt 返回备份文件名中表示加密方式的扩展名
+func (s *Service) encryptionExt() string {
+	switch {
+	case len(s.recipients) > 0:
+		return ageExt
+	case s.password != "":
+		return encryptedExt
+	default:
+		return ""
+	}
+}
+
+// writeBackup 将归档（按需加密）写入 w
+func (s *Service) writeBackup(ctx context.Context, w io.Writer, plan *backupPlan, format ArchiveFormat) (ArchiveStats, error) {
+	if len(s.recipients) > 0 {
+		s.logger.Info("Encrypting backup to age recipients", zap.Int("recipients", len(s.recipients)))
+		return s.writeEncrypted(ctx, w, plan, format, func(w io.Writer) (io.WriteCloser, error) {
+			return age.Encrypt(w, s.recipients...)
+		})
+	}
 
-		_, err = io.Copy(zipFile, file)
+	if s.password == "" {
+		stats, err := s.createArchive(ctx, w, plan, format)
 		if err != nil {
-			s.logger.Error("Failed to copy file to archive", zap.String("path", path), zap.Error(err))
-			return err
+			return stats, fmt.Errorf("failed to create %s archive: %w", format, err)
 		}
+		return stats, nil
+	}
 
-		filesAdded++
-		return nil
+	s.logger.Info("Encrypting backup with password")
+	return s.writeEncrypted(ctx, w, plan, format, func(w io.Writer) (io.WriteCloser, error) {
+		return newEncryptWriterWithKDF(w, s.password, s.kdf)
 	})
+}
 
+// writeEncrypted 将归档经 newWriter 创建的加密 writer 写入 w
+func (s *Service) writeEncrypted(ctx context.Context, w io.Writer, plan *backupPlan, format ArchiveFormat, newWriter func(io.Writer) (io.WriteCloser, error)) (ArchiveStats, error) {
+	encWriter, err := newWriter(w)
 	if err != nil {
-		return err
+		return ArchiveStats{}, fmt.Errorf("failed to encrypt backup: %w", err)
 	}
 
-	s.logger.Info("Archive creation completed", zap.Int("files_added", filesAdded))
-	return nil
-}
+	stats, err := s.createArchive(ctx, encWriter, plan, format)
+	if err != nil {
+		return stats, fmt.Errorf("failed to create %s archive: %w", format, err)
+	}
 
-func (s *Service) encryptData(data []byte) ([]byte, error) {
-	salt := make([]byte, 32)
-	if _, err := rand.Read(salt); err != nil {
-		return nil, err
+	if err := encWriter.Close(); err != nil {
+		return stats, fmt.Errorf("failed to encrypt backup: %w", err)
 	}
 
-	key := pbkdf2.Key([]byte(s.password), salt, 10000, 32, sha256.New)
+	return stats, nil
+}
 
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
+func (s *Service) createArchive(ctx context.Context, w io.Writer, plan *backupPlan, format ArchiveFormat) (ArchiveStats, error) {
+	stats := ArchiveStats{Vault: plan.manifest.Vault}
+	snapshotPath := plan.snapshot
 
-	gcm, err := cipher.NewGCM(block)
+	archive, err := newArchiveWriter(w, format, s.compressionLevel)
 	if err != nil {
-		return nil, err
+		return stats, err
 	}
 
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := rand.Read(nonce); err != nil {
-		return nil, err
+	s.logger.Debug("Creating archive from path",
+		zap.String("path", s.vaultwardenDataPath),
+		zap.String("format", string(format)),
+		zap.Int("compression_level", s.compressionLevel))
+
+	for _, relPath := range plan.files {
+		if err := ctx.Err(); err != nil {
+			archive.Close()
+			return stats, err
+		}
+
+		path := filepath.Join(s.vaultwardenDataPath, filepath.FromSlash(relPath))
+		if relPath == vaultwardenDBName && snapshotPath != "" {
+			path = snapshotPath
+		}
+
+		s.logger.Debug("Adding file to archive", zap.String("relative_path", relPath))
+
+		size, sum, err := addFileToArchive(archive, relPath, path)
+		if err != nil {
+			// 扫描之后被删除的文件（例如 Vaultwarden 清理的临时文件）不影响备份
+			if os.IsNotExist(err) {
+				s.logger.Warn("File disappeared during backup", zap.String("relative_path", relPath))
+				delete(plan.manifest.Files, relPath)
+				continue
+			}
+			s.logger.Error("Failed to add file to archive", zap.String("path", path), zap.Error(err))
+			archive.Close()
+			return stats, err
+		}
+
+		// 记录实际写入归档的内容，数据库为快照的大小和哈希
+		entry := plan.manifest.Files[relPath]
+		entry.Size = size
+		entry.SHA256 = sum
+		plan.manifest.Files[relPath] = entry
+
+		stats.Files++
+		stats.OriginalSize += size
 	}
 
-	ciphertext := gcm.Seal(nonce, nonce, data, nil)
+	if err := writeManifest(archive, plan.manifest); err != nil {
+		archive.Close()
+		return stats, fmt.Errorf("failed to write manifest: %w", err)
+	}
 
-	result := make([]byte, len(salt)+len(ciphertext))
-	copy(result, salt)
-	copy(result[len(salt):], ciphertext)
+	if err := archive.Close(); err != nil {
+		return stats, err
+	}
 
-	return result, nil
+	stats.StoredFiles = archive.storedFiles()
+	s.logger.Info("Archive creation completed",
+		zap.Int("files_added", stats.Files),
+		zap.Int("files_stored", stats.StoredFiles))
+	return stats, nil
 }
 
-func (s *Service) DecryptData(data []byte) ([]byte, error) {
-	if s.password == "" {
-		return nil, fmt.Errorf("no password set for decryption")
+// addFileToArchive 将 path 指向的文件以 name 写入归档，返回写入的字节数和内容的 SHA-256
+func addFileToArchive(archive archiveWriter, name, path string) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
 	}
+	defer file.Close()
 
-	if len(data) < 32 {
-		return nil, fmt.Errorf("encrypted data too short")
+	info, err := file.Stat()
+	if err != nil {
+		return 0, "", err
 	}
 
-	salt := data[:32]
-	ciphertext := data[32:]
+	hash := sha256.New()
+	counter := &countingWriter{w: hash}
+	if err := archive.addFile(name, info, io.TeeReader(file, counter)); err != nil {
+		return 0, "", err
+	}
+
+	return counter.n, fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
 
-	key := pbkdf2.Key([]byte(s.password), salt, 10000, 32, sha256.New)
+// encryptData 加密内存中的数据，输出格式与 CreateBackup 的流式加密一致
+func (s *Service) encryptData(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
 
-	block, err := aes.NewCipher(key)
+	encWriter, err := newEncryptWriterWithKDF(&buf, s.password, s.kdf)
 	if err != nil {
 		return nil, err
 	}
 
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
+	if _, err := encWriter.Write(data); err != nil {
 		return nil, err
 	}
 
-	nonceSize := gcm.NonceSize()
-	if len(ciphertext) < nonceSize {
-		return nil, fmt.Errorf("ciphertext too short")
+	if err := encWriter.Close(); err != nil {
+		return nil, err
 	}
 
-	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
-	return gcm.Open(nil, nonce, ciphertext, nil)
+	return buf.Bytes(), nil
 }
 
-func (s *Service) ExtractBackup(ctx context.Context, data io.Reader, destPath string) error {
-	zipData, err := io.ReadAll(data)
-	if err != nil {
-		return fmt.Errorf("failed to read backup data: %w", err)
+// DecryptData 解密内存中的数据，支持 v2/v1 流式加密格式和 v0 旧格式
+func (s *Service) DecryptData(data []byte) ([]byte, error) {
+	if s.password == "" {
+		return nil, errNoPassword
 	}
 
-	if s.password != "" && len(zipData) > 32 {
-		decryptedData, err := s.DecryptData(zipData)
+	if len(data) < 32 {
+		return nil, fmt.Errorf("encrypted data too short")
+	}
+
+	if isStreamEncrypted(data) {
+		reader, err := newDecryptReader(bytes.NewReader(data), s.password)
 		if err != nil {
-			return fmt.Errorf("failed to decrypt backup: %w", err)
+			return nil, err
 		}
-		zipData = decryptedData
+		return io.ReadAll(reader)
 	}
 
-	zipReader, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
+	return decryptLegacy(data, s.password)
+}
+
+// ExtractBackup 将备份解压到 destPath，归档格式根据内容自动识别。增量/差异备份需要
+// 按备份链顺序依次解压，解压时会删除 manifest 中记录为已删除的文件。
+func (s *Service) ExtractBackup(ctx context.Context, data io.Reader, destPath string) error {
+	plain, err := s.openBackupStream(ctx, data)
 	if err != nil {
-		return fmt.Errorf("failed to create zip reader: %w", err)
+		return err
 	}
 
-	for _, file := range zipReader.File {
-		if strings.Contains(file.Name, "..") {
-			continue
-		}
+	// 以 root 运行时恢复 tar 归档中记录的属主，例如 rsa_key.pem
+	restoreOwner := os.Geteuid() == 0
 
-		destFile := filepath.Join(destPath, file.Name)
+	destPath = filepath.Clean(destPath)
+	if err := os.MkdirAll(destPath, 0755); err != nil {
+		return fmt.Errorf("failed to create restore directory: %w", err)
+	}
 
-		if file.FileInfo().IsDir() {
-			if err := os.MkdirAll(destFile, file.FileInfo().Mode()); err != nil {
-				return err
-			}
-			continue
+	limits := &restoreLimits{maxSize: s.maxRestoreSize, maxFiles: s.maxRestoreFiles}
+
+	var manifest *Manifest
+	err = readArchive(plain, func(entry archiveEntry, r io.Reader) error {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
 
-		if err := os.MkdirAll(filepath.Dir(destFile), 0755); err != nil {
+		if entry.Name == ManifestName {
+			manifest, err = decodeManifest(r)
 			return err
 		}
 
-		rc, err := file.Open()
+		destFile, err := safeJoin(destPath, entry.Name)
 		if err != nil {
 			return err
 		}
+		if err := checkNoSymlinks(destPath, destFile); err != nil {
+			return err
+		}
+		if err := limits.addFile(); err != nil {
+			return err
+		}
 
-		outFile, err := os.Create(destFile)
-		if err != nil {
-			rc.Close()
+		if entry.IsDir {
+			return os.MkdirAll(destFile, 0755)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(destFile), 0755); err != nil {
 			return err
 		}
 
-		_, err = io.Copy(outFile, rc)
-		outFile.Close()
-		rc.Close()
+		if entry.IsSymlink {
+			// 只恢复仍指向目标目录之内的符号链接
+			if !symlinkInside(destPath, destFile, entry.Linkname) {
+				s.logger.Warn("Skipping symbolic link that points outside the restore directory",
+					zap.String("name", entry.Name), zap.String("target", entry.Linkname))
+				return nil
+			}
+			if err := os.Remove(destFile); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return os.Symlink(filepath.FromSlash(entry.Linkname), destFile)
+		}
 
+		limited, err := limits.reader(r, entry.Size)
 		if err != nil {
 			return err
 		}
+		if err := extractFile(destFile, entry, limited); err != nil {
+			return err
+		}
+
+		if restoreOwner && entry.HasOwner {
+			if err := os.Lchown(destFile, entry.Uid, entry.Gid); err != nil {
+				s.logger.Warn("Failed to restore file owner", zap.String("path", destFile), zap.Error(err))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if manifest != nil && manifest.Type != BackupTypeFull {
+		for _, name := range manifest.Deleted {
+			deleted, err := safeJoin(destPath, name)
+			if err != nil {
+				return err
+			}
+			if err := checkNoSymlinks(destPath, filepath.Dir(deleted)); err != nil {
+				return err
+			}
+			if err := os.Remove(deleted); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove deleted file %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// dosEpoch 之前的修改时间来自没有记录时间的旧版本 zip 条目，不应用到恢复的文件上
+var dosEpoch = time.Date(1980, 1, 2, 0, 0, 0, 0, time.UTC)
+
+// extractFile 写入单个文件并恢复权限和修改时间
+func extractFile(destFile string, entry archiveEntry, r io.Reader) error {
+	mode := entry.Mode
+	if mode == 0 {
+		mode = 0644
+	}
+
+	// 目标位置已有的符号链接不能被跟随写入，先删除再创建普通文件
+	if info, err := os.Lstat(destFile); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(destFile); err != nil {
+			return err
+		}
+	}
+
+	outFile, err := os.OpenFile(destFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(outFile, r)
+	if closeErr := outFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// 文件已存在时 OpenFile 不会修改权限
+	if err := os.Chmod(destFile, mode); err != nil {
+		return err
 	}
 
+	if entry.ModTime.After(dosEpoch) {
+		return os.Chtimes(destFile, entry.ModTime, entry.ModTime)
+	}
 	return nil
 }
 
+// openBackupStream 根据数据头判断备份是否加密，并返回明文归档流。
+// age 格式的备份使用 WithIdentities 放入 ctx 的私钥解密
+func (s *Service) openBackupStream(ctx context.Context, data io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(data)
+	header, err := br.Peek(len(ageMagic))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read backup data: %w", err)
+	}
+
+	switch {
+	case isAgeEncrypted(header):
+		return newAgeDecryptReader(ctx, br)
+
+	case isStreamEncrypted(header):
+		plain, err := newDecryptReader(br, s.password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt backup: %w", err)
+		}
+		return plain, nil
+
+	case bytes.HasPrefix(header, zipMagic) || bytes.HasPrefix(header, gzipMagic) ||
+		bytes.HasPrefix(header, zstdMagic) || s.password == "":
+		return br, nil
+
+	default:
+		// 旧版本加密格式无法流式解密，只能整体读入
+		encrypted, err := io.ReadAll(br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backup data: %w", err)
+		}
+		decrypted, err := s.DecryptData(encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt backup: %w", err)
+		}
+		return bytes.NewReader(decrypted), nil
+	}
+}
+
 // CalculateChecksum 计算备份数据的校验和以避免重复备份
 func (s *Service) CalculateChecksum(reader io.Reader) (string, error) {
-	data, err := io.ReadAll(reader)
-	if err != nil {
+	// 计算SHA256校验和
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
 		return "", fmt.Errorf("failed to read data for checksum: %w", err)
 	}
 
-	// 计算SHA256校验和
-	hash := sha256.Sum256(data)
-	return fmt.Sprintf("%x", hash), nil
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
 }
 
 // GetDataInfo 获取数据目录的信息用于比较
 func (s *Service) GetDataInfo() (map[string]time.Time, error) {
 	info := make(map[string]time.Time)
+	filter := s.PathFilter()
 
 	err := filepath.Walk(s.vaultwardenDataPath, func(path string, fileInfo os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		if !fileInfo.IsDir() {
-			relPath, err := filepath.Rel(s.vaultwardenDataPath, path)
-			if err != nil {
-				return err
+		relPath, err := filepath.Rel(s.vaultwardenDataPath, path)
+		if err != nil {
+			return err
+		}
+
+		if fileInfo.IsDir() {
+			if filter.SkipDir(relPath) {
+				return filepath.SkipDir
 			}
+			return nil
+		}
+
+		if filter.Match(relPath) {
 			info[relPath] = fileInfo.ModTime()
 		}
 
@@ -303,3 +862,69 @@ func (s *Service) GetDataInfo() (map[string]time.Time, error) {
 
 	return info, nil
 }
+
+// WalkFiles 按路径顺序遍历需要备份的文件，数据库使用一致性快照。
+// 供不生成归档的备份方式（例如去重仓库）使用。
+func (s *Service) WalkFiles(ctx context.Context, fn func(relPath string, info os.FileInfo, r io.Reader) error) error {
+	if _, err := os.Stat(s.vaultwardenDataPath); os.IsNotExist(err) {
+		return fmt.Errorf("vaultwarden data path does not exist: %s", s.vaultwardenDataPath)
+	}
+
+	files, err := s.scanDataDir(s.PathFilter())
+	if err != nil {
+		return err
+	}
+
+	snapshotPath := ""
+	if _, ok := files[vaultwardenDBName]; ok {
+		path, cleanup, err := s.takeDatabaseSnapshot(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to snapshot database: %w", err)
+		}
+		defer cleanup()
+		snapshotPath = path
+	}
+
+	names := make([]string, 0, len(files))
+	for relPath := range files {
+		names = append(names, relPath)
+	}
+	slices.Sort(names)
+
+	for _, relPath := range names {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		path := filepath.Join(s.vaultwardenDataPath, filepath.FromSlash(relPath))
+		if relPath == vaultwardenDBName && snapshotPath != "" {
+			path = snapshotPath
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				s.logger.Warn("File disappeared during backup", zap.String("relative_path", relPath))
+				continue
+			}
+			return err
+		}
+
+		err = walkFile(relPath, file, fn)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkFile(relPath string, file *os.File, fn func(relPath string, info os.FileInfo, r io.Reader) error) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	return fn(relPath, info, file)
+}
@@ -477,3 +477,31 @@ func TestEncryptLargeData(t *testing.T) {
 		t.Fatal("Large data encryption/decryption failed")
 	}
 }
+
+func TestCreateBackupWithSourceName(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tempDir, "test.txt"), []byte("test content"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: tempDir,
+		Exclude:             []string{"icon_cache/"},
+	})
+
+	opts := service.Options()
+	if len(opts.Exclude) != 1 || opts.Exclude[0] != "icon_cache/" {
+		t.Errorf("Expected options to carry the exclude rules, got: %v", opts.Exclude)
+	}
+
+	opts.Name = "family"
+	reader, filename, err := NewService(opts).CreateBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to create backup: %v", err)
+	}
+	reader.Close()
+
+	if !strings.HasPrefix(filename, "vaultwarden-backup-family-") {
+		t.Errorf("Expected the source name in the filename, got: %s", filename)
+	}
+}
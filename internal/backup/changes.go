@@ -0,0 +1,59 @@
+package backup
+
+import "go.uber.org/zap"
+
+// ChangeSummary 描述本次备份时的数据目录相对上一次成功备份的变化，用于发现异常的备份
+type ChangeSummary struct {
+	// Files 和 Size 为本次备份时数据目录中的文件数和总大小
+	Files int
+	Size  int64
+	// Compared 为上一次成功备份中的文件数（不含数据库），没有上一次备份的记录时为 0
+	Compared int
+	// Changed 和 Deleted 为上一次备份中大小或修改时间发生了变化、以及已被删除的文件数
+	Changed int
+	Deleted int
+}
+
+// ChangedRatio 返回上一次备份中发生变化或被删除的文件所占的比例，没有可比较的文件时返回 0
+func (c ChangeSummary) ChangedRatio() float64 {
+	if c.Compared == 0 {
+		return 0
+	}
+	return float64(c.Changed+c.Deleted) / float64(c.Compared)
+}
+
+// summarizeChanges 将 manifest 与状态目录中上一次成功备份的 manifest 比较。
+// 数据库每次备份都会变化，不计入比较。
+func (s *Service) summarizeChanges(manifest *Manifest) ChangeSummary {
+	var summary ChangeSummary
+	for _, entry := range manifest.Files {
+		summary.Files++
+		summary.Size += entry.Size
+	}
+
+	state, err := s.loadState()
+	if err != nil {
+		s.logger.Warn("Failed to load backup state, skipping change summary", zap.Error(err))
+		return summary
+	}
+	if state.Last == nil {
+		return summary
+	}
+
+	for relPath, prev := range state.Last.Manifest.Files {
+		if relPath == vaultwardenDBName {
+			continue
+		}
+		summary.Compared++
+
+		entry, ok := manifest.Files[relPath]
+		switch {
+		case !ok:
+			summary.Deleted++
+		case entry.Size != prev.Size || !entry.ModTime.Equal(prev.ModTime):
+			summary.Changed++
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,127 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"io"
+	"path"
+	"strings"
+)
+
+// DefaultCompressionLevel 为未配置或配置无效时使用的压缩级别
+const DefaultCompressionLevel = 6
+
+// sniffSize 为判断文件是否可压缩时读取的文件头大小
+const sniffSize = 64 * 1024
+
+// incompressibleRatio 试压缩后大小超过原始大小的该比例时，认为文件不可压缩
+const incompressibleRatio = 0.97
+
+// compressedExtensions 为本身已经压缩过的文件类型，写入 zip 时直接存储
+var compressedExtensions = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".webp": true, ".heic": true, ".avif": true,
+	".pdf": true,
+	".zip": true, ".gz": true, ".tgz": true, ".zst": true, ".xz": true, ".bz2": true, ".7z": true, ".rar": true,
+	".mp3": true, ".mp4": true, ".m4a": true, ".mov": true, ".webm": true, ".ogg": true,
+	".docx": true, ".xlsx": true, ".pptx": true, ".odt": true, ".ods": true, ".epub": true, ".apk": true,
+}
+
+// compressedMagics 为常见压缩格式的文件头，用于识别没有扩展名的文件
+var compressedMagics = [][]byte{
+	{0xff, 0xd8, 0xff},                 // JPEG
+	{0x89, 'P', 'N', 'G'},              // PNG
+	[]byte("GIF8"),                     // GIF
+	[]byte("%PDF"),                     // PDF
+	[]byte("PK\x03\x04"),               // zip 及 Office 文档
+	gzipMagic,                          // gzip
+	zstdMagic,                          // zstd
+	{0xfd, '7', 'z', 'X', 'Z'},         // xz
+	{'7', 'z', 0xbc, 0xaf, 0x27, 0x1c}, // 7z
+	[]byte("Rar!"),                     // rar
+}
+
+// normalizeCompressionLevel 将配置的压缩级别限制在 1-9，0 或无效值使用默认级别
+func normalizeCompressionLevel(level int) (int, bool) {
+	if level == 0 {
+		return DefaultCompressionLevel, true
+	}
+	if level < flate.BestSpeed || level > flate.BestCompression {
+		return DefaultCompressionLevel, false
+	}
+	return level, true
+}
+
+// shouldStore 判断文件是否应该不压缩直接存储：已压缩的文件类型、
+// 带有压缩格式文件头的文件，以及试压缩后几乎没有变小的文件（例如客户端加密的附件）
+func shouldStore(name string, head []byte) bool {
+	if compressedExtensions[strings.ToLower(path.Ext(name))] {
+		return true
+	}
+
+	for _, magic := range compressedMagics {
+		if bytes.HasPrefix(head, magic) {
+			return true
+		}
+	}
+
+	// 太小的文件压缩收益有限，交给 deflate 处理
+	if len(head) < 512 {
+		return false
+	}
+
+	var buf bytes.Buffer
+	fw, err := flate.NewWriter(&buf, flate.BestSpeed)
+	if err != nil {
+		return false
+	}
+	fw.Write(head)
+	fw.Close()
+
+	return float64(buf.Len()) >= float64(len(head))*incompressibleRatio
+}
+
+// peekHead 返回文件头和一个仍从头开始读取的 reader
+func peekHead(r io.Reader) ([]byte, io.Reader, error) {
+	br := bufio.NewReaderSize(r, sniffSize)
+	head, err := br.Peek(sniffSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, nil, err
+	}
+	return head, br, nil
+}
+
+// countingWriter 统计写入的字节数
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// ArchiveStats 为一次归档的压缩统计
+type ArchiveStats struct {
+	Files int
+	// OriginalSize 为写入归档的文件原始大小之和
+	OriginalSize int64
+	// ArchiveSize 为最终备份文件（压缩并加密后）的大小
+	ArchiveSize int64
+	// StoredFiles 为不压缩直接存储的文件数（仅 zip 格式）
+	StoredFiles int
+	// SHA256 为最终备份文件的哈希，用于校验上传后的对象
+	SHA256 string
+	// Vault 为备份中数据库的数据条数，没有数据库或统计失败时为 nil
+	Vault *VaultStats
+}
+
+// Ratio 返回归档大小与原始大小之比，原始大小为 0 时返回 0
+func (a ArchiveStats) Ratio() float64 {
+	if a.OriginalSize == 0 {
+		return 0
+	}
+	return float64(a.ArchiveSize) / float64(a.OriginalSize)
+}
@@ -0,0 +1,411 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// 加密格式：
+//
+//	v0: salt(32) || nonce(12) || ciphertext           整体 AES-GCM，PBKDF2 10000 次
+//	v1: "VWSE" || 1 || salt(32) || nonce prefix(7) || segment...
+//	v2: "VWSE" || 2 || header length(2) || header || segment...
+//
+// v2 的 header 描述解密所需的全部参数：
+//
+//	kdf id(1) || kdf params || salt length(1) || salt ||
+//	cipher id(1) || key id length(1) || key id || nonce prefix(7)
+//
+// 明文被切分为固定大小的分段，每段单独使用 AES-GCM 封装。分段 nonce 由
+// nonce prefix、4 字节计数器和 1 字节“最后一段”标志组成，因此分段被截断、
+// 重排或拼接都会导致解密失败。v2 的 header 作为每个分段的附加认证数据，
+// 篡改其中的参数同样会导致解密失败。v0 格式没有 magic，仍然可以通过 DecryptData 解密。
+const (
+	encMagic         = "VWSE"
+	encVersionStream = 1
+	encVersionHeader = 2
+
+	saltSize        = 32
+	noncePrefixSize = 7
+	segmentSize     = 64 * 1024
+	gcmTagSize      = 16
+
+	// pbkdf2Iterations 为 v0/v1 格式固定使用的迭代次数
+	pbkdf2Iterations = 10000
+	// keyIDSize 为 key id 的长度，key id 由密钥派生，用于在解密前识别密码错误
+	keyIDSize = 8
+)
+
+// cipherAES256GCM 为 v2 header 中的 cipher id：AES-256-GCM，64KiB 分段
+const cipherAES256GCM byte = 1
+
+var (
+	errNoPassword    = errors.New("no password set for decryption")
+	errWrongPassword = errors.New("wrong password for encrypted backup")
+)
+
+func deriveKey(password string, salt []byte) []byte {
+	return pbkdf2.Key([]byte(password), salt, pbkdf2Iterations, 32, sha256.New)
+}
+
+// keyID 返回密钥的标识。它由密钥经 HMAC 派生，不泄露密钥本身
+func keyID(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("vaultwarden-syncer key id"))
+	return mac.Sum(nil)[:keyIDSize]
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encHeader 为 v2 加密头部
+type encHeader struct {
+	kdf         kdfParams
+	cipher      byte
+	keyID       []byte
+	noncePrefix []byte
+}
+
+func (h *encHeader) marshal() []byte {
+	var buf bytes.Buffer
+	buf.Write(h.kdf.marshal())
+	buf.WriteByte(h.cipher)
+	buf.WriteByte(byte(len(h.keyID)))
+	buf.Write(h.keyID)
+	buf.Write(h.noncePrefix)
+	return buf.Bytes()
+}
+
+func parseEncHeader(data []byte) (*encHeader, error) {
+	r := bytes.NewReader(data)
+
+	kdf, err := parseKDFParams(r)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &encHeader{kdf: kdf}
+	if h.cipher, err = r.ReadByte(); err != nil {
+		return nil, errors.New("encryption header is truncated")
+	}
+	if h.cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("unsupported cipher: %d", h.cipher)
+	}
+
+	if h.keyID, err = readLengthPrefixed(r); err != nil {
+		return nil, err
+	}
+
+	h.noncePrefix = make([]byte, noncePrefixSize)
+	if _, err := io.ReadFull(r, h.noncePrefix); err != nil {
+		return nil, errors.New("encryption header is truncated")
+	}
+
+	if r.Len() != 0 {
+		return nil, errors.New("unexpected data in encryption header")
+	}
+
+	return h, nil
+}
+
+// readLengthPrefixed 读取 1 字节长度前缀的字段
+func readLengthPrefixed(r *bytes.Reader) ([]byte, error) {
+	n, err := r.ReadByte()
+	if err != nil {
+		return nil, errors.New("encryption header is truncated")
+	}
+
+	field := make([]byte, n)
+	if _, err := io.ReadFull(r, field); err != nil {
+		return nil, errors.New("encryption header is truncated")
+	}
+	return field, nil
+}
+
+// segmentNonce 构造第 counter 个分段的 nonce
+func segmentNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptWriter 以分段方式加密写入的数据，内存占用与备份大小无关
+type encryptWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	prefix  []byte
+	aad     []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// newEncryptWriter 使用默认 KDF（Argon2id）以 v2 格式加密
+func newEncryptWriter(w io.Writer, password string) (io.WriteCloser, error) {
+	return newEncryptWriterWithKDF(w, password, KDFArgon2id)
+}
+
+// newEncryptWriterWithKDF 使用指定 KDF 以 v2 格式加密
+func newEncryptWriterWithKDF(w io.Writer, password string, kdf KDF) (io.WriteCloser, error) {
+	params, err := newKDFParams(kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := params.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, err
+	}
+
+	header := (&encHeader{
+		kdf:         params,
+		cipher:      cipherAES256GCM,
+		keyID:       keyID(key),
+		noncePrefix: prefix,
+	}).marshal()
+
+	preamble := make([]byte, 0, len(encMagic)+3+len(header))
+	preamble = append(preamble, encMagic...)
+	preamble = append(preamble, encVersionHeader)
+	preamble = binary.BigEndian.AppendUint16(preamble, uint16(len(header)))
+	preamble = append(preamble, header...)
+	if _, err := w.Write(preamble); err != nil {
+		return nil, err
+	}
+
+	return &encryptWriter{
+		w:      w,
+		aead:   aead,
+		prefix: prefix,
+		aad:    header,
+		buf:    make([]byte, 0, segmentSize),
+	}, nil
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed encrypt writer")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		// 缓冲区已满且还有后续数据时，当前分段一定不是最后一段
+		if len(e.buf) == segmentSize {
+			if err := e.flush(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := copy(e.buf[len(e.buf):segmentSize], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+func (e *encryptWriter) flush(last bool) error {
+	nonce := segmentNonce(e.prefix, e.counter, last)
+	sealed := e.aead.Seal(nil, nonce, e.buf, e.aad)
+	if _, err := e.w.Write(sealed); err != nil {
+		return err
+	}
+
+	e.counter++
+	if e.counter == 0 {
+		return errors.New("too many segments")
+	}
+	e.buf = e.buf[:0]
+	return nil
+}
+
+// Close 写出最后一个分段，不会关闭底层 writer
+func (e *encryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.flush(true)
+}
+
+// decryptReader 逐段解密 encryptWriter 生成的数据
+type decryptReader struct {
+	r       *bufio.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	aad     []byte
+	counter uint32
+	segment []byte
+	plain   []byte
+	done    bool
+}
+
+// newDecryptReader 读取加密头部（v1 或 v2）并返回解密后的明文流
+func newDecryptReader(r io.Reader, password string) (io.Reader, error) {
+	if password == "" {
+		return nil, errNoPassword
+	}
+
+	br := bufio.NewReaderSize(r, segmentSize+gcmTagSize)
+
+	preamble := make([]byte, len(encMagic)+1)
+	if _, err := io.ReadFull(br, preamble); err != nil {
+		return nil, fmt.Errorf("failed to read encryption header: %w", err)
+	}
+
+	if string(preamble[:len(encMagic)]) != encMagic {
+		return nil, errors.New("not a stream encrypted backup")
+	}
+
+	d := &decryptReader{
+		r:       br,
+		segment: make([]byte, segmentSize+gcmTagSize),
+	}
+
+	var key []byte
+	switch version := preamble[len(encMagic)]; version {
+	case encVersionStream:
+		header := make([]byte, saltSize+noncePrefixSize)
+		if _, err := io.ReadFull(br, header); err != nil {
+			return nil, fmt.Errorf("failed to read encryption header: %w", err)
+		}
+		key = deriveKey(password, header[:saltSize])
+		d.prefix = header[saltSize:]
+
+	case encVersionHeader:
+		var length [2]byte
+		if _, err := io.ReadFull(br, length[:]); err != nil {
+			return nil, fmt.Errorf("failed to read encryption header: %w", err)
+		}
+		raw := make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(br, raw); err != nil {
+			return nil, fmt.Errorf("failed to read encryption header: %w", err)
+		}
+
+		header, err := parseEncHeader(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if key, err = header.kdf.deriveKey(password); err != nil {
+			return nil, err
+		}
+		if !hmac.Equal(keyID(key), header.keyID) {
+			return nil, errWrongPassword
+		}
+		d.prefix = header.noncePrefix
+		d.aad = raw
+
+	default:
+		return nil, fmt.Errorf("unsupported encryption format version: %d", version)
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	d.aead = aead
+
+	return d, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *decryptReader) next() error {
+	n, err := io.ReadFull(d.r, d.segment)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		if err == io.EOF {
+			return errors.New("encrypted backup is truncated")
+		}
+		return err
+	}
+
+	last := false
+	if n < len(d.segment) {
+		last = true
+	} else if _, err := d.r.Peek(1); err == io.EOF {
+		last = true
+	}
+
+	plain, err := d.aead.Open(d.segment[:0], segmentNonce(d.prefix, d.counter, last), d.segment[:n], d.aad)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt segment %d: %w", d.counter, err)
+	}
+
+	d.counter++
+	d.plain = plain
+	d.done = last
+	return nil
+}
+
+// isStreamEncrypted 判断数据是否以流式加密头开头
+func isStreamEncrypted(header []byte) bool {
+	return bytes.HasPrefix(header, []byte(encMagic))
+}
+
+// decryptLegacy 解密 v0 格式：salt || nonce || ciphertext
+func decryptLegacy(data []byte, password string) ([]byte, error) {
+	if len(data) < saltSize {
+		return nil, fmt.Errorf("encrypted data too short")
+	}
+
+	salt := data[:saltSize]
+	ciphertext := data[saltSize:]
+
+	gcm, err := newGCM(deriveKey(password, salt))
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
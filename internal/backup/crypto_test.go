@@ -0,0 +1,215 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestStreamEncryptionSegmentBoundaries(t *testing.T) {
+	sizes := []int{0, 1, segmentSize - 1, segmentSize, segmentSize + 1, 3*segmentSize + 17}
+
+	for _, size := range sizes {
+		data := make([]byte, size)
+		if _, err := rand.Read(data); err != nil {
+			t.Fatalf("Failed to generate data: %v", err)
+		}
+
+		var encrypted bytes.Buffer
+		w, err := newEncryptWriter(&encrypted, "test-password")
+		if err != nil {
+			t.Fatalf("Failed to create encrypt writer: %v", err)
+		}
+		if _, err := w.Write(data); err != nil {
+			t.Fatalf("Failed to write data: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Failed to close encrypt writer: %v", err)
+		}
+
+		r, err := newDecryptReader(bytes.NewReader(encrypted.Bytes()), "test-password")
+		if err != nil {
+			t.Fatalf("Failed to create decrypt reader: %v", err)
+		}
+		decrypted, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("Failed to decrypt %d bytes: %v", size, err)
+		}
+
+		if !bytes.Equal(data, decrypted) {
+			t.Errorf("Decrypted data mismatch for size %d", size)
+		}
+	}
+}
+
+func TestStreamEncryptionTruncated(t *testing.T) {
+	data := make([]byte, 2*segmentSize+100)
+
+	service := NewService(BackupOptions{
+		Password: "test-password",
+	})
+
+	encrypted, err := service.encryptData(data)
+	if err != nil {
+		t.Fatalf("Failed to encrypt data: %v", err)
+	}
+
+	// 在分段边界处截断，缺少最后一段
+	truncated := encrypted[:len(encrypted)-(100+gcmTagSize)]
+
+	if _, err := service.DecryptData(truncated); err == nil {
+		t.Fatal("Expected error when decrypting truncated data")
+	}
+}
+
+func TestDecryptLegacyFormat(t *testing.T) {
+	password := "test-password"
+	data := []byte("legacy backup content")
+
+	// 旧版本格式：salt || nonce || ciphertext
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		t.Fatalf("Failed to generate salt: %v", err)
+	}
+	gcm, err := newGCM(deriveKey(password, salt))
+	if err != nil {
+		t.Fatalf("Failed to create cipher: %v", err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatalf("Failed to generate nonce: %v", err)
+	}
+	legacy := append(salt, gcm.Seal(nonce, nonce, data, nil)...)
+
+	service := NewService(BackupOptions{
+		Password: password,
+	})
+
+	decrypted, err := service.DecryptData(legacy)
+	if err != nil {
+		t.Fatalf("Failed to decrypt legacy data: %v", err)
+	}
+
+	if !bytes.Equal(data, decrypted) {
+		t.Errorf("Decrypted data mismatch. Expected: %s, Got: %s", data, decrypted)
+	}
+}
+
+// encryptV1 以 v1 格式加密，用于验证旧版本流式备份仍然可以解密
+func encryptV1(t *testing.T, data []byte, password string) []byte {
+	t.Helper()
+
+	salt := make([]byte, saltSize)
+	prefix := make([]byte, noncePrefixSize)
+	rand.Read(salt)
+	rand.Read(prefix)
+
+	aead, err := newGCM(deriveKey(password, salt))
+	if err != nil {
+		t.Fatalf("Failed to create cipher: %v", err)
+	}
+
+	out := append([]byte(encMagic), encVersionStream)
+	out = append(out, salt...)
+	out = append(out, prefix...)
+
+	var counter uint32
+	for {
+		n := min(len(data), segmentSize)
+		last := n == len(data)
+		out = aead.Seal(out, segmentNonce(prefix, counter, last), data[:n], nil)
+		data = data[n:]
+		counter++
+		if last {
+			return out
+		}
+	}
+}
+
+func TestDecryptStreamV1Format(t *testing.T) {
+	data := make([]byte, segmentSize+10)
+	rand.Read(data)
+
+	service := NewService(BackupOptions{Password: "test-password"})
+
+	decrypted, err := service.DecryptData(encryptV1(t, data, "test-password"))
+	if err != nil {
+		t.Fatalf("Failed to decrypt v1 data: %v", err)
+	}
+	if !bytes.Equal(data, decrypted) {
+		t.Error("Decrypted v1 data mismatch")
+	}
+}
+
+func TestEncryptionHeaderV2(t *testing.T) {
+	for _, kdf := range []KDF{KDFArgon2id, KDFPBKDF2} {
+		service := NewService(BackupOptions{Password: "test-password", KDF: kdf})
+
+		encrypted, err := service.encryptData([]byte("secret"))
+		if err != nil {
+			t.Fatalf("%s: failed to encrypt: %v", kdf, err)
+		}
+
+		if string(encrypted[:len(encMagic)]) != encMagic || encrypted[len(encMagic)] != encVersionHeader {
+			t.Fatalf("%s: unexpected preamble %x", kdf, encrypted[:len(encMagic)+1])
+		}
+
+		headerLen := int(encrypted[len(encMagic)+1])<<8 | int(encrypted[len(encMagic)+2])
+		header, err := parseEncHeader(encrypted[len(encMagic)+3 : len(encMagic)+3+headerLen])
+		if err != nil {
+			t.Fatalf("%s: failed to parse header: %v", kdf, err)
+		}
+
+		expectedID := kdfIDArgon2id
+		if kdf == KDFPBKDF2 {
+			expectedID = kdfIDPBKDF2SHA256
+		}
+		if header.kdf.id != expectedID || header.cipher != cipherAES256GCM || len(header.keyID) != keyIDSize {
+			t.Errorf("%s: unexpected header %+v", kdf, header)
+		}
+
+		decrypted, err := service.DecryptData(encrypted)
+		if err != nil || string(decrypted) != "secret" {
+			t.Errorf("%s: failed to decrypt: %q, %v", kdf, decrypted, err)
+		}
+
+		wrong := NewService(BackupOptions{Password: "wrong-password"})
+		if _, err := wrong.DecryptData(encrypted); !errors.Is(err, errWrongPassword) {
+			t.Errorf("%s: expected errWrongPassword, got %v", kdf, err)
+		}
+	}
+}
+
+func TestEncryptionHeaderIsAuthenticated(t *testing.T) {
+	service := NewService(BackupOptions{Password: "test-password", KDF: KDFPBKDF2})
+
+	encrypted, err := service.encryptData([]byte("secret"))
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	// 修改 nonce prefix（header 的最后一个字节），key id 仍然匹配，但分段认证失败
+	headerLen := int(encrypted[len(encMagic)+1])<<8 | int(encrypted[len(encMagic)+2])
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(encMagic)+3+headerLen-1] ^= 0xff
+
+	if _, err := service.DecryptData(tampered); err == nil {
+		t.Error("Expected error when decrypting data with a tampered header")
+	}
+}
+
+func TestParseKDFParamsRejectsExcessiveCost(t *testing.T) {
+	params := kdfParams{
+		id:         kdfIDArgon2id,
+		iterations: 1,
+		memoryKiB:  maxArgon2MemoryKiB + 1,
+		threads:    1,
+		salt:       []byte("salt"),
+	}
+
+	if _, err := parseKDFParams(bytes.NewReader(params.marshal())); err == nil {
+		t.Error("Expected error for argon2id memory above the limit")
+	}
+}
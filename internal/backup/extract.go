@@ -0,0 +1,125 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// 恢复时默认的解压上限，防止损坏或恶意的备份（解压炸弹）耗尽磁盘
+const (
+	DefaultMaxRestoreSize  int64 = 64 << 30
+	DefaultMaxRestoreFiles       = 1000000
+)
+
+// maxLinkTarget 为符号链接目标的最大长度
+const maxLinkTarget = 4096
+
+// ErrUnsafePath 表示归档中的条目会被写到目标目录之外
+var ErrUnsafePath = errors.New("unsafe path in backup")
+
+// ErrRestoreLimit 表示备份解压后的大小或文件数超过了上限
+var ErrRestoreLimit = errors.New("backup exceeds restore limits")
+
+// safeJoin 将归档中的条目名拼接到 root 下。条目名必须是以 "/" 分隔的相对路径：
+// 绝对路径、盘符、反斜杠、NUL 以及会离开 root 的 ".." 都会被拒绝。
+func safeJoin(root, name string) (string, error) {
+	if name == "" ||
+		strings.ContainsAny(name, "\\\x00") ||
+		path.IsAbs(name) ||
+		(len(name) >= 2 && name[1] == ':') {
+		return "", fmt.Errorf("%w: %q", ErrUnsafePath, name)
+	}
+
+	local := filepath.FromSlash(strings.TrimSuffix(name, "/"))
+	if !filepath.IsLocal(local) {
+		return "", fmt.Errorf("%w: %q", ErrUnsafePath, name)
+	}
+
+	return filepath.Join(root, local), nil
+}
+
+// checkNoSymlinks 确认 root 与 target 之间的已有路径中没有符号链接，
+// 防止通过目标目录中已存在的链接把文件写到目录之外
+func checkNoSymlinks(root, target string) error {
+	rel, err := filepath.Rel(root, target)
+	if err != nil {
+		return err
+	}
+
+	current := root
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		if part == "." {
+			continue
+		}
+		current = filepath.Join(current, part)
+
+		info, err := os.Lstat(current)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("%w: %s is a symbolic link", ErrUnsafePath, current)
+		}
+	}
+	return nil
+}
+
+// symlinkInside 判断位于 linkPath 的符号链接指向 target 时是否仍在 root 之内
+func symlinkInside(root, linkPath, target string) bool {
+	if target == "" || filepath.IsAbs(target) || strings.ContainsAny(target, "\\\x00") {
+		return false
+	}
+
+	resolved := filepath.Join(filepath.Dir(linkPath), filepath.FromSlash(target))
+	rel, err := filepath.Rel(root, resolved)
+	return err == nil && filepath.IsLocal(rel)
+}
+
+// restoreLimits 统计一次解压已写入的文件数和字节数
+type restoreLimits struct {
+	maxSize  int64
+	maxFiles int
+	size     int64
+	files    int
+}
+
+// addFile 记录一个文件，超过文件数上限时返回错误
+func (l *restoreLimits) addFile() error {
+	l.files++
+	if l.files > l.maxFiles {
+		return fmt.Errorf("%w: more than %d files", ErrRestoreLimit, l.maxFiles)
+	}
+	return nil
+}
+
+// reader 返回最多还能读取剩余配额的 reader。声明的大小已经超出配额时直接返回错误
+func (l *restoreLimits) reader(r io.Reader, declared int64) (io.Reader, error) {
+	remaining := l.maxSize - l.size
+	if declared > remaining {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrRestoreLimit, l.maxSize)
+	}
+	return &limitedReader{r: r, limits: l}, nil
+}
+
+// limitedReader 在读取时累计字节数，超过配额时返回 ErrRestoreLimit
+type limitedReader struct {
+	r      io.Reader
+	limits *restoreLimits
+}
+
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	n, err := lr.r.Read(p)
+	lr.limits.size += int64(n)
+	if lr.limits.size > lr.limits.maxSize {
+		return n, fmt.Errorf("%w: more than %d bytes", ErrRestoreLimit, lr.limits.maxSize)
+	}
+	return n, err
+}
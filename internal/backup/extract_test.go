@@ -0,0 +1,144 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// tarEntry 为构造测试归档使用的条目
+type tarEntry struct {
+	name     string
+	content  string
+	linkname string
+}
+
+func buildTarGz(t *testing.T, entries ...tarEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0600, Size: int64(len(entry.content)), Typeflag: tar.TypeReg}
+		if entry.linkname != "" {
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = entry.linkname
+			header.Size = 0
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSafeJoin(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "data")
+
+	valid := []string{"db.sqlite3", "attachments/a/1", "attachments/", "./config.json"}
+	for _, name := range valid {
+		if _, err := safeJoin(root, name); err != nil {
+			t.Errorf("safeJoin(%q) returned error: %v", name, err)
+		}
+	}
+
+	invalid := []string{"", "../escape", "attachments/../../escape", "/etc/passwd", `..\escape`, `attachments\..\..\escape`, "C:/Windows", "c:escape", "a\x00b", ".."}
+	for _, name := range invalid {
+		if _, err := safeJoin(root, name); !errors.Is(err, ErrUnsafePath) {
+			t.Errorf("safeJoin(%q) = %v, want ErrUnsafePath", name, err)
+		}
+	}
+}
+
+func TestExtractRejectsPathTraversal(t *testing.T) {
+	service := NewService(BackupOptions{})
+	parent := t.TempDir()
+	destDir := filepath.Join(parent, "data")
+
+	for _, name := range []string{"../escape", "/tmp/escape", `..\escape`} {
+		data := buildTarGz(t, tarEntry{name: name, content: "owned"})
+		err := service.ExtractBackup(context.Background(), bytes.NewReader(data), destDir)
+		if !errors.Is(err, ErrUnsafePath) {
+			t.Errorf("%q: expected ErrUnsafePath, got: %v", name, err)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(parent, "escape")); !os.IsNotExist(err) {
+		t.Error("File was written outside the restore directory")
+	}
+}
+
+func TestExtractSymlinks(t *testing.T) {
+	service := NewService(BackupOptions{})
+	destDir := t.TempDir()
+
+	data := buildTarGz(t,
+		tarEntry{name: "rsa_key.pem", content: "key"},
+		tarEntry{name: "inside", linkname: "rsa_key.pem"},
+		tarEntry{name: "outside", linkname: "../../etc/passwd"},
+		tarEntry{name: "absolute", linkname: "/etc/passwd"},
+	)
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), destDir); err != nil {
+		t.Fatalf("Failed to extract backup: %v", err)
+	}
+
+	if target, err := os.Readlink(filepath.Join(destDir, "inside")); err != nil || target != "rsa_key.pem" {
+		t.Errorf("Expected symlink inside the target to be restored, got %q, %v", target, err)
+	}
+	for _, name := range []string{"outside", "absolute"} {
+		if _, err := os.Lstat(filepath.Join(destDir, name)); !os.IsNotExist(err) {
+			t.Errorf("Expected symlink %s pointing outside the target to be skipped", name)
+		}
+	}
+
+	// 目标目录中已有的链接不能被用来把文件写到目录之外
+	outside := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(destDir, "attachments")); err != nil {
+		t.Fatal(err)
+	}
+	data = buildTarGz(t, tarEntry{name: "attachments/evil", content: "owned"})
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), destDir); !errors.Is(err, ErrUnsafePath) {
+		t.Errorf("Expected ErrUnsafePath when writing through a symlink, got: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outside, "evil")); !os.IsNotExist(err) {
+		t.Error("File was written through a symlink")
+	}
+}
+
+func TestExtractLimits(t *testing.T) {
+	data := buildTarGz(t,
+		tarEntry{name: "a", content: strings.Repeat("a", 600)},
+		tarEntry{name: "b", content: strings.Repeat("b", 600)},
+	)
+
+	service := NewService(BackupOptions{MaxRestoreSize: 1000})
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), t.TempDir()); !errors.Is(err, ErrRestoreLimit) {
+		t.Errorf("Expected ErrRestoreLimit for size, got: %v", err)
+	}
+
+	service = NewService(BackupOptions{MaxRestoreFiles: 1})
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), t.TempDir()); !errors.Is(err, ErrRestoreLimit) {
+		t.Errorf("Expected ErrRestoreLimit for file count, got: %v", err)
+	}
+
+	service = NewService(BackupOptions{MaxRestoreSize: 1200, MaxRestoreFiles: 2})
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), t.TempDir()); err != nil {
+		t.Errorf("Expected extraction within limits to succeed, got: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package backup
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// PathFilter 根据 include/exclude 规则决定数据目录中哪些文件需要备份。
+//
+// 规则使用 path.Match 语法，匹配相对于数据目录、以 "/" 分隔的路径：
+//   - 以 "/" 结尾的规则只匹配目录，目录下的所有文件都会被匹配，例如 "icon_cache/"
+//   - 不包含 "/" 的规则匹配任意一级路径名，例如 "rsa_key*"、"config.json"
+//   - 包含 "/" 的规则从数据目录根开始匹配，例如 "attachments/*"
+//
+// Include 为空时包含所有文件；Exclude 优先于 Include。
+type PathFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// ValidatePatterns 检查规则是否为合法的 glob 表达式
+func ValidatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("empty path pattern")
+		}
+		if _, err := path.Match(strings.TrimSuffix(pattern, "/"), ""); err != nil {
+			return fmt.Errorf("invalid path pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// IsEmpty 判断是否没有配置任何规则
+func (f PathFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Match 判断文件 relPath 是否需要备份
+func (f PathFilter) Match(relPath string) bool {
+	relPath = filepath.ToSlash(relPath)
+
+	for _, pattern := range f.Exclude {
+		if matchPattern(pattern, relPath, false) {
+			return false
+		}
+	}
+
+	if len(f.Include) == 0 {
+		return true
+	}
+
+	for _, pattern := range f.Include {
+		if matchPattern(pattern, relPath, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SkipDir 判断目录 relDir 是否被整体排除，用于在遍历时跳过整个目录
+func (f PathFilter) SkipDir(relDir string) bool {
+	relDir = filepath.ToSlash(relDir)
+	if relDir == "." {
+		return false
+	}
+
+	for _, pattern := range f.Exclude {
+		if matchPattern(pattern, relDir, true) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPattern 判断 relPath 或其任意上级目录是否匹配 pattern
+func matchPattern(pattern, relPath string, isDir bool) bool {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+
+	elems := strings.Split(relPath, "/")
+	for i := range elems {
+		last := i == len(elems)-1
+		// 只匹配目录的规则不能匹配文件本身
+		if dirOnly && last && !isDir {
+			continue
+		}
+
+		candidate := elems[i]
+		if anchored {
+			candidate = strings.Join(elems[:i+1], "/")
+		}
+
+		if ok, _ := path.Match(pattern, candidate); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPathFilterMatch(t *testing.T) {
+	filter := PathFilter{
+		Include: []string{"attachments/", "sends/", "rsa_key*", "config.json", "db.sqlite3"},
+		Exclude: []string{"icon_cache/", "tmp/", "*.bak"},
+	}
+
+	tests := map[string]bool{
+		"db.sqlite3":               true,
+		"rsa_key.pem":              true,
+		"rsa_key.pub.pem":          true,
+		"config.json":              true,
+		"attachments/abc/file.bin": true,
+		"sends/xyz/file.bin":       true,
+		"icon_cache/example.png":   false,
+		"tmp/upload":               false,
+		"attachments/old.bak":      false,
+		"other.txt":                false,
+	}
+
+	for relPath, expected := range tests {
+		if got := filter.Match(relPath); got != expected {
+			t.Errorf("Match(%q) = %v, want %v", relPath, got, expected)
+		}
+	}
+}
+
+func TestPathFilterEmptyIncludesEverything(t *testing.T) {
+	filter := PathFilter{Exclude: []string{"icon_cache/"}}
+
+	if !filter.Match("anything/at/all.txt") {
+		t.Error("Expected file to be included when no include rules are set")
+	}
+	if filter.Match("icon_cache/a.png") {
+		t.Error("Expected icon_cache to be excluded")
+	}
+	if !filter.SkipDir("icon_cache") {
+		t.Error("Expected icon_cache directory to be skipped")
+	}
+	if filter.SkipDir("attachments") {
+		t.Error("Did not expect attachments directory to be skipped")
+	}
+}
+
+func TestPathFilterAnchoredPattern(t *testing.T) {
+	filter := PathFilter{Exclude: []string{"attachments/tmp*"}}
+
+	if filter.Match("attachments/tmp1/file") {
+		t.Error("Expected anchored pattern to exclude attachments/tmp1")
+	}
+	if !filter.Match("sends/tmp1/file") {
+		t.Error("Anchored pattern should not match other directories")
+	}
+}
+
+func TestValidatePatterns(t *testing.T) {
+	if err := ValidatePatterns([]string{"icon_cache/", "rsa_key*"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := ValidatePatterns([]string{"[invalid"}); err == nil {
+		t.Error("Expected error for invalid pattern")
+	}
+	if err := ValidatePatterns([]string{" "}); err == nil {
+		t.Error("Expected error for empty pattern")
+	}
+}
+
+func TestCreateBackupAppliesPathFilter(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"config.json":            "{}",
+		"rsa_key.pem":            "key",
+		"attachments/a/file.bin": "attachment",
+		"icon_cache/example.png": "icon",
+		"tmp/upload":             "tmp",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: tempDir,
+		Exclude:             []string{"icon_cache/", "tmp/"},
+	})
+
+	reader, _, err := service.CreateBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to create backup: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Failed to read backup data: %v", err)
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Failed to create zip reader: %v", err)
+	}
+
+	archived := make(map[string]*zip.File)
+	for _, file := range zipReader.File {
+		archived[file.Name] = file
+	}
+
+	for _, name := range []string{"config.json", "rsa_key.pem", "attachments/a/file.bin"} {
+		if archived[name] == nil {
+			t.Errorf("Expected %s in archive", name)
+		}
+	}
+	for _, name := range []string{"icon_cache/example.png", "tmp/upload"} {
+		if archived[name] != nil {
+			t.Errorf("Did not expect %s in archive", name)
+		}
+	}
+
+	manifestFile := archived[ManifestName]
+	if manifestFile == nil {
+		t.Fatal("Expected manifest in archive")
+	}
+	rc, err := manifestFile.Open()
+	if err != nil {
+		t.Fatalf("Failed to open manifest: %v", err)
+	}
+	defer rc.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(rc).Decode(&manifest); err != nil {
+		t.Fatalf("Failed to decode manifest: %v", err)
+	}
+	if len(manifest.Exclude) != 2 || manifest.Exclude[0] != "icon_cache/" {
+		t.Errorf("Unexpected exclude rules in manifest: %v", manifest.Exclude)
+	}
+
+	info, err := service.GetDataInfo()
+	if err != nil {
+		t.Fatalf("Failed to get data info: %v", err)
+	}
+	if len(info) != 3 {
+		t.Errorf("Expected 3 files in data info, got %d", len(info))
+	}
+}
+
+func TestValidateSourceName(t *testing.T) {
+	for _, name := range []string{"family", "vw-2", "team_a.prod"} {
+		if err := ValidateSourceName(name); err != nil {
+			t.Errorf("Expected %q to be valid, got: %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"", ".", "..", "../etc", "a/b", `a\b`, ".hidden", "-flag", "a b", strings.Repeat("a", 65)} {
+		if err := ValidateSourceName(name); err == nil {
+			t.Errorf("Expected %q to be rejected", name)
+		}
+	}
+}
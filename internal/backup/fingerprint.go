@@ -0,0 +1,48 @@
+package backup
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Fingerprint 返回数据目录当前状态的指纹，由 include/exclude 规则以及每个文件的路径、大小、权限和
+// 修改时间计算得到。指纹与上一次成功备份时相同，说明数据没有变化，可以跳过本次备份。
+func (s *Service) Fingerprint() (string, error) {
+	filter := s.PathFilter()
+	files, err := s.scanDataDir(filter)
+	if err != nil {
+		return "", err
+	}
+
+	return s.fingerprint(filter.Include, filter.Exclude, files), nil
+}
+
+// fingerprint 计算文件列表的指纹。数据库的改动可能只存在于 WAL 中，主文件的大小和修改时间不一定变化，
+// 因此同时计入 WAL 文件；SHM 文件在只读访问时也会被修改，不计入。
+func (s *Service) fingerprint(include, exclude []string, files map[string]FileEntry) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "include=%q\nexclude=%q\n", include, exclude)
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		entry := files[name]
+		fmt.Fprintf(hash, "%s\x00%d\x00%o\x00%d\n", name, entry.Size, entry.Mode, entry.ModTime.UnixNano())
+	}
+
+	if _, ok := files[vaultwardenDBName]; ok {
+		wal := vaultwardenDBName + "-wal"
+		if info, err := os.Stat(filepath.Join(s.vaultwardenDataPath, wal)); err == nil {
+			fmt.Fprintf(hash, "%s\x00%d\x00%d\n", wal, info.Size(), info.ModTime().UnixNano())
+		}
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
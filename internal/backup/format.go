@@ -0,0 +1,365 @@
+package backup
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// ArchiveFormat 备份归档格式
+type ArchiveFormat string
+
+const (
+	// FormatZip zip 归档，兼容旧版本备份
+	FormatZip ArchiveFormat = "zip"
+	// FormatTarGz gzip 压缩的 tar 归档，保留 POSIX 权限和属主
+	FormatTarGz ArchiveFormat = "tar.gz"
+	// FormatTarZst zstd 压缩的 tar 归档，保留 POSIX 权限和属主
+	FormatTarZst ArchiveFormat = "tar.zst"
+)
+
+// encryptedExt 为加密备份追加的扩展名。zip 格式加密后沿用旧版本的 ".enc"
+const encryptedExt = ".enc"
+
+// 归档格式的文件头
+var (
+	zipMagic  = []byte("PK")
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// ParseArchiveFormat 解析归档格式，空字符串表示 zip
+func ParseArchiveFormat(value string) (ArchiveFormat, error) {
+	switch ArchiveFormat(strings.ToLower(strings.TrimSpace(value))) {
+	case "", FormatZip:
+		return FormatZip, nil
+	case FormatTarGz, "tgz":
+		return FormatTarGz, nil
+	case FormatTarZst, "tzst":
+		return FormatTarZst, nil
+	default:
+		return "", fmt.Errorf("unsupported archive format: %s", value)
+	}
+}
+
+// archiveFilename 根据文件名主干、格式和加密扩展名（".enc"、".age" 或空）生成备份文件名
+func archiveFilename(stem string, format ArchiveFormat, encExt string) string {
+	// 密码加密的 zip 备份沿用旧版本的 ".enc" 文件名
+	if format == FormatZip && encExt == encryptedExt {
+		return stem + encryptedExt
+	}
+
+	return stem + "." + string(format) + encExt
+}
+
+// archiveStem 去掉备份文件名中的格式和加密扩展名
+func archiveStem(filename string) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(filename, encryptedExt), ageExt)
+	for _, ext := range []string{".zip", "." + string(FormatTarGz), "." + string(FormatTarZst)} {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
+
+// archiveWriter 为不同归档格式的统一写入接口
+type archiveWriter interface {
+	// addFile 写入一个文件，info 提供大小、权限和属主
+	addFile(name string, info os.FileInfo, r io.Reader) error
+	// addData 写入内存中的数据，例如 manifest
+	addData(name string, data []byte) error
+	// storedFiles 返回不压缩直接存储的文件数
+	storedFiles() int
+	Close() error
+}
+
+// newArchiveWriter 创建归档，level 为 1-9 的压缩级别
+func newArchiveWriter(w io.Writer, format ArchiveFormat, level int) (archiveWriter, error) {
+	switch format {
+	case FormatZip:
+		zw := zip.NewWriter(w)
+		zw.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+			return flate.NewWriter(out, level)
+		})
+		return &zipArchiveWriter{zw: zw}, nil
+
+	case FormatTarGz:
+		gw, err := gzip.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, err
+		}
+		return &tarArchiveWriter{tw: tar.NewWriter(gw), compressor: gw}, nil
+
+	case FormatTarZst:
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+		if err != nil {
+			return nil, err
+		}
+		return &tarArchiveWriter{tw: tar.NewWriter(zw), compressor: zw}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %s", format)
+	}
+}
+
+type zipArchiveWriter struct {
+	zw     *zip.Writer
+	stored int
+}
+
+// addFile 根据文件类型和内容选择压缩方式，已经压缩过的文件直接存储
+func (a *zipArchiveWriter) addFile(name string, info os.FileInfo, r io.Reader) error {
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+
+	head, r, err := peekHead(r)
+	if err != nil {
+		return err
+	}
+	if shouldStore(name, head) {
+		header.Method = zip.Store
+		a.stored++
+	}
+
+	w, err := a.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (a *zipArchiveWriter) addData(name string, data []byte) error {
+	w, err := a.zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func (a *zipArchiveWriter) storedFiles() int {
+	return a.stored
+}
+
+// Close 写出中央目录，流式输出时必须检查其错误
+func (a *zipArchiveWriter) Close() error {
+	return a.zw.Close()
+}
+
+type tarArchiveWriter struct {
+	tw         *tar.Writer
+	compressor io.WriteCloser
+}
+
+func (a *tarArchiveWriter) addFile(name string, info os.FileInfo, r io.Reader) error {
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Format = tar.FormatPAX
+
+	if err := a.tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	// tar 头部中的大小必须与内容一致；文件在备份过程中被截断时返回错误
+	n, err := io.CopyN(a.tw, r, header.Size)
+	if err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("file %s shrank during backup (%d of %d bytes)", name, n, header.Size)
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *tarArchiveWriter) addData(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+		Format:  tar.FormatPAX,
+	}
+	if err := a.tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err := a.tw.Write(data)
+	return err
+}
+
+// storedFiles 对 tar 格式恒为 0：压缩作用于整个归档，无法按文件选择
+func (a *tarArchiveWriter) storedFiles() int {
+	return 0
+}
+
+func (a *tarArchiveWriter) Close() error {
+	if err := a.tw.Close(); err != nil {
+		a.compressor.Close()
+		return err
+	}
+	return a.compressor.Close()
+}
+
+// archiveEntry 为归档中的一个条目
+type archiveEntry struct {
+	Name    string
+	Mode    os.FileMode
+	ModTime time.Time
+	IsDir   bool
+	// Size 为归档中声明的文件大小，用于在解压前检查上限
+	Size int64
+	// IsSymlink 为 true 时条目是指向 Linkname 的符号链接
+	IsSymlink bool
+	Linkname  string
+	// HasOwner 为 true 时 Uid/Gid 有效（仅 tar 格式记录属主）
+	HasOwner bool
+	Uid      int
+	Gid      int
+}
+
+// readArchive 依次读取明文归档中的每个条目，格式根据文件头自动判断。
+// zip 需要随机访问，会先写入临时文件；tar 格式直接流式读取。
+func readArchive(plain io.Reader, fn func(entry archiveEntry, r io.Reader) error) error {
+	br := bufio.NewReader(plain)
+	header, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read backup data: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("failed to create gzip reader: %w", err)
+		}
+		defer gr.Close()
+		return readTarArchive(gr, fn)
+
+	case bytes.HasPrefix(header, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("failed to create zstd reader: %w", err)
+		}
+		defer zr.Close()
+		return readTarArchive(zr, fn)
+
+	default:
+		return readZipArchive(br, fn)
+	}
+}
+
+func readTarArchive(r io.Reader, fn func(entry archiveEntry, r io.Reader) error) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tar archive: %w", err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeReg, tar.TypeDir, tar.TypeSymlink:
+		default:
+			// 备份只包含普通文件和目录，其他类型的条目（硬链接、设备等）一律忽略
+			continue
+		}
+
+		entry := archiveEntry{
+			Name:      header.Name,
+			Mode:      os.FileMode(header.Mode).Perm(),
+			ModTime:   header.ModTime,
+			IsDir:     header.Typeflag == tar.TypeDir,
+			Size:      header.Size,
+			IsSymlink: header.Typeflag == tar.TypeSymlink,
+			Linkname:  header.Linkname,
+			HasOwner:  true,
+			Uid:       header.Uid,
+			Gid:       header.Gid,
+		}
+		if err := fn(entry, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func readZipArchive(r io.Reader, fn func(entry archiveEntry, r io.Reader) error) error {
+	tmpFile, err := os.CreateTemp("", "vaultwarden-restore-*.zip")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+	}()
+
+	size, err := io.Copy(tmpFile, r)
+	if err != nil {
+		return fmt.Errorf("failed to read backup data: %w", err)
+	}
+
+	zipReader, err := zip.NewReader(tmpFile, size)
+	if err != nil {
+		return fmt.Errorf("failed to create zip reader: %w", err)
+	}
+
+	for _, file := range zipReader.File {
+		info := file.FileInfo()
+		entry := archiveEntry{
+			Name:      file.Name,
+			Mode:      info.Mode().Perm(),
+			ModTime:   file.Modified,
+			IsDir:     info.IsDir(),
+			Size:      int64(file.UncompressedSize64),
+			IsSymlink: info.Mode()&os.ModeSymlink != 0,
+		}
+
+		if err := readZipEntry(file, entry, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readZipEntry(file *zip.File, entry archiveEntry, fn func(entry archiveEntry, r io.Reader) error) error {
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// zip 中符号链接的目标保存为条目内容
+	if entry.IsSymlink {
+		target, err := io.ReadAll(io.LimitReader(rc, maxLinkTarget))
+		if err != nil {
+			return err
+		}
+		entry.Linkname = string(target)
+		return fn(entry, bytes.NewReader(nil))
+	}
+
+	return fn(entry, rc)
+}
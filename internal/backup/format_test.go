@@ -0,0 +1,149 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestArchiveFormatsRoundTrip(t *testing.T) {
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	for _, format := range []ArchiveFormat{FormatZip, FormatTarGz, FormatTarZst} {
+		for _, password := range []string{"", "test-password"} {
+			name := string(format)
+			if password != "" {
+				name += "-encrypted"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				dataDir := t.TempDir()
+				writeTestFile(t, dataDir, "config.json", `{"domain":"example"}`, past)
+				writeTestFile(t, dataDir, "rsa_key.pem", "private key", past)
+				if err := os.Chmod(filepath.Join(dataDir, "rsa_key.pem"), 0600); err != nil {
+					t.Fatalf("Failed to chmod: %v", err)
+				}
+
+				service := NewService(BackupOptions{
+					VaultwardenDataPath: dataDir,
+					Password:            password,
+					Format:              format,
+				})
+
+				data, filename := createAndFinishBackup(t, service)
+
+				if want := archiveFilename(archiveStem(filename), format, service.encryptionExt()); filename != want {
+					t.Errorf("Unexpected filename %s, want %s", filename, want)
+				}
+				if format != FormatZip && !strings.Contains(filename, "."+string(format)) {
+					t.Errorf("Expected format in filename, got %s", filename)
+				}
+
+				restoreDir := t.TempDir()
+				if err := service.ExtractBackup(context.Background(), strings.NewReader(string(data)), restoreDir); err != nil {
+					t.Fatalf("Failed to extract backup: %v", err)
+				}
+
+				content, err := os.ReadFile(filepath.Join(restoreDir, "rsa_key.pem"))
+				if err != nil || string(content) != "private key" {
+					t.Fatalf("Unexpected restored key: %q, %v", content, err)
+				}
+
+				info, err := os.Stat(filepath.Join(restoreDir, "rsa_key.pem"))
+				if err != nil {
+					t.Fatalf("Failed to stat restored key: %v", err)
+				}
+				if info.Mode().Perm() != 0600 {
+					t.Errorf("Expected mode 0600, got %v", info.Mode().Perm())
+				}
+				if !info.ModTime().Equal(past) {
+					t.Errorf("Expected mtime %v, got %v", past, info.ModTime())
+				}
+
+				if _, err := os.Stat(filepath.Join(restoreDir, ManifestName)); !os.IsNotExist(err) {
+					t.Error("Manifest should not be extracted")
+				}
+			})
+		}
+	}
+}
+
+func TestParseArchiveFormat(t *testing.T) {
+	tests := map[string]ArchiveFormat{
+		"":        FormatZip,
+		"zip":     FormatZip,
+		"TAR.GZ":  FormatTarGz,
+		"tgz":     FormatTarGz,
+		"tar.zst": FormatTarZst,
+	}
+	for value, expected := range tests {
+		format, err := ParseArchiveFormat(value)
+		if err != nil || format != expected {
+			t.Errorf("ParseArchiveFormat(%q) = %s, %v; want %s", value, format, err, expected)
+		}
+	}
+
+	if _, err := ParseArchiveFormat("rar"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestArchiveStem(t *testing.T) {
+	for _, filename := range []string{
+		"vaultwarden-backup-20240101-000000.zip",
+		"vaultwarden-backup-20240101-000000.enc",
+		"vaultwarden-backup-20240101-000000.tar.gz",
+		"vaultwarden-backup-20240101-000000.tar.zst.enc",
+		"vaultwarden-backup-20240101-000000.zip.age",
+	} {
+		if stem := archiveStem(filename); stem != "vaultwarden-backup-20240101-000000" {
+			t.Errorf("archiveStem(%s) = %s", filename, stem)
+		}
+	}
+}
+
+func TestPreparedBackupFormatsShareChain(t *testing.T) {
+	dataDir := t.TempDir()
+	writeTestFile(t, dataDir, "config.json", "{}", time.Now().Add(-time.Hour))
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Mode:                BackupTypeIncremental,
+		StateDir:            t.TempDir(),
+	})
+
+	_, fullName := createAndFinishBackup(t, service)
+
+	writeTestFile(t, dataDir, "a.txt", "a", time.Now())
+
+	prepared, err := service.PrepareBackup(context.Background(), testTarget)
+	if err != nil {
+		t.Fatalf("Failed to prepare backup: %v", err)
+	}
+	defer prepared.Close()
+
+	for _, format := range []ArchiveFormat{FormatZip, FormatTarZst} {
+		reader, filename, err := prepared.Open(context.Background(), format)
+		if err != nil {
+			t.Fatalf("Failed to open %s backup: %v", format, err)
+		}
+
+		manifest, err := service.ReadManifest(context.Background(), reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("Failed to read manifest: %v", err)
+		}
+
+		// 基准备份文件名与本归档的格式一致
+		wantBase := archiveFilename(archiveStem(fullName), format, "")
+		if manifest.Base != wantBase {
+			t.Errorf("%s: expected base %s, got %s", format, wantBase, manifest.Base)
+		}
+		if !strings.HasSuffix(filename, archiveFilename("", format, "")) {
+			t.Errorf("%s: unexpected filename %s", format, filename)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+// stateFileName 保存每个存储最近一次成功备份的 manifest，用于计算下一次增量/差异备份
+const stateFileName = "backup-state.json"
+
+// backupRecord 记录一次备份的文件名及其 manifest
+type backupRecord struct {
+	Filename string    `json:"filename"`
+	Manifest *Manifest `json:"manifest"`
+}
+
+// backupState 为增量备份链的本地状态。备份可能只上传到了部分存储，
+// 因此每个存储单独记录自己的备份链，增量备份的基准必须存在于所有目标存储中。
+type backupState struct {
+	// Last 为最近一次成功上传到任意存储的备份，用于统计数据目录的变化
+	Last *backupRecord `json:"last,omitempty"`
+	// Targets 为每个存储的备份链，键为调用方指定的存储标识
+	Targets map[string]*chainState `json:"targets,omitempty"`
+}
+
+// chainState 为一个存储中的备份链
+type chainState struct {
+	Last     *backupRecord `json:"last,omitempty"`
+	LastFull *backupRecord `json:"last_full,omitempty"`
+}
+
+// backupPlan 描述一次备份需要写入归档的内容
+type backupPlan struct {
+	manifest *Manifest
+	// files 为需要写入归档的文件（相对路径，以 "/" 分隔），按路径排序
+	files []string
+	// snapshot 为写入归档的数据库快照，为空时归档中没有数据库
+	snapshot string
+}
+
+// scanDataDir 遍历数据目录，返回所有需要备份的文件及其大小和修改时间
+func (s *Service) scanDataDir(filter PathFilter) (map[string]FileEntry, error) {
+	files := make(map[string]FileEntry)
+
+	// 数据库存在时会使用快照，WAL/SHM 辅助文件不会进入备份
+	_, err := os.Stat(filepath.Join(s.vaultwardenDataPath, vaultwardenDBName))
+	hasDB := err == nil
+
+	err = filepath.Walk(s.vaultwardenDataPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(s.vaultwardenDataPath, path)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if filter.SkipDir(relPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !info.Mode().IsRegular() || !filter.Match(relPath) {
+			return nil
+		}
+
+		if hasDB && isSQLiteSidecar(relPath) {
+			return nil
+		}
+
+		files[filepath.ToSlash(relPath)] = FileEntry{
+			Size:    info.Size(),
+			Mode:    info.Mode().Perm(),
+			ModTime: info.ModTime().UTC(),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk data directory: %w", err)
+	}
+
+	return files, nil
+}
+
+// planBackup 根据备份模式和目标存储中上一次备份的 manifest 决定本次备份的类型和内容
+func (s *Service) planBackup(targets []string) (*backupPlan, error) {
+	filter := s.PathFilter()
+
+	files, err := s.scanDataDir(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := newManifest(s.vaultwardenDataPath, filter, files)
+	plan := &backupPlan{manifest: manifest}
+
+	base, sequence := s.selectBase(filter, targets)
+	if base == nil {
+		for relPath := range files {
+			plan.files = append(plan.files, relPath)
+		}
+		sort.Strings(plan.files)
+		return plan, nil
+	}
+
+	manifest.Type = s.mode
+	manifest.Base = base.Filename
+	manifest.Sequence = sequence
+
+	for relPath, entry := range files {
+		prev, ok := base.Manifest.Files[relPath]
+		// 数据库的改动可能只存在于 WAL 中，主文件的大小和修改时间不一定变化，因此总是备份
+		if !ok || prev.Size != entry.Size || !prev.ModTime.Equal(entry.ModTime) || relPath == vaultwardenDBName {
+			plan.files = append(plan.files, relPath)
+			continue
+		}
+		// 未变化的文件不写入归档，沿用基准备份中的哈希
+		entry.SHA256 = prev.SHA256
+		files[relPath] = entry
+	}
+	sort.Strings(plan.files)
+
+	for relPath := range base.Manifest.Files {
+		if _, ok := files[relPath]; !ok {
+			manifest.Deleted = append(manifest.Deleted, relPath)
+		}
+	}
+	sort.Strings(manifest.Deleted)
+
+	return plan, nil
+}
+
+// selectBase 返回本次增量/差异备份的基准备份；需要完整备份时返回 nil。
+// 只有所有目标存储都保存了同一个基准时才能做增量/差异备份，否则缺少基准的存储无法恢复。
+func (s *Service) selectBase(filter PathFilter, targets []string) (*backupRecord, int) {
+	if s.mode != BackupTypeIncremental && s.mode != BackupTypeDifferential {
+		return nil, 0
+	}
+	if len(targets) == 0 {
+		return nil, 0
+	}
+
+	state, err := s.loadState()
+	if err != nil {
+		s.logger.Warn("Failed to load backup state, taking a full backup", zap.Error(err))
+		return nil, 0
+	}
+
+	var base *backupRecord
+	sequence := 0
+	for _, target := range targets {
+		chain := state.Targets[target]
+		if chain == nil || chain.Last == nil || chain.LastFull == nil {
+			return nil, 0
+		}
+
+		candidate := chain.Last
+		if s.mode == BackupTypeDifferential {
+			candidate = chain.LastFull
+		}
+		if base != nil && archiveStem(candidate.Filename) != archiveStem(base.Filename) {
+			s.logger.Info("Storages have different backup chains, taking a full backup",
+				zap.String("base", base.Filename), zap.String("other", candidate.Filename))
+			return nil, 0
+		}
+		base = candidate
+		sequence = max(sequence, chain.Last.Manifest.Sequence+1)
+	}
+
+	if s.fullEvery > 0 && sequence >= s.fullEvery {
+		return nil, 0
+	}
+
+	// 规则变化后旧的 manifest 无法反映应备份的文件集合
+	if !slices.Equal(base.Manifest.Include, filter.Include) || !slices.Equal(base.Manifest.Exclude, filter.Exclude) {
+		return nil, 0
+	}
+
+	return base, sequence
+}
+
+// FinishBackup 在备份上传结束后调用，targets 为已经完整保存了该备份的存储。
+// 该备份成为这些存储下一次增量/差异备份的基准，其他存储仍基于之前的备份；
+// targets 为空表示备份没有成功上传到任何存储。
+func (s *Service) FinishBackup(filename string, targets ...string) error {
+	// 同一次备份的其他格式共享文件名主干，一并清理
+	stem := archiveStem(filename)
+	s.mu.Lock()
+	manifest, ok := s.pending[filename]
+	for name := range s.pending {
+		if archiveStem(name) == stem {
+			delete(s.pending, name)
+			delete(s.stats, name)
+			delete(s.sidecars, name)
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok || len(targets) == 0 || s.stateDir == "" {
+		return nil
+	}
+
+	state, err := s.loadState()
+	if err != nil {
+		state = &backupState{}
+	}
+	if state.Targets == nil {
+		state.Targets = make(map[string]*chainState)
+	}
+
+	record := &backupRecord{Filename: filename, Manifest: manifest}
+	state.Last = record
+	for _, target := range targets {
+		chain := state.Targets[target]
+		if chain == nil {
+			chain = &chainState{}
+			state.Targets[target] = chain
+		}
+		chain.Last = record
+		if manifest.Type == BackupTypeFull {
+			chain.LastFull = record
+		}
+	}
+
+	return s.saveState(state)
+}
+
+func (s *Service) loadState() (*backupState, error) {
+	if s.stateDir == "" {
+		return &backupState{}, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.stateDir, stateFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &backupState{}, nil
+		}
+		return nil, err
+	}
+
+	var state backupState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode backup state: %w", err)
+	}
+
+	return &state, nil
+}
+
+func (s *Service) saveState(state *backupState) error {
+	if err := os.MkdirAll(s.stateDir, 0700); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	// 先写临时文件再重命名，避免中断时留下损坏的状态文件
+	path := filepath.Join(s.stateDir, stateFileName)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write backup state: %w", err)
+	}
+
+	return os.Rename(tmpPath, path)
+}
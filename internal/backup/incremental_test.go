@@ -0,0 +1,400 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testTarget 为测试中备份上传到的存储
+const testTarget = "storage"
+
+// createAndFinishBackup 创建备份、读取全部数据并标记上传成功
+func createAndFinishBackup(t *testing.T, service *Service) ([]byte, string) {
+	t.Helper()
+
+	reader, filename, err := service.CreateBackup(context.Background(), testTarget)
+	if err != nil {
+		t.Fatalf("Failed to create backup: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Failed to read backup data: %v", err)
+	}
+
+	if err := service.FinishBackup(filename, testTarget); err != nil {
+		t.Fatalf("Failed to finish backup: %v", err)
+	}
+
+	return data, filename
+}
+
+// archiveNames 返回归档中除 manifest 以外的条目名
+func archiveNames(t *testing.T, service *Service, data []byte) []string {
+	t.Helper()
+
+	plain, err := service.openBackupStream(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to open backup: %v", err)
+	}
+
+	var names []string
+	err = readArchive(plain, func(entry archiveEntry, r io.Reader) error {
+		if entry.Name != ManifestName {
+			names = append(names, entry.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to read archive: %v", err)
+	}
+
+	return names
+}
+
+func writeTestFile(t *testing.T, dir, name, content string, modTime time.Time) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set file time: %v", err)
+	}
+}
+
+func TestIncrementalBackupChain(t *testing.T) {
+	dataDir := t.TempDir()
+	past := time.Now().Add(-time.Hour)
+
+	writeTestFile(t, dataDir, "config.json", "{}", past)
+	writeTestFile(t, dataDir, "attachments/a/file.bin", "attachment", past)
+	writeTestFile(t, dataDir, "sends/s/file.bin", "send", past)
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Password:            "test-password",
+		Mode:                BackupTypeIncremental,
+		StateDir:            t.TempDir(),
+	})
+
+	fullData, fullName := createAndFinishBackup(t, service)
+	fullManifest, err := service.ReadManifest(context.Background(), bytes.NewReader(fullData))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	if fullManifest.Type != BackupTypeFull {
+		t.Fatalf("Expected first backup to be full, got %s", fullManifest.Type)
+	}
+
+	// 修改一个文件、删除一个文件、新增一个文件
+	writeTestFile(t, dataDir, "config.json", `{"domain":"example"}`, time.Now())
+	if err := os.RemoveAll(filepath.Join(dataDir, "sends")); err != nil {
+		t.Fatalf("Failed to remove sends: %v", err)
+	}
+	writeTestFile(t, dataDir, "attachments/b/new.bin", "new attachment", time.Now())
+
+	incrData, incrName := createAndFinishBackup(t, service)
+	if !strings.Contains(incrName, string(BackupTypeIncremental)) {
+		t.Errorf("Expected incremental filename, got %s", incrName)
+	}
+
+	incrManifest, err := service.ReadManifest(context.Background(), bytes.NewReader(incrData))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	if incrManifest.Type != BackupTypeIncremental || incrManifest.Base != fullName {
+		t.Fatalf("Unexpected incremental manifest: type=%s base=%s", incrManifest.Type, incrManifest.Base)
+	}
+	if len(incrManifest.Deleted) != 1 || incrManifest.Deleted[0] != "sends/s/file.bin" {
+		t.Errorf("Unexpected deleted files: %v", incrManifest.Deleted)
+	}
+
+	archived := archiveNames(t, service, incrData)
+
+	if strings.Join(archived, ",") != "attachments/b/new.bin,config.json" {
+		t.Errorf("Unexpected files in incremental archive: %v", archived)
+	}
+
+	// 按顺序应用备份链
+	restoreDir := t.TempDir()
+	for _, data := range [][]byte{fullData, incrData} {
+		if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), restoreDir); err != nil {
+			t.Fatalf("Failed to extract backup: %v", err)
+		}
+	}
+
+	expected := map[string]string{
+		"config.json":            `{"domain":"example"}`,
+		"attachments/a/file.bin": "attachment",
+		"attachments/b/new.bin":  "new attachment",
+	}
+	for name, content := range expected {
+		data, err := os.ReadFile(filepath.Join(restoreDir, name))
+		if err != nil {
+			t.Errorf("Failed to read restored %s: %v", name, err)
+			continue
+		}
+		if string(data) != content {
+			t.Errorf("Unexpected content for %s: %s", name, data)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(restoreDir, "sends/s/file.bin")); !os.IsNotExist(err) {
+		t.Error("Expected deleted file to be removed after applying incremental backup")
+	}
+}
+
+func TestDifferentialBackupUsesLastFull(t *testing.T) {
+	dataDir := t.TempDir()
+	past := time.Now().Add(-time.Hour)
+	writeTestFile(t, dataDir, "config.json", "{}", past)
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Mode:                BackupTypeDifferential,
+		StateDir:            t.TempDir(),
+	})
+
+	_, fullName := createAndFinishBackup(t, service)
+
+	writeTestFile(t, dataDir, "a.txt", "a", time.Now())
+	createAndFinishBackup(t, service)
+
+	writeTestFile(t, dataDir, "b.txt", "b", time.Now())
+	data, _ := createAndFinishBackup(t, service)
+
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+
+	if manifest.Type != BackupTypeDifferential || manifest.Base != fullName {
+		t.Errorf("Expected differential backup based on %s, got type=%s base=%s", fullName, manifest.Type, manifest.Base)
+	}
+	if manifest.Sequence != 2 {
+		t.Errorf("Expected sequence 2, got %d", manifest.Sequence)
+	}
+}
+
+func TestIncrementalBackupFullEvery(t *testing.T) {
+	dataDir := t.TempDir()
+	writeTestFile(t, dataDir, "config.json", "{}", time.Now())
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Mode:                BackupTypeIncremental,
+		FullEvery:           2,
+		StateDir:            t.TempDir(),
+	})
+
+	var types []BackupType
+	for i := 0; i < 4; i++ {
+		data, _ := createAndFinishBackup(t, service)
+		manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("Failed to read manifest: %v", err)
+		}
+		types = append(types, manifest.Type)
+	}
+
+	expected := []BackupType{BackupTypeFull, BackupTypeIncremental, BackupTypeFull, BackupTypeIncremental}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Errorf("Backup %d: expected %s, got %s", i, expected[i], types[i])
+		}
+	}
+}
+
+func TestFailedUploadDoesNotAdvanceChain(t *testing.T) {
+	dataDir := t.TempDir()
+	writeTestFile(t, dataDir, "config.json", "{}", time.Now())
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Mode:                BackupTypeIncremental,
+		StateDir:            t.TempDir(),
+	})
+
+	reader, filename, err := service.CreateBackup(context.Background(), testTarget)
+	if err != nil {
+		t.Fatalf("Failed to create backup: %v", err)
+	}
+	io.Copy(io.Discard, reader)
+	reader.Close()
+
+	if err := service.FinishBackup(filename); err != nil {
+		t.Fatalf("Failed to finish backup: %v", err)
+	}
+
+	// 上一次上传失败，下一次仍然是完整备份
+	data, _ := createAndFinishBackup(t, service)
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	if manifest.Type != BackupTypeFull {
+		t.Errorf("Expected full backup after failed upload, got %s", manifest.Type)
+	}
+}
+
+func TestBackupChainIsTrackedPerStorage(t *testing.T) {
+	dataDir := t.TempDir()
+	writeTestFile(t, dataDir, "config.json", "{}", time.Now().Add(-time.Hour))
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Mode:                BackupTypeIncremental,
+		StateDir:            t.TempDir(),
+	})
+
+	// prepare 创建备份并返回其 manifest，备份只保存到 stored 中的存储
+	prepare := func(targets []string, stored ...string) (*Manifest, string) {
+		t.Helper()
+		reader, filename, err := service.CreateBackup(context.Background(), targets...)
+		if err != nil {
+			t.Fatalf("Failed to create backup: %v", err)
+		}
+		manifest, err := service.ReadManifest(context.Background(), reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("Failed to read manifest: %v", err)
+		}
+		if err := service.FinishBackup(filename, stored...); err != nil {
+			t.Fatalf("Failed to finish backup: %v", err)
+		}
+		return manifest, filename
+	}
+
+	both := []string{"a", "b"}
+	_, fullName := prepare(both, both...)
+
+	// 增量备份只上传到了 a
+	writeTestFile(t, dataDir, "a.txt", "a", time.Now())
+	manifest, incrName := prepare([]string{"a"}, "a")
+	if manifest.Type != BackupTypeIncremental || manifest.Base != fullName {
+		t.Fatalf("Expected incremental backup based on %s, got type=%s base=%s", fullName, manifest.Type, manifest.Base)
+	}
+
+	// b 仍然只有完整备份，可以继续基于它做增量备份
+	manifest, _ = prepare([]string{"b"})
+	if manifest.Type != BackupTypeIncremental || manifest.Base != fullName {
+		t.Errorf("Expected storage b to keep its own chain based on %s, got type=%s base=%s", fullName, manifest.Type, manifest.Base)
+	}
+
+	// a 和 b 的基准不同，同时上传时必须做完整备份
+	manifest, _ = prepare(both)
+	if manifest.Type != BackupTypeFull {
+		t.Errorf("Expected a full backup for storages with different chains, got %s based on %s", manifest.Type, manifest.Base)
+	}
+
+	// 从未保存过备份的存储也需要完整备份
+	manifest, _ = prepare([]string{"a", "c"})
+	if manifest.Type != BackupTypeFull {
+		t.Errorf("Expected a full backup for a storage without a chain, got %s", manifest.Type)
+	}
+
+	manifest, _ = prepare([]string{"a"})
+	if manifest.Base != incrName {
+		t.Errorf("Expected storage a to continue from %s, got %s", incrName, manifest.Base)
+	}
+}
+
+func TestChangeSummary(t *testing.T) {
+	sourceDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	for i := 0; i < 10; i++ {
+		writeTestFile(t, sourceDir, fmt.Sprintf("attachments/%d", i), "original", modTime)
+	}
+
+	service := NewService(BackupOptions{VaultwardenDataPath: sourceDir, StateDir: t.TempDir()})
+
+	prepared, err := service.PrepareBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to prepare backup: %v", err)
+	}
+	if changes := prepared.Changes(); changes.Files != 10 || changes.Size != 80 || changes.Compared != 0 {
+		t.Errorf("Unexpected changes without a previous backup: %+v", changes)
+	}
+	createAndFinishBackup(t, service)
+
+	// 模拟勒索软件：修改大部分文件并删除一个
+	for i := 0; i < 8; i++ {
+		writeTestFile(t, sourceDir, fmt.Sprintf("attachments/%d", i), "encrypted!", time.Now())
+	}
+	if err := os.Remove(filepath.Join(sourceDir, "attachments", "9")); err != nil {
+		t.Fatal(err)
+	}
+
+	prepared, err = service.PrepareBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to prepare backup: %v", err)
+	}
+	changes := prepared.Changes()
+	if changes.Files != 9 || changes.Compared != 10 || changes.Changed != 8 || changes.Deleted != 1 {
+		t.Errorf("Unexpected changes: %+v", changes)
+	}
+	if ratio := changes.ChangedRatio(); ratio != 0.9 {
+		t.Errorf("Expected changed ratio 0.9, got %v", ratio)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	sourceDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	writeTestFile(t, sourceDir, "config.json", "{}", modTime)
+	writeTestFile(t, sourceDir, "attachments/a", "attachment", modTime)
+
+	service := NewService(BackupOptions{VaultwardenDataPath: sourceDir, StateDir: t.TempDir()})
+
+	first, err := service.Fingerprint()
+	if err != nil {
+		t.Fatalf("Failed to compute fingerprint: %v", err)
+	}
+	second, err := service.Fingerprint()
+	if err != nil {
+		t.Fatalf("Failed to compute fingerprint: %v", err)
+	}
+	if first != second {
+		t.Error("Expected the fingerprint to be stable for unchanged data")
+	}
+
+	prepared, err := service.PrepareBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to prepare backup: %v", err)
+	}
+	if prepared.Fingerprint() != first {
+		t.Error("Expected the prepared backup to carry the current fingerprint")
+	}
+
+	// 内容长度不变，只有修改时间变化
+	writeTestFile(t, sourceDir, "attachments/a", "attachment", time.Now())
+	changed, err := service.Fingerprint()
+	if err != nil {
+		t.Fatalf("Failed to compute fingerprint: %v", err)
+	}
+	if changed == first {
+		t.Error("Expected the fingerprint to change when a file is modified")
+	}
+
+	excluded := NewService(BackupOptions{VaultwardenDataPath: sourceDir, StateDir: t.TempDir(), Exclude: []string{"attachments"}})
+	other, err := excluded.Fingerprint()
+	if err != nil {
+		t.Fatalf("Failed to compute fingerprint: %v", err)
+	}
+	if other == changed {
+		t.Error("Expected the fingerprint to depend on the exclude rules")
+	}
+}
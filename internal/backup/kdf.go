@@ -0,0 +1,211 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// KDF 为从密码派生加密密钥的算法
+type KDF string
+
+const (
+	// KDFArgon2id Argon2id，默认算法
+	KDFArgon2id KDF = "argon2id"
+	// KDFPBKDF2 PBKDF2-SHA256，用于无法承受 Argon2id 内存开销的环境
+	KDFPBKDF2 KDF = "pbkdf2"
+)
+
+// v2 header 中的 kdf id
+const (
+	kdfIDPBKDF2SHA256 byte = 1
+	kdfIDArgon2id     byte = 2
+)
+
+// 新备份使用的 KDF 参数
+const (
+	argon2Time      = 3
+	argon2MemoryKiB = 64 * 1024
+	argon2Threads   = 4
+
+	pbkdf2DefaultIterations = 600000
+)
+
+// 读取 header 时允许的参数上限，防止损坏或恶意的备份文件耗尽内存和 CPU
+const (
+	maxArgon2Time      = 64
+	maxArgon2MemoryKiB = 1024 * 1024
+	maxPBKDF2Iters     = 10000000
+)
+
+// ParseKDF 解析 KDF 名称，空字符串表示默认的 Argon2id
+func ParseKDF(value string) (KDF, error) {
+	switch KDF(strings.ToLower(strings.TrimSpace(value))) {
+	case "", KDFArgon2id:
+		return KDFArgon2id, nil
+	case KDFPBKDF2, "pbkdf2-sha256":
+		return KDFPBKDF2, nil
+	default:
+		return "", fmt.Errorf("unsupported kdf: %s", value)
+	}
+}
+
+// kdfParams 为 v2 header 中记录的 KDF 参数
+type kdfParams struct {
+	id         byte
+	iterations uint32 // PBKDF2 迭代次数，或 Argon2id 的 time 参数
+	memoryKiB  uint32 // 仅 Argon2id
+	threads    uint8  // 仅 Argon2id
+	salt       []byte
+}
+
+// newKDFParams 使用默认参数和随机 salt 创建 KDF 参数
+func newKDFParams(kdf KDF) (kdfParams, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return kdfParams{}, err
+	}
+
+	switch kdf {
+	case KDFArgon2id, "":
+		return kdfParams{
+			id:         kdfIDArgon2id,
+			iterations: argon2Time,
+			memoryKiB:  argon2MemoryKiB,
+			threads:    argon2Threads,
+			salt:       salt,
+		}, nil
+	case KDFPBKDF2:
+		return kdfParams{
+			id:         kdfIDPBKDF2SHA256,
+			iterations: pbkdf2DefaultIterations,
+			salt:       salt,
+		}, nil
+	default:
+		return kdfParams{}, fmt.Errorf("unsupported kdf: %s", kdf)
+	}
+}
+
+// KDFParams 为保存在归档 header 之外的 KDF 参数，例如去重仓库的配置，
+// 使同一个密码在归档和仓库中使用相同的 KDF 保护
+type KDFParams struct {
+	KDF KDF
+	// Iterations 为 PBKDF2 迭代次数，或 Argon2id 的 time 参数
+	Iterations uint32
+	MemoryKiB  uint32 // 仅 Argon2id
+	Threads    uint8  // 仅 Argon2id
+	Salt       []byte
+}
+
+// NewKDFParams 使用新备份的默认参数和随机 salt 创建 KDF 参数
+func NewKDFParams(kdf KDF) (KDFParams, error) {
+	p, err := newKDFParams(kdf)
+	if err != nil {
+		return KDFParams{}, err
+	}
+
+	params := KDFParams{KDF: KDFPBKDF2, Iterations: p.iterations, Salt: p.salt}
+	if p.id == kdfIDArgon2id {
+		params.KDF = KDFArgon2id
+		params.MemoryKiB = p.memoryKiB
+		params.Threads = p.threads
+	}
+	return params, nil
+}
+
+// DeriveKey 从密码派生 32 字节密钥。参数与读取归档 header 时一样检查范围，
+// 防止被篡改的参数耗尽内存和 CPU
+func (p KDFParams) DeriveKey(password string) ([]byte, error) {
+	kdf, err := ParseKDF(string(p.KDF))
+	if err != nil {
+		return nil, err
+	}
+
+	params := kdfParams{iterations: p.Iterations, salt: p.Salt}
+	switch kdf {
+	case KDFArgon2id:
+		params.id = kdfIDArgon2id
+		params.memoryKiB = p.MemoryKiB
+		params.threads = p.Threads
+	case KDFPBKDF2:
+		params.id = kdfIDPBKDF2SHA256
+	}
+
+	if _, err := parseKDFParams(bytes.NewReader(params.marshal())); err != nil {
+		return nil, err
+	}
+	return params.deriveKey(password)
+}
+
+func (p kdfParams) deriveKey(password string) ([]byte, error) {
+	switch p.id {
+	case kdfIDArgon2id:
+		return argon2.IDKey([]byte(password), p.salt, p.iterations, p.memoryKiB, p.threads, 32), nil
+	case kdfIDPBKDF2SHA256:
+		return pbkdf2.Key([]byte(password), p.salt, int(p.iterations), 32, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported kdf id: %d", p.id)
+	}
+}
+
+func (p kdfParams) marshal() []byte {
+	var buf bytes.Buffer
+	buf.WriteByte(p.id)
+	binary.Write(&buf, binary.BigEndian, p.iterations)
+	if p.id == kdfIDArgon2id {
+		binary.Write(&buf, binary.BigEndian, p.memoryKiB)
+		buf.WriteByte(p.threads)
+	}
+	buf.WriteByte(byte(len(p.salt)))
+	buf.Write(p.salt)
+	return buf.Bytes()
+}
+
+func parseKDFParams(r *bytes.Reader) (kdfParams, error) {
+	var p kdfParams
+	truncated := errors.New("encryption header is truncated")
+
+	var err error
+	if p.id, err = r.ReadByte(); err != nil {
+		return p, truncated
+	}
+	if err := binary.Read(r, binary.BigEndian, &p.iterations); err != nil {
+		return p, truncated
+	}
+
+	switch p.id {
+	case kdfIDArgon2id:
+		if err := binary.Read(r, binary.BigEndian, &p.memoryKiB); err != nil {
+			return p, truncated
+		}
+		if p.threads, err = r.ReadByte(); err != nil {
+			return p, truncated
+		}
+		if p.iterations == 0 || p.iterations > maxArgon2Time ||
+			p.memoryKiB == 0 || p.memoryKiB > maxArgon2MemoryKiB || p.threads == 0 {
+			return p, fmt.Errorf("argon2id parameters out of range: t=%d m=%dKiB p=%d", p.iterations, p.memoryKiB, p.threads)
+		}
+	case kdfIDPBKDF2SHA256:
+		if p.iterations == 0 || p.iterations > maxPBKDF2Iters {
+			return p, fmt.Errorf("pbkdf2 iterations out of range: %d", p.iterations)
+		}
+	default:
+		return p, fmt.Errorf("unsupported kdf id: %d", p.id)
+	}
+
+	if p.salt, err = readLengthPrefixed(r); err != nil {
+		return p, err
+	}
+	if len(p.salt) == 0 {
+		return p, errors.New("empty kdf salt")
+	}
+
+	return p, nil
+}
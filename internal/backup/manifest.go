@@ -0,0 +1,158 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/version"
+)
+
+// ManifestName 是归档中描述本次备份的文件名，恢复时不会写入数据目录
+const ManifestName = "manifest.json"
+
+// manifestVersion 2 起记录文件权限和 SHA-256、syncer 版本、主机名以及数据目录布局
+const manifestVersion = 2
+
+// BackupType 备份类型
+type BackupType string
+
+const (
+	// BackupTypeFull 完整备份，包含所有文件
+	BackupTypeFull BackupType = "full"
+	// BackupTypeIncremental 增量备份，只包含自上一次备份以来变化的文件
+	BackupTypeIncremental BackupType = "incremental"
+	// BackupTypeDifferential 差异备份，只包含自上一次完整备份以来变化的文件
+	BackupTypeDifferential BackupType = "differential"
+)
+
+// FileEntry 记录数据目录中单个文件的状态
+type FileEntry struct {
+	Size    int64       `json:"size"`
+	Mode    os.FileMode `json:"mode,omitempty"`
+	ModTime time.Time   `json:"mtime"`
+	// SHA256 为归档中文件内容的哈希。增量/差异备份中未变化的文件沿用基准备份记录的哈希，
+	// 旧版本的 manifest 中为空
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// DataLayout 描述备份时检测到的 Vaultwarden 数据目录布局
+type DataLayout struct {
+	// DataPath 为备份时的数据目录
+	DataPath string `json:"data_path"`
+	// Database 为数据目录中是否存在 SQLite 数据库 db.sqlite3
+	Database bool `json:"database"`
+	// RSAKey 为是否存在 Vaultwarden 用于签发令牌的 rsa_key.*
+	RSAKey bool `json:"rsa_key"`
+	// Config 为是否存在管理页面保存的 config.json
+	Config bool `json:"config"`
+	// Attachments 和 Sends 为对应目录下的文件数
+	Attachments int `json:"attachments"`
+	Sends       int `json:"sends"`
+	IconCache   int `json:"icon_cache"`
+}
+
+// Manifest 描述一次备份的内容
+type Manifest struct {
+	Version   int        `json:"version"`
+	Type      BackupType `json:"type"`
+	CreatedAt time.Time  `json:"created_at"`
+	// Base 为增量/差异备份所依赖的上一个备份文件名，恢复时需要先应用 Base
+	Base string `json:"base,omitempty"`
+	// Sequence 为自上一次完整备份以来的备份序号，完整备份为 0
+	Sequence int `json:"sequence"`
+	// SyncerVersion 和 Hostname 记录生成备份的 syncer 版本和主机
+	SyncerVersion string      `json:"syncer_version,omitempty"`
+	Hostname      string      `json:"hostname,omitempty"`
+	Layout        *DataLayout `json:"layout,omitempty"`
+	Include       []string    `json:"include,omitempty"`
+	Exclude       []string    `json:"exclude,omitempty"`
+	// Vault 为备份中数据库的用户、组织、密码项、附件和 Send 的条数
+	Vault *VaultStats `json:"vault,omitempty"`
+	// Files 为备份时数据目录中的全部文件，而不仅是本归档包含的文件
+	Files map[string]FileEntry `json:"files"`
+	// Deleted 为相对 Base 被删除的文件
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+func newManifest(dataPath string, filter PathFilter, files map[string]FileEntry) *Manifest {
+	hostname, _ := os.Hostname()
+	return &Manifest{
+		Version:       manifestVersion,
+		Type:          BackupTypeFull,
+		CreatedAt:     time.Now().UTC(),
+		SyncerVersion: version.Version,
+		Hostname:      hostname,
+		Layout:        detectLayout(dataPath, files),
+		Include:       filter.Include,
+		Exclude:       filter.Exclude,
+		Files:         files,
+	}
+}
+
+// detectLayout 根据扫描到的文件识别 Vaultwarden 数据目录中的各个组成部分
+func detectLayout(dataPath string, files map[string]FileEntry) *DataLayout {
+	layout := &DataLayout{DataPath: dataPath}
+	for relPath := range files {
+		dir, name, _ := strings.Cut(relPath, "/")
+		switch {
+		case relPath == vaultwardenDBName:
+			layout.Database = true
+		case relPath == "config.json":
+			layout.Config = true
+		case name == "" && strings.HasPrefix(dir, "rsa_key"):
+			layout.RSAKey = true
+		case name != "" && dir == "attachments":
+			layout.Attachments++
+		case name != "" && dir == "sends":
+			layout.Sends++
+		case name != "" && dir == "icon_cache":
+			layout.IconCache++
+		}
+	}
+	return layout
+}
+
+// writeManifest 将 manifest 写入归档
+func writeManifest(archive archiveWriter, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return archive.addData(ManifestName, append(data, '\n'))
+}
+
+func decodeManifest(r io.Reader) (*Manifest, error) {
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// ReadManifest 读取备份中的 manifest。没有 manifest 的旧版本备份返回 nil，按完整备份处理。
+func (s *Service) ReadManifest(ctx context.Context, data io.Reader) (*Manifest, error) {
+	plain, err := s.openBackupStream(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest *Manifest
+	err = readArchive(plain, func(entry archiveEntry, r io.Reader) error {
+		if entry.Name != ManifestName {
+			return nil
+		}
+		manifest, err = decodeManifest(r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
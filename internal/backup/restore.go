@@ -0,0 +1,152 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrInvalidRestore 表示解压到暂存目录的数据未通过校验，目标目录保持不变
+var ErrInvalidRestore = errors.New("restored data failed validation")
+
+// NewStagingDir 在 destPath 旁创建用于恢复的暂存目录。暂存目录与目标目录位于同一文件系统，
+// 校验通过后可以通过重命名原子地替换目标目录。
+func NewStagingDir(destPath string) (string, error) {
+	destPath = filepath.Clean(destPath)
+	parent := filepath.Dir(destPath)
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return "", fmt.Errorf("failed to create parent directory: %w", err)
+	}
+
+	staging, err := os.MkdirTemp(parent, filepath.Base(destPath)+".restore-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create staging directory: %w", err)
+	}
+
+	// MkdirTemp 创建的目录权限为 0700，沿用目标目录的权限，交换后 Vaultwarden 仍然可以访问
+	mode := os.FileMode(0755)
+	if info, err := os.Stat(destPath); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(staging, mode); err != nil {
+		os.RemoveAll(staging)
+		return "", fmt.Errorf("failed to create staging directory: %w", err)
+	}
+
+	return staging, nil
+}
+
+// ValidateRestore 校验暂存目录中的数据：manifest 中记录了 SHA-256 的文件必须存在且内容一致，
+// 数据库必须通过 SQLite 的 integrity_check。manifest 为 nil（旧版本备份）时只检查数据库。
+func (s *Service) ValidateRestore(ctx context.Context, dir string, manifest *Manifest) error {
+	if manifest != nil {
+		names := make([]string, 0, len(manifest.Files))
+		for name := range manifest.Files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			entry := manifest.Files[name]
+			if entry.SHA256 == "" {
+				continue
+			}
+			size, sum, err := hashFile(filepath.Join(dir, filepath.FromSlash(name)))
+			if err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrInvalidRestore, name, err)
+			}
+			if size != entry.Size || sum != entry.SHA256 {
+				return fmt.Errorf("%w: %s does not match the manifest", ErrInvalidRestore, name)
+			}
+		}
+	}
+
+	dbPath := filepath.Join(dir, vaultwardenDBName)
+	if _, err := os.Stat(dbPath); err == nil {
+		if err := checkDatabaseIntegrity(ctx, dbPath, false); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidRestore, err)
+		}
+	}
+
+	s.logger.Info("Restored data validated", zap.String("path", dir))
+	return nil
+}
+
+// SwapIntoPlace 用暂存目录替换 destPath。原目录被重命名为 destPath 旁的
+// ".pre-restore-<时间>" 目录并返回其路径，用于撤销恢复；目标目录原本不存在时返回空字符串。
+func SwapIntoPlace(staging, destPath string) (string, error) {
+	destPath = filepath.Clean(destPath)
+
+	var rollback string
+	if _, err := os.Lstat(destPath); err == nil {
+		rollback = fmt.Sprintf("%s.pre-restore-%s", destPath, time.Now().Format("20060102-150405"))
+		if err := os.Rename(destPath, rollback); err != nil {
+			return "", fmt.Errorf("failed to move current data aside: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	if err := os.Rename(staging, destPath); err != nil {
+		// 放回原目录，保持恢复前的状态
+		if rollback != "" {
+			if rerr := os.Rename(rollback, destPath); rerr != nil {
+				return "", fmt.Errorf("failed to move restored data into place: %v; previous data is kept at %s: %w", err, rollback, rerr)
+			}
+		}
+		return "", fmt.Errorf("failed to move restored data into place: %w", err)
+	}
+
+	return rollback, nil
+}
+
+// UndoRestore 撤销一次恢复：把 SwapIntoPlace 保留的原目录换回 destPath，并删除恢复出的数据
+func UndoRestore(destPath, rollback string) error {
+	destPath = filepath.Clean(destPath)
+	if rollback == "" {
+		return errors.New("restore has no previous data to roll back to")
+	}
+	if _, err := os.Stat(rollback); err != nil {
+		return fmt.Errorf("previous data is no longer available: %w", err)
+	}
+
+	restored := fmt.Sprintf("%s.undone-%s", destPath, time.Now().Format("20060102-150405"))
+	if err := os.Rename(destPath, restored); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to move restored data aside: %w", err)
+	}
+
+	if err := os.Rename(rollback, destPath); err != nil {
+		os.Rename(restored, destPath)
+		return fmt.Errorf("failed to move previous data back: %w", err)
+	}
+
+	return os.RemoveAll(restored)
+}
+
+// hashFile 返回文件的大小和 SHA-256
+func hashFile(path string) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
@@ -0,0 +1,99 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSafeRestoreSwapAndUndo(t *testing.T) {
+	sourceDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	writeTestFile(t, sourceDir, "config.json", "restored config", modTime)
+	writeTestFile(t, sourceDir, "attachments/a/1", "attachment", modTime)
+
+	service := NewService(BackupOptions{VaultwardenDataPath: sourceDir})
+	data, _ := createBackupData(t, service)
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+
+	destPath := filepath.Join(t.TempDir(), "vaultwarden")
+	writeTestFile(t, destPath, "config.json", "live config", modTime)
+
+	staging, err := NewStagingDir(destPath)
+	if err != nil {
+		t.Fatalf("Failed to create staging directory: %v", err)
+	}
+	if filepath.Dir(staging) != filepath.Dir(destPath) {
+		t.Errorf("Staging directory %s is not next to %s", staging, destPath)
+	}
+
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), staging); err != nil {
+		t.Fatalf("Failed to extract backup: %v", err)
+	}
+	if err := service.ValidateRestore(context.Background(), staging, manifest); err != nil {
+		t.Fatalf("Failed to validate restore: %v", err)
+	}
+
+	rollback, err := SwapIntoPlace(staging, destPath)
+	if err != nil {
+		t.Fatalf("Failed to swap restored data: %v", err)
+	}
+	assertFileContent(t, filepath.Join(destPath, "config.json"), "restored config")
+	assertFileContent(t, filepath.Join(rollback, "config.json"), "live config")
+
+	if err := UndoRestore(destPath, rollback); err != nil {
+		t.Fatalf("Failed to undo restore: %v", err)
+	}
+	assertFileContent(t, filepath.Join(destPath, "config.json"), "live config")
+	if _, err := os.Stat(rollback); !os.IsNotExist(err) {
+		t.Errorf("Expected rollback directory to be moved back, got: %v", err)
+	}
+}
+
+func TestValidateRestoreRejectsBadData(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeTestFile(t, sourceDir, "config.json", "config", time.Now())
+
+	service := NewService(BackupOptions{VaultwardenDataPath: sourceDir})
+	data, _ := createBackupData(t, service)
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+
+	// 内容与 manifest 不一致
+	dir := t.TempDir()
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), dir); err != nil {
+		t.Fatalf("Failed to extract backup: %v", err)
+	}
+	writeTestFile(t, dir, "config.json", "tampered", time.Now())
+	if err := service.ValidateRestore(context.Background(), dir, manifest); !errors.Is(err, ErrInvalidRestore) {
+		t.Errorf("Expected ErrInvalidRestore for modified file, got: %v", err)
+	}
+
+	// 损坏的数据库
+	dir = t.TempDir()
+	writeTestFile(t, dir, vaultwardenDBName, "definitely not a sqlite database, just some text padding it out", time.Now())
+	if err := service.ValidateRestore(context.Background(), dir, nil); !errors.Is(err, ErrInvalidRestore) {
+		t.Errorf("Expected ErrInvalidRestore for corrupt database, got: %v", err)
+	}
+}
+
+func assertFileContent(t *testing.T, path, expected string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	if string(data) != expected {
+		t.Errorf("%s: expected %q, got %q", path, expected, string(data))
+	}
+}
@@ -0,0 +1,169 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SidecarSuffix 为与备份一同上传的 manifest 副本的文件名后缀。
+// 副本不加密，校验、比较和恢复预览无需下载和解密整个备份
+const SidecarSuffix = ".manifest.json"
+
+const sidecarVersion = 1
+
+var errSidecarSignature = errors.New("manifest sidecar signature is missing or invalid")
+
+// Sidecar 为备份的 manifest 副本及备份文件本身的大小和哈希
+type Sidecar struct {
+	Version       int       `json:"version"`
+	Archive       string    `json:"archive"`
+	ArchiveSize   int64     `json:"archive_size"`
+	ArchiveSHA256 string    `json:"archive_sha256"`
+	Manifest      *Manifest `json:"manifest"`
+	// Signed 为 true 时签名已使用备份密码验证通过
+	Signed bool `json:"-"`
+}
+
+// sidecarFile 为 sidecar 的存储格式。签名覆盖 payload 压缩空白后的字节，
+// 因此读取时无需重新序列化
+type sidecarFile struct {
+	Payload   json.RawMessage   `json:"payload"`
+	Signature *sidecarSignature `json:"signature,omitempty"`
+}
+
+// sidecarSignature 为使用备份密码派生的密钥计算的 HMAC-SHA256。
+// KDF 参数与加密头部的格式相同，密钥派生同样需要承受 Argon2id 的开销
+type sidecarSignature struct {
+	KDF string `json:"kdf"`
+	MAC string `json:"mac"`
+}
+
+// SidecarName 返回备份文件对应的 manifest 副本文件名
+func SidecarName(filename string) string {
+	return filename + SidecarSuffix
+}
+
+// Sidecar 返回已生成完毕的备份的 manifest 副本，需在 FinishBackup 之前调用。
+// 设置了密码时副本会被签名；未设置密码（例如只使用 age 公钥加密）时副本不签名，
+// 只能作为预览，可信的 manifest 仍以备份内加密的那一份为准。
+func (s *Service) Sidecar(filename string) ([]byte, error) {
+	s.mu.RLock()
+	cached, ok := s.sidecars[filename]
+	manifest, hasManifest := s.pending[filename]
+	stats, hasStats := s.stats[filename]
+	s.mu.RUnlock()
+
+	if ok {
+		return cached, nil
+	}
+	if !hasManifest || !hasStats {
+		return nil, fmt.Errorf("backup %s is not complete", filename)
+	}
+
+	payload, err := json.Marshal(&Sidecar{
+		Version:       sidecarVersion,
+		Archive:       filename,
+		ArchiveSize:   stats.ArchiveSize,
+		ArchiveSHA256: stats.SHA256,
+		Manifest:      manifest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	file := sidecarFile{Payload: payload}
+	if s.password != "" {
+		params, err := newKDFParams(s.kdf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign manifest: %w", err)
+		}
+		mac, err := sidecarMAC(params, s.password, payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign manifest: %w", err)
+		}
+		file.Signature = &sidecarSignature{
+			KDF: base64.StdEncoding.EncodeToString(params.marshal()),
+			MAC: fmt.Sprintf("%x", mac),
+		}
+	}
+
+	data, err := json.MarshalIndent(&file, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	s.sidecars[filename] = data
+	s.mu.Unlock()
+
+	return data, nil
+}
+
+// ReadSidecar 解析 manifest 副本。设置了密码时必须带有有效签名，否则返回错误；
+// 未设置密码时返回的副本 Signed 为 false，调用方不应信任其中的内容。
+func (s *Service) ReadSidecar(data []byte) (*Sidecar, error) {
+	var file sidecarFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest sidecar: %w", err)
+	}
+
+	var sidecar Sidecar
+	if err := json.Unmarshal(file.Payload, &sidecar); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest sidecar: %w", err)
+	}
+	if sidecar.Version != sidecarVersion {
+		return nil, fmt.Errorf("unsupported manifest sidecar version: %d", sidecar.Version)
+	}
+
+	if s.password == "" {
+		return &sidecar, nil
+	}
+
+	if file.Signature == nil {
+		return nil, errSidecarSignature
+	}
+	rawParams, err := base64.StdEncoding.DecodeString(file.Signature.KDF)
+	if err != nil {
+		return nil, errSidecarSignature
+	}
+	params, err := parseKDFParams(bytes.NewReader(rawParams))
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest sidecar signature: %w", err)
+	}
+	// 写入时 payload 被缩进，签名针对的是缩进前的紧凑形式
+	var payload bytes.Buffer
+	if err := json.Compact(&payload, file.Payload); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest sidecar: %w", err)
+	}
+	mac, err := sidecarMAC(params, s.password, payload.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(fmt.Sprintf("%x", mac)), []byte(file.Signature.MAC)) {
+		return nil, errSidecarSignature
+	}
+
+	sidecar.Signed = true
+	return &sidecar, nil
+}
+
+// sidecarMAC 使用由密码派生、专用于签名的子密钥计算 payload 的 HMAC
+func sidecarMAC(params kdfParams, password string, payload []byte) ([]byte, error) {
+	key, err := params.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := hmac.New(sha256.New, key)
+	sub.Write([]byte("vaultwarden-syncer manifest signature"))
+
+	mac := hmac.New(sha256.New, sub.Sum(nil))
+	mac.Write(payload)
+	return mac.Sum(nil), nil
+}
@@ -0,0 +1,138 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/version"
+)
+
+func TestManifestRecordsHashesAndLayout(t *testing.T) {
+	sourceDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeTestFile(t, sourceDir, "config.json", `{"domain":"https://vault.example.com"}`, modTime)
+	writeTestFile(t, sourceDir, "rsa_key.pem", "private key", modTime)
+	writeTestFile(t, sourceDir, "attachments/a/1", "attachment", modTime)
+	if err := os.Chmod(filepath.Join(sourceDir, "rsa_key.pem"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewService(BackupOptions{VaultwardenDataPath: sourceDir})
+	data, _ := createBackupData(t, service)
+
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+
+	if manifest.Version != manifestVersion || manifest.SyncerVersion != version.Version {
+		t.Errorf("Unexpected manifest version info: %d, %q", manifest.Version, manifest.SyncerVersion)
+	}
+	if hostname, _ := os.Hostname(); manifest.Hostname != hostname {
+		t.Errorf("Expected hostname %q, got %q", hostname, manifest.Hostname)
+	}
+
+	layout := manifest.Layout
+	if layout == nil || layout.DataPath != sourceDir || !layout.Config || !layout.RSAKey || layout.Database || layout.Attachments != 1 {
+		t.Errorf("Unexpected layout: %+v", layout)
+	}
+
+	entry := manifest.Files["config.json"]
+	expected := fmt.Sprintf("%x", sha256.Sum256([]byte(`{"domain":"https://vault.example.com"}`)))
+	if entry.SHA256 != expected {
+		t.Errorf("Expected sha256 %s, got %s", expected, entry.SHA256)
+	}
+	if mode := manifest.Files["rsa_key.pem"].Mode; mode != 0600 {
+		t.Errorf("Expected mode 0600 for rsa_key.pem, got %o", mode)
+	}
+}
+
+func TestIncrementalManifestKeepsBaseHashes(t *testing.T) {
+	sourceDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeTestFile(t, sourceDir, "config.json", "config", modTime)
+	writeTestFile(t, sourceDir, "attachments/a/1", "attachment", modTime)
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: sourceDir,
+		Mode:                BackupTypeIncremental,
+		StateDir:            t.TempDir(),
+	})
+	createAndFinishBackup(t, service)
+
+	writeTestFile(t, sourceDir, "config.json", "changed config", modTime.Add(time.Minute))
+	data, _ := createAndFinishBackup(t, service)
+
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	if manifest.Type != BackupTypeIncremental {
+		t.Fatalf("Expected incremental backup, got %s", manifest.Type)
+	}
+
+	// 未变化的文件不在归档中，但仍带有基准备份记录的哈希
+	expected := fmt.Sprintf("%x", sha256.Sum256([]byte("attachment")))
+	if got := manifest.Files["attachments/a/1"].SHA256; got != expected {
+		t.Errorf("Expected base hash %s for unchanged file, got %q", expected, got)
+	}
+}
+
+func TestSidecarSignature(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeTestFile(t, sourceDir, "config.json", "config", time.Now())
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: sourceDir,
+		Password:            "sidecar-password",
+		KDF:                 KDFPBKDF2,
+	})
+	data, filename := createBackupData(t, service)
+
+	raw, err := service.Sidecar(filename)
+	if err != nil {
+		t.Fatalf("Failed to create sidecar: %v", err)
+	}
+
+	sidecar, err := service.ReadSidecar(raw)
+	if err != nil {
+		t.Fatalf("Failed to read sidecar: %v", err)
+	}
+	if !sidecar.Signed {
+		t.Error("Expected sidecar to be signed")
+	}
+	if sidecar.Archive != filename || sidecar.ArchiveSize != int64(len(data)) {
+		t.Errorf("Unexpected archive info: %s, %d", sidecar.Archive, sidecar.ArchiveSize)
+	}
+	if sidecar.ArchiveSHA256 != fmt.Sprintf("%x", sha256.Sum256(data)) {
+		t.Error("Sidecar archive hash doesn't match backup data")
+	}
+	if sidecar.Manifest.Files["config.json"].SHA256 == "" {
+		t.Error("Expected file hashes in sidecar manifest")
+	}
+
+	// 篡改内容或使用其他密码都无法通过校验
+	tampered := bytes.Replace(raw, []byte(`config.json`), []byte(`config.jsom`), 1)
+	if _, err := service.ReadSidecar(tampered); err == nil {
+		t.Error("Expected tampered sidecar to be rejected")
+	}
+	other := NewService(BackupOptions{Password: "other-password"})
+	if _, err := other.ReadSidecar(raw); err == nil {
+		t.Error("Expected sidecar signed with another password to be rejected")
+	}
+
+	// 未设置密码时可以读取，但不视为已验证
+	unsigned, err := NewService(BackupOptions{}).ReadSidecar(raw)
+	if err != nil {
+		t.Fatalf("Failed to read sidecar without password: %v", err)
+	}
+	if unsigned.Signed {
+		t.Error("Expected sidecar read without password to be unverified")
+	}
+}
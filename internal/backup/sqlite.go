@@ -0,0 +1,205 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	_ "github.com/lib-x/entsqlite"
+	"go.uber.org/zap"
+)
+
+// Vaultwarden 默认使用的 SQLite 数据库文件名
+const vaultwardenDBName = "db.sqlite3"
+
+// ErrDatabaseCorrupt 表示 SQLite 的完整性检查报告了问题
+var ErrDatabaseCorrupt = errors.New("database integrity check failed")
+
+// IntegrityCheck 为创建备份前对数据库运行的完整性检查
+type IntegrityCheck string
+
+const (
+	// IntegrityQuick 运行 PRAGMA quick_check，不校验索引内容，开销较小
+	IntegrityQuick IntegrityCheck = "quick"
+	// IntegrityFull 运行完整的 PRAGMA integrity_check
+	IntegrityFull IntegrityCheck = "full"
+	// IntegrityOff 不检查数据库
+	IntegrityOff IntegrityCheck = "off"
+)
+
+// IntegrityOK 和 IntegritySkipped 为 CheckDatabase 返回的检查结果
+const (
+	IntegrityOK      = "ok"
+	IntegritySkipped = "skipped"
+)
+
+// ParseIntegrityCheck 解析完整性检查的方式，空字符串表示默认的 quick
+func ParseIntegrityCheck(value string) (IntegrityCheck, error) {
+	switch IntegrityCheck(strings.ToLower(strings.TrimSpace(value))) {
+	case "", IntegrityQuick:
+		return IntegrityQuick, nil
+	case IntegrityFull:
+		return IntegrityFull, nil
+	case IntegrityOff, "none":
+		return IntegrityOff, nil
+	default:
+		return "", fmt.Errorf("unsupported integrity check: %s", value)
+	}
+}
+
+// sqliteSidecarSuffixes 是 SQLite 运行时的辅助文件，快照已包含其中的数据
+var sqliteSidecarSuffixes = []string{"-wal", "-shm", "-journal"}
+
+// isSQLiteSidecar 判断 relPath 是否为数据库的 WAL/SHM 等辅助文件
+func isSQLiteSidecar(relPath string) bool {
+	for _, suffix := range sqliteSidecarSuffixes {
+		if filepath.ToSlash(relPath) == vaultwardenDBName+suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// openSQLite 以只读方式打开 SQLite 数据库。Vaultwarden 可能正在写入，因此设置 busy_timeout 等待锁释放。
+// 路径经过转义后放入 file: URI，包含 ?、# 或 % 的路径不会被解析为 URI 参数
+func openSQLite(path string) (*sql.DB, error) {
+	dsn := url.URL{
+		Scheme:   "file",
+		Opaque:   (&url.URL{Path: filepath.ToSlash(path)}).EscapedPath(),
+		RawQuery: "mode=ro&_pragma=busy_timeout(10000)",
+	}
+	return sql.Open("sqlite3", dsn.String())
+}
+
+// checkDatabaseIntegrity 以只读方式打开数据库并运行 integrity_check，quick 为 true 时运行
+// 开销较小、不校验索引内容的 quick_check。数据库损坏时返回的错误包含 SQLite 报告的问题。
+func checkDatabaseIntegrity(ctx context.Context, dbPath string, quick bool) error {
+	db, err := openSQLite(dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	pragma := "PRAGMA integrity_check"
+	if quick {
+		pragma = "PRAGMA quick_check"
+	}
+
+	rows, err := db.QueryContext(ctx, pragma)
+	if err != nil {
+		return integrityError(err)
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return integrityError(err)
+		}
+		if line != "ok" {
+			problems = append(problems, line)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return integrityError(err)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrDatabaseCorrupt, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// CheckDatabase 以只读方式对数据目录中的 Vaultwarden 数据库运行完整性检查，返回检查结果：
+// 通过时为 IntegrityOK，数据库不存在或检查被关闭时为 IntegritySkipped，失败时为错误信息。
+// 数据库损坏时返回的错误包含 ErrDatabaseCorrupt，此时不能继续创建备份，否则损坏的数据库
+// 会随着保留策略逐渐替换掉之前完好的备份。
+func (s *Service) CheckDatabase(ctx context.Context) (string, error) {
+	if s.integrityCheck == IntegrityOff {
+		return IntegritySkipped, nil
+	}
+
+	dbPath := filepath.Join(s.vaultwardenDataPath, vaultwardenDBName)
+	if _, err := os.Stat(dbPath); err != nil {
+		if os.IsNotExist(err) {
+			return IntegritySkipped, nil
+		}
+		return err.Error(), err
+	}
+
+	start := time.Now()
+	if err := checkDatabaseIntegrity(ctx, dbPath, s.integrityCheck == IntegrityQuick); err != nil {
+		s.logger.Error("Database integrity check failed", zap.String("path", dbPath), zap.Error(err))
+		return err.Error(), err
+	}
+
+	s.logger.Info("Database integrity check passed",
+		zap.String("check", string(s.integrityCheck)), zap.Duration("duration", time.Since(start)))
+	return IntegrityOK, nil
+}
+
+// sqliteCorruptMessages 为 SQLite 的 SQLITE_CORRUPT 和 SQLITE_NOTADB 错误信息，
+// 数据库损坏严重时检查本身就会以这些错误失败
+var sqliteCorruptMessages = []string{"database disk image is malformed", "file is not a database"}
+
+// integrityError 包装运行完整性检查时的错误，数据库损坏导致的错误包含 ErrDatabaseCorrupt
+func integrityError(err error) error {
+	for _, msg := range sqliteCorruptMessages {
+		if strings.Contains(err.Error(), msg) {
+			return fmt.Errorf("%w: %v", ErrDatabaseCorrupt, err)
+		}
+	}
+	return fmt.Errorf("failed to check database integrity: %w", err)
+}
+
+// snapshotDatabase 以只读方式打开数据库，使用 VACUUM INTO 生成其事务一致性副本。
+// 返回的副本位于临时目录中，调用方使用完毕后需调用 cleanup 删除。
+func snapshotDatabase(ctx context.Context, dbPath string) (string, func(), error) {
+	tmpDir, err := os.MkdirTemp("", "vaultwarden-snapshot-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(tmpDir) }
+
+	db, err := openSQLite(dbPath)
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	snapshotPath := filepath.Join(tmpDir, vaultwardenDBName)
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", snapshotPath); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to snapshot database: %w", err)
+	}
+
+	return snapshotPath, cleanup, nil
+}
+
+// takeDatabaseSnapshot 如果数据目录中存在 Vaultwarden 数据库，则生成其一致性快照。
+// 数据库不存在时返回空路径。
+func (s *Service) takeDatabaseSnapshot(ctx context.Context) (string, func(), error) {
+	dbPath := filepath.Join(s.vaultwardenDataPath, vaultwardenDBName)
+	if _, err := os.Stat(dbPath); err != nil {
+		if os.IsNotExist(err) {
+			return "", func() {}, nil
+		}
+		return "", nil, err
+	}
+
+	snapshotPath, cleanup, err := snapshotDatabase(ctx, dbPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	s.logger.Debug("Database snapshot created", zap.String("path", snapshotPath))
+	return snapshotPath, cleanup, nil
+}
@@ -0,0 +1,327 @@
+package backup
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// createTestDatabase 在 dir 中创建 WAL 模式的 Vaultwarden 数据库，并保持连接打开，
+// 使写入的数据停留在 WAL 文件中
+func createTestDatabase(t *testing.T, dir string, rows int) *sql.DB {
+	t.Helper()
+
+	dsn := url.URL{Scheme: "file", Opaque: (&url.URL{Path: filepath.ToSlash(filepath.Join(dir, vaultwardenDBName))}).EscapedPath()}
+	db, err := sql.Open("sqlite3", dsn.String())
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	db.SetMaxOpenConns(1)
+
+	stmts := []string{
+		"PRAGMA journal_mode=WAL",
+		"PRAGMA wal_autocheckpoint=0",
+		"CREATE TABLE ciphers (uuid TEXT PRIMARY KEY, data TEXT)",
+	}
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("Failed to execute %q: %v", stmt, err)
+		}
+	}
+
+	for i := 0; i < rows; i++ {
+		if _, err := db.Exec("INSERT INTO ciphers (uuid, data) VALUES (?, ?)", i, "secret"); err != nil {
+			t.Fatalf("Failed to insert row: %v", err)
+		}
+	}
+
+	return db
+}
+
+func TestCreateBackupSnapshotsDatabase(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestDatabase(t, tempDir, 10)
+
+	if _, err := os.Stat(filepath.Join(tempDir, vaultwardenDBName+"-wal")); err != nil {
+		t.Fatalf("Expected WAL file to exist: %v", err)
+	}
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: tempDir,
+	})
+
+	reader, _, err := service.CreateBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to create backup: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Failed to read backup data: %v", err)
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Failed to open zip: %v", err)
+	}
+
+	var names []string
+	for _, file := range zipReader.File {
+		if isSQLiteSidecar(file.Name) {
+			t.Errorf("Sidecar file %s should not be archived", file.Name)
+		}
+		names = append(names, file.Name)
+	}
+
+	restoreDir := t.TempDir()
+	if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), restoreDir); err != nil {
+		t.Fatalf("Failed to extract backup: %v", err)
+	}
+
+	restored, err := sql.Open("sqlite3", "file:"+filepath.Join(restoreDir, vaultwardenDBName))
+	if err != nil {
+		t.Fatalf("Failed to open restored database: %v", err)
+	}
+	defer restored.Close()
+
+	var count int
+	if err := restored.QueryRow("SELECT COUNT(*) FROM ciphers").Scan(&count); err != nil {
+		t.Fatalf("Failed to query restored database (archived files: %v): %v", names, err)
+	}
+
+	if count != 10 {
+		t.Errorf("Expected 10 rows in restored database, got %d", count)
+	}
+}
+
+func TestIsSQLiteSidecar(t *testing.T) {
+	tests := map[string]bool{
+		"db.sqlite3":           false,
+		"db.sqlite3-wal":       true,
+		"db.sqlite3-shm":       true,
+		"db.sqlite3-journal":   true,
+		"attachments/file-wal": false,
+	}
+
+	for name, expected := range tests {
+		if got := isSQLiteSidecar(name); got != expected {
+			t.Errorf("isSQLiteSidecar(%q) = %v, want %v", name, got, expected)
+		}
+	}
+}
+
+func TestCheckDatabase(t *testing.T) {
+	tempDir := t.TempDir()
+
+	service := NewService(BackupOptions{VaultwardenDataPath: tempDir})
+	if result, err := service.CheckDatabase(context.Background()); err != nil || result != IntegritySkipped {
+		t.Errorf("Expected check to be skipped without a database, got %q, %v", result, err)
+	}
+
+	db := createTestDatabase(t, tempDir, 500)
+	for _, check := range []IntegrityCheck{IntegrityQuick, IntegrityFull} {
+		service := NewService(BackupOptions{VaultwardenDataPath: tempDir, IntegrityCheck: check})
+		if result, err := service.CheckDatabase(context.Background()); err != nil || result != IntegrityOK {
+			t.Errorf("%s: expected healthy database to pass, got %q, %v", check, result, err)
+		}
+	}
+	db.Close()
+
+	// 覆盖数据库中间的页面
+	dbPath := filepath.Join(tempDir, vaultwardenDBName)
+	file, err := os.OpenFile(dbPath, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteAt(bytes.Repeat([]byte{0xff}, 4096), 8192); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	result, err := service.CheckDatabase(context.Background())
+	if !errors.Is(err, ErrDatabaseCorrupt) || result == IntegrityOK {
+		t.Errorf("Expected ErrDatabaseCorrupt for a damaged database, got %q, %v", result, err)
+	}
+	if _, err := service.PrepareBackup(context.Background()); !errors.Is(err, ErrDatabaseCorrupt) {
+		t.Errorf("Expected PrepareBackup to refuse a damaged database, got: %v", err)
+	}
+
+	off := NewService(BackupOptions{VaultwardenDataPath: tempDir, IntegrityCheck: IntegrityOff})
+	if result, err := off.CheckDatabase(context.Background()); err != nil || result != IntegritySkipped {
+		t.Errorf("Expected check to be skipped when disabled, got %q, %v", result, err)
+	}
+}
+
+func TestCheckDatabaseNotADatabase(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTestFile(t, tempDir, vaultwardenDBName, "definitely not a sqlite database, just some text padding it out", time.Now())
+
+	service := NewService(BackupOptions{VaultwardenDataPath: tempDir})
+	if _, err := service.CheckDatabase(context.Background()); !errors.Is(err, ErrDatabaseCorrupt) {
+		t.Errorf("Expected ErrDatabaseCorrupt, got: %v", err)
+	}
+}
+
+func TestParseIntegrityCheck(t *testing.T) {
+	tests := map[string]IntegrityCheck{
+		"":      IntegrityQuick,
+		"quick": IntegrityQuick,
+		"FULL":  IntegrityFull,
+		"off":   IntegrityOff,
+	}
+	for value, expected := range tests {
+		if got, err := ParseIntegrityCheck(value); err != nil || got != expected {
+			t.Errorf("ParseIntegrityCheck(%q) = %q, %v, want %q", value, got, err, expected)
+		}
+	}
+
+	if _, err := ParseIntegrityCheck("paranoid"); err == nil {
+		t.Error("Expected error for unknown integrity check")
+	}
+}
+
+func TestVaultStats(t *testing.T) {
+	tempDir := t.TempDir()
+	db := createTestDatabase(t, tempDir, 10)
+	stmts := []string{
+		"CREATE TABLE users (uuid TEXT PRIMARY KEY)",
+		"INSERT INTO users (uuid) VALUES ('a'), ('b')",
+		"CREATE TABLE organizations (uuid TEXT PRIMARY KEY)",
+		"INSERT INTO organizations (uuid) VALUES ('org')",
+	}
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("Failed to execute %q: %v", stmt, err)
+		}
+	}
+
+	service := NewService(BackupOptions{VaultwardenDataPath: tempDir})
+	data, filename := createBackupData(t, service)
+
+	// 缺少的表（attachments、sends）记为 0
+	expected := VaultStats{Users: 2, Organizations: 1, Ciphers: 10}
+	stats, ok := service.ArchiveStats(filename)
+	if !ok || stats.Vault == nil || *stats.Vault != expected {
+		t.Fatalf("Expected vault stats %+v, got %+v", expected, stats.Vault)
+	}
+
+	manifest, err := service.ReadManifest(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	if manifest.Vault == nil || *manifest.Vault != expected {
+		t.Errorf("Expected manifest vault stats %+v, got %+v", expected, manifest.Vault)
+	}
+}
+
+func TestPreparedBackupSharesSnapshotAcrossFormats(t *testing.T) {
+	tempDir := t.TempDir()
+	db := createTestDatabase(t, tempDir, 10)
+
+	service := NewService(BackupOptions{VaultwardenDataPath: tempDir})
+	prepared, err := service.PrepareBackup(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to prepare backup: %v", err)
+	}
+	defer prepared.Close()
+
+	if vault := prepared.Vault(); vault == nil || vault.Ciphers != 10 {
+		t.Fatalf("Expected vault stats of the snapshot, got %+v", vault)
+	}
+
+	for i, format := range []ArchiveFormat{FormatZip, FormatTarZst} {
+		// 准备之后写入的数据不应出现在任何格式的归档中
+		if _, err := db.Exec("INSERT INTO ciphers (uuid, data) VALUES (?, ?)", 100+i, "later"); err != nil {
+			t.Fatalf("Failed to insert row: %v", err)
+		}
+
+		reader, filename, err := prepared.Open(context.Background(), format)
+		if err != nil {
+			t.Fatalf("Failed to open %s backup: %v", format, err)
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("Failed to read %s backup: %v", format, err)
+		}
+
+		restoreDir := t.TempDir()
+		if err := service.ExtractBackup(context.Background(), bytes.NewReader(data), restoreDir); err != nil {
+			t.Fatalf("Failed to extract %s backup: %v", format, err)
+		}
+
+		restored, err := sql.Open("sqlite3", "file:"+filepath.Join(restoreDir, vaultwardenDBName))
+		if err != nil {
+			t.Fatalf("Failed to open restored database: %v", err)
+		}
+		var count int
+		err = restored.QueryRow("SELECT COUNT(*) FROM ciphers").Scan(&count)
+		restored.Close()
+		if err != nil {
+			t.Fatalf("Failed to query restored database: %v", err)
+		}
+		if count != 10 {
+			t.Errorf("%s: expected the 10 rows of the shared snapshot, got %d", format, count)
+		}
+
+		if stats, ok := service.ArchiveStats(filename); !ok || stats.Vault == nil || stats.Vault.Ciphers != 10 {
+			t.Errorf("%s: expected vault stats of the shared snapshot, got %+v", format, stats.Vault)
+		}
+	}
+
+	snapshotDir := filepath.Dir(prepared.snapshot)
+	prepared.Close()
+	if _, err := os.Stat(snapshotDir); !os.IsNotExist(err) {
+		t.Errorf("Expected the snapshot to be removed on Close, got: %v", err)
+	}
+}
+
+func TestSnapshotDatabaseEscapesPath(t *testing.T) {
+	// 路径中的 ?、# 和 % 不能被当作 URI 参数或转义
+	dir := filepath.Join(t.TempDir(), "vault ?mode=memory#1 100%")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writer := createTestDatabase(t, dir, 5)
+
+	dbPath := filepath.Join(dir, vaultwardenDBName)
+	snapshotPath, cleanup, err := snapshotDatabase(context.Background(), dbPath)
+	if err != nil {
+		t.Fatalf("Failed to snapshot database: %v", err)
+	}
+	defer cleanup()
+
+	snapshot, err := openSQLite(snapshotPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snapshot.Close()
+
+	var count int
+	if err := snapshot.QueryRow("SELECT COUNT(*) FROM ciphers").Scan(&count); err != nil || count != 5 {
+		t.Fatalf("Expected 5 rows in the snapshot, got %d: %v", count, err)
+	}
+
+	// 快照只以只读方式打开正在使用的数据库
+	db, err := openSQLite(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("INSERT INTO ciphers (uuid, data) VALUES ('ro', 'secret')"); err == nil {
+		t.Error("Expected the database to be opened read-only")
+	}
+	if _, err := writer.Exec("INSERT INTO ciphers (uuid, data) VALUES ('rw', 'secret')"); err != nil {
+		t.Errorf("Failed to write to the live database after the snapshot: %v", err)
+	}
+}
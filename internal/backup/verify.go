@@ -0,0 +1,99 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ErrCorrupt 表示备份无法完整读取或解密，或内容与 manifest 不一致
+var ErrCorrupt = errors.New("backup is corrupt")
+
+// VerifyResult 为一次备份校验的结果
+type VerifyResult struct {
+	// Files 为归档中的文件数
+	Files int
+	// Checked 为与 manifest 中记录的大小和 SHA-256 比对过的文件数
+	Checked int
+	// Manifest 为归档中的 manifest，旧版本的备份为 nil
+	Manifest *Manifest
+}
+
+// VerifyBackup 解密并完整读取备份，将每个文件的大小和 SHA-256 与归档内的 manifest 比对。
+// 内容不一致时返回的错误包装 ErrCorrupt；备份无法解密或解析时返回其他错误。
+func (s *Service) VerifyBackup(ctx context.Context, data io.Reader) (*VerifyResult, error) {
+	plain, err := s.openBackupStream(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	type fileSum struct {
+		size int64
+		sum  string
+	}
+	sums := make(map[string]fileSum)
+
+	result := &VerifyResult{}
+	err = readArchive(plain, func(entry archiveEntry, r io.Reader) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir {
+			return nil
+		}
+		if entry.Name == ManifestName {
+			manifest, err := decodeManifest(r)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrCorrupt, err)
+			}
+			result.Manifest = manifest
+			return nil
+		}
+
+		hash := sha256.New()
+		size, err := io.Copy(hash, r)
+		if err != nil {
+			return fmt.Errorf("%w: failed to read %s: %v", ErrCorrupt, entry.Name, err)
+		}
+		sums[entry.Name] = fileSum{size: size, sum: fmt.Sprintf("%x", hash.Sum(nil))}
+		result.Files++
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrCorrupt) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+
+	if result.Manifest == nil {
+		return result, nil
+	}
+
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		expected, ok := result.Manifest.Files[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not listed in the manifest", ErrCorrupt, name)
+		}
+		// 旧版本的 manifest 没有记录哈希
+		if expected.SHA256 == "" {
+			continue
+		}
+		got := sums[name]
+		if got.size != expected.Size || got.sum != expected.SHA256 {
+			return nil, fmt.Errorf("%w: %s does not match the manifest (size %d, expected %d)", ErrCorrupt, name, got.size, expected.Size)
+		}
+		result.Checked++
+	}
+
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerifyBackup(t *testing.T) {
+	sourceDir := t.TempDir()
+	modTime := time.Now().Add(-time.Hour)
+	writeTestFile(t, sourceDir, "config.json", strings.Repeat("config ", 100), modTime)
+	writeTestFile(t, sourceDir, "attachments/a/1", strings.Repeat("attachment ", 100), modTime)
+
+	for _, format := range []ArchiveFormat{FormatZip, FormatTarGz} {
+		service := NewService(BackupOptions{VaultwardenDataPath: sourceDir, Format: format})
+		data, _ := createBackupData(t, service)
+
+		result, err := service.VerifyBackup(context.Background(), bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: failed to verify backup: %v", format, err)
+		}
+		if result.Files != 2 || result.Checked != 2 || result.Manifest == nil {
+			t.Errorf("%s: unexpected result: %+v", format, result)
+		}
+
+		// 翻转归档中间的一个字节
+		corrupted := bytes.Clone(data)
+		corrupted[len(corrupted)/2] ^= 0xff
+		if _, err := service.VerifyBackup(context.Background(), bytes.NewReader(corrupted)); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: expected ErrCorrupt for corrupted backup, got: %v", format, err)
+		}
+	}
+}
+
+func TestVerifyEncryptedBackup(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeTestFile(t, sourceDir, "config.json", "config", time.Now())
+
+	service := NewService(BackupOptions{
+		VaultwardenDataPath: sourceDir,
+		Password:            "verify-password",
+		KDF:                 KDFPBKDF2,
+	})
+	data, _ := createBackupData(t, service)
+
+	if _, err := service.VerifyBackup(context.Background(), bytes.NewReader(data)); err != nil {
+		t.Fatalf("Failed to verify encrypted backup: %v", err)
+	}
+
+	corrupted := bytes.Clone(data)
+	corrupted[len(corrupted)-20] ^= 0xff
+	if _, err := service.VerifyBackup(context.Background(), bytes.NewReader(corrupted)); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("Expected ErrCorrupt for tampered ciphertext, got: %v", err)
+	}
+
+	// 密码错误不属于备份损坏
+	other := NewService(BackupOptions{Password: "wrong-password"})
+	if _, err := other.VerifyBackup(context.Background(), bytes.NewReader(data)); err == nil || errors.Is(err, ErrCorrupt) {
+		t.Errorf("Expected a non-corruption error for a wrong password, got: %v", err)
+	}
+}
@@ -48,9 +48,12 @@ func (s *Service) CleanupOldSyncJobs(ctx context.Context) error {
 		default:
 		}
 
-		// 获取一批要删除的记录ID
+		// 获取一批要删除的记录ID。可疑备份的记录指向隔离目录中的备份，需要人工处理，不自动清理
 		ids, err := s.client.SyncJob.Query().
-			Where(syncjob.CreatedAtLT(cutoffTime)).
+			Where(
+				syncjob.CreatedAtLT(cutoffTime),
+				syncjob.StatusNEQ(syncjob.StatusSuspicious),
+			).
 			Limit(batchSize).
 			IDs(ctx)
 
@@ -132,6 +135,13 @@ func (s *Service) GetSyncJobStats(ctx context.Context) (map[string]interface{},
 		return nil, err
 	}
 
+	suspiciousJobs, err := s.client.SyncJob.Query().
+		Where(syncjob.StatusEQ(syncjob.StatusSuspicious)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get oldest and newest records
 	var oldestJob, newestJob *ent.SyncJob
 	oldestJob, _ = s.client.SyncJob.Query().
@@ -143,12 +153,13 @@ func (s *Service) GetSyncJobStats(ctx context.Context) (map[string]interface{},
 		First(ctx)
 
 	stats := map[string]interface{}{
-		"total_jobs":     totalJobs,
-		"completed_jobs": completedJobs,
-		"failed_jobs":    failedJobs,
-		"running_jobs":   runningJobs,
-		"pending_jobs":   pendingJobs,
-		"retention_days": s.config.Sync.HistoryRetentionDays,
+		"total_jobs":      totalJobs,
+		"completed_jobs":  completedJobs,
+		"failed_jobs":     failedJobs,
+		"running_jobs":    runningJobs,
+		"pending_jobs":    pendingJobs,
+		"suspicious_jobs": suspiciousJobs,
+		"retention_days":  s.config.Sync.HistoryRetentionDays,
 	}
 
 	if oldestJob != nil {
@@ -189,6 +200,7 @@ func (s *Service) CleanupOldSyncJobsByStorage(ctx context.Context, storageID int
 				syncjob.And(
 					syncjob.HasStorageWith(entstorage.IDEQ(storageID)),
 					syncjob.CreatedAtLT(cutoffTime),
+					syncjob.StatusNEQ(syncjob.StatusSuspicious),
 				),
 			).
 			Limit(batchSize).
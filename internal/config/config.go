@@ -1,7 +1,11 @@
 package config
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/viper"
 )
@@ -15,6 +19,7 @@ type Config struct {
 	Logging      LoggingConfig      `mapstructure:"logging"`
 	Vaultwarden  VaultwardenConfig  `mapstructure:"vaultwarden"`
 	Notification NotificationConfig `mapstructure:"notification"`
+	Hooks        HooksConfig        `mapstructure:"hooks"`
 }
 
 type ServerConfig struct {
@@ -87,13 +92,43 @@ func (c S3Config) Validate() error {
 }
 
 type SyncConfig struct {
-	Interval             int    `mapstructure:"interval"`
-	CompressionLevel     int    `mapstructure:"compression_level"`
-	Password             string `mapstructure:"password"`
-	HistoryRetentionDays int    `mapstructure:"history_retention_days"`
-	MaxRetries           int    `mapstructure:"max_retries"`
-	RetryDelaySeconds    int    `mapstructure:"retry_delay_seconds"`
-	Concurrency          int    `mapstructure:"concurrency"`
+	Interval              int      `mapstructure:"interval"`
+	CompressionLevel      int      `mapstructure:"compression_level"`
+	Password              string   `mapstructure:"password"`
+	KDF                   string   `mapstructure:"kdf"`
+	Recipients            []string `mapstructure:"recipients"`
+	ArchiveFormat         string   `mapstructure:"archive_format"`
+	HistoryRetentionDays  int      `mapstructure:"history_retention_days"`
+	MaxRetries            int      `mapstructure:"max_retries"`
+	RetryDelaySeconds     int      `mapstructure:"retry_delay_seconds"`
+	Concurrency           int      `mapstructure:"concurrency"`
+	Include               []string `mapstructure:"include"`
+	Exclude               []string `mapstructure:"exclude"`
+	RulesFile             string   `mapstructure:"rules_file"`
+	BackupMode            string   `mapstructure:"backup_mode"`
+	FullBackupEvery       int      `mapstructure:"full_backup_every"`
+	StateDir              string   `mapstructure:"state_dir"`
+	Repository            bool     `mapstructure:"repository"`
+	VerifyAfterUpload     bool     `mapstructure:"verify_after_upload"`
+	VerifyInterval        int      `mapstructure:"verify_interval"`
+	MaxRestoreSizeMB      int64    `mapstructure:"max_restore_size_mb"`
+	MaxRestoreFiles       int      `mapstructure:"max_restore_files"`
+	IntegrityCheck        string   `mapstructure:"integrity_check"`
+	AnomalyShrinkPercent  int      `mapstructure:"anomaly_shrink_percent"`
+	AnomalyChangedPercent int      `mapstructure:"anomaly_changed_percent"`
+	QuarantinePrefix      string   `mapstructure:"quarantine_prefix"`
+	SkipUnchanged         bool     `mapstructure:"skip_unchanged"`
+	VolumeSizeMB          int64    `mapstructure:"volume_size_mb"`
+	TempDir               string   `mapstructure:"temp_dir"`
+}
+
+// HooksConfig 为备份前后通过系统 shell 执行的命令
+type HooksConfig struct {
+	PreBackup      string `mapstructure:"pre_backup"`
+	PostBackup     string `mapstructure:"post_backup"`
+	OnSuccess      string `mapstructure:"on_success"`
+	OnFailure      string `mapstructure:"on_failure"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds"`
 }
 
 type LoggingConfig struct {
@@ -125,15 +160,32 @@ func Load() (*Config, error) {
 	viper.SetDefault("database.dsn", "./data/syncer.db")
 	viper.SetDefault("sync.interval", 3600)
 	viper.SetDefault("sync.compression_level", 6)
+	viper.SetDefault("sync.kdf", "argon2id")
+	viper.SetDefault("sync.archive_format", "zip")
 	viper.SetDefault("sync.history_retention_days", 30)
 	viper.SetDefault("sync.max_retries", 3)
 	viper.SetDefault("sync.retry_delay_seconds", 5)
 	viper.SetDefault("sync.concurrency", 3)
+	viper.SetDefault("sync.rules_file", "./data/backup_rules.json")
+	viper.SetDefault("sync.backup_mode", "full")
+	viper.SetDefault("sync.full_backup_every", 24)
+	viper.SetDefault("sync.state_dir", "./data/state")
+	viper.SetDefault("sync.repository", false)
+	viper.SetDefault("sync.max_restore_size_mb", 65536)
+	viper.SetDefault("sync.max_restore_files", 1000000)
+	viper.SetDefault("sync.integrity_check", "quick")
+	viper.SetDefault("sync.anomaly_shrink_percent", 50)
+	viper.SetDefault("sync.anomaly_changed_percent", 80)
+	viper.SetDefault("sync.quarantine_prefix", "quarantine")
+	viper.SetDefault("sync.skip_unchanged", true)
+	viper.SetDefault("sync.volume_size_mb", 0)
+	viper.SetDefault("sync.temp_dir", "")
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.file", "./logs/vaultwarden-syncer.log")
 	viper.SetDefault("vaultwarden.data_path", "./data/vaultwarden")
 	viper.SetDefault("notification.email.enabled", false)
 	viper.SetDefault("notification.email.smtp_port", 587)
+	viper.SetDefault("hooks.timeout_seconds", 300)
 
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -151,5 +203,86 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	// 在设置页面保存的规则优先于配置文件中的规则
+	rules, err := LoadPathRules(config.Sync.RulesFile)
+	if err != nil {
+		return nil, err
+	}
+	if rules != nil {
+		config.Sync.Include = rules.Include
+		config.Sync.Exclude = rules.Exclude
+	}
+
 	return &config, nil
 }
+
+// ErrNoRulesFile 表示没有配置规则文件，修改无法持久化
+var ErrNoRulesFile = errors.New("no rules file configured")
+
+// PathRules 为在设置页面保存的备份 include/exclude 规则
+type PathRules struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// LoadPathRules 读取规则文件，文件不存在或 path 为空时返回 nil
+func LoadPathRules(path string) (*PathRules, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file: %w", err)
+	}
+
+	var rules PathRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to decode rules file %s: %w", path, err)
+	}
+	return &rules, nil
+}
+
+// SavePathRules 将备份的 include/exclude 规则写入规则文件，不修改 config.yaml。
+// 先写入临时文件再重命名，写入失败时保留原有规则；path 为空时返回 ErrNoRulesFile
+func SavePathRules(path string, include, exclude []string) error {
+	if path == "" {
+		return ErrNoRulesFile
+	}
+
+	data, err := json.MarshalIndent(PathRules{Include: include, Exclude: exclude}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode rules: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create rules directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(dir, ".backup-rules-*")
+	if err != nil {
+		return fmt.Errorf("failed to create rules file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write rules file: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write rules file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write rules file: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace rules file: %w", err)
+	}
+	return nil
+}
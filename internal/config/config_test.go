@@ -1,7 +1,13 @@
 package config
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
+
+	"github.com/spf13/viper"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -118,4 +124,66 @@ func TestS3ConfigValidation(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestPathRulesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules", "backup_rules.json")
+
+	rules, err := LoadPathRules(path)
+	if err != nil || rules != nil {
+		t.Fatalf("LoadPathRules() without a file = %v, %v, want nil, nil", rules, err)
+	}
+
+	if err := SavePathRules(path, []string{"db.sqlite3", "attachments/"}, []string{"tmp/"}); err != nil {
+		t.Fatalf("SavePathRules() error = %v", err)
+	}
+	rules, err = LoadPathRules(path)
+	if err != nil {
+		t.Fatalf("LoadPathRules() error = %v", err)
+	}
+	if !slices.Equal(rules.Include, []string{"db.sqlite3", "attachments/"}) || !slices.Equal(rules.Exclude, []string{"tmp/"}) {
+		t.Errorf("LoadPathRules() = %+v, want the saved rules", rules)
+	}
+
+	if err := SavePathRules("", nil, nil); !errors.Is(err, ErrNoRulesFile) {
+		t.Errorf("SavePathRules() without a path error = %v, want ErrNoRulesFile", err)
+	}
+}
+
+func TestLoadAppliesRulesFile(t *testing.T) {
+	dir := t.TempDir()
+	rulesFile := filepath.Join(dir, "backup_rules.json")
+	configFile := filepath.Join(dir, "config.yaml")
+	original := "# keep this comment\nsync:\n  exclude: [\"tmp/\"]\n  rules_file: " + rulesFile + "\n"
+	if err := os.WriteFile(configFile, []byte(original), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	t.Cleanup(viper.Reset)
+	viper.Reset()
+
+	if err := SavePathRules(rulesFile, []string{"attachments/"}, []string{"icon_cache/"}); err != nil {
+		t.Fatalf("SavePathRules() error = %v", err)
+	}
+
+	config, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !slices.Equal(config.Sync.Include, []string{"attachments/"}) || !slices.Equal(config.Sync.Exclude, []string{"icon_cache/"}) {
+		t.Errorf("Load() rules = %v, %v, want the rules file", config.Sync.Include, config.Sync.Exclude)
+	}
+
+	data, err := os.ReadFile(configFile)
+	if err != nil || string(data) != original {
+		t.Errorf("config.yaml was modified: %q, %v", data, err)
+	}
+}
@@ -7,21 +7,30 @@ import (
 	"html/template"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ca-x/vaultwarden-syncer/ent"
+	"github.com/ca-x/vaultwarden-syncer/ent/azureblobconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/ftpconfig"
+	"github.com/ca-x/vaultwarden-syncer/ent/gcsconfig"
 	"github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
 	"github.com/ca-x/vaultwarden-syncer/internal/cleanup"
+	"github.com/ca-x/vaultwarden-syncer/internal/config"
 	"github.com/ca-x/vaultwarden-syncer/internal/i18n"
 	"github.com/ca-x/vaultwarden-syncer/internal/scheduler"
 	"github.com/ca-x/vaultwarden-syncer/internal/service"
 	"github.com/ca-x/vaultwarden-syncer/internal/setup"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
 	"github.com/ca-x/vaultwarden-syncer/internal/sync"
 	tmpl "github.com/ca-x/vaultwarden-syncer/internal/template"
+	"github.com/ca-x/vaultwarden-syncer/internal/version"
 
 	"github.com/labstack/echo/v4"
 )
@@ -32,11 +41,13 @@ type Handler struct {
 	syncService      *sync.Service
 	cleanupService   *cleanup.Service
 	schedulerService *scheduler.Service
+	backupService    *backup.Service
 	client           *ent.Client
 	tmplManager      *tmpl.Manager
+	rulesFile        string
 }
 
-func New(userService *service.UserService, setupService *setup.SetupService, syncService *sync.Service, cleanupService *cleanup.Service, schedulerService *scheduler.Service, client *ent.Client) *Handler {
+func New(userService *service.UserService, setupService *setup.SetupService, syncService *sync.Service, cleanupService *cleanup.Service, schedulerService *scheduler.Service, backupService *backup.Service, client *ent.Client, cfg *config.Config) *Handler {
 	tmplManager, err := tmpl.New()
 	if err != nil {
 		// Log error but don't fail, fallback to basic responses
@@ -50,8 +61,10 @@ func New(userService *service.UserService, setupService *setup.SetupService, syn
 		syncService:      syncService,
 		cleanupService:   cleanupService,
 		schedulerService: schedulerService,
+		backupService:    backupService,
 		client:           client,
 		tmplManager:      tmplManager,
+		rulesFile:        cfg.Sync.RulesFile,
 	}
 }
 
@@ -61,6 +74,86 @@ func (h *Handler) Health(c echo.Context) error {
 	})
 }
 
+// vaultHistoryLimit 为仪表盘数据增长图中显示的备份数
+const vaultHistoryLimit = 30
+
+// cipherDropPercent 为相邻两次备份之间密码项减少超过多少百分比时提示可能的数据丢失
+const cipherDropPercent = 10
+
+// cipherDrop 描述相邻两次备份之间密码项数量的明显下降
+type cipherDrop struct {
+	Previous int64
+	Current  int64
+	Percent  float64
+	Time     string
+}
+
+// vaultHistory 返回最近备份的数据统计（按时间先后排列），以及最新一次备份相对上一次的密码项下降。
+// 同一次备份上传到多个存储时只计一次。
+func (h *Handler) vaultHistory(ctx context.Context) ([]tmpl.VaultPoint, *cipherDrop) {
+	// 只统计默认实例，其他数据源的数据量不能相互比较
+	jobs, err := h.client.SyncJob.Query().
+		Where(
+			syncjob.Not(syncjob.HasSource()),
+			syncjob.OperationEQ(syncjob.OperationBackup),
+			syncjob.StatusIn(syncjob.StatusCompleted, syncjob.StatusSuspicious),
+			syncjob.VaultCiphersNotNil(),
+		).
+		Order(ent.Desc(syncjob.FieldCreatedAt)).
+		Limit(vaultHistoryLimit * 4).
+		All(ctx)
+	if err != nil {
+		return nil, nil
+	}
+
+	var points []tmpl.VaultPoint
+	seen := make(map[string]bool)
+	for _, job := range jobs {
+		if job.Filename != "" {
+			if seen[job.Filename] {
+				continue
+			}
+			seen[job.Filename] = true
+		}
+		points = append(points, tmpl.VaultPoint{
+			Time:          job.CreatedAt.Format("2006-01-02 15:04"),
+			Users:         job.VaultUsers,
+			Organizations: job.VaultOrganizations,
+			Ciphers:       job.VaultCiphers,
+			Attachments:   job.VaultAttachments,
+			Sends:         job.VaultSends,
+		})
+		if len(points) == vaultHistoryLimit {
+			break
+		}
+	}
+	slices.Reverse(points)
+
+	var maxCiphers int64
+	for _, point := range points {
+		maxCiphers = max(maxCiphers, point.Ciphers)
+	}
+	for i := range points {
+		if maxCiphers > 0 {
+			points[i].Height = int(points[i].Ciphers * 100 / maxCiphers)
+		}
+	}
+
+	var drop *cipherDrop
+	if n := len(points); n >= 2 {
+		previous, current := points[n-2], points[n-1]
+		if previous.Ciphers > 0 && current.Ciphers < previous.Ciphers {
+			percent := float64(previous.Ciphers-current.Ciphers) * 100 / float64(previous.Ciphers)
+			if percent >= cipherDropPercent {
+				points[n-1].Dropped = true
+				drop = &cipherDrop{Previous: previous.Ciphers, Current: current.Ciphers, Percent: percent, Time: current.Time}
+			}
+		}
+	}
+
+	return points, drop
+}
+
 func (h *Handler) Index(c echo.Context) error {
 	// Authentication middleware already checks setup status,
 	// so we can directly show the dashboard
@@ -108,6 +201,17 @@ func (h *Handler) Index(c echo.Context) error {
 			if lastJob.Message != "" {
 				lastSyncError = lastJob.Message
 			}
+		case syncjob.StatusSuspicious:
+			syncStatus = translator.T(lang, "status.sync_suspicious")
+			syncStatusClass = "icon-warning"
+			syncStatusIcon = "mdi:alert"
+			if lastJob.Message != "" {
+				lastSyncError = lastJob.Message
+			}
+		case syncjob.StatusSkipped:
+			syncStatus = translator.T(lang, "status.sync_skipped")
+			syncStatusClass = "icon-success"
+			syncStatusIcon = "mdi:check-circle-outline"
 		case syncjob.StatusRunning:
 			syncStatus = translator.T(lang, "status.sync_running")
 			syncStatusClass = "icon-warning"
@@ -125,6 +229,49 @@ func (h *Handler) Index(c echo.Context) error {
 		jobsCount = 0
 	}
 
+	// Get the result of the latest pre-backup database integrity check
+	integrityStatus := ""
+	integrityClass := "icon-info"
+	integrityIcon := "mdi:database-check"
+	integrityError := ""
+
+	integrityJob, err := h.client.SyncJob.Query().
+		Where(
+			syncjob.Not(syncjob.HasSource()),
+			syncjob.OperationEQ(syncjob.OperationBackup),
+			syncjob.IntegrityCheckNotNil(),
+			syncjob.IntegrityCheckNEQ(""),
+		).
+		Order(ent.Desc(syncjob.FieldCreatedAt)).
+		First(c.Request().Context())
+
+	if err == nil {
+		checkedAt := integrityJob.CreatedAt.Format("2006-01-02 15:04")
+		switch integrityJob.IntegrityCheck {
+		case backup.IntegrityOK:
+			integrityStatus = translator.T(lang, "dashboard.integrity_ok", checkedAt)
+			integrityClass = "icon-success"
+		case backup.IntegritySkipped:
+			integrityStatus = translator.T(lang, "dashboard.integrity_skipped", checkedAt)
+		default:
+			integrityStatus = translator.T(lang, "dashboard.integrity_failed", checkedAt)
+			integrityClass = "icon-danger"
+			integrityIcon = "mdi:database-alert"
+			integrityError = integrityJob.IntegrityCheck
+		}
+	}
+
+	vaultHistory, cipherDrop := h.vaultHistory(c.Request().Context())
+	var vaultLatest *tmpl.VaultPoint
+	if len(vaultHistory) > 0 {
+		vaultLatest = &vaultHistory[len(vaultHistory)-1]
+	}
+	cipherDropWarning := ""
+	if cipherDrop != nil {
+		cipherDropWarning = translator.T(lang, "dashboard.vault_cipher_drop",
+			cipherDrop.Previous, cipherDrop.Current, cipherDrop.Percent, cipherDrop.Time)
+	}
+
 	dashboardData := tmpl.DashboardData{
 		StorageCount:    storageCount,
 		LastSync:        lastSyncTime,
@@ -135,6 +282,13 @@ func (h *Handler) Index(c echo.Context) error {
 		SyncStatusClass: syncStatusClass,
 		SyncStatusIcon:  syncStatusIcon,
 		LastSyncError:   lastSyncError,
+		IntegrityStatus: integrityStatus,
+		IntegrityClass:  integrityClass,
+		IntegrityIcon:   integrityIcon,
+		IntegrityError:  integrityError,
+		VaultHistory:    vaultHistory,
+		VaultLatest:     vaultLatest,
+		CipherDrop:      cipherDropWarning,
 	}
 
 	html, err := h.tmplManager.RenderDashboard(dashboardData, lang, translator)
@@ -345,7 +499,11 @@ func (h *Handler) Settings(c echo.Context) error {
 		translator = i18n.New()
 	}
 
-	html, err := h.tmplManager.RenderSettings(lang, translator)
+	filter := h.backupService.PathFilter()
+	html, err := h.tmplManager.RenderSettings(lang, translator, tmpl.SettingsData{
+		Include: strings.Join(filter.Include, "\n"),
+		Exclude: strings.Join(filter.Exclude, "\n"),
+	})
 	if err != nil {
 		return c.String(http.StatusInternalServerError, "Failed to render settings page")
 	}
@@ -353,6 +511,46 @@ func (h *Handler) Settings(c echo.Context) error {
 	return c.HTML(http.StatusOK, html)
 }
 
+// UpdateBackupRules 更新备份的 include/exclude 规则
+func (h *Handler) UpdateBackupRules(c echo.Context) error {
+	lang := i18n.GetLanguageFromContext(c.Request().Context())
+	translator := i18n.GetTranslatorFromContext(c.Request().Context())
+	if translator == nil {
+		translator = i18n.New()
+	}
+
+	if err := c.Request().ParseForm(); err != nil {
+		return c.HTML(http.StatusBadRequest, `<div class="result error">Invalid form data</div>`)
+	}
+
+	include := splitPatterns(c.FormValue("include"))
+	exclude := splitPatterns(c.FormValue("exclude"))
+
+	if err := h.backupService.SetPathFilter(include, exclude); err != nil {
+		return c.HTML(http.StatusBadRequest, fmt.Sprintf(`<div class="result error">%s: %s</div>`, translator.T(lang, "settings.backup_rules_invalid"), template.HTMLEscapeString(err.Error())))
+	}
+
+	if err := config.SavePathRules(h.rulesFile, include, exclude); err != nil {
+		fmt.Printf("Failed to save backup rules: %v\n", err)
+		return c.HTML(http.StatusOK, fmt.Sprintf(`<div class="result warning">%s</div>`, translator.T(lang, "settings.backup_rules_not_persisted")))
+	}
+
+	return c.HTML(http.StatusOK, fmt.Sprintf(`<div class="result success">%s</div>`, translator.T(lang, "settings.backup_rules_updated")))
+}
+
+// splitPatterns 将多行文本拆分为规则列表，忽略空行和 # 开头的注释
+func splitPatterns(text string) []string {
+	var patterns []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns
+}
+
 // CreateStorage creates a new storage backend
 func (h *Handler) CreateStorage(c echo.Context) error {
 	// Parse form data
@@ -373,10 +571,17 @@ func (h *Handler) CreateStorage(c echo.Context) error {
 	}
 
 	// Validate storage type
-	if storageType != "webdav" && storageType != "s3" {
+	if storage.TypeValidator(storage.Type(storageType)) != nil {
 		return c.HTML(http.StatusBadRequest, `<div class="result error">Invalid storage type</div>`)
 	}
 
+	archiveFormat := c.FormValue("archive_format")
+	if archiveFormat != "" {
+		if _, err := backup.ParseArchiveFormat(archiveFormat); err != nil {
+			return c.HTML(http.StatusBadRequest, `<div class="result error">Invalid archive format</div>`)
+		}
+	}
+
 	// Start a transaction
 	tx, err := h.client.Tx(c.Request().Context())
 	if err != nil {
@@ -389,7 +594,8 @@ func (h *Handler) CreateStorage(c echo.Context) error {
 	storageBuilder := tx.Storage.
 		Create().
 		SetName(name).
-		SetEnabled(enabled)
+		SetEnabled(enabled).
+		SetArchiveFormat(archiveFormat)
 
 	// Convert string to storage.Type enum
 	switch storageType {
@@ -397,6 +603,16 @@ func (h *Handler) CreateStorage(c echo.Context) error {
 		storageBuilder.SetType(storage.TypeWebdav)
 	case "s3":
 		storageBuilder.SetType(storage.TypeS3)
+	case "local":
+		storageBuilder.SetType(storage.TypeLocal)
+	case "sftp":
+		storageBuilder.SetType(storage.TypeSftp)
+	case "ftp":
+		storageBuilder.SetType(storage.TypeFtp)
+	case "azureblob":
+		storageBuilder.SetType(storage.TypeAzureblob)
+	case "gcs":
+		storageBuilder.SetType(storage.TypeGcs)
 	default:
 		return c.HTML(http.StatusBadRequest, `<div class="result error">Invalid storage type</div>`)
 	}
@@ -469,6 +685,112 @@ func (h *Handler) CreateStorage(c echo.Context) error {
 			fmt.Printf("S3 config creation error: %v\n", err)
 			return c.HTML(http.StatusInternalServerError, `<div class="result error">Failed to create S3 config: `+err.Error()+`</div>`)
 		}
+	} else if storageType == "local" {
+		path, minFreeSpaceMB, err := parseLocalConfigForm(c)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, `<div class="result error">`+err.Error()+`</div>`)
+		}
+
+		fmt.Printf("Local config: path=%s, minFreeSpaceMB=%d\n", path, minFreeSpaceMB)
+
+		// Create local config
+		_, err = tx.LocalConfig.
+			Create().
+			SetPath(path).
+			SetMinFreeSpaceMB(minFreeSpaceMB).
+			SetSyncDirectory(c.FormValue("local_sync_directory") == "on").
+			SetStorageID(createdStorage.ID).
+			Save(c.Request().Context())
+
+		if err != nil {
+			fmt.Printf("Local config creation error: %v\n", err)
+			return c.HTML(http.StatusInternalServerError, `<div class="result error">Failed to create local config: `+err.Error()+`</div>`)
+		}
+	} else if storageType == "sftp" {
+		config, err := parseSFTPConfigForm(c, nil)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, `<div class="result error">`+err.Error()+`</div>`)
+		}
+
+		fmt.Printf("SFTP config: host=%s, port=%d, username=%s, baseDir=%s\n", config.Host, config.Port, config.Username, config.BaseDir)
+
+		// Create SFTP config
+		_, err = tx.SFTPConfig.
+			Create().
+			SetHost(config.Host).
+			SetPort(config.Port).
+			SetUsername(config.Username).
+			SetPassword(config.Password).
+			SetPrivateKey(config.PrivateKey).
+			SetPassphrase(config.Passphrase).
+			SetHostKey(config.HostKey).
+			SetBaseDir(config.BaseDir).
+			SetStorageID(createdStorage.ID).
+			Save(c.Request().Context())
+
+		if err != nil {
+			fmt.Printf("SFTP config creation error: %v\n", err)
+			return c.HTML(http.StatusInternalServerError, `<div class="result error">Failed to create SFTP config: `+err.Error()+`</div>`)
+		}
+	} else if storageType == "ftp" {
+		config, err := parseFTPConfigForm(c, nil)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, `<div class="result error">`+err.Error()+`</div>`)
+		}
+
+		fmt.Printf("FTP config: host=%s, port=%d, username=%s, tls=%s, baseDir=%s\n", config.Host, config.Port, config.Username, config.TLS, config.BaseDir)
+
+		// Create FTP config
+		_, err = tx.FTPConfig.
+			Create().
+			SetHost(config.Host).
+			SetPort(config.Port).
+			SetUsername(config.Username).
+			SetPassword(config.Password).
+			SetTLSMode(ftpconfig.TLSMode(config.TLS)).
+			SetSkipTLSVerify(config.SkipTLSVerify).
+			SetBaseDir(config.BaseDir).
+			SetStorageID(createdStorage.ID).
+			Save(c.Request().Context())
+
+		if err != nil {
+			fmt.Printf("FTP config creation error: %v\n", err)
+			return c.HTML(http.StatusInternalServerError, `<div class="result error">Failed to create FTP config: `+err.Error()+`</div>`)
+		}
+	} else if storageType == "azureblob" {
+		config, err := parseAzureBlobConfigForm(c, nil)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, `<div class="result error">`+err.Error()+`</div>`)
+		}
+
+		fmt.Printf("Azure Blob config: account=%s, container=%s, endpoint=%s, tier=%s\n", config.AccountName, config.Container, config.Endpoint, config.AccessTier)
+
+		// Create Azure Blob config
+		_, err = newAzureBlobConfigCreate(tx, config).
+			SetStorageID(createdStorage.ID).
+			Save(c.Request().Context())
+
+		if err != nil {
+			fmt.Printf("Azure Blob config creation error: %v\n", err)
+			return c.HTML(http.StatusInternalServerError, `<div class="result error">Failed to create Azure Blob config: `+err.Error()+`</div>`)
+		}
+	} else if storageType == "gcs" {
+		config, err := parseGCSConfigForm(c, nil)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, `<div class="result error">`+err.Error()+`</div>`)
+		}
+
+		fmt.Printf("GCS config: bucket=%s, endpoint=%s, class=%s, retention=%d days\n", config.Bucket, config.Endpoint, config.StorageClass, config.RetentionDays)
+
+		// Create GCS config
+		_, err = newGCSConfigCreate(tx, config).
+			SetStorageID(createdStorage.ID).
+			Save(c.Request().Context())
+
+		if err != nil {
+			fmt.Printf("GCS config creation error: %v\n", err)
+			return c.HTML(http.StatusInternalServerError, `<div class="result error">Failed to create GCS config: `+err.Error()+`</div>`)
+		}
 	}
 
 	// Commit the transaction
@@ -535,6 +857,201 @@ func (h *Handler) CreateStorage(c echo.Context) error {
     </div>`, translator.T(lang, "storage.create_success")))
 }
 
+// parseLocalConfigForm reads and validates the local storage form fields
+func parseLocalConfigForm(c echo.Context) (string, int64, error) {
+	path := c.FormValue("local_path")
+	if path == "" || !filepath.IsAbs(path) {
+		return "", 0, fmt.Errorf("Local storage requires an absolute path")
+	}
+
+	var minFreeSpaceMB int64
+	if value := c.FormValue("local_min_free_space_mb"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 {
+			return "", 0, fmt.Errorf("Minimum free space must be a non-negative number")
+		}
+		minFreeSpaceMB = parsed
+	}
+
+	return filepath.Clean(path), minFreeSpaceMB, nil
+}
+
+// parseSFTPConfigForm reads and validates the SFTP storage form fields. When both
+// password and private key are left blank, the credentials of existing are kept.
+func parseSFTPConfigForm(c echo.Context, existing *ent.SFTPConfig) (storageProvider.SFTPConfig, error) {
+	config := storageProvider.SFTPConfig{
+		Host:       strings.TrimSpace(c.FormValue("sftp_host")),
+		Port:       22,
+		Username:   c.FormValue("sftp_username"),
+		Password:   c.FormValue("sftp_password"),
+		PrivateKey: strings.TrimSpace(c.FormValue("sftp_private_key")),
+		Passphrase: c.FormValue("sftp_passphrase"),
+		HostKey:    strings.TrimSpace(c.FormValue("sftp_host_key")),
+		BaseDir:    strings.TrimSpace(c.FormValue("sftp_base_dir")),
+	}
+	if config.PrivateKey != "" {
+		config.PrivateKey += "\n"
+	}
+
+	if value := c.FormValue("sftp_port"); value != "" {
+		port, err := strconv.Atoi(value)
+		if err != nil || port < 1 || port > 65535 {
+			return config, fmt.Errorf("SFTP port must be between 1 and 65535")
+		}
+		config.Port = port
+	}
+
+	if existing != nil {
+		if config.Password == "" && config.PrivateKey == "" {
+			config.Password = existing.Password
+			config.PrivateKey = existing.PrivateKey
+		}
+		if config.Passphrase == "" && config.PrivateKey == existing.PrivateKey {
+			config.Passphrase = existing.Passphrase
+		}
+	}
+
+	if config.Host == "" || config.Username == "" || config.HostKey == "" {
+		return config, fmt.Errorf("SFTP requires host, username, and host key")
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("Invalid SFTP config: %w", err)
+	}
+	return config, nil
+}
+
+// parseFTPConfigForm reads and validates the FTP storage form fields. When the
+// password is left blank, the password of existing is kept.
+func parseFTPConfigForm(c echo.Context, existing *ent.FTPConfig) (storageProvider.FTPConfig, error) {
+	config := storageProvider.FTPConfig{
+		Host:          strings.TrimSpace(c.FormValue("ftp_host")),
+		Username:      c.FormValue("ftp_username"),
+		Password:      c.FormValue("ftp_password"),
+		TLS:           c.FormValue("ftp_tls_mode"),
+		SkipTLSVerify: c.FormValue("ftp_skip_tls_verify") == "on",
+		BaseDir:       strings.TrimSpace(c.FormValue("ftp_base_dir")),
+	}
+	if config.TLS == "" {
+		config.TLS = storageProvider.FTPTLSNone
+	}
+
+	config.Port = 21
+	if config.TLS == storageProvider.FTPTLSImplicit {
+		config.Port = 990
+	}
+	if value := c.FormValue("ftp_port"); value != "" {
+		port, err := strconv.Atoi(value)
+		if err != nil || port < 1 || port > 65535 {
+			return config, fmt.Errorf("FTP port must be between 1 and 65535")
+		}
+		config.Port = port
+	}
+
+	if existing != nil && config.Password == "" {
+		config.Password = existing.Password
+	}
+
+	if config.Host == "" || config.Username == "" {
+		return config, fmt.Errorf("FTP requires host and username")
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("Invalid FTP config: %w", err)
+	}
+	return config, nil
+}
+
+// parseAzureBlobConfigForm reads and validates the Azure Blob storage form fields.
+// When both account key and SAS token are left blank, the credentials of existing are kept.
+func parseAzureBlobConfigForm(c echo.Context, existing *ent.AzureBlobConfig) (storageProvider.AzureBlobConfig, error) {
+	config := storageProvider.AzureBlobConfig{
+		AccountName: strings.TrimSpace(c.FormValue("azure_account_name")),
+		AccountKey:  strings.TrimSpace(c.FormValue("azure_account_key")),
+		SASToken:    strings.TrimSpace(c.FormValue("azure_sas_token")),
+		Container:   strings.TrimSpace(c.FormValue("azure_container")),
+		Endpoint:    strings.TrimSpace(c.FormValue("azure_endpoint")),
+		AccessTier:  c.FormValue("azure_access_tier"),
+	}
+
+	if existing != nil && config.AccountKey == "" && config.SASToken == "" {
+		config.AccountKey = existing.AccountKey
+		config.SASToken = existing.SasToken
+	}
+
+	if config.AccountName == "" || config.Container == "" {
+		return config, fmt.Errorf("Azure Blob requires account name and container")
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("Invalid Azure Blob config: %w", err)
+	}
+	return config, nil
+}
+
+// newAzureBlobConfigCreate prepares an AzureBlobConfig create builder. The access
+// tier is only set when chosen, otherwise the account default tier applies.
+func newAzureBlobConfigCreate(tx *ent.Tx, config storageProvider.AzureBlobConfig) *ent.AzureBlobConfigCreate {
+	create := tx.AzureBlobConfig.
+		Create().
+		SetAccountName(config.AccountName).
+		SetAccountKey(config.AccountKey).
+		SetSasToken(config.SASToken).
+		SetContainer(config.Container).
+		SetEndpoint(config.Endpoint)
+	if config.AccessTier != "" {
+		create.SetAccessTier(azureblobconfig.AccessTier(config.AccessTier))
+	}
+	return create
+}
+
+// parseGCSConfigForm reads and validates the Google Cloud Storage form fields.
+// When the service account key is left blank, the key of existing is kept.
+func parseGCSConfigForm(c echo.Context, existing *ent.GCSConfig) (storageProvider.GCSConfig, error) {
+	config := storageProvider.GCSConfig{
+		Bucket:          strings.TrimSpace(c.FormValue("gcs_bucket")),
+		CredentialsJSON: strings.TrimSpace(c.FormValue("gcs_credentials_json")),
+		Endpoint:        strings.TrimSpace(c.FormValue("gcs_endpoint")),
+		StorageClass:    c.FormValue("gcs_storage_class"),
+		RetentionMode:   c.FormValue("gcs_retention_mode"),
+	}
+	if config.RetentionMode == "" {
+		config.RetentionMode = storageProvider.GCSRetentionUnlocked
+	}
+	if value := c.FormValue("gcs_retention_days"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 0 {
+			return config, fmt.Errorf("GCS retention days must be a non-negative number")
+		}
+		config.RetentionDays = days
+	}
+
+	if existing != nil && config.CredentialsJSON == "" {
+		config.CredentialsJSON = existing.CredentialsJSON
+	}
+
+	if config.Bucket == "" {
+		return config, fmt.Errorf("GCS requires a bucket")
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("Invalid GCS config: %w", err)
+	}
+	return config, nil
+}
+
+// newGCSConfigCreate prepares a GCSConfig create builder. The storage class is
+// only set when chosen, otherwise the bucket default class applies.
+func newGCSConfigCreate(tx *ent.Tx, config storageProvider.GCSConfig) *ent.GCSConfigCreate {
+	create := tx.GCSConfig.
+		Create().
+		SetBucket(config.Bucket).
+		SetCredentialsJSON(config.CredentialsJSON).
+		SetEndpoint(config.Endpoint).
+		SetRetentionDays(config.RetentionDays).
+		SetRetentionMode(gcsconfig.RetentionMode(config.RetentionMode))
+	if config.StorageClass != "" {
+		create.SetStorageClass(gcsconfig.StorageClass(config.StorageClass))
+	}
+	return create
+}
+
 // UpdateStorage updates an existing storage backend
 func (h *Handler) UpdateStorage(c echo.Context) error {
 	id, err := strconv.Atoi(c.Param("id"))
@@ -556,6 +1073,18 @@ func (h *Handler) UpdateStorage(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Name and type are required"})
 	}
 
+	// Validate storage type
+	if storage.TypeValidator(storage.Type(storageType)) != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid storage type"})
+	}
+
+	archiveFormat := c.FormValue("archive_format")
+	if archiveFormat != "" {
+		if _, err := backup.ParseArchiveFormat(archiveFormat); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid archive format"})
+		}
+	}
+
 	// Start a transaction
 	tx, err := h.client.Tx(c.Request().Context())
 	if err != nil {
@@ -569,6 +1098,7 @@ func (h *Handler) UpdateStorage(c echo.Context) error {
 		SetName(name).
 		SetType(storage.Type(storageType)).
 		SetEnabled(enabled).
+		SetArchiveFormat(archiveFormat).
 		SetUpdatedAt(time.Now()).
 		Save(c.Request().Context())
 
@@ -581,6 +1111,11 @@ func (h *Handler) UpdateStorage(c echo.Context) error {
 		Where(storage.ID(id)).
 		WithWebdavConfig().
 		WithS3Config().
+		WithLocalConfig().
+		WithSftpConfig().
+		WithFtpConfig().
+		WithAzureBlobConfig().
+		WithGcsConfig().
 		Only(c.Request().Context())
 
 	if err != nil {
@@ -680,6 +1215,151 @@ func (h *Handler) UpdateStorage(c echo.Context) error {
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create S3 config: " + err.Error()})
 		}
+	} else if storageType == "local" {
+		path, minFreeSpaceMB, err := parseLocalConfigForm(c)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+
+		// Delete existing config if it exists
+		if existingStorage.Edges.LocalConfig != nil {
+			err = tx.LocalConfig.
+				DeleteOne(existingStorage.Edges.LocalConfig).
+				Exec(c.Request().Context())
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete existing local config: " + err.Error()})
+			}
+		}
+
+		// Create new local config
+		_, err = tx.LocalConfig.
+			Create().
+			SetPath(path).
+			SetMinFreeSpaceMB(minFreeSpaceMB).
+			SetSyncDirectory(c.FormValue("local_sync_directory") == "on").
+			SetStorageID(id).
+			Save(c.Request().Context())
+
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create local config: " + err.Error()})
+		}
+	} else if storageType == "sftp" {
+		// Keep existing credentials if not provided
+		config, err := parseSFTPConfigForm(c, existingStorage.Edges.SftpConfig)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+
+		// Delete existing config if it exists
+		if existingStorage.Edges.SftpConfig != nil {
+			err = tx.SFTPConfig.
+				DeleteOne(existingStorage.Edges.SftpConfig).
+				Exec(c.Request().Context())
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete existing SFTP config: " + err.Error()})
+			}
+		}
+
+		// Create new SFTP config
+		_, err = tx.SFTPConfig.
+			Create().
+			SetHost(config.Host).
+			SetPort(config.Port).
+			SetUsername(config.Username).
+			SetPassword(config.Password).
+			SetPrivateKey(config.PrivateKey).
+			SetPassphrase(config.Passphrase).
+			SetHostKey(config.HostKey).
+			SetBaseDir(config.BaseDir).
+			SetStorageID(id).
+			Save(c.Request().Context())
+
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create SFTP config: " + err.Error()})
+		}
+	} else if storageType == "ftp" {
+		// Keep existing password if not provided
+		config, err := parseFTPConfigForm(c, existingStorage.Edges.FtpConfig)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+
+		// Delete existing config if it exists
+		if existingStorage.Edges.FtpConfig != nil {
+			err = tx.FTPConfig.
+				DeleteOne(existingStorage.Edges.FtpConfig).
+				Exec(c.Request().Context())
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete existing FTP config: " + err.Error()})
+			}
+		}
+
+		// Create new FTP config
+		_, err = tx.FTPConfig.
+			Create().
+			SetHost(config.Host).
+			SetPort(config.Port).
+			SetUsername(config.Username).
+			SetPassword(config.Password).
+			SetTLSMode(ftpconfig.TLSMode(config.TLS)).
+			SetSkipTLSVerify(config.SkipTLSVerify).
+			SetBaseDir(config.BaseDir).
+			SetStorageID(id).
+			Save(c.Request().Context())
+
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create FTP config: " + err.Error()})
+		}
+	} else if storageType == "azureblob" {
+		// Keep existing credentials if not provided
+		config, err := parseAzureBlobConfigForm(c, existingStorage.Edges.AzureBlobConfig)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+
+		// Delete existing config if it exists
+		if existingStorage.Edges.AzureBlobConfig != nil {
+			err = tx.AzureBlobConfig.
+				DeleteOne(existingStorage.Edges.AzureBlobConfig).
+				Exec(c.Request().Context())
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete existing Azure Blob config: " + err.Error()})
+			}
+		}
+
+		// Create new Azure Blob config
+		_, err = newAzureBlobConfigCreate(tx, config).
+			SetStorageID(id).
+			Save(c.Request().Context())
+
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create Azure Blob config: " + err.Error()})
+		}
+	} else if storageType == "gcs" {
+		// Keep existing service account key if not provided
+		config, err := parseGCSConfigForm(c, existingStorage.Edges.GcsConfig)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+
+		// Delete existing config if it exists
+		if existingStorage.Edges.GcsConfig != nil {
+			err = tx.GCSConfig.
+				DeleteOne(existingStorage.Edges.GcsConfig).
+				Exec(c.Request().Context())
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete existing GCS config: " + err.Error()})
+			}
+		}
+
+		// Create new GCS config
+		_, err = newGCSConfigCreate(tx, config).
+			SetStorageID(id).
+			Save(c.Request().Context())
+
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create GCS config: " + err.Error()})
+		}
 	}
 
 	// Commit the transaction
@@ -798,6 +1478,59 @@ func (h *Handler) TriggerSync(c echo.Context) error {
     </div>`, translator.T(lang, "sync.triggered_success")))
 }
 
+// TriggerVerify 手动校验存储中最近一次上传的备份
+func (h *Handler) TriggerVerify(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.HTML(http.StatusBadRequest, `<div class="result error">Invalid storage ID</div>`)
+	}
+
+	if _, err := h.client.Storage.Get(c.Request().Context(), id); err != nil {
+		return c.HTML(http.StatusNotFound, `<div class="result error">Storage not found</div>`)
+	}
+
+	go func() {
+		ctx := context.Background()
+		if err := h.syncService.VerifyLatest(ctx, id); err != nil {
+			fmt.Printf("Verification failed for storage %d: %v\n", id, err)
+		}
+	}()
+
+	lang := i18n.GetLanguageFromContext(c.Request().Context())
+	translator := i18n.GetTranslatorFromContext(c.Request().Context())
+	if translator == nil {
+		translator = i18n.New()
+	}
+	return c.HTML(http.StatusOK, fmt.Sprintf(`<div class="result success">
+        <iconify-icon icon="mdi:shield-check" class="icon-success"></iconify-icon>
+        %s
+    </div>`, translator.T(lang, "verify.triggered_success")))
+}
+
+// UndoRestore 撤销一次恢复，把恢复前的数据目录换回原位置
+func (h *Handler) UndoRestore(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.HTML(http.StatusBadRequest, `<div class="result error">Invalid job ID</div>`)
+	}
+
+	lang := i18n.GetLanguageFromContext(c.Request().Context())
+	translator := i18n.GetTranslatorFromContext(c.Request().Context())
+	if translator == nil {
+		translator = i18n.New()
+	}
+
+	if err := h.syncService.UndoRestore(c.Request().Context(), id); err != nil {
+		return c.HTML(http.StatusBadRequest, fmt.Sprintf(`<div class="result error">%s: %s</div>`,
+			translator.T(lang, "restore.undo_failed"), template.HTMLEscapeString(err.Error())))
+	}
+
+	return c.HTML(http.StatusOK, fmt.Sprintf(`<div class="result success">
+        <iconify-icon icon="mdi:undo" class="icon-success"></iconify-icon>
+        %s
+    </div>`, translator.T(lang, "restore.undo_success")))
+}
+
 // TriggerConcurrentSync 手动触发并发同步到所有启用的存储后端
 func (h *Handler) TriggerConcurrentSync(c echo.Context) error {
 	// 获取所有启用的存储后端
@@ -945,6 +1678,17 @@ func (h *Handler) GetSyncStatus(c echo.Context) error {
 			if lastJob.Message != "" {
 				lastSyncError = lastJob.Message
 			}
+		case syncjob.StatusSuspicious:
+			syncStatus = translator.T(lang, "status.sync_suspicious")
+			syncStatusClass = "icon-warning"
+			syncStatusIcon = "mdi:alert"
+			if lastJob.Message != "" {
+				lastSyncError = lastJob.Message
+			}
+		case syncjob.StatusSkipped:
+			syncStatus = translator.T(lang, "status.sync_skipped")
+			syncStatusClass = "icon-success"
+			syncStatusIcon = "mdi:check-circle-outline"
 		case syncjob.StatusRunning:
 			syncStatus = translator.T(lang, "status.sync_running")
 			syncStatusClass = "icon-warning"
@@ -1144,6 +1888,11 @@ func (h *Handler) EditStorage(c echo.Context) error {
 		Where(storage.ID(id)).
 		WithWebdavConfig().
 		WithS3Config().
+		WithLocalConfig().
+		WithSftpConfig().
+		WithFtpConfig().
+		WithAzureBlobConfig().
+		WithGcsConfig().
 		Only(c.Request().Context())
 
 	if err != nil {
@@ -1168,6 +1917,43 @@ func (h *Handler) EditStorage(c echo.Context) error {
 		config["secret_access_key"] = ""
 		config["region"] = storage.Edges.S3Config.Region
 		config["bucket"] = storage.Edges.S3Config.Bucket
+	} else if storage.Edges.LocalConfig != nil {
+		config["path"] = storage.Edges.LocalConfig.Path
+		config["min_free_space_mb"] = storage.Edges.LocalConfig.MinFreeSpaceMB
+		config["sync_directory"] = storage.Edges.LocalConfig.SyncDirectory
+	} else if storage.Edges.SftpConfig != nil {
+		config["host"] = storage.Edges.SftpConfig.Host
+		config["port"] = storage.Edges.SftpConfig.Port
+		config["username"] = storage.Edges.SftpConfig.Username
+		// Don't send password or private key to frontend for security
+		config["password"] = ""
+		config["has_private_key"] = storage.Edges.SftpConfig.PrivateKey != ""
+		config["host_key"] = storage.Edges.SftpConfig.HostKey
+		config["base_dir"] = storage.Edges.SftpConfig.BaseDir
+	} else if storage.Edges.FtpConfig != nil {
+		config["host"] = storage.Edges.FtpConfig.Host
+		config["port"] = storage.Edges.FtpConfig.Port
+		config["username"] = storage.Edges.FtpConfig.Username
+		// Don't send password to frontend for security
+		config["password"] = ""
+		config["tls_mode"] = string(storage.Edges.FtpConfig.TLSMode)
+		config["skip_tls_verify"] = storage.Edges.FtpConfig.SkipTLSVerify
+		config["base_dir"] = storage.Edges.FtpConfig.BaseDir
+	} else if storage.Edges.AzureBlobConfig != nil {
+		config["account_name"] = storage.Edges.AzureBlobConfig.AccountName
+		// Don't send account key or SAS token to frontend for security
+		config["has_account_key"] = storage.Edges.AzureBlobConfig.AccountKey != ""
+		config["container"] = storage.Edges.AzureBlobConfig.Container
+		config["endpoint"] = storage.Edges.AzureBlobConfig.Endpoint
+		config["access_tier"] = string(storage.Edges.AzureBlobConfig.AccessTier)
+	} else if storage.Edges.GcsConfig != nil {
+		config["bucket"] = storage.Edges.GcsConfig.Bucket
+		// Don't send service account key to frontend for security
+		config["has_credentials"] = storage.Edges.GcsConfig.CredentialsJSON != ""
+		config["endpoint"] = storage.Edges.GcsConfig.Endpoint
+		config["storage_class"] = string(storage.Edges.GcsConfig.StorageClass)
+		config["retention_days"] = storage.Edges.GcsConfig.RetentionDays
+		config["retention_mode"] = string(storage.Edges.GcsConfig.RetentionMode)
 	}
 
 	// Get language and translator from context
@@ -1258,9 +2044,9 @@ func (h *Handler) getSystemInfo() map[string]interface{} {
 	uptime := time.Since(startTime).Truncate(time.Second)
 
 	return map[string]interface{}{
-		"version":     "v1.0.0", // This should come from build flags
-		"build_date":  "2024-01-01", // This should come from build flags
-		"git_commit":  "dev", // This should come from build flags
+		"version":     version.Version,
+		"build_date":  version.BuildDate,
+		"git_commit":  version.GitCommit,
 		"go_version":  runtime.Version(),
 		"platform":    runtime.GOOS + "/" + runtime.GOARCH,
 		"uptime":      uptime.String(),
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	"github.com/ca-x/vaultwarden-syncer/internal/repository"
+	"github.com/ca-x/vaultwarden-syncer/internal/sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+// snapshotResponse 为快照列表中的一项，不包含快照的文件列表
+type snapshotResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Hostname  string    `json:"hostname,omitempty"`
+	Files     int       `json:"files"`
+	Size      int64     `json:"size"`
+}
+
+// repositoryService returns the sync service of the instance selected by the optional
+// source query parameter and the storage ID; without source the default instance is used.
+// On failure it returns the HTTP status to respond with
+func (h *Handler) repositoryService(c echo.Context) (*sync.Service, int, int, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return nil, 0, http.StatusBadRequest, errors.New("Invalid storage ID")
+	}
+	if _, err := h.client.Storage.Get(c.Request().Context(), id); err != nil {
+		return nil, 0, http.StatusNotFound, errors.New("Storage not found")
+	}
+
+	if c.QueryParam("source") == "" {
+		return h.syncService, id, 0, nil
+	}
+	sourceID, err := strconv.Atoi(c.QueryParam("source"))
+	if err != nil {
+		return nil, 0, http.StatusBadRequest, errors.New("Invalid source ID")
+	}
+	service, err := h.syncService.ForSource(c.Request().Context(), sourceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 0, http.StatusNotFound, errors.New("Source not found")
+		}
+		return nil, 0, http.StatusInternalServerError, err
+	}
+	return service, id, 0, nil
+}
+
+// repositoryError maps errors of the repository operations to HTTP status codes
+func repositoryError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, sync.ErrRepositoryDisabled):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Repository mode is disabled"})
+	case errors.Is(err, sync.ErrBackupRunning):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "A backup to the repository is running, try again later"})
+	case errors.Is(err, repository.ErrSnapshotNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Snapshot not found"})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
+// GetSnapshots lists the snapshots in the deduplicated repository of a storage
+func (h *Handler) GetSnapshots(c echo.Context) error {
+	service, id, status, err := h.repositoryService(c)
+	if err != nil {
+		return c.JSON(status, map[string]string{"error": err.Error()})
+	}
+
+	snapshots, err := service.RepositorySnapshots(c.Request().Context(), id)
+	if err != nil {
+		return repositoryError(c, err)
+	}
+
+	response := make([]snapshotResponse, len(snapshots))
+	for i, snapshot := range snapshots {
+		response[i] = snapshotResponse{
+			ID:        snapshot.ID,
+			CreatedAt: snapshot.CreatedAt,
+			Hostname:  snapshot.Hostname,
+			Files:     len(snapshot.Files),
+		}
+		for _, file := range snapshot.Files {
+			response[i].Size += file.Size
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ForgetSnapshot deletes a snapshot from the repository of a storage and prunes the chunks
+// no other snapshot references
+func (h *Handler) ForgetSnapshot(c echo.Context) error {
+	service, id, status, err := h.repositoryService(c)
+	if err != nil {
+		return c.JSON(status, map[string]string{"error": err.Error()})
+	}
+
+	stats, err := service.ForgetSnapshots(c.Request().Context(), id, []string{c.Param("snapshot")})
+	if err != nil {
+		return repositoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]int{
+		"snapshots":      stats.Snapshots,
+		"deleted_chunks": stats.DeletedChunks,
+		"kept_chunks":    stats.KeptChunks,
+	})
+}
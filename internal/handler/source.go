@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	entsource "github.com/ca-x/vaultwarden-syncer/ent/source"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+
+	"github.com/labstack/echo/v4"
+)
+
+// sourceRequest 为创建和更新数据源的请求。更新时省略的字段保持不变，
+// password 为空时保留原密码，clear_password 为 true 时清除
+type sourceRequest struct {
+	Name          string    `json:"name"`
+	DataPath      string    `json:"data_path"`
+	Include       *[]string `json:"include"`
+	Exclude       *[]string `json:"exclude"`
+	Password      string    `json:"password"`
+	ClearPassword bool      `json:"clear_password"`
+	Recipients    *[]string `json:"recipients"`
+	Interval      *int      `json:"interval"`
+	Enabled       *bool     `json:"enabled"`
+}
+
+func (r *sourceRequest) validate(create bool) error {
+	if create || r.Name != "" {
+		if err := backup.ValidateSourceName(r.Name); err != nil {
+			return err
+		}
+	}
+	if create && r.DataPath == "" {
+		return fmt.Errorf("data_path is required")
+	}
+	if r.Include != nil {
+		if err := backup.ValidatePatterns(*r.Include); err != nil {
+			return fmt.Errorf("invalid include rules: %w", err)
+		}
+	}
+	if r.Exclude != nil {
+		if err := backup.ValidatePatterns(*r.Exclude); err != nil {
+			return fmt.Errorf("invalid exclude rules: %w", err)
+		}
+	}
+	if r.Recipients != nil {
+		if _, err := backup.ParseRecipients(*r.Recipients); err != nil {
+			return fmt.Errorf("invalid recipients: %w", err)
+		}
+	}
+	if r.Interval != nil && *r.Interval < 0 {
+		return fmt.Errorf("interval must not be negative")
+	}
+	return nil
+}
+
+// GetSources returns the list of Vaultwarden sources
+func (h *Handler) GetSources(c echo.Context) error {
+	sources, err := h.client.Source.Query().Order(ent.Asc(entsource.FieldName)).All(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load sources"})
+	}
+
+	return c.JSON(http.StatusOK, sources)
+}
+
+// CreateSource adds a Vaultwarden source
+func (h *Handler) CreateSource(c echo.Context) error {
+	var request sourceRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if err := request.validate(true); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	create := h.client.Source.Create().
+		SetName(request.Name).
+		SetDataPath(request.DataPath).
+		SetPassword(request.Password)
+	if request.Include != nil {
+		create.SetInclude(*request.Include)
+	}
+	if request.Exclude != nil {
+		create.SetExclude(*request.Exclude)
+	}
+	if request.Recipients != nil {
+		create.SetRecipients(*request.Recipients)
+	}
+	if request.Interval != nil {
+		create.SetInterval(*request.Interval)
+	}
+	if request.Enabled != nil {
+		create.SetEnabled(*request.Enabled)
+	}
+
+	source, err := create.Save(c.Request().Context())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "A source with this name already exists"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create source"})
+	}
+
+	return c.JSON(http.StatusCreated, source)
+}
+
+// UpdateSource updates a Vaultwarden source
+func (h *Handler) UpdateSource(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid source ID"})
+	}
+
+	var request sourceRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if err := request.validate(false); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	update := h.client.Source.UpdateOneID(id)
+	if request.Name != "" {
+		update.SetName(request.Name)
+	}
+	if request.DataPath != "" {
+		update.SetDataPath(request.DataPath)
+	}
+	if request.Include != nil {
+		update.SetInclude(*request.Include)
+	}
+	if request.Exclude != nil {
+		update.SetExclude(*request.Exclude)
+	}
+	if request.Password != "" {
+		update.SetPassword(request.Password)
+	} else if request.ClearPassword {
+		update.ClearPassword()
+	}
+	if request.Recipients != nil {
+		update.SetRecipients(*request.Recipients)
+	}
+	if request.Interval != nil {
+		update.SetInterval(*request.Interval)
+	}
+	if request.Enabled != nil {
+		update.SetEnabled(*request.Enabled)
+	}
+
+	source, err := update.Save(c.Request().Context())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Source not found"})
+		case ent.IsConstraintError(err):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "A source with this name already exists"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update source"})
+	}
+
+	return c.JSON(http.StatusOK, source)
+}
+
+// DeleteSource removes a Vaultwarden source. Its sync jobs and uploaded backups are kept.
+func (h *Handler) DeleteSource(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid source ID"})
+	}
+
+	if err := h.client.Source.DeleteOneID(id).Exec(c.Request().Context()); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Source not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to delete source"})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// TriggerSourceSync backs up a Vaultwarden source to all enabled storages in the background
+func (h *Handler) TriggerSourceSync(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid source ID"})
+	}
+
+	source, err := h.client.Source.Get(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Source not found"})
+	}
+	if !source.Enabled {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Source is disabled"})
+	}
+
+	go func() {
+		if err := h.schedulerService.RunSourceSyncNow(context.Background(), id); err != nil {
+			fmt.Printf("Sync failed for source %s: %v\n", source.Name, err)
+		}
+	}()
+
+	return c.JSON(http.StatusAccepted, map[string]string{"status": "started"})
+}
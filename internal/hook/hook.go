@@ -0,0 +1,161 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// Event 为执行 hook 命令的时机
+type Event string
+
+const (
+	// PreBackup 在生成备份之前执行，命令失败时中止本次备份
+	PreBackup Event = "pre_backup"
+	// PostBackup 在每个备份任务结束后执行，无论成功与否
+	PostBackup Event = "post_backup"
+	// OnSuccess 在备份任务成功后执行
+	OnSuccess Event = "on_success"
+	// OnFailure 在备份任务失败或被标记为可疑后执行
+	OnFailure Event = "on_failure"
+)
+
+const (
+	// DefaultTimeout 为未配置超时时间时每个命令的最长执行时间
+	DefaultTimeout = 5 * time.Minute
+
+	// maxOutput 为保存的命令输出的最大字节数，超出的部分被丢弃
+	maxOutput = 64 << 10
+)
+
+// ErrTimeout 表示命令在超时时间内没有结束
+var ErrTimeout = errors.New("hook timed out")
+
+type Options struct {
+	PreBackup  string
+	PostBackup string
+	OnSuccess  string
+	OnFailure  string
+	// Timeout 为每个命令的最长执行时间，<= 0 时使用 DefaultTimeout
+	Timeout time.Duration
+}
+
+// Job 描述触发 hook 的备份任务，以 SYNCER_* 环境变量传给命令
+type Job struct {
+	ID       int
+	Storage  string
+	Filename string
+	Size     int64
+	Status   string
+	Message  string
+	// Source 为数据源名称，默认实例为空
+	Source string
+}
+
+func (j Job) environ(event Event) []string {
+	return []string{
+		"SYNCER_HOOK=" + string(event),
+		"SYNCER_JOB_ID=" + strconv.Itoa(j.ID),
+		"SYNCER_SOURCE=" + j.Source,
+		"SYNCER_STORAGE=" + j.Storage,
+		"SYNCER_FILENAME=" + j.Filename,
+		"SYNCER_SIZE=" + strconv.FormatInt(j.Size, 10),
+		"SYNCER_STATUS=" + j.Status,
+		"SYNCER_MESSAGE=" + j.Message,
+	}
+}
+
+// Runner 通过系统 shell 执行配置的 hook 命令
+type Runner struct {
+	commands map[Event]string
+	timeout  time.Duration
+}
+
+func NewRunner(opts Options) *Runner {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Runner{
+		commands: map[Event]string{
+			PreBackup:  opts.PreBackup,
+			PostBackup: opts.PostBackup,
+			OnSuccess:  opts.OnSuccess,
+			OnFailure:  opts.OnFailure,
+		},
+		timeout: timeout,
+	}
+}
+
+// Enabled 判断是否为该时机配置了命令。Runner 为 nil 时所有时机都没有命令
+func (r *Runner) Enabled(event Event) bool {
+	return r != nil && r.commands[event] != ""
+}
+
+// Run 执行该时机的命令并返回其标准输出和标准错误。没有配置命令时直接返回。
+// 命令以非零状态退出或超时时返回错误，此时输出同样会返回。
+func (r *Runner) Run(ctx context.Context, event Event, job Job) (string, error) {
+	if !r.Enabled(event) {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	cmd := shellCommand(ctx, r.commands[event])
+	cmd.Env = append(os.Environ(), job.environ(event)...)
+
+	var output limitedBuffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	// 命令启动的子进程可能在命令被结束后仍然持有输出管道，不再等待它们
+	cmd.WaitDelay = time.Second
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return output.String(), fmt.Errorf("%s: %w after %v", event, ErrTimeout, r.timeout)
+	}
+	if err != nil {
+		return output.String(), fmt.Errorf("%s hook failed: %w", event, err)
+	}
+
+	return output.String(), nil
+}
+
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// limitedBuffer 只保存前 maxOutput 字节的输出，并记录被丢弃的字节数
+type limitedBuffer struct {
+	buf     bytes.Buffer
+	dropped int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := maxOutput - b.buf.Len(); room < len(p) {
+		if room > 0 {
+			b.buf.Write(p[:room])
+		}
+		b.dropped += len(p) - max(room, 0)
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	if b.dropped > 0 {
+		return fmt.Sprintf("%s\n... (%d bytes truncated)", b.buf.String(), b.dropped)
+	}
+	return b.buf.String()
+}
@@ -0,0 +1,111 @@
+package hook
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func skipOnWindows(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("hook tests use POSIX shell commands")
+	}
+}
+
+func TestRunEnvironment(t *testing.T) {
+	skipOnWindows(t)
+
+	runner := NewRunner(Options{
+		PostBackup: `echo "$SYNCER_HOOK $SYNCER_JOB_ID $SYNCER_STORAGE $SYNCER_FILENAME $SYNCER_SIZE $SYNCER_STATUS"; echo "$SYNCER_MESSAGE" >&2`,
+	})
+
+	output, err := runner.Run(context.Background(), PostBackup, Job{
+		ID:       7,
+		Storage:  "s3-main",
+		Filename: "backup.zip",
+		Size:     1024,
+		Status:   "completed",
+		Message:  "uploaded",
+	})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+
+	expected := "post_backup 7 s3-main backup.zip 1024 completed\nuploaded\n"
+	if output != expected {
+		t.Errorf("Expected output %q, got %q", expected, output)
+	}
+}
+
+func TestRunNotConfigured(t *testing.T) {
+	runner := NewRunner(Options{PreBackup: "exit 1"})
+
+	if runner.Enabled(OnSuccess) {
+		t.Error("Expected on_success to be disabled")
+	}
+	if output, err := runner.Run(context.Background(), OnSuccess, Job{}); output != "" || err != nil {
+		t.Errorf("Expected no-op for an unconfigured hook, got %q, %v", output, err)
+	}
+
+	var nilRunner *Runner
+	if nilRunner.Enabled(PreBackup) {
+		t.Error("Expected a nil runner to have no hooks")
+	}
+	if _, err := nilRunner.Run(context.Background(), PreBackup, Job{}); err != nil {
+		t.Errorf("Expected a nil runner to do nothing, got: %v", err)
+	}
+}
+
+func TestRunFailure(t *testing.T) {
+	skipOnWindows(t)
+
+	runner := NewRunner(Options{PreBackup: "echo cache flush failed; exit 3"})
+
+	output, err := runner.Run(context.Background(), PreBackup, Job{})
+	if err == nil {
+		t.Fatal("Expected an error for a non-zero exit status")
+	}
+	if !strings.Contains(err.Error(), "exit status 3") {
+		t.Errorf("Expected the exit status in the error, got: %v", err)
+	}
+	if output != "cache flush failed\n" {
+		t.Errorf("Expected the output of a failed hook to be returned, got %q", output)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	skipOnWindows(t)
+
+	runner := NewRunner(Options{OnFailure: "echo started; sleep 10", Timeout: 200 * time.Millisecond})
+
+	start := time.Now()
+	output, err := runner.Run(context.Background(), OnFailure, Job{})
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("Expected ErrTimeout, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the hook to be stopped after the timeout, took %v", elapsed)
+	}
+	if output != "started\n" {
+		t.Errorf("Expected the output before the timeout, got %q", output)
+	}
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	var buf limitedBuffer
+	buf.Write([]byte(strings.Repeat("a", maxOutput-10)))
+	buf.Write([]byte(strings.Repeat("b", 30)))
+	buf.Write([]byte("c"))
+
+	output := buf.String()
+	if !strings.HasPrefix(output, strings.Repeat("a", maxOutput-10)+strings.Repeat("b", 10)+"\n") {
+		t.Error("Expected the first maxOutput bytes to be kept")
+	}
+	if !strings.HasSuffix(output, "(21 bytes truncated)") {
+		t.Errorf("Expected the number of dropped bytes, got %q", output[len(output)-30:])
+	}
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// 内容定义分块（CDC）参数。分块边界由内容决定，文件中间插入或删除数据时
+// 只有附近的分块会变化，其余分块仍然可以去重。
+const (
+	DefaultMinChunkSize = 256 * 1024
+	DefaultAvgChunkSize = 1024 * 1024
+	DefaultMaxChunkSize = 4 * 1024 * 1024
+)
+
+// gearTable 为 gear 滚动哈希使用的随机表。表由固定种子生成，
+// 修改它会改变所有分块边界，导致已有仓库无法去重。
+var gearTable = func() [256]uint64 {
+	var table [256]uint64
+	for i := range table {
+		sum := sha256.Sum256([]byte{'v', 'w', 's', 'g', 'e', 'a', 'r', byte(i)})
+		table[i] = binary.BigEndian.Uint64(sum[:8])
+	}
+	return table
+}()
+
+// Chunker 使用 gear 滚动哈希将数据流切分为内容定义的分块
+type Chunker struct {
+	r       io.Reader
+	min     int
+	max     int
+	mask    uint64
+	buf     []byte
+	start   int
+	end     int
+	eof     bool
+	readErr error
+}
+
+// NewChunker 创建分块器。avg 必须是 2 的幂
+func NewChunker(r io.Reader, min, avg, max int) *Chunker {
+	// gear 哈希的高位受最近 64 个字节影响，使用高位判断边界
+	maskBits := bits.Len(uint(avg)) - 1
+	return &Chunker{
+		r:    r,
+		min:  min,
+		max:  max,
+		mask: ((uint64(1) << maskBits) - 1) << (64 - maskBits),
+		buf:  make([]byte, 2*max),
+	}
+}
+
+// Next 返回下一个分块，数据流结束时返回 io.EOF。
+// 返回的切片在下一次调用 Next 之前有效。
+func (c *Chunker) Next() ([]byte, error) {
+	if err := c.fill(); err != nil {
+		return nil, err
+	}
+
+	pending := c.buf[c.start:c.end]
+	if len(pending) == 0 {
+		return nil, io.EOF
+	}
+
+	cut := c.cutPoint(pending)
+	chunk := pending[:cut]
+	c.start += cut
+	return chunk, nil
+}
+
+// fill 保证缓冲区中至少有 max 个字节，或者已经读到数据流末尾
+func (c *Chunker) fill() error {
+	if c.end-c.start >= c.max || c.eof {
+		return c.readErr
+	}
+
+	// 将未处理的数据移动到缓冲区开头
+	if c.start > 0 {
+		copy(c.buf, c.buf[c.start:c.end])
+		c.end -= c.start
+		c.start = 0
+	}
+
+	for c.end < c.max && !c.eof {
+		n, err := c.r.Read(c.buf[c.end:])
+		c.end += n
+		if err == io.EOF {
+			c.eof = true
+		} else if err != nil {
+			c.eof = true
+			c.readErr = err
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Chunker) cutPoint(data []byte) int {
+	if len(data) <= c.min {
+		return len(data)
+	}
+
+	limit := len(data)
+	if limit > c.max {
+		limit = c.max
+	}
+
+	var hash uint64
+	// 最小分块之前的数据不参与边界判断，但哈希需要包含边界前的 64 个字节
+	for i := max(c.min-64, 0); i < c.min; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+	}
+
+	for i := c.min; i < limit; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.mask == 0 {
+			return i + 1
+		}
+	}
+
+	return limit
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+const (
+	testMinChunkSize = 4 * 1024
+	testAvgChunkSize = 16 * 1024
+	testMaxChunkSize = 64 * 1024
+)
+
+func randomData(seed int64, size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(seed)).Read(data)
+	return data
+}
+
+func chunkAll(t *testing.T, data []byte) [][]byte {
+	t.Helper()
+
+	chunker := NewChunker(bytes.NewReader(data), testMinChunkSize, testAvgChunkSize, testMaxChunkSize)
+	var chunks [][]byte
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to chunk data: %v", err)
+		}
+		chunks = append(chunks, append([]byte(nil), chunk...))
+	}
+	return chunks
+}
+
+func TestChunkerSizesAndReassembly(t *testing.T) {
+	data := randomData(1, 1024*1024)
+	chunks := chunkAll(t, data)
+
+	if len(chunks) < 2 {
+		t.Fatalf("Expected multiple chunks, got %d", len(chunks))
+	}
+
+	for i, chunk := range chunks {
+		if len(chunk) > testMaxChunkSize {
+			t.Errorf("Chunk %d exceeds max size: %d", i, len(chunk))
+		}
+		if i < len(chunks)-1 && len(chunk) < testMinChunkSize {
+			t.Errorf("Chunk %d below min size: %d", i, len(chunk))
+		}
+	}
+
+	if !bytes.Equal(bytes.Join(chunks, nil), data) {
+		t.Error("Reassembled chunks do not match input")
+	}
+}
+
+func TestChunkerEmptyInput(t *testing.T) {
+	if chunks := chunkAll(t, nil); len(chunks) != 0 {
+		t.Errorf("Expected no chunks for empty input, got %d", len(chunks))
+	}
+}
+
+func TestChunkerBoundariesSurviveInsertion(t *testing.T) {
+	data := randomData(2, 1024*1024)
+
+	// 在文件开头插入数据，后面的分块应该保持不变
+	shifted := append(randomData(3, 100), data...)
+
+	original := make(map[string]bool)
+	for _, chunk := range chunkAll(t, data) {
+		original[string(chunk)] = true
+	}
+
+	chunks := chunkAll(t, shifted)
+	shared := 0
+	for _, chunk := range chunks {
+		if original[string(chunk)] {
+			shared++
+		}
+	}
+
+	if shared < len(chunks)-2 {
+		t.Errorf("Expected most chunks to be shared after insertion, got %d of %d", shared, len(chunks))
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// PruneStats 为一次清理的统计
+type PruneStats struct {
+	// Snapshots 为清理后仓库中剩余的快照数
+	Snapshots     int
+	DeletedChunks int
+	KeptChunks    int
+}
+
+// Forget 删除快照索引。快照引用的分块仍然保留在仓库中，需要通过 Prune 删除
+func (r *Repository) Forget(ctx context.Context, ids ...string) error {
+	for _, id := range ids {
+		if _, err := r.LoadSnapshot(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range ids {
+		if err := r.provider.Delete(ctx, r.objectPath(snapshotsDir, id)); err != nil {
+			return fmt.Errorf("failed to delete snapshot %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// Prune 删除不再被任何快照引用的分块。正在进行的备份上传的分块在快照写入前不被任何快照引用，
+// 因此不能与同一仓库的备份同时运行。任何一个快照无法读取时不删除分块
+func (r *Repository) Prune(ctx context.Context) (*PruneStats, error) {
+	ids, err := r.Snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, id := range ids {
+		snapshot, err := r.LoadSnapshot(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load snapshot %s: %w", id, err)
+		}
+		for _, file := range snapshot.Files {
+			for _, chunk := range file.Chunks {
+				referenced[chunk] = true
+			}
+		}
+	}
+
+	chunks, err := r.listNames(ctx, dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunks: %w", err)
+	}
+
+	stats := &PruneStats{Snapshots: len(ids)}
+	for _, id := range chunks {
+		if referenced[id] {
+			stats.KeptChunks++
+			continue
+		}
+		if err := r.provider.Delete(ctx, r.chunkPath(id)); err != nil {
+			return stats, fmt.Errorf("failed to delete chunk %s: %w", id, err)
+		}
+		stats.DeletedChunks++
+	}
+	return stats, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+// countChunks 返回 provider 中仓库 repo 的分块数
+func countChunks(provider *memoryProvider) int {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	count := 0
+	for name := range provider.objects {
+		if strings.HasPrefix(name, "repo/data/") {
+			count++
+		}
+	}
+	return count
+}
+
+func TestRepositoryForgetAndPrune(t *testing.T) {
+	ctx := context.Background()
+	provider := newMemoryProvider()
+	dataDir := t.TempDir()
+
+	repo, err := Open(ctx, provider, "repo", "test-password", backup.KDFArgon2id)
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	// 第一个快照中的数据库在第二个快照前被替换，只有附件的分块被两个快照共用
+	if err := os.WriteFile(filepath.Join(dataDir, "db.sqlite3"), randomData(1, 2*1024*1024), 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, "attachment.bin"), randomData(2, 1024*1024), 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	first, err := repo.Backup(ctx, dirWalker(dataDir), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to back up: %v", err)
+	}
+
+	db := randomData(3, 2*1024*1024)
+	if err := os.WriteFile(filepath.Join(dataDir, "db.sqlite3"), db, 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	second, err := repo.Backup(ctx, dirWalker(dataDir), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to back up: %v", err)
+	}
+
+	// 没有删除快照时所有分块都被引用
+	stats, err := repo.Prune(ctx)
+	if err != nil {
+		t.Fatalf("Failed to prune: %v", err)
+	}
+	if stats.DeletedChunks != 0 || stats.Snapshots != 2 {
+		t.Fatalf("Unexpected prune stats before forget: %+v", stats)
+	}
+	before := countChunks(provider)
+
+	if err := repo.Forget(ctx, first.SnapshotID); err != nil {
+		t.Fatalf("Failed to forget snapshot: %v", err)
+	}
+	if err := repo.Forget(ctx, first.SnapshotID); !errors.Is(err, ErrSnapshotNotFound) {
+		t.Errorf("Expected ErrSnapshotNotFound for a forgotten snapshot, got %v", err)
+	}
+	if countChunks(provider) != before {
+		t.Error("Forget should not delete chunks")
+	}
+
+	// 只删除第一个快照独有的数据库分块
+	stats, err = repo.Prune(ctx)
+	if err != nil {
+		t.Fatalf("Failed to prune: %v", err)
+	}
+	if stats.Snapshots != 1 || stats.DeletedChunks == 0 || stats.DeletedChunks >= first.NewChunks {
+		t.Errorf("Unexpected prune stats: %+v, first backup %+v", stats, first)
+	}
+	if countChunks(provider) != before-stats.DeletedChunks || stats.KeptChunks != countChunks(provider) {
+		t.Errorf("Expected %d chunks after pruning %d, got %d", before-stats.DeletedChunks, stats.DeletedChunks, countChunks(provider))
+	}
+
+	restoreDir := t.TempDir()
+	if err := repo.Restore(ctx, second.SnapshotID, restoreDir); err != nil {
+		t.Fatalf("Failed to restore the remaining snapshot: %v", err)
+	}
+	restored, err := os.ReadFile(filepath.Join(restoreDir, "db.sqlite3"))
+	if err != nil || !bytes.Equal(restored, db) {
+		t.Errorf("Restored database does not match: %v", err)
+	}
+}
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+)
+
+// 仓库在存储中的布局：
+//
+//	<prefix>/config              仓库配置（明文 JSON）
+//	<prefix>/data/<sha256>       分块，按明文 SHA-256 命名，压缩并加密
+//	<prefix>/snapshots/<id>      快照索引，压缩并加密
+//
+// 每个分块在同一个存储中只保存一次，快照只记录文件由哪些分块组成。
+const (
+	configName   = "config"
+	dataDir      = "data"
+	snapshotsDir = "snapshots"
+
+	repositoryVersion = 1
+	keyCheckPlaintext = "vaultwarden-syncer repository"
+)
+
+// 分块头部的压缩标志
+const (
+	blobRaw     byte = 0
+	blobDeflate byte = 1
+)
+
+var (
+	// ErrWrongPassword 表示密码与仓库不匹配
+	ErrWrongPassword = errors.New("wrong repository password")
+	// ErrSnapshotNotFound 表示快照不存在
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+// Config 为仓库配置，保存在仓库根目录
+type Config struct {
+	Version      int  `json:"version"`
+	MinChunkSize int  `json:"min_chunk_size"`
+	AvgChunkSize int  `json:"avg_chunk_size"`
+	MaxChunkSize int  `json:"max_chunk_size"`
+	Encrypted    bool `json:"encrypted"`
+	// KDF 及其参数与加密归档使用的相同，见 backup.KDFParams
+	KDF        string `json:"kdf,omitempty"`
+	Iterations uint32 `json:"iterations,omitempty"`
+	MemoryKiB  uint32 `json:"memory_kib,omitempty"`
+	Threads    uint8  `json:"threads,omitempty"`
+	Salt       []byte `json:"salt,omitempty"`
+	// KeyCheck 为加密后的固定字符串，用于在读取数据前校验密码
+	KeyCheck []byte `json:"key_check,omitempty"`
+}
+
+// Repository 是建立在 storage.Provider 之上的内容寻址、去重备份仓库
+type Repository struct {
+	provider storageProvider.Provider
+	prefix   string
+	config   Config
+	aead     cipher.AEAD
+}
+
+// Open 打开 provider 中 prefix 下的仓库，仓库不存在时使用 kdf 从 password 派生密钥并初始化。
+// password 为空时分块不加密；已有仓库使用其配置中记录的 KDF 参数。
+func Open(ctx context.Context, provider storageProvider.Provider, prefix, password string, kdf backup.KDF) (*Repository, error) {
+	repo := &Repository{
+		provider: provider,
+		prefix:   strings.Trim(prefix, "/"),
+	}
+
+	exists, err := provider.Exists(ctx, repo.objectPath(configName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check repository config: %w", err)
+	}
+
+	if !exists {
+		if err := repo.init(ctx, password, kdf); err != nil {
+			return nil, err
+		}
+		return repo, nil
+	}
+
+	reader, err := provider.Download(ctx, repo.objectPath(configName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download repository config: %w", err)
+	}
+	defer reader.Close()
+
+	if err := json.NewDecoder(reader).Decode(&repo.config); err != nil {
+		return nil, fmt.Errorf("failed to decode repository config: %w", err)
+	}
+
+	if repo.config.Version != repositoryVersion {
+		return nil, fmt.Errorf("unsupported repository version: %d", repo.config.Version)
+	}
+
+	if repo.config.Encrypted {
+		if password == "" {
+			return nil, fmt.Errorf("repository is encrypted but no password is set")
+		}
+		if err := repo.setKey(password); err != nil {
+			return nil, err
+		}
+		check, err := repo.open(repo.config.KeyCheck)
+		if err != nil || string(check) != keyCheckPlaintext {
+			return nil, ErrWrongPassword
+		}
+	}
+
+	return repo, nil
+}
+
+func (r *Repository) init(ctx context.Context, password string, kdf backup.KDF) error {
+	r.config = Config{
+		Version:      repositoryVersion,
+		MinChunkSize: DefaultMinChunkSize,
+		AvgChunkSize: DefaultAvgChunkSize,
+		MaxChunkSize: DefaultMaxChunkSize,
+	}
+
+	if password != "" {
+		params, err := backup.NewKDFParams(kdf)
+		if err != nil {
+			return err
+		}
+
+		r.config.Encrypted = true
+		r.config.KDF = string(params.KDF)
+		r.config.Iterations = params.Iterations
+		r.config.MemoryKiB = params.MemoryKiB
+		r.config.Threads = params.Threads
+		r.config.Salt = params.Salt
+
+		if err := r.setKey(password); err != nil {
+			return err
+		}
+
+		check, err := r.seal([]byte(keyCheckPlaintext))
+		if err != nil {
+			return err
+		}
+		r.config.KeyCheck = check
+	}
+
+	data, err := json.MarshalIndent(r.config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := r.provider.Upload(ctx, r.objectPath(configName), bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to initialize repository: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) setKey(password string) error {
+	key, err := backup.KDFParams{
+		KDF:        backup.KDF(r.config.KDF),
+		Iterations: r.config.Iterations,
+		MemoryKiB:  r.config.MemoryKiB,
+		Threads:    r.config.Threads,
+		Salt:       r.config.Salt,
+	}.DeriveKey(password)
+	if err != nil {
+		return fmt.Errorf("invalid repository kdf: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+
+	r.aead, err = cipher.NewGCM(block)
+	return err
+}
+
+func (r *Repository) objectPath(elem ...string) string {
+	return path.Join(append([]string{r.prefix}, elem...)...)
+}
+
+func (r *Repository) chunkPath(id string) string {
+	return r.objectPath(dataDir, id)
+}
+
+// seal 加密数据：nonce || ciphertext
+func (r *Repository) seal(plaintext []byte) ([]byte, error) {
+	if r.aead == nil {
+		return plaintext, nil
+	}
+
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return r.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (r *Repository) open(data []byte) ([]byte, error) {
+	if r.aead == nil {
+		return data, nil
+	}
+
+	nonceSize := r.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("encrypted blob too short")
+	}
+
+	return r.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+}
+
+// encodeBlob 压缩（仅在变小时）并加密数据
+func (r *Repository) encodeBlob(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(blobDeflate)
+
+	fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := fw.Close(); err != nil {
+		return nil, err
+	}
+
+	encoded := buf.Bytes()
+	if len(encoded) >= len(data)+1 {
+		encoded = append([]byte{blobRaw}, data...)
+	}
+
+	return r.seal(encoded)
+}
+
+func (r *Repository) decodeBlob(data []byte) ([]byte, error) {
+	plain, err := r.open(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt blob: %w", err)
+	}
+
+	if len(plain) == 0 {
+		return nil, fmt.Errorf("empty blob")
+	}
+
+	switch plain[0] {
+	case blobRaw:
+		return plain[1:], nil
+	case blobDeflate:
+		return io.ReadAll(flate.NewReader(bytes.NewReader(plain[1:])))
+	default:
+		return nil, fmt.Errorf("unknown blob encoding: %d", plain[0])
+	}
+}
+
+func (r *Repository) downloadBlob(ctx context.Context, name string) ([]byte, error) {
+	reader, err := r.provider.Download(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.decodeBlob(data)
+}
+
+// readChunk 下载分块并校验其内容与名称中的 SHA-256 是否一致
+func (r *Repository) readChunk(ctx context.Context, id string) ([]byte, error) {
+	data, err := r.downloadBlob(ctx, r.chunkPath(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chunk %s: %w", id, err)
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != id {
+		return nil, fmt.Errorf("chunk %s is corrupt", id)
+	}
+
+	return data, nil
+}
+
+// listNames 列出目录下的对象名。不同存储返回的可能是完整路径或文件名，这里统一取文件名
+func (r *Repository) listNames(ctx context.Context, dir string) ([]string, error) {
+	entries, err := r.provider.List(ctx, r.objectPath(dir)+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, path.Base(entry))
+	}
+	return names, nil
+}
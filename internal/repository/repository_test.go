@@ -0,0 +1,349 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// memoryProvider 为测试使用的内存存储
+type memoryProvider struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads int
+}
+
+func newMemoryProvider() *memoryProvider {
+	return &memoryProvider{objects: make(map[string][]byte)}
+}
+
+func (m *memoryProvider) Name() string { return "memory" }
+func (m *memoryProvider) Type() string { return "memory" }
+
+func (m *memoryProvider) Upload(ctx context.Context, path string, reader io.Reader) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[path] = data
+	m.uploads++
+	return nil
+}
+
+func (m *memoryProvider) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.objects[path]
+	if !ok {
+		return nil, fmt.Errorf("object not found: %s", path)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memoryProvider) Delete(ctx context.Context, path string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, path)
+	return nil
+}
+
+func (m *memoryProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var names []string
+	for name := range m.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *memoryProvider) Exists(ctx context.Context, path string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.objects[path]
+	return ok, nil
+}
+
+func (m *memoryProvider) UploadPart(ctx context.Context, path string, reader io.Reader, offset int64) error {
+	return m.Upload(ctx, path, reader)
+}
+
+func (m *memoryProvider) DownloadPart(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	return m.Download(ctx, path)
+}
+
+func (m *memoryProvider) GetFileSize(ctx context.Context, path string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return int64(len(m.objects[path])), nil
+}
+
+// dirWalker 遍历目录中的所有文件，模拟 backup.Service.WalkFiles
+func dirWalker(root string) WalkFunc {
+	return func(ctx context.Context, fn func(relPath string, info os.FileInfo, r io.Reader) error) error {
+		return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+
+			relPath, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			return fn(relPath, info, file)
+		})
+	}
+}
+
+func TestRepositoryBackupDedupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	provider := newMemoryProvider()
+	dataDir := t.TempDir()
+
+	db := randomData(10, 3*1024*1024)
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.WriteFile(filepath.Join(dataDir, "db.sqlite3"), db, 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.Chtimes(filepath.Join(dataDir, "db.sqlite3"), modTime, modTime); err != nil {
+		t.Fatalf("Failed to set file time: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dataDir, "attachments"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, "attachments", "a.bin"), []byte("attachment"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	repo, err := Open(ctx, provider, "repo", "test-password", backup.KDFArgon2id)
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	first, err := repo.Backup(ctx, dirWalker(dataDir), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to back up: %v", err)
+	}
+	if first.Files != 2 || first.NewChunks == 0 || first.ReusedChunks != 0 {
+		t.Fatalf("Unexpected first backup stats: %+v", first)
+	}
+
+	// 修改数据库中间的一小段，第二次备份应复用大部分分块
+	copy(db[len(db)/2:], []byte("changed"))
+	if err := os.WriteFile(filepath.Join(dataDir, "db.sqlite3"), db, 0600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.Chtimes(filepath.Join(dataDir, "db.sqlite3"), modTime, modTime); err != nil {
+		t.Fatalf("Failed to set file time: %v", err)
+	}
+
+	reopened, err := Open(ctx, provider, "repo", "test-password", backup.KDFArgon2id)
+	if err != nil {
+		t.Fatalf("Failed to reopen repository: %v", err)
+	}
+
+	second, err := reopened.Backup(ctx, dirWalker(dataDir), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to back up: %v", err)
+	}
+	if second.ReusedChunks == 0 || second.NewChunks >= first.NewChunks {
+		t.Errorf("Expected second backup to reuse chunks: first=%+v second=%+v", first, second)
+	}
+
+	snapshots, err := reopened.Snapshots(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list snapshots: %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("Expected 2 snapshots, got %v", snapshots)
+	}
+
+	restoreDir := t.TempDir()
+	if err := reopened.Restore(ctx, second.SnapshotID, restoreDir); err != nil {
+		t.Fatalf("Failed to restore: %v", err)
+	}
+
+	restored, err := os.ReadFile(filepath.Join(restoreDir, "db.sqlite3"))
+	if err != nil {
+		t.Fatalf("Failed to read restored file: %v", err)
+	}
+	if !bytes.Equal(restored, db) {
+		t.Error("Restored database does not match")
+	}
+
+	info, err := os.Stat(filepath.Join(restoreDir, "db.sqlite3"))
+	if err != nil {
+		t.Fatalf("Failed to stat restored file: %v", err)
+	}
+	if !info.ModTime().Equal(modTime) {
+		t.Errorf("Expected mtime %v, got %v", modTime, info.ModTime())
+	}
+
+	attachment, err := os.ReadFile(filepath.Join(restoreDir, "attachments", "a.bin"))
+	if err != nil || string(attachment) != "attachment" {
+		t.Errorf("Unexpected restored attachment: %q, %v", attachment, err)
+	}
+
+	// 分块在存储中是加密的
+	for name, data := range provider.objects {
+		if strings.HasPrefix(name, "repo/data/") && bytes.Contains(data, []byte("attachment")) {
+			t.Errorf("Chunk %s is stored in plaintext", name)
+		}
+	}
+}
+
+func TestRepositoryWrongPassword(t *testing.T) {
+	ctx := context.Background()
+	provider := newMemoryProvider()
+
+	if _, err := Open(ctx, provider, "repo", "right", backup.KDFArgon2id); err != nil {
+		t.Fatalf("Failed to initialize repository: %v", err)
+	}
+
+	if _, err := Open(ctx, provider, "repo", "wrong", backup.KDFArgon2id); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("Expected ErrWrongPassword, got %v", err)
+	}
+}
+
+func TestRepositoryDetectsCorruptChunk(t *testing.T) {
+	ctx := context.Background()
+	provider := newMemoryProvider()
+	dataDir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dataDir, "config.json"), []byte(`{"domain":"example"}`), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	repo, err := Open(ctx, provider, "repo", "", backup.KDFArgon2id)
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	stats, err := repo.Backup(ctx, dirWalker(dataDir), nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to back up: %v", err)
+	}
+
+	// 用另一个分块的内容替换，名称与内容的哈希不再一致
+	other, err := repo.encodeBlob([]byte("tampered"))
+	if err != nil {
+		t.Fatalf("Failed to encode blob: %v", err)
+	}
+	for name := range provider.objects {
+		if strings.HasPrefix(name, "repo/data/") {
+			provider.objects[name] = other
+		}
+	}
+
+	if err := repo.Restore(ctx, stats.SnapshotID, t.TempDir()); err == nil {
+		t.Error("Expected restore to fail on corrupt chunk")
+	}
+
+	if err := repo.Restore(ctx, "missing", t.TempDir()); !errors.Is(err, ErrSnapshotNotFound) {
+		t.Errorf("Expected ErrSnapshotNotFound, got %v", err)
+	}
+}
+
+func TestRepositoryKDF(t *testing.T) {
+	ctx := context.Background()
+	provider := newMemoryProvider()
+
+	if _, err := Open(ctx, provider, "repo", "secret", backup.KDFArgon2id); err != nil {
+		t.Fatalf("Failed to initialize repository: %v", err)
+	}
+
+	var config Config
+	if err := json.Unmarshal(provider.objects["repo/config"], &config); err != nil {
+		t.Fatalf("Failed to decode repository config: %v", err)
+	}
+	if config.KDF != string(backup.KDFArgon2id) || config.Iterations == 0 || config.MemoryKiB == 0 || config.Threads == 0 || len(config.Salt) == 0 {
+		t.Errorf("Expected argon2id parameters in the repository config, got %+v", config)
+	}
+
+	// 已有仓库使用配置中记录的 KDF，而不是当前设置的 KDF
+	if _, err := Open(ctx, provider, "repo", "secret", backup.KDFPBKDF2); err != nil {
+		t.Errorf("Failed to reopen repository with another default kdf: %v", err)
+	}
+
+	// 被篡改为超出范围的参数在派生密钥前被拒绝
+	config.MemoryKiB = 1 << 30
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.objects["repo/config"] = data
+	if _, err := Open(ctx, provider, "repo", "secret", backup.KDFArgon2id); err == nil || errors.Is(err, ErrWrongPassword) {
+		t.Errorf("Expected out-of-range kdf parameters to be rejected, got %v", err)
+	}
+}
+
+func TestRepositoryOpensPBKDF2Config(t *testing.T) {
+	ctx := context.Background()
+	provider := newMemoryProvider()
+
+	// 早期版本的仓库使用 100000 次迭代的 PBKDF2-SHA256
+	salt := bytes.Repeat([]byte{7}, 32)
+	block, err := aes.NewCipher(pbkdf2.Key([]byte("secret"), salt, 100000, 32, sha256.New))
+	if err != nil {
+		t.Fatal(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &Repository{provider: provider, prefix: "repo", aead: aead, config: Config{
+		Version:    repositoryVersion,
+		Encrypted:  true,
+		KDF:        "pbkdf2-sha256",
+		Iterations: 100000,
+		Salt:       salt,
+	}}
+	check, err := repo.seal([]byte(keyCheckPlaintext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.config.KeyCheck = check
+	data, err := json.Marshal(repo.config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.objects["repo/config"] = data
+
+	if _, err := Open(ctx, provider, "repo", "secret", backup.KDFArgon2id); err != nil {
+		t.Errorf("Failed to open a PBKDF2 repository: %v", err)
+	}
+	if _, err := Open(ctx, provider, "repo", "wrong", backup.KDFArgon2id); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("Expected ErrWrongPassword, got %v", err)
+	}
+}
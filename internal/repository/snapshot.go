@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Snapshot 为一次备份的索引，记录每个文件由哪些分块组成
+type Snapshot struct {
+	ID        string         `json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	Hostname  string         `json:"hostname,omitempty"`
+	Include   []string       `json:"include,omitempty"`
+	Exclude   []string       `json:"exclude,omitempty"`
+	Files     []SnapshotFile `json:"files"`
+}
+
+// SnapshotFile 为快照中的单个文件
+type SnapshotFile struct {
+	Path    string      `json:"path"`
+	Size    int64       `json:"size"`
+	Mode    os.FileMode `json:"mode"`
+	ModTime time.Time   `json:"mtime"`
+	Chunks  []string    `json:"chunks"`
+}
+
+// Stats 为一次备份的去重统计
+type Stats struct {
+	SnapshotID    string
+	Files         int
+	TotalBytes    int64
+	NewChunks     int
+	ReusedChunks  int
+	UploadedBytes int64
+}
+
+// WalkFunc 遍历待备份的文件，对每个文件调用 fn
+type WalkFunc func(ctx context.Context, fn func(relPath string, info os.FileInfo, r io.Reader) error) error
+
+// Backup 将 walk 提供的文件写入仓库并保存快照。已存在的分块不会重复上传，
+// 因此中断后重新运行相当于断点续传。
+func (r *Repository) Backup(ctx context.Context, walk WalkFunc, include, exclude []string) (*Stats, error) {
+	names, err := r.listNames(ctx, dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunks: %w", err)
+	}
+
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+
+	snapshot := &Snapshot{
+		ID:        newSnapshotID(),
+		CreatedAt: time.Now().UTC(),
+		Include:   include,
+		Exclude:   exclude,
+	}
+	snapshot.Hostname, _ = os.Hostname()
+
+	stats := &Stats{SnapshotID: snapshot.ID}
+
+	err = walk(ctx, func(relPath string, info os.FileInfo, reader io.Reader) error {
+		file := SnapshotFile{
+			Path:    filepath.ToSlash(relPath),
+			Mode:    info.Mode().Perm(),
+			ModTime: info.ModTime().UTC(),
+		}
+
+		chunker := NewChunker(reader, r.config.MinChunkSize, r.config.AvgChunkSize, r.config.MaxChunkSize)
+		for {
+			chunk, err := chunker.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", relPath, err)
+			}
+
+			sum := sha256.Sum256(chunk)
+			id := hex.EncodeToString(sum[:])
+			file.Chunks = append(file.Chunks, id)
+			file.Size += int64(len(chunk))
+
+			if known[id] {
+				stats.ReusedChunks++
+				continue
+			}
+
+			blob, err := r.encodeBlob(chunk)
+			if err != nil {
+				return err
+			}
+			if err := r.provider.Upload(ctx, r.chunkPath(id), bytes.NewReader(blob)); err != nil {
+				return fmt.Errorf("failed to upload chunk %s: %w", id, err)
+			}
+
+			known[id] = true
+			stats.NewChunks++
+			stats.UploadedBytes += int64(len(blob))
+		}
+
+		snapshot.Files = append(snapshot.Files, file)
+		stats.Files++
+		stats.TotalBytes += file.Size
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := r.encodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// 快照在所有分块上传完成后才写入，仓库中不会出现引用缺失分块的快照
+	if err := r.provider.Upload(ctx, r.objectPath(snapshotsDir, snapshot.ID), bytes.NewReader(blob)); err != nil {
+		return nil, fmt.Errorf("failed to upload snapshot: %w", err)
+	}
+
+	return stats, nil
+}
+
+// Snapshots 返回仓库中所有快照的 ID，按时间排序
+func (r *Repository) Snapshots(ctx context.Context) ([]string, error) {
+	ids, err := r.listNames(ctx, snapshotsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// LoadSnapshot 读取快照索引
+func (r *Repository) LoadSnapshot(ctx context.Context, id string) (*Snapshot, error) {
+	if id == "" || strings.ContainsAny(id, "/\\") {
+		return nil, ErrSnapshotNotFound
+	}
+
+	name := r.objectPath(snapshotsDir, id)
+	exists, err := r.provider.Exists(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrSnapshotNotFound
+	}
+
+	data, err := r.downloadBlob(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", id, err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", id, err)
+	}
+
+	return &snapshot, nil
+}
+
+// Restore 将快照中的文件恢复到 destPath
+func (r *Repository) Restore(ctx context.Context, id, destPath string) error {
+	snapshot, err := r.LoadSnapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range snapshot.Files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := r.restoreFile(ctx, file, destPath); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) restoreFile(ctx context.Context, file SnapshotFile, destPath string) error {
+	name := filepath.FromSlash(file.Path)
+	if filepath.IsAbs(name) || strings.HasPrefix(filepath.Clean(name), "..") {
+		return fmt.Errorf("invalid path in snapshot")
+	}
+
+	target := filepath.Join(destPath, name)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	mode := file.Mode
+	if mode == 0 {
+		mode = 0644
+	}
+
+	out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range file.Chunks {
+		data, err := r.readChunk(ctx, id)
+		if err != nil {
+			out.Close()
+			return err
+		}
+		if _, err := out.Write(data); err != nil {
+			out.Close()
+			return err
+		}
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	return os.Chtimes(target, file.ModTime, file.ModTime)
+}
+
+func newSnapshotID() string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return time.Now().UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix)
+}
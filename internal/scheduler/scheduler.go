@@ -2,16 +2,24 @@ package scheduler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	gosync "sync"
 	"time"
 
 	"github.com/ca-x/vaultwarden-syncer/ent"
+	entsource "github.com/ca-x/vaultwarden-syncer/ent/source"
 	entstorage "github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/internal/cleanup"
 	"github.com/ca-x/vaultwarden-syncer/internal/config"
+	"github.com/ca-x/vaultwarden-syncer/internal/hook"
 	"github.com/ca-x/vaultwarden-syncer/internal/sync"
 )
 
+// sourceCheckInterval 为检查数据源是否到了定时备份时间的间隔
+const sourceCheckInterval = time.Minute
+
 type Service struct {
 	client         *ent.Client
 	syncService    *sync.Service
@@ -19,7 +27,14 @@ type Service struct {
 	config         *config.Config
 	ticker         *time.Ticker
 	cleanupTicker  *time.Ticker
+	verifyTicker   *time.Ticker
+	sourceTicker   *time.Ticker
 	stopChan       chan struct{}
+
+	// sourceRuns 记录每个数据源上一次定时备份的开始时间，sourceRunning 记录正在备份的数据源
+	sourceMu      gosync.Mutex
+	sourceRuns    map[int]time.Time
+	sourceRunning map[int]bool
 }
 
 func NewService(client *ent.Client, syncService *sync.Service, cleanupService *cleanup.Service, config *config.Config) *Service {
@@ -32,12 +47,27 @@ func NewService(client *ent.Client, syncService *sync.Service, cleanupService *c
 		syncService.SetConcurrency(config.Sync.Concurrency)
 	}
 
+	syncService.SetRepositoryMode(config.Sync.Repository, config.Sync.Password)
+	syncService.SetVerifyAfterUpload(config.Sync.VerifyAfterUpload)
+	syncService.SetAnomalyDetection(config.Sync.AnomalyShrinkPercent, config.Sync.AnomalyChangedPercent, config.Sync.QuarantinePrefix)
+	syncService.SetVolumeSize(config.Sync.VolumeSizeMB << 20)
+	syncService.SetTempDir(config.Sync.TempDir)
+	syncService.SetHooks(hook.NewRunner(hook.Options{
+		PreBackup:  config.Hooks.PreBackup,
+		PostBackup: config.Hooks.PostBackup,
+		OnSuccess:  config.Hooks.OnSuccess,
+		OnFailure:  config.Hooks.OnFailure,
+		Timeout:    time.Duration(config.Hooks.TimeoutSeconds) * time.Second,
+	}))
+
 	return &Service{
 		client:         client,
 		syncService:    syncService,
 		cleanupService: cleanupService,
 		config:         config,
 		stopChan:       make(chan struct{}),
+		sourceRuns:     make(map[int]time.Time),
+		sourceRunning:  make(map[int]bool),
 	}
 }
 
@@ -53,7 +83,7 @@ func (s *Service) Start(ctx context.Context) error {
 			for {
 				select {
 				case <-s.ticker.C:
-					if err := s.runSync(ctx); err != nil {
+					if err := s.runSync(ctx, s.syncService, s.config.Sync.SkipUnchanged); err != nil {
 						log.Printf("Scheduled sync failed: %v", err)
 					}
 				case <-s.stopChan:
@@ -66,6 +96,20 @@ func (s *Service) Start(ctx context.Context) error {
 		log.Println("Sync scheduler disabled (interval <= 0)")
 	}
 
+	// 每个数据源按照自己的间隔定时备份
+	s.sourceTicker = time.NewTicker(sourceCheckInterval)
+	go func() {
+		started := time.Now()
+		for {
+			select {
+			case <-s.sourceTicker.C:
+				s.runDueSources(ctx, started)
+			case <-s.stopChan:
+				return
+			}
+		}
+	}()
+
 	// Start cleanup scheduler if history retention is enabled
 	if s.config.Sync.HistoryRetentionDays > 0 {
 		// Run cleanup daily at 2 AM
@@ -97,6 +141,28 @@ func (s *Service) Start(ctx context.Context) error {
 		log.Println("Cleanup scheduler disabled (history_retention_days <= 0)")
 	}
 
+	// Start verify scheduler if enabled
+	if s.config.Sync.VerifyInterval > 0 {
+		interval := time.Duration(s.config.Sync.VerifyInterval) * time.Second
+		s.verifyTicker = time.NewTicker(interval)
+
+		go func() {
+			log.Printf("Verify scheduler started with interval: %v", interval)
+
+			for {
+				select {
+				case <-s.verifyTicker.C:
+					if err := s.runVerify(ctx); err != nil {
+						log.Printf("Scheduled verification failed: %v", err)
+					}
+				case <-s.stopChan:
+					log.Println("Verify scheduler stopped")
+					return
+				}
+			}
+		}()
+	}
+
 	return nil
 }
 
@@ -107,10 +173,17 @@ func (s *Service) Stop() {
 	if s.cleanupTicker != nil {
 		s.cleanupTicker.Stop()
 	}
+	if s.verifyTicker != nil {
+		s.verifyTicker.Stop()
+	}
+	if s.sourceTicker != nil {
+		s.sourceTicker.Stop()
+	}
 	close(s.stopChan)
 }
 
-func (s *Service) runSync(ctx context.Context) error {
+// runSync 使用 syncService 备份到所有启用的存储。skipUnchanged 为 true 时跳过数据自上一次成功备份以来没有变化的存储
+func (s *Service) runSync(ctx context.Context, syncService *sync.Service, skipUnchanged bool) error {
 	storages, err := s.client.Storage.
 		Query().
 		Where(entstorage.Enabled(true)).
@@ -133,8 +206,23 @@ func (s *Service) runSync(ctx context.Context) error {
 		storageIDs[i] = st.ID
 	}
 
+	if skipUnchanged {
+		storageIDs, err = syncService.SkipUnchanged(ctx, storageIDs)
+		if err != nil {
+			log.Printf("Failed to check for changes, backing up all storages: %v", err)
+			storageIDs = make([]int, len(storages))
+			for i, st := range storages {
+				storageIDs[i] = st.ID
+			}
+		}
+		if len(storageIDs) == 0 {
+			log.Println("No changes since the last backup, scheduled sync skipped")
+			return nil
+		}
+	}
+
 	// 使用并发同步
-	if err := s.syncService.ConcurrentSyncToStorages(ctx, storageIDs); err != nil {
+	if err := syncService.ConcurrentSyncToStorages(ctx, storageIDs); err != nil {
 		log.Printf("Failed to concurrently sync to storage backends: %v", err)
 		return err
 	}
@@ -145,7 +233,127 @@ func (s *Service) runSync(ctx context.Context) error {
 
 func (s *Service) RunSyncNow(ctx context.Context) error {
 	log.Println("Manual sync triggered")
-	return s.runSync(ctx)
+	return s.runSync(ctx, s.syncService, false)
+}
+
+// RunSourceSyncNow 立即将指定数据源备份到所有启用的存储
+func (s *Service) RunSourceSyncNow(ctx context.Context, sourceID int) error {
+	syncService, err := s.syncService.ForSource(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Manual sync of source %s triggered", syncService.Source().Name)
+	return s.runSync(ctx, syncService, false)
+}
+
+// runDueSources 启动所有到了定时备份时间的数据源的备份。数据源上一次备份仍在进行时不会重复启动，
+// 调度器启动后第一次备份在一个间隔之后进行。
+func (s *Service) runDueSources(ctx context.Context, started time.Time) {
+	sources, err := s.client.Source.
+		Query().
+		Where(entsource.Enabled(true), entsource.IntervalGT(0)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to load sources: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, src := range sources {
+		s.sourceMu.Lock()
+		last, ok := s.sourceRuns[src.ID]
+		if !ok {
+			last = started
+		}
+		due := !s.sourceRunning[src.ID] && now.Sub(last) >= time.Duration(src.Interval)*time.Second
+		if due {
+			s.sourceRuns[src.ID] = now
+			s.sourceRunning[src.ID] = true
+		}
+		s.sourceMu.Unlock()
+		if !due {
+			continue
+		}
+
+		go func(src *ent.Source) {
+			defer func() {
+				s.sourceMu.Lock()
+				delete(s.sourceRunning, src.ID)
+				s.sourceMu.Unlock()
+			}()
+
+			syncService, err := s.syncService.ForSource(ctx, src.ID)
+			if err != nil {
+				log.Printf("Scheduled sync of source %s failed: %v", src.Name, err)
+				return
+			}
+			log.Printf("Starting scheduled sync of source %s", src.Name)
+			if err := s.runSync(ctx, syncService, s.config.Sync.SkipUnchanged); err != nil {
+				log.Printf("Scheduled sync of source %s failed: %v", src.Name, err)
+			}
+		}(src)
+	}
+}
+
+// runVerify 校验每个启用的存储中默认实例和每个启用的数据源最近一次上传的备份
+func (s *Service) runVerify(ctx context.Context) error {
+	storages, err := s.client.Storage.
+		Query().
+		Where(entstorage.Enabled(true)).
+		All(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	sources, err := s.client.Source.
+		Query().
+		Where(entsource.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	services := []*sync.Service{s.syncService}
+	for _, src := range sources {
+		syncService, err := s.syncService.ForSource(ctx, src.ID)
+		if err != nil {
+			log.Printf("Scheduled verification of source %s failed: %v", src.Name, err)
+			continue
+		}
+		services = append(services, syncService)
+	}
+
+	var checked, failed int
+	for _, syncService := range services {
+		for _, st := range storages {
+			err := syncService.VerifyLatest(ctx, st.ID)
+			if errors.Is(err, sync.ErrNoBackupToVerify) {
+				continue
+			}
+			checked++
+			if err != nil {
+				log.Printf("Verification of latest backup%s on %s failed: %v", sourceLabel(syncService), st.Name, err)
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("verification failed for %d of %d backups", failed, checked)
+	}
+
+	log.Println("Scheduled verification completed")
+	return nil
+}
+
+// sourceLabel 返回日志中标识数据源的文本，默认实例返回空字符串
+func sourceLabel(syncService *sync.Service) string {
+	if src := syncService.Source(); src != nil {
+		return fmt.Sprintf(" of source %s", src.Name)
+	}
+	return ""
 }
 
 func (s *Service) runCleanup(ctx context.Context) error {
@@ -75,6 +75,10 @@ func New(cfg *config.Config, handler *handler.Handler, authService *auth.Service
 	protected.PUT("/api/storage/:id", handler.UpdateStorage)
 	protected.DELETE("/api/storage/:id", handler.DeleteStorage)
 	protected.POST("/api/sync/:id", handler.TriggerSync)
+	protected.POST("/api/verify/:id", handler.TriggerVerify)
+	protected.POST("/api/restore/:id/undo", handler.UndoRestore)
+	protected.GET("/api/storage/:id/snapshots", handler.GetSnapshots)
+	protected.DELETE("/api/storage/:id/snapshots/:snapshot", handler.ForgetSnapshot)
 	protected.POST("/api/sync-concurrent", handler.TriggerConcurrentSync) // 添加并发同步端点
 	protected.POST("/api/health-check", handler.HealthCheckAll)           // 添加健康检查端点
 	protected.GET("/api/jobs", handler.GetSyncJobs)
@@ -85,6 +89,12 @@ func New(cfg *config.Config, handler *handler.Handler, authService *auth.Service
 	protected.GET("/api/storages", handler.GetStorages)           // 添加获取存储列表端点
 	protected.POST("/api/sync-manual", handler.TriggerManualSync) // 添加手动同步端点
 	protected.GET("/api/version", handler.GetVersionInfo)         // 添加版本信息端点
+	protected.PUT("/api/settings/backup-rules", handler.UpdateBackupRules)
+	protected.GET("/api/sources", handler.GetSources)
+	protected.POST("/api/sources", handler.CreateSource)
+	protected.PUT("/api/sources/:id", handler.UpdateSource)
+	protected.DELETE("/api/sources/:id", handler.DeleteSource)
+	protected.POST("/api/sources/:id/sync", handler.TriggerSourceSync)
 
 	return &Server{
 		echo:   e,
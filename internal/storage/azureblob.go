@@ -0,0 +1,666 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// azureAPIVersion 为请求使用的 Blob 服务 REST API 版本
+	azureAPIVersion = "2021-08-06"
+	// azureBlockSize 为暂存块的大小，单个块 Blob 最多 50000 个块
+	azureBlockSize = 4 << 20
+)
+
+// Azure Blob 的访问层
+const (
+	AzureTierHot     = "Hot"
+	AzureTierCool    = "Cool"
+	AzureTierArchive = "Archive"
+)
+
+// backupArchivePrefix 为备份归档及其分卷的文件名前缀
+const backupArchivePrefix = "vaultwarden-backup-"
+
+var azureContainerPattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9]|-[a-z0-9]){2,62}$`)
+
+type AzureBlobConfig struct {
+	Name        string `json:"name"`
+	AccountName string `json:"account_name"`
+	// AccountKey 和 SASToken 二选一，AccountKey 为 Base64 编码的存储账户密钥
+	AccountKey string `json:"account_key"`
+	SASToken   string `json:"sas_token"`
+	Container  string `json:"container"`
+	// Endpoint 为 Blob 服务地址，为空时使用 https://<account>.blob.core.windows.net。
+	// Azurite 模拟器为 http://127.0.0.1:10000/devstoreaccount1
+	Endpoint string `json:"endpoint"`
+	// AccessTier 为备份归档及其分卷设置的访问层，为空时使用账户的默认访问层。
+	// 清单、分卷索引和仓库等元数据总是使用账户的默认访问层
+	AccessTier string `json:"access_tier"`
+}
+
+func (c AzureBlobConfig) Validate() error {
+	if c.AccountName == "" {
+		return fmt.Errorf("account name is required")
+	}
+	if !azureContainerPattern.MatchString(c.Container) {
+		return fmt.Errorf("container name must be 3-63 lowercase letters, digits or single hyphens")
+	}
+
+	switch {
+	case c.AccountKey == "" && c.SASToken == "":
+		return fmt.Errorf("either an account key or a SAS token is required")
+	case c.AccountKey != "" && c.SASToken != "":
+		return fmt.Errorf("set either an account key or a SAS token, not both")
+	case c.AccountKey != "":
+		if _, err := base64.StdEncoding.DecodeString(c.AccountKey); err != nil {
+			return fmt.Errorf("account key must be base64 encoded: %w", err)
+		}
+	default:
+		query, err := url.ParseQuery(strings.TrimPrefix(c.SASToken, "?"))
+		if err != nil || query.Get("sig") == "" {
+			return fmt.Errorf("SAS token must contain a signature (sig)")
+		}
+	}
+
+	if c.Endpoint != "" {
+		endpoint, err := url.Parse(c.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("endpoint must be an http or https URL")
+		}
+	}
+
+	switch c.AccessTier {
+	case "", AzureTierHot, AzureTierCool, AzureTierArchive:
+	default:
+		return fmt.Errorf("access tier must be one of Hot, Cool or Archive")
+	}
+	return nil
+}
+
+// AzureBlobProvider 将备份保存为 Azure 块 Blob。上传时先暂存块再提交块列表，
+// 块 ID 包含块序号和内容哈希，中断后重新上传时已暂存的块不会重复传输
+type AzureBlobProvider struct {
+	config    AzureBlobConfig
+	container *url.URL
+	key       []byte
+	sas       url.Values
+	client    *http.Client
+	blockSize int
+}
+
+func NewAzureBlobProvider(config AzureBlobConfig) (*AzureBlobProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid Azure Blob config: %w", err)
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "https://" + config.AccountName + ".blob.core.windows.net"
+	}
+	container, err := url.Parse(strings.TrimSuffix(endpoint, "/") + "/" + config.Container)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Azure Blob endpoint: %w", err)
+	}
+
+	provider := &AzureBlobProvider{
+		config:    config,
+		container: container,
+		client:    &http.Client{},
+		blockSize: azureBlockSize,
+	}
+	if config.AccountKey != "" {
+		provider.key, _ = base64.StdEncoding.DecodeString(config.AccountKey)
+	} else {
+		provider.sas, _ = url.ParseQuery(strings.TrimPrefix(config.SASToken, "?"))
+	}
+	return provider, nil
+}
+
+func (p *AzureBlobProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *AzureBlobProvider) Type() string {
+	return "azureblob"
+}
+
+// azureError 为 Blob 服务返回的错误
+type azureError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *azureError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("azure blob: %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("azure blob: %d %s", e.StatusCode, e.Code)
+}
+
+func isAzureNotFound(err error) bool {
+	var azErr *azureError
+	return errors.As(err, &azErr) && azErr.StatusCode == http.StatusNotFound
+}
+
+// blobName 将对象名转换为 Blob 名称，拒绝空名称和包含 .. 的名称
+func blobName(name string) (string, error) {
+	cleaned, err := cleanObjectName(name)
+	if err != nil {
+		return "", err
+	}
+	return cleaned[1:], nil
+}
+
+// newRequest 创建针对容器（blob 为空时）或 Blob 的请求
+func (p *AzureBlobProvider) newRequest(ctx context.Context, method, blob string, query url.Values, body []byte) (*http.Request, error) {
+	target := *p.container
+	if blob != "" {
+		segments := strings.Split(blob, "/")
+		for i, segment := range segments {
+			segments[i] = url.PathEscape(segment)
+		}
+		target.RawPath = target.EscapedPath() + "/" + strings.Join(segments, "/")
+		target.Path = target.Path + "/" + blob
+	}
+
+	values := url.Values{}
+	for key, value := range query {
+		values[key] = value
+	}
+	for key, value := range p.sas {
+		values[key] = value
+	}
+	target.RawQuery = values.Encode()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	return req, nil
+}
+
+// do 发送请求，状态码不在 expected 中时返回 *azureError
+func (p *AzureBlobProvider) do(req *http.Request, expected ...int) (*http.Response, error) {
+	if p.key != nil {
+		req.Header.Set("Authorization", "SharedKey "+p.config.AccountName+":"+p.signature(req))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+
+	azErr := &azureError{StatusCode: resp.StatusCode, Code: resp.Header.Get("x-ms-error-code")}
+	var body struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10)); xml.Unmarshal(data, &body) == nil {
+		if azErr.Code == "" {
+			azErr.Code = body.Code
+		}
+		azErr.Message = strings.TrimSpace(strings.SplitN(body.Message, "\n", 2)[0])
+	}
+	if azErr.Code == "" {
+		azErr.Code = http.StatusText(resp.StatusCode)
+	}
+	return nil, azErr
+}
+
+// signature 计算 Shared Key 签名
+func (p *AzureBlobProvider) signature(req *http.Request) string {
+	length := ""
+	if req.ContentLength > 0 {
+		length = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var b strings.Builder
+	b.WriteString(req.Method + "\n")
+	for _, value := range []string{
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		length,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date，使用 x-ms-date 代替
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+	} {
+		b.WriteString(value + "\n")
+	}
+
+	var headers []string
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			headers = append(headers, lower)
+		}
+	}
+	sort.Strings(headers)
+	for _, name := range headers {
+		b.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+
+	b.WriteString("/" + p.config.AccountName + req.URL.EscapedPath())
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		b.WriteString("\n" + strings.ToLower(key) + ":" + strings.Join(values, ","))
+	}
+
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(b.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// azureBlock 为块列表中的一个块
+type azureBlock struct {
+	Name string `xml:"Name"`
+	Size int64  `xml:"Size"`
+}
+
+// azureBlockID 返回第 index 个块的 ID，包含内容哈希，相同内容的块可以直接复用
+func azureBlockID(index int, data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d-%s", index, hex.EncodeToString(sum[:16]))))
+}
+
+// azureBlockIndex 解析块 ID 中的块序号，不是本程序生成的块 ID 时返回 -1
+func azureBlockIndex(id string) int {
+	decoded, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		return -1
+	}
+	prefix, _, ok := strings.Cut(string(decoded), "-")
+	if !ok {
+		return -1
+	}
+	index, err := strconv.Atoi(prefix)
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// blockList 获取 Blob 已提交和未提交的块，Blob 不存在时返回空列表
+func (p *AzureBlobProvider) blockList(ctx context.Context, blob string) ([]azureBlock, []azureBlock, error) {
+	req, err := p.newRequest(ctx, http.MethodGet, blob, url.Values{"comp": {"blocklist"}, "blocklisttype": {"all"}}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := p.do(req, http.StatusOK)
+	if err != nil {
+		if isAzureNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	var list struct {
+		Committed   []azureBlock `xml:"CommittedBlocks>Block"`
+		Uncommitted []azureBlock `xml:"UncommittedBlocks>Block"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, nil, fmt.Errorf("invalid block list response: %w", err)
+	}
+	return list.Committed, list.Uncommitted, nil
+}
+
+// stageBlocks 从 reader 读取数据并暂存为块，块序号从 first 开始。已暂存的相同块不会重复上传
+func (p *AzureBlobProvider) stageBlocks(ctx context.Context, blob string, reader io.Reader, first int, staged map[string]int64) ([]string, error) {
+	var ids []string
+	buf := make([]byte, p.blockSize)
+	for index := first; ; index++ {
+		n, readErr := io.ReadFull(reader, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return nil, readErr
+		}
+		if n > 0 {
+			id := azureBlockID(index, buf[:n])
+			if size, ok := staged[id]; !ok || size != int64(n) {
+				req, err := p.newRequest(ctx, http.MethodPut, blob, url.Values{"comp": {"block"}, "blockid": {id}}, buf[:n])
+				if err != nil {
+					return nil, err
+				}
+				resp, err := p.do(req, http.StatusCreated)
+				if err != nil {
+					return nil, fmt.Errorf("failed to stage block %d: %w", index, err)
+				}
+				resp.Body.Close()
+			}
+			ids = append(ids, id)
+		}
+
+		if readErr != nil {
+			return ids, nil
+		}
+	}
+}
+
+// commitBlocks 提交块列表，Blob 的内容变为按顺序拼接的这些块
+func (p *AzureBlobProvider) commitBlocks(ctx context.Context, blob string, ids []string) error {
+	var body bytes.Buffer
+	body.WriteString(xml.Header + "<BlockList>")
+	for _, id := range ids {
+		body.WriteString("<Latest>" + id + "</Latest>")
+	}
+	body.WriteString("</BlockList>")
+
+	req, err := p.newRequest(ctx, http.MethodPut, blob, url.Values{"comp": {"blocklist"}}, body.Bytes())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("x-ms-blob-content-type", "application/octet-stream")
+	if tier := p.accessTier(blob); tier != "" {
+		req.Header.Set("x-ms-access-tier", tier)
+	}
+
+	resp, err := p.do(req, http.StatusCreated)
+	if err != nil {
+		return fmt.Errorf("failed to commit block list: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// accessTier 返回提交 blob 时设置的访问层。配置的访问层只用于备份归档及其分卷；
+// 清单、分卷索引和仓库在同步、清理和校验时都需要读取，Archive 层的 Blob 无法直接读取，
+// 因此这些元数据使用账户的默认访问层（Hot 或 Cool）
+func (p *AzureBlobProvider) accessTier(blob string) string {
+	base := path.Base(blob)
+	if strings.HasPrefix(base, backupArchivePrefix) && !strings.HasSuffix(base, ".json") {
+		return p.config.AccessTier
+	}
+	return ""
+}
+
+func (p *AzureBlobProvider) Upload(ctx context.Context, name string, reader io.Reader) error {
+	blob, err := blobName(name)
+	if err != nil {
+		return err
+	}
+
+	committed, uncommitted, err := p.blockList(ctx, blob)
+	if err != nil {
+		return fmt.Errorf("failed to get block list: %w", err)
+	}
+	staged := make(map[string]int64, len(committed)+len(uncommitted))
+	for _, block := range append(committed, uncommitted...) {
+		staged[block.Name] = block.Size
+	}
+
+	ids, err := p.stageBlocks(ctx, blob, contextReader{ctx: ctx, r: reader}, 0, staged)
+	if err != nil {
+		return fmt.Errorf("failed to upload to Azure Blob: %w", err)
+	}
+	if err := p.commitBlocks(ctx, blob, ids); err != nil {
+		return fmt.Errorf("failed to upload to Azure Blob: %w", err)
+	}
+	return nil
+}
+
+// UploadStream 上传长度未知的数据流，数据按块暂存，全部读取后才提交块列表
+func (p *AzureBlobProvider) UploadStream(ctx context.Context, name string, reader io.Reader) error {
+	return p.Upload(ctx, name, reader)
+}
+
+// UploadPart 从 offset 处继续上传，offset 必须是块大小的整数倍。offset 之前的数据使用
+// Blob 已提交的块，或者上次中断的上传暂存的块
+func (p *AzureBlobProvider) UploadPart(ctx context.Context, name string, reader io.Reader, offset int64) error {
+	blob, err := blobName(name)
+	if err != nil {
+		return err
+	}
+	if offset < 0 || offset%int64(p.blockSize) != 0 {
+		return fmt.Errorf("%w: offset %d is not a multiple of the block size %d", ErrResumeUnsupported, offset, p.blockSize)
+	}
+
+	committed, uncommitted, err := p.blockList(ctx, blob)
+	if err != nil {
+		return fmt.Errorf("failed to get block list: %w", err)
+	}
+	prefix, err := p.resumeBlocks(committed, uncommitted, int(offset/int64(p.blockSize)))
+	if err != nil {
+		return err
+	}
+
+	ids, err := p.stageBlocks(ctx, blob, contextReader{ctx: ctx, r: reader}, len(prefix), nil)
+	if err != nil {
+		return fmt.Errorf("failed to upload to Azure Blob: %w", err)
+	}
+	if err := p.commitBlocks(ctx, blob, append(prefix, ids...)); err != nil {
+		return fmt.Errorf("failed to upload to Azure Blob: %w", err)
+	}
+	return nil
+}
+
+// resumeBlocks 返回前 count 个完整块的 ID，优先使用已提交的块，其次使用序号唯一的未提交块
+func (p *AzureBlobProvider) resumeBlocks(committed, uncommitted []azureBlock, count int) ([]string, error) {
+	full := func(block azureBlock, index int) bool {
+		return block.Size == int64(p.blockSize) && azureBlockIndex(block.Name) == index
+	}
+
+	if len(committed) >= count {
+		ids := make([]string, 0, count)
+		for i, block := range committed[:count] {
+			if !full(block, i) {
+				break
+			}
+			ids = append(ids, block.Name)
+		}
+		if len(ids) == count {
+			return ids, nil
+		}
+	}
+
+	byIndex := make(map[int][]string)
+	for _, block := range uncommitted {
+		if index := azureBlockIndex(block.Name); index >= 0 && index < count && full(block, index) {
+			byIndex[index] = append(byIndex[index], block.Name)
+		}
+	}
+	ids := make([]string, count)
+	for i := range ids {
+		if len(byIndex[i]) != 1 {
+			return nil, fmt.Errorf("%w: block %d before offset %d is not staged", ErrResumeUnsupported, i, int64(count)*int64(p.blockSize))
+		}
+		ids[i] = byIndex[i][0]
+	}
+	return ids, nil
+}
+
+func (p *AzureBlobProvider) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	return p.get(ctx, name, "")
+}
+
+// DownloadPart 通过 x-ms-range 读取从 offset 开始的 length 字节
+func (p *AzureBlobProvider) DownloadPart(ctx context.Context, name string, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 || length < 0 {
+		return nil, fmt.Errorf("invalid range offset %d length %d", offset, length)
+	}
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	return p.get(ctx, name, fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+}
+
+func (p *AzureBlobProvider) get(ctx context.Context, name, byteRange string) (io.ReadCloser, error) {
+	blob, err := blobName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := p.newRequest(ctx, http.MethodGet, blob, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if byteRange != "" {
+		req.Header.Set("x-ms-range", byteRange)
+	}
+
+	resp, err := p.do(req, http.StatusOK, http.StatusPartialContent)
+	if err != nil {
+		var azErr *azureError
+		if errors.As(err, &azErr) && azErr.Code == "BlobArchived" {
+			return nil, fmt.Errorf("blob %s is in the Archive tier and must be rehydrated before it can be downloaded: %w", blob, err)
+		}
+		return nil, fmt.Errorf("failed to download from Azure Blob: %w", err)
+	}
+	return resp.Body, nil
+}
+
+func (p *AzureBlobProvider) Delete(ctx context.Context, name string) error {
+	blob, err := blobName(name)
+	if err != nil {
+		return err
+	}
+
+	req, err := p.newRequest(ctx, http.MethodDelete, blob, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.do(req, http.StatusAccepted)
+	if err != nil {
+		return fmt.Errorf("failed to delete from Azure Blob: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// List 列出名称以 prefix 开头的 Blob
+func (p *AzureBlobProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	var result []string
+	marker := ""
+	for {
+		page, next, err := p.listPage(ctx, prefix, marker, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Azure Blob container: %w", err)
+		}
+		result = append(result, page...)
+		if next == "" {
+			return result, nil
+		}
+		marker = next
+	}
+}
+
+// listPage 获取一页 Blob 名称，maxResults 为 0 时使用服务端的默认值
+func (p *AzureBlobProvider) listPage(ctx context.Context, prefix, marker string, maxResults int) ([]string, string, error) {
+	query := url.Values{"restype": {"container"}, "comp": {"list"}}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+	if maxResults > 0 {
+		query.Set("maxresults", strconv.Itoa(maxResults))
+	}
+
+	req, err := p.newRequest(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	var page struct {
+		Names      []string `xml:"Blobs>Blob>Name"`
+		NextMarker string   `xml:"NextMarker"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, "", fmt.Errorf("invalid list response: %w", err)
+	}
+	return page.Names, page.NextMarker, nil
+}
+
+func (p *AzureBlobProvider) Exists(ctx context.Context, name string) (bool, error) {
+	if _, err := p.size(ctx, name); err != nil {
+		if isAzureNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check Azure Blob existence: %w", err)
+	}
+	return true, nil
+}
+
+// GetFileSize 获取 Blob 大小，Blob 不存在时返回 0
+func (p *AzureBlobProvider) GetFileSize(ctx context.Context, name string) (int64, error) {
+	size, err := p.size(ctx, name)
+	if err != nil {
+		if isAzureNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get Azure Blob size: %w", err)
+	}
+	return size, nil
+}
+
+func (p *AzureBlobProvider) size(ctx context.Context, name string) (int64, error) {
+	blob, err := blobName(name)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := p.newRequest(ctx, http.MethodHead, blob, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.ContentLength, nil
+}
+
+// HealthCheck 检查认证信息是否有效以及容器是否存在
+func (p *AzureBlobProvider) HealthCheck(ctx context.Context) error {
+	if _, _, err := p.listPage(ctx, "", "", 1); err != nil {
+		return fmt.Errorf("failed to access Azure Blob container %s: %w", p.config.Container, err)
+	}
+	return nil
+}
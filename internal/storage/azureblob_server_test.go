@@ -0,0 +1,299 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Azurite 模拟器的默认账户和密钥
+const (
+	testAzureAccount = "devstoreaccount1"
+	testAzureKey     = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testAzureSAS     = "sv=2021-08-06&ss=b&srt=co&sp=rwdlac&sig=test-signature"
+)
+
+type testAzureBlock struct {
+	id   string
+	data []byte
+}
+
+type testAzureBlob struct {
+	committed   []testAzureBlock
+	uncommitted map[string][]byte
+	tier        string
+}
+
+func (b *testAzureBlob) content() []byte {
+	var data []byte
+	for _, block := range b.committed {
+		data = append(data, block.data...)
+	}
+	return data
+}
+
+// testAzureServer 为测试用的 Blob 服务，按 Azurite 的路径格式 /<account>/<container>/<blob> 提供一个容器
+type testAzureServer struct {
+	*httptest.Server
+	container string
+	// pageSize 为列出 Blob 时每页的默认数量，覆盖客户端分页的情况
+	pageSize int
+
+	mu        sync.Mutex
+	blobs     map[string]*testAzureBlob
+	putBlocks int
+}
+
+func startTestAzureServer(t *testing.T) *testAzureServer {
+	t.Helper()
+	server := &testAzureServer{container: "backups", pageSize: 2, blobs: map[string]*testAzureBlob{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.handle))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// config 返回使用账户密钥认证的存储配置
+func (s *testAzureServer) config() AzureBlobConfig {
+	return AzureBlobConfig{
+		Name:        "test",
+		AccountName: testAzureAccount,
+		AccountKey:  testAzureKey,
+		Container:   s.container,
+		Endpoint:    s.URL + "/" + testAzureAccount,
+	}
+}
+
+// blob 返回已提交的 Blob 内容和访问层
+func (s *testAzureServer) blob(name string) ([]byte, string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	blob, ok := s.blobs[name]
+	if !ok || blob.committed == nil {
+		return nil, "", false
+	}
+	return blob.content(), blob.tier, true
+}
+
+func (s *testAzureServer) stagedBlockCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.putBlocks
+}
+
+func testAzureFail(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+// authorized 按 Shared Key 规则独立计算签名，或者检查 SAS 签名
+func (s *testAzureServer) authorized(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return r.URL.Query().Get("sig") == "test-signature"
+	}
+
+	lines := []string{r.Method, "", "", "", "", r.Header.Get("Content-Type"), "", "", "", "", "", ""}
+	if r.ContentLength > 0 {
+		lines[3] = strconv.FormatInt(r.ContentLength, 10)
+	}
+	var msHeaders []string
+	for name, values := range r.Header {
+		if strings.HasPrefix(strings.ToLower(name), "x-ms-") {
+			msHeaders = append(msHeaders, strings.ToLower(name)+":"+values[0])
+		}
+	}
+	sort.Strings(msHeaders)
+	lines = append(lines, msHeaders...)
+
+	resource := "/" + testAzureAccount + r.URL.EscapedPath()
+	query := r.URL.Query()
+	var params []string
+	for name, values := range query {
+		sort.Strings(values)
+		params = append(params, strings.ToLower(name)+":"+strings.Join(values, ","))
+	}
+	sort.Strings(params)
+	lines = append(lines, strings.Join(append([]string{resource}, params...), "\n"))
+
+	key, _ := base64.StdEncoding.DecodeString(testAzureKey)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.Join(lines, "\n")))
+	want := "SharedKey " + testAzureAccount + ":" + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(auth), []byte(want))
+}
+
+func (s *testAzureServer) handle(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		testAzureFail(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != testAzureAccount || parts[1] != s.container {
+		testAzureFail(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	if len(parts) == 2 {
+		if query.Get("restype") == "container" && query.Get("comp") == "list" {
+			s.list(w, query)
+			return
+		}
+		testAzureFail(w, http.StatusBadRequest, "UnsupportedQueryParameter")
+		return
+	}
+
+	name := parts[2]
+	blob := s.blobs[name]
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		id := query.Get("blockid")
+		if decoded, err := base64.StdEncoding.DecodeString(id); err != nil || len(decoded) > 64 {
+			testAzureFail(w, http.StatusBadRequest, "InvalidQueryParameterValue")
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		if blob == nil {
+			blob = &testAzureBlob{}
+			s.blobs[name] = blob
+		}
+		if blob.uncommitted == nil {
+			blob.uncommitted = map[string][]byte{}
+		}
+		blob.uncommitted[id] = data
+		s.putBlocks++
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Blocks []struct {
+				XMLName xml.Name
+				ID      string `xml:",chardata"`
+			} `xml:",any"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&list); err != nil {
+			testAzureFail(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+		if blob == nil {
+			blob = &testAzureBlob{}
+			s.blobs[name] = blob
+		}
+
+		committed := []testAzureBlock{}
+		for _, entry := range list.Blocks {
+			var data []byte
+			found := false
+			if entry.XMLName.Local != "Committed" {
+				data, found = blob.uncommitted[entry.ID]
+			}
+			if !found && entry.XMLName.Local != "Uncommitted" {
+				for _, block := range blob.committed {
+					if block.id == entry.ID {
+						data, found = block.data, true
+					}
+				}
+			}
+			if !found {
+				testAzureFail(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			committed = append(committed, testAzureBlock{id: entry.ID, data: data})
+		}
+		blob.committed = committed
+		blob.uncommitted = nil
+		blob.tier = r.Header.Get("x-ms-access-tier")
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodGet && query.Get("comp") == "blocklist":
+		if blob == nil {
+			testAzureFail(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><BlockList><CommittedBlocks>`)
+		for _, block := range blob.committed {
+			fmt.Fprintf(w, "<Block><Name>%s</Name><Size>%d</Size></Block>", block.id, len(block.data))
+		}
+		fmt.Fprint(w, "</CommittedBlocks><UncommittedBlocks>")
+		for id, data := range blob.uncommitted {
+			fmt.Fprintf(w, "<Block><Name>%s</Name><Size>%d</Size></Block>", id, len(data))
+		}
+		fmt.Fprint(w, "</UncommittedBlocks></BlockList>")
+
+	case blob == nil || blob.committed == nil:
+		testAzureFail(w, http.StatusNotFound, "BlobNotFound")
+
+	case r.Method == http.MethodHead:
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob.content())))
+		w.Header().Set("x-ms-access-tier", blob.tier)
+
+	case r.Method == http.MethodGet:
+		if blob.tier == AzureTierArchive {
+			testAzureFail(w, http.StatusConflict, "BlobArchived")
+			return
+		}
+		data := blob.content()
+		if byteRange := r.Header.Get("x-ms-range"); byteRange != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(byteRange, "bytes=%d-%d", &start, &end); err != nil || start > end || start >= len(data) {
+				testAzureFail(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+				return
+			}
+			data = data[start:min(end+1, len(data))]
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		w.Write(data)
+
+	case r.Method == http.MethodDelete:
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		testAzureFail(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func (s *testAzureServer) list(w http.ResponseWriter, query url.Values) {
+	var names []string
+	for name, blob := range s.blobs {
+		if blob.committed != nil && strings.HasPrefix(name, query.Get("prefix")) && name > query.Get("marker") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	pageSize := s.pageSize
+	if value, err := strconv.Atoi(query.Get("maxresults")); err == nil {
+		pageSize = value
+	}
+	next := ""
+	if len(names) > pageSize {
+		names = names[:pageSize]
+		next = names[pageSize-1]
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+	for _, name := range names {
+		fmt.Fprintf(w, "<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length></Properties></Blob>", name, len(s.blobs[name].content()))
+	}
+	fmt.Fprintf(w, "</Blobs><NextMarker>%s</NextMarker></EnumerationResults>", next)
+}
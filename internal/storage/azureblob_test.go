@@ -0,0 +1,492 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func createTestAzureBlobProvider(t *testing.T, config AzureBlobConfig) *AzureBlobProvider {
+	t.Helper()
+	provider, err := NewAzureBlobProvider(config)
+	if err != nil {
+		t.Fatalf("NewAzureBlobProvider() error = %v", err)
+	}
+	// 使用较小的块，少量数据即可覆盖多个块的情况
+	provider.blockSize = 1024
+	return provider
+}
+
+// failingReader 在读取 limit 字节后返回错误，模拟中断的上传
+type failingReader struct {
+	r     io.Reader
+	limit int
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if f.limit <= 0 {
+		return 0, errors.New("connection reset")
+	}
+	if len(p) > f.limit {
+		p = p[:f.limit]
+	}
+	n, err := f.r.Read(p)
+	f.limit -= n
+	return n, err
+}
+
+func TestAzureBlobConfig_Validate(t *testing.T) {
+	valid := AzureBlobConfig{Name: "azure", AccountName: "account", AccountKey: testAzureKey, Container: "backups"}
+
+	tests := []struct {
+		name    string
+		modify  func(c *AzureBlobConfig)
+		wantErr bool
+	}{
+		{"valid config", func(c *AzureBlobConfig) {}, false},
+		{"sas token", func(c *AzureBlobConfig) { c.AccountKey = ""; c.SASToken = "?" + testAzureSAS }, false},
+		{"custom endpoint", func(c *AzureBlobConfig) { c.Endpoint = "http://127.0.0.1:10000/devstoreaccount1" }, false},
+		{"archive tier", func(c *AzureBlobConfig) { c.AccessTier = AzureTierArchive }, false},
+		{"missing account", func(c *AzureBlobConfig) { c.AccountName = "" }, true},
+		{"invalid container", func(c *AzureBlobConfig) { c.Container = "Backups" }, true},
+		{"short container", func(c *AzureBlobConfig) { c.Container = "bk" }, true},
+		{"missing credentials", func(c *AzureBlobConfig) { c.AccountKey = "" }, true},
+		{"both credentials", func(c *AzureBlobConfig) { c.SASToken = testAzureSAS }, true},
+		{"invalid account key", func(c *AzureBlobConfig) { c.AccountKey = "not base64!" }, true},
+		{"sas without signature", func(c *AzureBlobConfig) { c.AccountKey = ""; c.SASToken = "sv=2021-08-06" }, true},
+		{"invalid endpoint", func(c *AzureBlobConfig) { c.Endpoint = "ftp://example.com" }, true},
+		{"invalid tier", func(c *AzureBlobConfig) { c.AccessTier = "Premium" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewAzureBlobProvider_DefaultEndpoint(t *testing.T) {
+	provider, err := NewAzureBlobProvider(AzureBlobConfig{AccountName: "account", AccountKey: testAzureKey, Container: "backups"})
+	if err != nil {
+		t.Fatalf("NewAzureBlobProvider() error = %v", err)
+	}
+	if got, want := provider.container.String(), "https://account.blob.core.windows.net/backups"; got != want {
+		t.Errorf("container URL = %s, want %s", got, want)
+	}
+}
+
+// TestAzureBlobProvider_SharedKeySignature 使用 Azurite 公开的开发账户密钥，
+// 期望的签名由 azure-sdk-for-go（storage/azblob v1.6.3）的 SharedKeyCredential 对相同请求计算得到
+func TestAzureBlobProvider_SharedKeySignature(t *testing.T) {
+	provider := createTestAzureBlobProvider(t, AzureBlobConfig{
+		AccountName: testAzureAccount,
+		AccountKey:  testAzureKey,
+		Container:   "backups",
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		method  string
+		blob    string
+		query   url.Values
+		body    []byte
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "ranged download with escaped name",
+			method:  http.MethodGet,
+			blob:    "vault warden/备份 2026.zip",
+			headers: map[string]string{"x-ms-range": "bytes=1024-2047"},
+			want:    "QuMHSHD2LuvFRdvCIDFdKdeWyLJAIj709NQRwRSEO5M=",
+		},
+		{
+			name:   "stage block",
+			method: http.MethodPut,
+			blob:   "backups/vaultwarden.zip",
+			query:  url.Values{"comp": {"block"}, "blockid": {azureBlockID(7, []byte("data"))}},
+			body:   []byte("block data"),
+			want:   "0GVlnsXMe8GlT69YMkue9qolUvWnjCHAnQfkyzs3PBI=",
+		},
+		{
+			name:   "commit block list",
+			method: http.MethodPut,
+			blob:   "backups/vaultwarden.zip",
+			query:  url.Values{"comp": {"blocklist"}},
+			body:   []byte("<BlockList></BlockList>"),
+			headers: map[string]string{
+				"Content-Type":           "application/xml",
+				"x-ms-blob-content-type": "application/octet-stream",
+				"x-ms-access-tier":       "Cool",
+			},
+			want: "s2TiP7XI9Nd2J4O+aQ72iSpuACSOMB5c9Ehp7va8KNY=",
+		},
+		{
+			name:   "list container",
+			method: http.MethodGet,
+			query: url.Values{
+				"restype": {"container"},
+				"comp":    {"list"},
+				"prefix":  {"backups/vault warden+"},
+				"marker":  {"2!80!MDAwMDE5IWJhY2t1cHMvYi56aXAhMDAwMDI4ITk5OTktMTItMzFUMjM6NTk6NTkuOTk5OTk5OVoh"},
+			},
+			want: "AV+w6cLax+DJGSMlIMk9PERm+v9Lm99kLA5abeOl/s8=",
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			blob:   "backups/old.zip",
+			want:   "8zmO0o1hEsXO9wB3R9G0d1Du+ckF+LZjytqT5R0gn3c=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := provider.newRequest(ctx, tt.method, tt.blob, tt.query, tt.body)
+			if err != nil {
+				t.Fatalf("newRequest() error = %v", err)
+			}
+			req.Header.Set("x-ms-date", "Fri, 16 Oct 2026 08:00:00 GMT")
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			if got := provider.signature(req); got != tt.want {
+				t.Errorf("signature() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAzureBlobProvider_UploadDownload(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	testData := make([]byte, 5*1024+123)
+	rand.Read(testData)
+
+	if err := provider.Upload(ctx, "vault/backup 1.zip", bytes.NewReader(testData)); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	// 再次上传不同内容应覆盖原 Blob
+	testData = testData[:3000]
+	if err := provider.Upload(ctx, "vault/backup 1.zip", bytes.NewReader(testData)); err != nil {
+		t.Fatalf("Upload() overwrite error = %v", err)
+	}
+
+	reader, err := provider.Download(ctx, "vault/backup 1.zip")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if !bytes.Equal(data, testData) {
+		t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+	}
+
+	stored, _, ok := server.blob("vault/backup 1.zip")
+	if !ok || !bytes.Equal(stored, testData) {
+		t.Errorf("stored blob has %d bytes, want %d", len(stored), len(testData))
+	}
+}
+
+func TestAzureBlobProvider_SASToken(t *testing.T) {
+	server := startTestAzureServer(t)
+	config := server.config()
+	config.AccountKey = ""
+	config.SASToken = "?" + testAzureSAS
+	provider := createTestAzureBlobProvider(t, config)
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if _, _, ok := server.blob("file.txt"); !ok {
+		t.Error("uploaded blob not found")
+	}
+
+	config.SASToken = strings.Replace(testAzureSAS, "test-signature", "wrong", 1)
+	provider = createTestAzureBlobProvider(t, config)
+	if err := provider.HealthCheck(ctx); err == nil {
+		t.Error("HealthCheck() expected error for wrong SAS signature, got nil")
+	}
+}
+
+func TestAzureBlobProvider_WrongKey(t *testing.T) {
+	server := startTestAzureServer(t)
+	config := server.config()
+	config.AccountKey = "d3Jvbmcta2V5"
+	provider := createTestAzureBlobProvider(t, config)
+
+	err := provider.HealthCheck(context.Background())
+	var azErr *azureError
+	if !errors.As(err, &azErr) || azErr.Code != "AuthenticationFailed" {
+		t.Errorf("HealthCheck() error = %v, want AuthenticationFailed", err)
+	}
+}
+
+func TestAzureBlobProvider_ResumeUpload(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	testData := make([]byte, 6*1024+500)
+	rand.Read(testData)
+
+	// 上传在第 4 个块中途中断，前 3 个块已暂存但未提交
+	err := provider.Upload(ctx, "backup.zip", &failingReader{r: bytes.NewReader(testData), limit: 3*1024 + 100})
+	if err == nil {
+		t.Fatal("Upload() expected error for interrupted reader, got nil")
+	}
+	if _, _, ok := server.blob("backup.zip"); ok {
+		t.Fatal("interrupted upload should not commit the blob")
+	}
+	if got := server.stagedBlockCount(); got != 3 {
+		t.Fatalf("staged blocks = %d, want 3", got)
+	}
+
+	// 重新上传时复用已暂存的块，只上传剩余的 4 个块
+	if err := provider.Upload(ctx, "backup.zip", bytes.NewReader(testData)); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if got := server.stagedBlockCount(); got != 7 {
+		t.Errorf("staged blocks after resume = %d, want 7", got)
+	}
+	stored, _, _ := server.blob("backup.zip")
+	if !bytes.Equal(stored, testData) {
+		t.Errorf("stored blob has %d bytes, want %d", len(stored), len(testData))
+	}
+}
+
+func TestAzureBlobProvider_UploadPart(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	testData := make([]byte, 4*1024+10)
+	rand.Read(testData)
+
+	// 中断的上传暂存了前 2 个块，从块边界处续传剩余数据
+	provider.Upload(ctx, "backup.zip", &failingReader{r: bytes.NewReader(testData), limit: 2*1024 + 1})
+	if err := provider.UploadPart(ctx, "backup.zip", bytes.NewReader(testData[2048:]), 2048); err != nil {
+		t.Fatalf("UploadPart() error = %v", err)
+	}
+	stored, _, _ := server.blob("backup.zip")
+	if !bytes.Equal(stored, testData) {
+		t.Errorf("stored blob has %d bytes, want %d", len(stored), len(testData))
+	}
+
+	// 已提交的 Blob 同样可以从块边界处追加
+	extra := []byte("appended data")
+	if err := provider.UploadPart(ctx, "backup.zip", bytes.NewReader(append(testData[4096:], extra...)), 4096); err != nil {
+		t.Fatalf("UploadPart() append error = %v", err)
+	}
+	stored, _, _ = server.blob("backup.zip")
+	if want := append(append([]byte(nil), testData...), extra...); !bytes.Equal(stored, want) {
+		t.Errorf("stored blob has %d bytes, want %d", len(stored), len(want))
+	}
+
+	if err := provider.UploadPart(ctx, "backup.zip", strings.NewReader("data"), 100); err == nil {
+		t.Error("UploadPart() expected error for unaligned offset, got nil")
+	}
+	if err := provider.UploadPart(ctx, "missing.zip", strings.NewReader("data"), 1024); err == nil {
+		t.Error("UploadPart() expected error without staged blocks, got nil")
+	}
+}
+
+func TestAzureBlobProvider_AccessTier(t *testing.T) {
+	server := startTestAzureServer(t)
+	config := server.config()
+	config.AccessTier = AzureTierArchive
+	provider := createTestAzureBlobProvider(t, config)
+	ctx := context.Background()
+
+	// 访问层只用于备份归档及其分卷，元数据使用账户的默认访问层
+	tiers := map[string]string{
+		"vaultwarden-backup-20240101-120000.zip":                    AzureTierArchive,
+		"quarantine/vaultwarden-backup-20240101-120000.tar.zst.age": AzureTierArchive,
+		"vaultwarden-backup-20240101-120000.zip.001":                AzureTierArchive,
+		"vaultwarden-backup-20240101-120000.zip.manifest.json":      "",
+		"vaultwarden-backup-20240101-120000.zip.volumes.json":       "",
+		"vaultwarden-repository/config":                             "",
+		"vaultwarden-repository/snapshots/0123abcd":                 "",
+	}
+	for name, want := range tiers {
+		if err := provider.Upload(ctx, name, strings.NewReader("data")); err != nil {
+			t.Fatalf("Upload(%q) error = %v", name, err)
+		}
+		if _, tier, _ := server.blob(name); tier != want {
+			t.Errorf("access tier of %s = %q, want %q", name, tier, want)
+		}
+	}
+
+	// 归档层的 Blob 需要解冻后才能下载
+	if _, err := provider.Download(ctx, "vaultwarden-backup-20240101-120000.zip"); err == nil || !strings.Contains(err.Error(), "rehydrated") {
+		t.Errorf("Download() error = %v, want rehydration error", err)
+	}
+	if _, err := provider.Download(ctx, "vaultwarden-backup-20240101-120000.zip.manifest.json"); err != nil {
+		t.Errorf("Download() of the sidecar error = %v", err)
+	}
+}
+
+func TestAzureBlobProvider_DownloadPart(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	reader, err := provider.DownloadPart(ctx, "file.txt", 2, 5)
+	if err != nil {
+		t.Fatalf("DownloadPart() error = %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(data) != "23456" {
+		t.Errorf("DownloadPart() = %q, want %q", data, "23456")
+	}
+}
+
+func TestAzureBlobProvider_List(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	for _, name := range []string{"backup-1.zip", "backup-2.zip", "backup-3.zip", "other.txt", "repo/chunk"} {
+		if err := provider.Upload(ctx, name, strings.NewReader("data")); err != nil {
+			t.Fatalf("Upload(%q) error = %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"", []string{"backup-1.zip", "backup-2.zip", "backup-3.zip", "other.txt", "repo/chunk"}},
+		{"backup-", []string{"backup-1.zip", "backup-2.zip", "backup-3.zip"}},
+		{"repo/", []string{"repo/chunk"}},
+		{"missing/", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := provider.List(ctx, tt.prefix)
+		if err != nil {
+			t.Fatalf("List(%q) error = %v", tt.prefix, err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("List(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestAzureBlobProvider_ExistsAndSize(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		wantExists bool
+		wantSize   int64
+	}{
+		{"file.txt", true, 10},
+		{"missing.txt", false, 0},
+	}
+
+	for _, tt := range tests {
+		exists, err := provider.Exists(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("Exists(%q) error = %v", tt.name, err)
+		}
+		if exists != tt.wantExists {
+			t.Errorf("Exists(%q) = %v, want %v", tt.name, exists, tt.wantExists)
+		}
+
+		size, err := provider.GetFileSize(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("GetFileSize(%q) error = %v", tt.name, err)
+		}
+		if size != tt.wantSize {
+			t.Errorf("GetFileSize(%q) = %d, want %d", tt.name, size, tt.wantSize)
+		}
+	}
+}
+
+func TestAzureBlobProvider_Delete(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := provider.Delete(ctx, "file.txt"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exists, err := provider.Exists(ctx, "file.txt")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if exists {
+		t.Error("Exists() = true after Delete()")
+	}
+}
+
+func TestAzureBlobProvider_HealthCheck(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+
+	if err := provider.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+
+	config := server.config()
+	config.Container = "missing"
+	provider = createTestAzureBlobProvider(t, config)
+	if err := provider.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error for missing container, got nil")
+	}
+}
+
+func TestAzureBlobProvider_Methods(t *testing.T) {
+	server := startTestAzureServer(t)
+	provider := createTestAzureBlobProvider(t, server.config())
+
+	if provider.Name() != "test" {
+		t.Errorf("Name() = %s, want test", provider.Name())
+	}
+	if provider.Type() != "azureblob" {
+		t.Errorf("Type() = %s, want azureblob", provider.Type())
+	}
+
+	var _ Provider = provider
+	var _ HealthChecker = provider
+	var _ StreamUploader = provider
+}
@@ -0,0 +1,489 @@
+package storage
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jlaffaye/ftp"
+)
+
+// FTP 的 TLS 模式
+const (
+	FTPTLSNone     = "none"
+	FTPTLSExplicit = "explicit"
+	FTPTLSImplicit = "implicit"
+)
+
+type FTPConfig struct {
+	Name     string `json:"name"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// TLS 为 none（明文）、explicit（连接后通过 AUTH TLS 升级）或 implicit（连接即使用 TLS，默认端口 990）
+	TLS string `json:"tls"`
+	// SkipTLSVerify 为 true 时不校验服务器证书，用于自签名证书的 NAS
+	SkipTLSVerify bool `json:"skip_tls_verify"`
+	// BaseDir 为保存备份的远程目录，不存在时会自动创建；相对路径相对于登录后的目录
+	BaseDir string `json:"base_dir"`
+}
+
+func (c FTPConfig) Validate() error {
+	if c.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+	if c.Port < 0 || c.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+	if c.Username == "" {
+		return fmt.Errorf("username is required")
+	}
+	switch c.TLS {
+	case "", FTPTLSNone, FTPTLSExplicit, FTPTLSImplicit:
+	default:
+		return fmt.Errorf("tls must be one of none, explicit or implicit")
+	}
+	return nil
+}
+
+// FTPProvider 通过 FTP 或 FTPS 将备份保存到远程目录。每个操作使用独立的控制连接，
+// 数据连接使用被动模式，优先使用 EPSV，服务器不支持时回退到 PASV。
+type FTPProvider struct {
+	config    FTPConfig
+	addr      string
+	tlsConfig *tls.Config
+}
+
+func NewFTPProvider(config FTPConfig) (*FTPProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid FTP config: %w", err)
+	}
+
+	port := config.Port
+	if port == 0 {
+		port = 21
+		if config.TLS == FTPTLSImplicit {
+			port = 990
+		}
+	}
+
+	provider := &FTPProvider{
+		config: config,
+		addr:   net.JoinHostPort(config.Host, strconv.Itoa(port)),
+	}
+	if config.TLS == FTPTLSExplicit || config.TLS == FTPTLSImplicit {
+		provider.tlsConfig = &tls.Config{
+			ServerName:         config.Host,
+			InsecureSkipVerify: config.SkipTLSVerify,
+			// 多数服务器要求数据连接复用控制连接的 TLS 会话
+			ClientSessionCache: tls.NewLRUClientSessionCache(4),
+		}
+	}
+	return provider, nil
+}
+
+func (p *FTPProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *FTPProvider) Type() string {
+	return "ftp"
+}
+
+// ftpDialTimeout 为建立控制连接和数据连接的超时时间
+const ftpDialTimeout = 30 * time.Second
+
+// ftpSession 为单次操作使用的控制连接，关闭时退出登录
+type ftpSession struct {
+	*ftp.ServerConn
+	stop func() bool
+}
+
+func (s *ftpSession) Close() error {
+	s.stop()
+	return s.Quit()
+}
+
+// isFTPNotFound 判断错误是否为文件不存在或不可用（550）
+func isFTPNotFound(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code == ftp.StatusFileUnavailable
+}
+
+// connect 建立控制连接并登录。ctx 被取消时该操作建立的所有连接随之关闭，进行中的操作立即返回
+func (p *FTPProvider) connect(ctx context.Context) (*ftpSession, error) {
+	var (
+		mu    sync.Mutex
+		conns []net.Conn
+		host  string
+	)
+	dialer := net.Dialer{Timeout: ftpDialTimeout}
+	dial := func(network, address string) (net.Conn, error) {
+		mu.Lock()
+		control := host == ""
+		if !control {
+			// 数据连接总是连接控制连接的服务器地址，忽略 PASV 返回的 IP，服务器位于 NAT 之后时该地址通常无法访问
+			if _, port, err := net.SplitHostPort(address); err == nil {
+				address = net.JoinHostPort(host, port)
+			}
+		}
+		mu.Unlock()
+
+		conn, err := dialer.DialContext(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		conns = append(conns, conn)
+		if control {
+			host, _, _ = net.SplitHostPort(conn.RemoteAddr().String())
+		}
+		mu.Unlock()
+
+		// 控制连接只在 implicit 模式下直接使用 TLS，explicit 模式在 AUTH TLS 后升级；启用 TLS 时数据连接总是加密
+		if p.tlsConfig != nil && (!control || p.config.TLS == FTPTLSImplicit) {
+			return tls.Client(conn, p.tlsConfig), nil
+		}
+		return conn, nil
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range conns {
+			conn.Close()
+		}
+	})
+
+	options := []ftp.DialOption{ftp.DialWithDialFunc(dial)}
+	switch p.config.TLS {
+	case FTPTLSExplicit:
+		options = append(options, ftp.DialWithExplicitTLS(p.tlsConfig))
+	case FTPTLSImplicit:
+		options = append(options, ftp.DialWithTLS(p.tlsConfig))
+	}
+
+	conn, err := ftp.Dial(p.addr, options...)
+	if err != nil {
+		stop()
+		return nil, fmt.Errorf("failed to connect to %s: %w", p.addr, err)
+	}
+	session := &ftpSession{ServerConn: conn, stop: stop}
+
+	if err := conn.Login(p.config.Username, p.config.Password); err != nil {
+		session.Close()
+		return nil, fmt.Errorf("login failed: %w", err)
+	}
+	return session, nil
+}
+
+// mkdirAll 逐级创建目录。FTP 无法可靠地判断目录是否存在，已存在时的错误被忽略，
+// 真正的失败会在随后的上传中暴露
+func (s *ftpSession) mkdirAll(dir string) {
+	if dir == "" || dir == "." || dir == "/" {
+		return
+	}
+	s.mkdirAll(path.Dir(dir))
+	s.MakeDir(dir)
+}
+
+// replace 将 from 重命名为 to，先删除已存在的目标文件，因为多数服务器拒绝覆盖
+func (s *ftpSession) replace(from, to string) error {
+	if err := s.Delete(to); err != nil && !isFTPNotFound(err) {
+		return err
+	}
+	return s.Rename(from, to)
+}
+
+// remotePath 将对象名转换为远程路径，拒绝指向 BaseDir 之外的名称
+func (p *FTPProvider) remotePath(name string) (string, error) {
+	cleaned, err := cleanObjectName(name)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(p.config.BaseDir, cleaned[1:]), nil
+}
+
+// partialPath 返回上传 target 时使用的临时文件。名称由 target 决定，中断的上传保留该文件，
+// 下次上传时可以通过 SIZE 得到已写入的字节数，并通过 REST 从该位置继续写入
+func partialPath(target string) string {
+	return path.Join(path.Dir(target), tempPrefix+path.Base(target))
+}
+
+// Upload 先写入临时文件，完成后再移动到目标位置。上传失败时保留临时文件，用于 UploadPart 续传
+func (p *FTPProvider) Upload(ctx context.Context, name string, reader io.Reader) error {
+	return p.UploadPart(ctx, name, reader, 0)
+}
+
+// ResumesUploads 中断的上传保留在临时文件中，可以通过 REST 续传
+func (p *FTPProvider) ResumesUploads() bool {
+	return true
+}
+
+// UploadStream 上传长度未知的数据流，数据通过 STOR 边读边发送
+func (p *FTPProvider) UploadStream(ctx context.Context, name string, reader io.Reader) error {
+	return p.Upload(ctx, name, reader)
+}
+
+func (p *FTPProvider) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	return p.retrieve(ctx, name, 0, -1)
+}
+
+// retrieve 从 offset 处读取文件，length 小于 0 时读取到文件末尾
+func (p *FTPProvider) retrieve(ctx context.Context, name string, offset, length int64) (io.ReadCloser, error) {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.RetrFrom(target, uint64(offset))
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to download from FTP: %w", err)
+	}
+	return &ftpReader{resp: resp, conn: conn, remaining: length}, nil
+}
+
+// ftpReader 为 RETR 的数据连接，关闭时读取传输结果并关闭控制连接
+type ftpReader struct {
+	resp *ftp.Response
+	conn *ftpSession
+	// remaining 为还允许读取的字节数，小于 0 时读取到文件末尾
+	remaining int64
+	complete  bool
+}
+
+func (r *ftpReader) Read(p []byte) (int, error) {
+	if r.remaining == 0 {
+		return 0, io.EOF
+	}
+	if r.remaining > 0 && int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+
+	n, err := r.resp.Read(p)
+	if r.remaining > 0 {
+		r.remaining -= int64(n)
+	}
+	if err == io.EOF {
+		r.complete = true
+	}
+	return n, err
+}
+
+// Close 关闭数据连接。读取完整个文件时检查服务器的传输结果，提前关闭时服务器通常返回 426，忽略该结果
+func (r *ftpReader) Close() error {
+	err := r.resp.Close()
+	if !r.complete {
+		err = nil
+	}
+	if closeErr := r.conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (p *FTPProvider) Delete(ctx context.Context, name string) error {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return err
+	}
+
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.Delete(target); err != nil {
+		return fmt.Errorf("failed to delete from FTP: %w", err)
+	}
+	return nil
+}
+
+// List 递归列出名称以 prefix 开头的文件，从 prefix 所在的目录开始遍历。不存在的目录视为空
+func (p *FTPProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	dir := ""
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = prefix[:i+1]
+	}
+
+	root := p.config.BaseDir
+	if dir != "" {
+		var err error
+		if root, err = p.remotePath(dir); err != nil {
+			return nil, err
+		}
+	}
+	if root == "" {
+		root = "."
+	}
+
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var result []string
+	if err := conn.walk(root, dir, prefix, &result); err != nil {
+		return nil, fmt.Errorf("failed to list FTP directory: %w", err)
+	}
+	return result, nil
+}
+
+// walk 列出远程目录 remote 中以 prefix 开头的文件，name 为该目录对应的对象名前缀。
+// 不存在的目录（包括遍历过程中被删除的目录）视为空
+func (s *ftpSession) walk(remote, name, prefix string, result *[]string) error {
+	entries, err := s.List(remote)
+	if err != nil {
+		if isFTPNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+		child := name + entry.Name
+		switch entry.Type {
+		case ftp.EntryTypeFolder:
+			if strings.HasPrefix(child+"/", prefix) {
+				if err := s.walk(path.Join(remote, entry.Name), child+"/", prefix, result); err != nil {
+					return err
+				}
+			}
+		case ftp.EntryTypeFile:
+			if !strings.HasPrefix(entry.Name, tempPrefix) && strings.HasPrefix(child, prefix) {
+				*result = append(*result, child)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *FTPProvider) Exists(ctx context.Context, name string) (bool, error) {
+	if _, err := p.size(ctx, name); err != nil {
+		if isFTPNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check FTP file existence: %w", err)
+	}
+	return true, nil
+}
+
+func (p *FTPProvider) size(ctx context.Context, name string) (int64, error) {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return 0, err
+	}
+
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	return conn.FileSize(target)
+}
+
+// UploadPart 通过 REST 从 offset 处继续写入上次中断时保留的临时文件，完成后移动到目标位置。
+// 临时文件的大小与 offset 不一致时返回 ErrResumeUnsupported
+func (p *FTPProvider) UploadPart(ctx context.Context, name string, reader io.Reader, offset int64) error {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return err
+	}
+
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	tmp := partialPath(target)
+	if offset > 0 {
+		size, err := conn.FileSize(tmp)
+		if err != nil && !isFTPNotFound(err) {
+			return fmt.Errorf("failed to get FTP file size: %w", err)
+		}
+		if err != nil || size != offset {
+			return fmt.Errorf("%w: no partial upload of %s ending at offset %d", ErrResumeUnsupported, target, offset)
+		}
+	}
+
+	conn.mkdirAll(path.Dir(target))
+	if err := conn.StorFrom(tmp, contextReader{ctx: ctx, r: reader}, uint64(offset)); err != nil {
+		return fmt.Errorf("failed to upload to FTP: %w", err)
+	}
+	if err := conn.replace(tmp, target); err != nil {
+		return fmt.Errorf("failed to move file into place: %w", err)
+	}
+	return nil
+}
+
+// DownloadPart 通过 REST 从 offset 处读取 length 字节
+func (p *FTPProvider) DownloadPart(ctx context.Context, name string, offset, length int64) (io.ReadCloser, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("invalid length %d", length)
+	}
+	return p.retrieve(ctx, name, offset, length)
+}
+
+// GetFileSize 获取文件大小。文件不存在但有中断的上传保留的临时文件时返回临时文件的大小，
+// 调用方可以从该位置通过 UploadPart 继续上传；都不存在时返回 0
+func (p *FTPProvider) GetFileSize(ctx context.Context, name string) (int64, error) {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return 0, err
+	}
+
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	for _, file := range []string{target, partialPath(target)} {
+		size, err := conn.FileSize(file)
+		if err == nil {
+			return size, nil
+		}
+		if !isFTPNotFound(err) {
+			return 0, fmt.Errorf("failed to get FTP file size: %w", err)
+		}
+	}
+	return 0, nil
+}
+
+// HealthCheck 检查能否连接并登录（FTPS 时包括 TLS 握手和证书校验）
+func (p *FTPProvider) HealthCheck(ctx context.Context) error {
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.NoOp(); err != nil {
+		return fmt.Errorf("FTP server is not responding: %w", err)
+	}
+	return nil
+}
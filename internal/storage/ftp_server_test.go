@@ -0,0 +1,416 @@
+package storage
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/textproto"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testFTPServer 为测试用的进程内 FTP 服务器，将 root 目录作为登录用户的根目录
+type testFTPServer struct {
+	root     string
+	host     string
+	port     int
+	tlsMode  string
+	tls      *tls.Config
+	certPool *x509.CertPool
+	// noEPSV 为 true 时拒绝 EPSV，覆盖客户端回退到 PASV 的情况
+	noEPSV bool
+	// noMLSD 为 true 时 FEAT 不声明 MLST，客户端使用 LIST 列出目录
+	noMLSD bool
+	// requireSessionReuse 为 true 时与 vsftpd 的 require_ssl_reuse 一致，
+	// 拒绝没有复用控制连接 TLS 会话的数据连接
+	requireSessionReuse bool
+}
+
+// startTestFTPServer 启动 FTP 服务器，接受用户 test 和密码 secret 登录。
+// tlsMode 为 FTPTLSExplicit 或 FTPTLSImplicit 时使用自签名证书提供 FTPS
+func startTestFTPServer(t *testing.T, tlsMode string) *testFTPServer {
+	t.Helper()
+
+	server := &testFTPServer{root: t.TempDir(), tlsMode: tlsMode}
+	server.tls, server.certPool = newTestFTPCertificate(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	server.host = host
+	server.port, _ = strconv.Atoi(port)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serveConn(conn)
+		}
+	}()
+
+	return server
+}
+
+func newTestFTPCertificate(t *testing.T) (*tls.Config, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "vaultwarden-syncer test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	config := &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	return config, pool
+}
+
+// config 返回登录测试服务器的存储配置
+func (s *testFTPServer) config() FTPConfig {
+	return FTPConfig{
+		Name:     "test",
+		Host:     s.host,
+		Port:     s.port,
+		Username: "test",
+		Password: "secret",
+		TLS:      s.tlsMode,
+	}
+}
+
+// provider 创建信任测试服务器证书的存储提供者
+func (s *testFTPServer) provider(t *testing.T, config FTPConfig) *FTPProvider {
+	t.Helper()
+	provider, err := NewFTPProvider(config)
+	if err != nil {
+		t.Fatalf("NewFTPProvider() error = %v", err)
+	}
+	if provider.tlsConfig != nil {
+		provider.tlsConfig.RootCAs = s.certPool
+	}
+	return provider
+}
+
+type testFTPSession struct {
+	server     *testFTPServer
+	conn       net.Conn
+	text       *textproto.Conn
+	loggedIn   bool
+	user       string
+	protect    bool
+	passive    net.Listener
+	offset     int64
+	renameFrom string
+}
+
+func (s *testFTPServer) serveConn(conn net.Conn) {
+	if s.tlsMode == FTPTLSImplicit {
+		conn = tls.Server(conn, s.tls)
+	}
+	session := &testFTPSession{server: s, conn: conn, text: textproto.NewConn(conn)}
+	defer func() {
+		if session.passive != nil {
+			session.passive.Close()
+		}
+		session.text.Close()
+	}()
+
+	// 多行的欢迎信息
+	session.text.PrintfLine("220-test FTP server")
+	session.reply(220, "ready")
+	for {
+		line, err := session.text.ReadLine()
+		if err != nil {
+			return
+		}
+		command, arg, _ := strings.Cut(line, " ")
+		if !session.handle(strings.ToUpper(command), arg) {
+			return
+		}
+	}
+}
+
+func (s *testFTPSession) reply(code int, format string, args ...any) {
+	s.text.PrintfLine("%d %s", code, fmt.Sprintf(format, args...))
+}
+
+func (s *testFTPSession) resolve(name string) string {
+	return filepath.Join(s.server.root, filepath.FromSlash(path.Clean("/"+name)))
+}
+
+// acceptData 接受被动模式的数据连接，PROT P 时在数据连接上进行 TLS 握手
+func (s *testFTPSession) acceptData() (net.Conn, error) {
+	if s.passive == nil {
+		return nil, fmt.Errorf("no passive listener")
+	}
+	defer func() {
+		s.passive.Close()
+		s.passive = nil
+	}()
+
+	conn, err := s.passive.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if s.protect {
+		tlsConn := tls.Server(conn, s.server.tls)
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if s.server.requireSessionReuse && !tlsConn.ConnectionState().DidResume {
+			tlsConn.Close()
+			return nil, fmt.Errorf("TLS session reuse required")
+		}
+		conn = tlsConn
+	}
+	return conn, nil
+}
+
+// handle 处理一条命令，返回 false 时结束会话
+func (s *testFTPSession) handle(command, arg string) bool {
+	switch command {
+	case "AUTH":
+		if s.server.tlsMode != FTPTLSExplicit || !strings.EqualFold(arg, "TLS") {
+			s.reply(502, "AUTH not supported")
+			return true
+		}
+		s.reply(234, "AUTH TLS successful")
+		tlsConn := tls.Server(s.conn, s.server.tls)
+		if err := tlsConn.Handshake(); err != nil {
+			return false
+		}
+		s.conn = tlsConn
+		s.text = textproto.NewConn(tlsConn)
+		return true
+	case "USER":
+		s.user = arg
+		s.reply(331, "password required")
+		return true
+	case "PASS":
+		if s.user != "test" || arg != "secret" {
+			s.reply(530, "login incorrect")
+			return true
+		}
+		s.loggedIn = true
+		s.reply(230, "logged in")
+		return true
+	case "QUIT":
+		s.reply(221, "goodbye")
+		return false
+	}
+
+	if !s.loggedIn {
+		s.reply(530, "not logged in")
+		return true
+	}
+
+	switch command {
+	case "PBSZ":
+		s.reply(200, "PBSZ=0")
+	case "PROT":
+		s.protect = strings.EqualFold(arg, "P")
+		s.reply(200, "protection level set")
+	case "TYPE", "NOOP":
+		s.reply(200, "ok")
+	case "FEAT":
+		features := []string{"211-Features:", " EPSV", " PASV", " REST STREAM", " SIZE"}
+		if !s.server.noMLSD {
+			features = append(features, " MLST type*;size*;")
+		}
+		for _, feature := range features {
+			s.text.PrintfLine("%s", feature)
+		}
+		s.reply(211, "End")
+	case "EPSV", "PASV":
+		if command == "EPSV" && s.server.noEPSV {
+			s.reply(500, "EPSV not understood")
+			return true
+		}
+		if s.passive != nil {
+			s.passive.Close()
+		}
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			s.reply(425, "cannot open passive connection")
+			return true
+		}
+		s.passive = listener
+		port := listener.Addr().(*net.TCPAddr).Port
+		if command == "EPSV" {
+			s.reply(229, "Entering Extended Passive Mode (|||%d|)", port)
+		} else {
+			// 返回一个无法连接的地址，客户端应忽略该地址而连接控制连接的服务器地址
+			s.reply(227, "Entering Passive Mode (10,255,255,1,%d,%d)", port>>8, port&0xff)
+		}
+	case "REST":
+		offset, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || offset < 0 {
+			s.reply(501, "invalid offset")
+			return true
+		}
+		s.offset = offset
+		s.reply(350, "restarting at %d", offset)
+	case "STOR":
+		offset := s.offset
+		s.offset = 0
+		flags := os.O_WRONLY | os.O_CREATE
+		if offset == 0 {
+			flags |= os.O_TRUNC
+		}
+		file, err := os.OpenFile(s.resolve(arg), flags, 0640)
+		if err != nil {
+			s.reply(550, "%v", err)
+			return true
+		}
+		defer file.Close()
+		s.reply(150, "opening data connection")
+		data, err := s.acceptData()
+		if err != nil {
+			s.reply(425, "%v", err)
+			return true
+		}
+		_, err = io.Copy(io.NewOffsetWriter(file, offset), data)
+		data.Close()
+		if err != nil {
+			s.reply(426, "%v", err)
+			return true
+		}
+		s.reply(226, "transfer complete")
+	case "RETR":
+		offset := s.offset
+		s.offset = 0
+		file, err := os.Open(s.resolve(arg))
+		if err != nil {
+			s.reply(550, "%v", err)
+			return true
+		}
+		defer file.Close()
+		s.reply(150, "opening data connection")
+		data, err := s.acceptData()
+		if err != nil {
+			s.reply(425, "%v", err)
+			return true
+		}
+		_, err = io.Copy(data, io.NewSectionReader(file, offset, 1<<62))
+		data.Close()
+		if err != nil {
+			s.reply(426, "transfer aborted")
+			return true
+		}
+		s.reply(226, "transfer complete")
+	case "MLSD", "LIST":
+		entries, err := os.ReadDir(s.resolve(arg))
+		if err != nil {
+			s.reply(550, "%v", err)
+			return true
+		}
+		s.reply(150, "opening data connection")
+		data, err := s.acceptData()
+		if err != nil {
+			s.reply(425, "%v", err)
+			return true
+		}
+		writer := bufio.NewWriter(data)
+		if command == "MLSD" {
+			fmt.Fprintf(writer, "type=cdir; .\r\ntype=pdir; ..\r\n")
+		}
+		for _, entry := range entries {
+			info, _ := entry.Info()
+			switch {
+			case command == "LIST":
+				// ls -l 格式
+				fmt.Fprintf(writer, "%s 1 ftp ftp %d Jan 01 00:00 %s\r\n", info.Mode(), info.Size(), entry.Name())
+			case entry.IsDir():
+				fmt.Fprintf(writer, "type=dir; %s\r\n", entry.Name())
+			default:
+				fmt.Fprintf(writer, "type=file;size=%d; %s\r\n", info.Size(), entry.Name())
+			}
+		}
+		writer.Flush()
+		data.Close()
+		s.reply(226, "transfer complete")
+	case "SIZE":
+		info, err := os.Stat(s.resolve(arg))
+		if err != nil || info.IsDir() {
+			s.reply(550, "file not found")
+			return true
+		}
+		s.reply(213, "%d", info.Size())
+	case "DELE":
+		name := s.resolve(arg)
+		if info, err := os.Stat(name); err != nil || info.IsDir() {
+			s.reply(550, "file not found")
+			return true
+		}
+		if err := os.Remove(name); err != nil {
+			s.reply(550, "%v", err)
+			return true
+		}
+		s.reply(250, "deleted")
+	case "MKD":
+		if err := os.Mkdir(s.resolve(arg), 0750); err != nil {
+			s.reply(550, "%v", err)
+			return true
+		}
+		s.reply(257, "%q created", arg)
+	case "RNFR":
+		if _, err := os.Stat(s.resolve(arg)); err != nil {
+			s.reply(550, "file not found")
+			return true
+		}
+		s.renameFrom = arg
+		s.reply(350, "ready for RNTO")
+	case "RNTO":
+		from := s.renameFrom
+		s.renameFrom = ""
+		// 与多数 NAS 的 FTP 服务一致，目标文件存在时拒绝重命名
+		if _, err := os.Stat(s.resolve(arg)); from == "" || err == nil {
+			s.reply(553, "rename refused")
+			return true
+		}
+		if err := os.Rename(s.resolve(from), s.resolve(arg)); err != nil {
+			s.reply(553, "%v", err)
+			return true
+		}
+		s.reply(250, "renamed")
+	default:
+		s.reply(502, "command not implemented")
+	}
+	return true
+}
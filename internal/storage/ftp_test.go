@@ -0,0 +1,398 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFTPConfig_Validate(t *testing.T) {
+	valid := FTPConfig{Name: "nas", Host: "nas.local", Username: "backup", Password: "secret", TLS: FTPTLSExplicit}
+
+	tests := []struct {
+		name    string
+		modify  func(c *FTPConfig)
+		wantErr bool
+	}{
+		{"valid config", func(c *FTPConfig) {}, false},
+		{"default tls mode", func(c *FTPConfig) { c.TLS = "" }, false},
+		{"implicit tls", func(c *FTPConfig) { c.TLS = FTPTLSImplicit; c.Port = 990 }, false},
+		{"missing host", func(c *FTPConfig) { c.Host = "" }, true},
+		{"invalid port", func(c *FTPConfig) { c.Port = 70000 }, true},
+		{"missing username", func(c *FTPConfig) { c.Username = "" }, true},
+		{"invalid tls mode", func(c *FTPConfig) { c.TLS = "starttls" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewFTPProvider_DefaultPort(t *testing.T) {
+	tests := []struct {
+		tls  string
+		want string
+	}{
+		{FTPTLSNone, "nas.local:21"},
+		{FTPTLSExplicit, "nas.local:21"},
+		{FTPTLSImplicit, "nas.local:990"},
+	}
+
+	for _, tt := range tests {
+		provider, err := NewFTPProvider(FTPConfig{Host: "nas.local", Username: "backup", TLS: tt.tls})
+		if err != nil {
+			t.Fatalf("NewFTPProvider() error = %v", err)
+		}
+		if provider.addr != tt.want {
+			t.Errorf("addr for %s = %s, want %s", tt.tls, provider.addr, tt.want)
+		}
+	}
+}
+
+func TestFTPProvider_UploadDownload(t *testing.T) {
+	for _, mode := range []string{FTPTLSNone, FTPTLSExplicit, FTPTLSImplicit} {
+		t.Run(mode, func(t *testing.T) {
+			server := startTestFTPServer(t, mode)
+			config := server.config()
+			config.BaseDir = "backups/vaultwarden"
+			provider := server.provider(t, config)
+			ctx := context.Background()
+
+			testData := make([]byte, 256*1024+123)
+			rand.Read(testData)
+
+			if err := provider.Upload(ctx, "test-file.bin", bytes.NewReader(testData)); err != nil {
+				t.Fatalf("Upload() error = %v", err)
+			}
+			// 再次上传同名文件应覆盖原文件
+			if err := provider.Upload(ctx, "test-file.bin", bytes.NewReader(testData)); err != nil {
+				t.Fatalf("Upload() overwrite error = %v", err)
+			}
+
+			reader, err := provider.Download(ctx, "test-file.bin")
+			if err != nil {
+				t.Fatalf("Download() error = %v", err)
+			}
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if err := reader.Close(); err != nil {
+				t.Errorf("Close() error = %v", err)
+			}
+			if !bytes.Equal(data, testData) {
+				t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+			}
+
+			// 远程目录被逐级创建，且没有残留的临时文件
+			entries, err := os.ReadDir(filepath.Join(server.root, "backups", "vaultwarden"))
+			if err != nil {
+				t.Fatalf("ReadDir() error = %v", err)
+			}
+			if len(entries) != 1 || entries[0].Name() != "test-file.bin" {
+				t.Errorf("unexpected remote directory contents: %v", entries)
+			}
+		})
+	}
+}
+
+func TestFTPProvider_PASVFallback(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	server.noEPSV = true
+	provider := server.provider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	got, err := provider.List(ctx, "")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if !slices.Equal(got, []string{"file.txt"}) {
+		t.Errorf("List() = %v, want [file.txt]", got)
+	}
+}
+
+func TestFTPProvider_TLSSessionReuse(t *testing.T) {
+	for _, mode := range []string{FTPTLSExplicit, FTPTLSImplicit} {
+		t.Run(mode, func(t *testing.T) {
+			server := startTestFTPServer(t, mode)
+			server.requireSessionReuse = true
+			provider := server.provider(t, server.config())
+			ctx := context.Background()
+
+			if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+				t.Fatalf("Upload() error = %v", err)
+			}
+			got, err := provider.List(ctx, "")
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if !slices.Equal(got, []string{"file.txt"}) {
+				t.Errorf("List() = %v, want [file.txt]", got)
+			}
+		})
+	}
+}
+
+func TestFTPProvider_UntrustedCertificate(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSExplicit)
+	config := server.config()
+
+	provider, err := NewFTPProvider(config)
+	if err != nil {
+		t.Fatalf("NewFTPProvider() error = %v", err)
+	}
+	if err := provider.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error for untrusted certificate, got nil")
+	}
+
+	config.SkipTLSVerify = true
+	provider, err = NewFTPProvider(config)
+	if err != nil {
+		t.Fatalf("NewFTPProvider() error = %v", err)
+	}
+	if err := provider.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() with SkipTLSVerify error = %v", err)
+	}
+}
+
+func TestFTPProvider_WrongPassword(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	config := server.config()
+	config.Password = "wrong"
+	provider := server.provider(t, config)
+
+	if err := provider.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error for wrong password, got nil")
+	}
+}
+
+func TestFTPProvider_InvalidName(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	config := server.config()
+	config.BaseDir = "backups"
+	provider := server.provider(t, config)
+
+	for _, name := range []string{"", "../escape.txt", "a/../../escape.txt"} {
+		if err := provider.Upload(context.Background(), name, strings.NewReader("data")); err == nil {
+			t.Errorf("Upload(%q) expected error, got nil", name)
+		}
+	}
+}
+
+func TestFTPProvider_ResumeUpload(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSExplicit)
+	provider := server.provider(t, server.config())
+	ctx := context.Background()
+
+	testData := make([]byte, 64*1024)
+	rand.Read(testData)
+
+	// 上传在写入 40000 字节后中断，已写入的数据保留在临时文件中，不出现在目标位置和列表中
+	err := provider.Upload(ctx, "repo/backup.zip", &failingReader{r: bytes.NewReader(testData), limit: 40000})
+	if err == nil {
+		t.Fatal("Upload() expected error for interrupted reader, got nil")
+	}
+	if exists, _ := provider.Exists(ctx, "repo/backup.zip"); exists {
+		t.Fatal("interrupted upload should not create the target file")
+	}
+	if names, _ := provider.List(ctx, "repo/"); len(names) != 0 {
+		t.Errorf("List() = %v, want the partial file to be hidden", names)
+	}
+	size, err := provider.GetFileSize(ctx, "repo/backup.zip")
+	if err != nil {
+		t.Fatalf("GetFileSize() error = %v", err)
+	}
+	if size != 40000 {
+		t.Fatalf("GetFileSize() = %d, want 40000", size)
+	}
+
+	// 与临时文件大小不一致的位置无法续传
+	if err := provider.UploadPart(ctx, "repo/backup.zip", bytes.NewReader(testData[1000:]), 1000); !errors.Is(err, ErrResumeUnsupported) {
+		t.Errorf("UploadPart() at wrong offset error = %v, want ErrResumeUnsupported", err)
+	}
+
+	if err := provider.UploadPart(ctx, "repo/backup.zip", bytes.NewReader(testData[size:]), size); err != nil {
+		t.Fatalf("UploadPart() error = %v", err)
+	}
+	stored, err := os.ReadFile(filepath.Join(server.root, "repo", "backup.zip"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !bytes.Equal(stored, testData) {
+		t.Errorf("stored file has %d bytes, want %d", len(stored), len(testData))
+	}
+	if _, err := os.Stat(filepath.Join(server.root, "repo", tempPrefix+"backup.zip")); !os.IsNotExist(err) {
+		t.Errorf("partial file should be moved into place, Stat() error = %v", err)
+	}
+
+	reader, err := provider.DownloadPart(ctx, "repo/backup.zip", 2, 5)
+	if err != nil {
+		t.Fatalf("DownloadPart() error = %v", err)
+	}
+	defer reader.Close()
+
+	part, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if !bytes.Equal(part, testData[2:7]) {
+		t.Errorf("DownloadPart() = %x, want %x", part, testData[2:7])
+	}
+}
+
+// 上传失败的临时文件在下次从头上传时被覆盖，不会残留旧数据
+func TestFTPProvider_UploadOverwritesPartial(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	provider := server.provider(t, server.config())
+	ctx := context.Background()
+
+	provider.Upload(ctx, "file.txt", &failingReader{r: strings.NewReader("stale partial data"), limit: 10})
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("new")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(server.root, "file.txt"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(data) != "new" {
+		t.Errorf("uploaded content = %q, want %q", data, "new")
+	}
+}
+
+func TestFTPProvider_Delete(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	provider := server.provider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := provider.Delete(ctx, "file.txt"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exists, err := provider.Exists(ctx, "file.txt")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if exists {
+		t.Error("Exists() = true after Delete()")
+	}
+}
+
+func TestFTPProvider_List(t *testing.T) {
+	for _, noMLSD := range []bool{false, true} {
+		server := startTestFTPServer(t, FTPTLSNone)
+		server.noMLSD = noMLSD
+		config := server.config()
+		config.BaseDir = "backups"
+		provider := server.provider(t, config)
+		ctx := context.Background()
+
+		for _, name := range []string{"backup-1.zip", "backup-2.zip", "backup-3.zip", "other.txt", "repo/chunk", "repo/nested/chunk"} {
+			if err := provider.Upload(ctx, name, strings.NewReader("data")); err != nil {
+				t.Fatalf("Upload(%q) error = %v", name, err)
+			}
+		}
+		// 未完成上传的临时文件不应被列出
+		for _, dir := range []string{"backups", "backups/repo"} {
+			if err := os.WriteFile(filepath.Join(server.root, dir, tempPrefix+"123"), []byte("partial"), 0600); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+		}
+
+		tests := []struct {
+			prefix string
+			want   []string
+		}{
+			{"", []string{"backup-1.zip", "backup-2.zip", "backup-3.zip", "other.txt", "repo/chunk", "repo/nested/chunk"}},
+			{"backup-", []string{"backup-1.zip", "backup-2.zip", "backup-3.zip"}},
+			{"repo/", []string{"repo/chunk", "repo/nested/chunk"}},
+			{"repo/n", []string{"repo/nested/chunk"}},
+			{"missing/", nil},
+		}
+
+		for _, tt := range tests {
+			got, err := provider.List(ctx, tt.prefix)
+			if err != nil {
+				t.Fatalf("List(%q) error = %v", tt.prefix, err)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("List(%q) with noMLSD=%v = %v, want %v", tt.prefix, noMLSD, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestFTPProvider_ExistsAndSize(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	provider := server.provider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		wantExists bool
+		wantSize   int64
+	}{
+		{"file.txt", true, 10},
+		{"missing.txt", false, 0},
+	}
+
+	for _, tt := range tests {
+		exists, err := provider.Exists(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("Exists(%q) error = %v", tt.name, err)
+		}
+		if exists != tt.wantExists {
+			t.Errorf("Exists(%q) = %v, want %v", tt.name, exists, tt.wantExists)
+		}
+
+		size, err := provider.GetFileSize(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("GetFileSize(%q) error = %v", tt.name, err)
+		}
+		if size != tt.wantSize {
+			t.Errorf("GetFileSize(%q) = %d, want %d", tt.name, size, tt.wantSize)
+		}
+	}
+}
+
+func TestFTPProvider_Methods(t *testing.T) {
+	server := startTestFTPServer(t, FTPTLSNone)
+	provider := server.provider(t, server.config())
+
+	if provider.Name() != "test" {
+		t.Errorf("Name() = %s, want test", provider.Name())
+	}
+	if provider.Type() != "ftp" {
+		t.Errorf("Type() = %s, want ftp", provider.Type())
+	}
+	if err := provider.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+
+	var _ Provider = provider
+	var _ HealthChecker = provider
+	var _ StreamUploader = provider
+}
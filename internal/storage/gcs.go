@@ -0,0 +1,711 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsDefaultTokenURI = "https://oauth2.googleapis.com/token"
+	gcsScope           = "https://www.googleapis.com/auth/devstorage.read_write"
+	// gcsChunkSize 为可恢复上传每次请求的数据量，必须是 256 KB 的整数倍
+	gcsChunkSize = 8 << 20
+)
+
+// GCS 的存储类别
+const (
+	GCSClassStandard = "STANDARD"
+	GCSClassNearline = "NEARLINE"
+	GCSClassColdline = "COLDLINE"
+	GCSClassArchive  = "ARCHIVE"
+)
+
+// GCS 对象保留模式
+const (
+	GCSRetentionUnlocked = "Unlocked"
+	GCSRetentionLocked   = "Locked"
+)
+
+type GCSConfig struct {
+	Name   string `json:"name"`
+	Bucket string `json:"bucket"`
+	// CredentialsJSON 为服务账号的 JSON 密钥文件内容
+	CredentialsJSON string `json:"credentials_json"`
+	// Endpoint 为 JSON API 地址，为空时使用 https://storage.googleapis.com。
+	// 设置 Endpoint 时可以不提供密钥，用于 fake-gcs-server 等不校验认证的模拟服务
+	Endpoint string `json:"endpoint"`
+	// StorageClass 为上传对象的存储类别，为空时使用存储桶的默认类别
+	StorageClass string `json:"storage_class"`
+	// RetentionDays 大于 0 时为对象设置保留期，需要存储桶启用对象保留
+	RetentionDays int `json:"retention_days"`
+	// RetentionMode 为 Unlocked 或 Locked，Locked 的保留期无法缩短或移除
+	RetentionMode string `json:"retention_mode"`
+}
+
+// gcsServiceAccount 为服务账号 JSON 密钥中使用到的字段
+type gcsServiceAccount struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+func parseGCSCredentials(data string) (*gcsServiceAccount, *rsa.PrivateKey, error) {
+	var account gcsServiceAccount
+	if err := json.Unmarshal([]byte(data), &account); err != nil {
+		return nil, nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	if account.Type != "service_account" {
+		return nil, nil, fmt.Errorf("credentials must be a service account key, got type %q", account.Type)
+	}
+	if account.ClientEmail == "" {
+		return nil, nil, fmt.Errorf("service account key has no client_email")
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid service account private key: %w", err)
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = gcsDefaultTokenURI
+	}
+	return &account, key, nil
+}
+
+func (c GCSConfig) Validate() error {
+	if c.Bucket == "" {
+		return fmt.Errorf("bucket is required")
+	}
+
+	if c.CredentialsJSON != "" {
+		if _, _, err := parseGCSCredentials(c.CredentialsJSON); err != nil {
+			return err
+		}
+	} else if c.Endpoint == "" {
+		return fmt.Errorf("service account key is required")
+	}
+
+	if c.Endpoint != "" {
+		endpoint, err := url.Parse(c.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("endpoint must be an http or https URL")
+		}
+	}
+
+	switch c.StorageClass {
+	case "", GCSClassStandard, GCSClassNearline, GCSClassColdline, GCSClassArchive:
+	default:
+		return fmt.Errorf("storage class must be one of STANDARD, NEARLINE, COLDLINE or ARCHIVE")
+	}
+
+	if c.RetentionDays < 0 {
+		return fmt.Errorf("retention days must not be negative")
+	}
+	switch c.RetentionMode {
+	case "", GCSRetentionUnlocked, GCSRetentionLocked:
+	default:
+		return fmt.Errorf("retention mode must be Unlocked or Locked")
+	}
+	return nil
+}
+
+// gcsSessions 记录未完成的可恢复上传会话。Provider 在每次同步时重新创建，
+// 会话只保存在进程内：同一进程中中断的上传可以通过 UploadPart 继续，进程重启后无法续传，
+// 未完成的会话由 GCS 在一周后自动清除
+var gcsSessions = struct {
+	sync.Mutex
+	uris map[string]string
+}{uris: map[string]string{}}
+
+// GCSProvider 通过 JSON API 将备份保存到 Google Cloud Storage，使用服务账号密钥认证，
+// 上传使用可恢复上传
+type GCSProvider struct {
+	config     GCSConfig
+	endpoint   string
+	account    *gcsServiceAccount
+	privateKey *rsa.PrivateKey
+	client     *http.Client
+	chunkSize  int
+
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+func NewGCSProvider(config GCSConfig) (*GCSProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid GCS config: %w", err)
+	}
+
+	provider := &GCSProvider{
+		config:    config,
+		endpoint:  strings.TrimSuffix(config.Endpoint, "/"),
+		client:    &http.Client{},
+		chunkSize: gcsChunkSize,
+	}
+	if provider.endpoint == "" {
+		provider.endpoint = gcsDefaultEndpoint
+	}
+	if config.CredentialsJSON != "" {
+		provider.account, provider.privateKey, _ = parseGCSCredentials(config.CredentialsJSON)
+	}
+	return provider, nil
+}
+
+func (p *GCSProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *GCSProvider) Type() string {
+	return "gcs"
+}
+
+// gcsError 为 JSON API 返回的错误
+type gcsError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *gcsError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("gcs: %d %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("gcs: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func isGCSNotFound(err error) bool {
+	var gcsErr *gcsError
+	return errors.As(err, &gcsErr) && gcsErr.StatusCode == http.StatusNotFound
+}
+
+// assertion 返回用服务账号私钥以 RS256 签名的 JWT 断言，有效期一小时
+func (p *GCSProvider) assertion(now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   p.account.ClientEmail,
+		"scope": gcsScope,
+		"aud":   p.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if p.account.PrivateKeyID != "" {
+		token.Header["kid"] = p.account.PrivateKeyID
+	}
+	return token.SignedString(p.privateKey)
+}
+
+// accessToken 返回缓存的访问令牌，过期前一分钟用服务账号签名的 JWT 换取新令牌
+func (p *GCSProvider) accessToken(ctx context.Context) (string, error) {
+	p.tokenMu.Lock()
+	defer p.tokenMu.Unlock()
+
+	if p.token != "" && time.Now().Add(time.Minute).Before(p.tokenExpiry) {
+		return p.token, nil
+	}
+
+	now := time.Now()
+	signed, err := p.assertion(now)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token request: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signed},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&token); err != nil {
+		return "", fmt.Errorf("invalid token response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		return "", fmt.Errorf("failed to request access token: %s %s", token.Error, token.ErrorDescription)
+	}
+
+	p.token = token.AccessToken
+	p.tokenExpiry = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return p.token, nil
+}
+
+// do 添加认证信息并发送请求，状态码不在 expected 中时返回 *gcsError
+func (p *GCSProvider) do(req *http.Request, expected ...int) (*http.Response, error) {
+	if p.account != nil {
+		token, err := p.accessToken(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+
+	gcsErr := &gcsError{StatusCode: resp.StatusCode}
+	var body struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10)); json.Unmarshal(data, &body) == nil {
+		gcsErr.Message = body.Error.Message
+	}
+	return nil, gcsErr
+}
+
+// objectURL 返回对象元数据的地址，对象名中的 / 需要转义
+func (p *GCSProvider) objectURL(object string) string {
+	return p.endpoint + "/storage/v1/b/" + url.PathEscape(p.config.Bucket) + "/o/" + url.PathEscape(object)
+}
+
+// objectName 将对象名转换为 GCS 对象名，拒绝空名称和包含 .. 的名称
+func (p *GCSProvider) objectName(name string) (string, error) {
+	cleaned, err := cleanObjectName(name)
+	if err != nil {
+		return "", err
+	}
+	return cleaned[1:], nil
+}
+
+func (p *GCSProvider) sessionKey(object string) string {
+	return p.endpoint + "/" + p.config.Bucket + "/" + object
+}
+
+// startSession 创建可恢复上传会话，对象的存储类别和保留期在此时设置
+func (p *GCSProvider) startSession(ctx context.Context, object string) (string, error) {
+	metadata := map[string]any{"name": object}
+	if p.config.StorageClass != "" {
+		metadata["storageClass"] = p.config.StorageClass
+	}
+	if p.config.RetentionDays > 0 {
+		mode := p.config.RetentionMode
+		if mode == "" {
+			mode = GCSRetentionUnlocked
+		}
+		metadata["retention"] = map[string]string{
+			"mode":            mode,
+			"retainUntilTime": time.Now().AddDate(0, 0, p.config.RetentionDays).UTC().Format(time.RFC3339),
+		}
+	}
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	target := p.endpoint + "/upload/storage/v1/b/" + url.PathEscape(p.config.Bucket) + "/o?uploadType=resumable"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Type", "application/octet-stream")
+
+	resp, err := p.do(req, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return "", fmt.Errorf("failed to start resumable upload: %w", err)
+	}
+	resp.Body.Close()
+
+	session := resp.Header.Get("Location")
+	if session == "" {
+		return "", fmt.Errorf("failed to start resumable upload: no session URI returned")
+	}
+	return session, nil
+}
+
+// putChunk 上传从 offset 开始的数据，total 小于 0 时表示总大小未知。
+// 返回服务端已保存的字节数，以及上传是否已完成
+func (p *GCSProvider) putChunk(ctx context.Context, session string, data []byte, offset, total int64) (int64, bool, error) {
+	size := "*"
+	if total >= 0 {
+		size = strconv.FormatInt(total, 10)
+	}
+	contentRange := "bytes */" + size
+	if len(data) > 0 {
+		contentRange = fmt.Sprintf("bytes %d-%d/%s", offset, offset+int64(len(data))-1, size)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, bytes.NewReader(data))
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Range", contentRange)
+
+	// 308 表示上传未完成，Range 响应头为已保存的数据范围
+	resp, err := p.do(req, http.StatusOK, http.StatusCreated, http.StatusPermanentRedirect)
+	if err != nil {
+		return 0, false, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPermanentRedirect {
+		return total, true, nil
+	}
+	return parseGCSPersisted(resp.Header.Get("Range"))
+}
+
+// parseGCSPersisted 解析 "bytes=0-N" 格式的 Range 响应头，没有 Range 时表示尚未保存任何数据
+func parseGCSPersisted(header string) (int64, bool, error) {
+	if header == "" {
+		return 0, false, nil
+	}
+	_, end, ok := strings.Cut(strings.TrimPrefix(header, "bytes="), "-")
+	last, err := strconv.ParseInt(end, 10, 64)
+	if !ok || err != nil {
+		return 0, false, fmt.Errorf("invalid Range header %q", header)
+	}
+	return last + 1, false, nil
+}
+
+// uploadChunks 从 offset 开始分块上传 reader 中的数据，服务端只保存了部分数据时重新发送剩余部分
+func (p *GCSProvider) uploadChunks(ctx context.Context, session string, reader io.Reader, offset int64) error {
+	buf := make([]byte, p.chunkSize)
+	pending := 0
+	eof := false
+	for {
+		if !eof {
+			n, err := io.ReadFull(reader, buf[pending:])
+			pending += n
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				eof = true
+			} else if err != nil {
+				return err
+			}
+		}
+
+		total := int64(-1)
+		if eof {
+			total = offset + int64(pending)
+		}
+		persisted, done, err := p.putChunk(ctx, session, buf[:pending], offset, total)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		consumed := persisted - offset
+		if consumed < 0 || consumed > int64(pending) || (consumed == 0 && pending > 0) {
+			return fmt.Errorf("unexpected upload progress: server has %d bytes, sent up to %d", persisted, offset+int64(pending))
+		}
+		copy(buf, buf[consumed:pending])
+		pending -= int(consumed)
+		offset = persisted
+	}
+}
+
+// uploadStatus 查询会话已保存的字节数
+func (p *GCSProvider) uploadStatus(ctx context.Context, session string) (int64, bool, error) {
+	return p.putChunk(ctx, session, nil, 0, -1)
+}
+
+func (p *GCSProvider) Upload(ctx context.Context, name string, reader io.Reader) error {
+	object, err := p.objectName(name)
+	if err != nil {
+		return err
+	}
+
+	session, err := p.startSession(ctx, object)
+	if err != nil {
+		return err
+	}
+	key := p.sessionKey(object)
+	gcsSessions.Lock()
+	gcsSessions.uris[key] = session
+	gcsSessions.Unlock()
+
+	if err := p.uploadChunks(ctx, session, contextReader{ctx: ctx, r: reader}, 0); err != nil {
+		return fmt.Errorf("failed to upload to GCS: %w", err)
+	}
+
+	gcsSessions.Lock()
+	delete(gcsSessions.uris, key)
+	gcsSessions.Unlock()
+	return nil
+}
+
+// ResumesUploads 中断的上传保留在可恢复上传会话中，可以从服务端已保存的位置续传
+func (p *GCSProvider) ResumesUploads() bool {
+	return true
+}
+
+// UploadStream 上传长度未知的数据流，数据按块写入可续传上传会话
+func (p *GCSProvider) UploadStream(ctx context.Context, name string, reader io.Reader) error {
+	return p.Upload(ctx, name, reader)
+}
+
+// session 返回对象未完成的上传会话
+func (p *GCSProvider) session(object string) (string, bool) {
+	gcsSessions.Lock()
+	defer gcsSessions.Unlock()
+	session, ok := gcsSessions.uris[p.sessionKey(object)]
+	return session, ok
+}
+
+// UploadPart 继续未完成的可恢复上传，reader 为从 offset 开始的数据。offset 为 0 且没有
+// 未完成的会话时等同于 Upload。GCS 对象不可修改，无法向已完成的对象追加数据
+func (p *GCSProvider) UploadPart(ctx context.Context, name string, reader io.Reader, offset int64) error {
+	object, err := p.objectName(name)
+	if err != nil {
+		return err
+	}
+
+	session, ok := p.session(object)
+	if !ok {
+		if offset == 0 {
+			return p.Upload(ctx, name, reader)
+		}
+		return fmt.Errorf("%w: no unfinished upload of %s to resume at offset %d", ErrResumeUnsupported, object, offset)
+	}
+
+	persisted, done, err := p.uploadStatus(ctx, session)
+	if err != nil {
+		if isGCSNotFound(err) {
+			gcsSessions.Lock()
+			delete(gcsSessions.uris, p.sessionKey(object))
+			gcsSessions.Unlock()
+			return fmt.Errorf("%w: upload session of %s expired", ErrResumeUnsupported, object)
+		}
+		return fmt.Errorf("failed to query upload status: %w", err)
+	}
+	if !done {
+		if persisted < offset {
+			return fmt.Errorf("%w: only %d bytes of %s were uploaded, cannot continue at offset %d", ErrResumeUnsupported, persisted, object, offset)
+		}
+		// 服务端已保存的数据可能比调用方认为的多，跳过这部分
+		if _, err := io.CopyN(io.Discard, reader, persisted-offset); err != nil {
+			return fmt.Errorf("failed to skip uploaded data: %w", err)
+		}
+		if err := p.uploadChunks(ctx, session, contextReader{ctx: ctx, r: reader}, persisted); err != nil {
+			return fmt.Errorf("failed to upload to GCS: %w", err)
+		}
+	}
+
+	gcsSessions.Lock()
+	delete(gcsSessions.uris, p.sessionKey(object))
+	gcsSessions.Unlock()
+	return nil
+}
+
+func (p *GCSProvider) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	return p.get(ctx, name, "")
+}
+
+// DownloadPart 通过 Range 请求读取从 offset 开始的 length 字节
+func (p *GCSProvider) DownloadPart(ctx context.Context, name string, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 || length < 0 {
+		return nil, fmt.Errorf("invalid range offset %d length %d", offset, length)
+	}
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	return p.get(ctx, name, fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+}
+
+func (p *GCSProvider) get(ctx context.Context, name, byteRange string) (io.ReadCloser, error) {
+	object, err := p.objectName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.objectURL(object)+"?alt=media", nil)
+	if err != nil {
+		return nil, err
+	}
+	if byteRange != "" {
+		req.Header.Set("Range", byteRange)
+	}
+
+	resp, err := p.do(req, http.StatusOK, http.StatusPartialContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download from GCS: %w", err)
+	}
+	return resp.Body, nil
+}
+
+func (p *GCSProvider) Delete(ctx context.Context, name string) error {
+	object, err := p.objectName(name)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, p.objectURL(object), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.do(req, http.StatusNoContent, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("failed to delete from GCS: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// List 列出名称以 prefix 开头的对象
+func (p *GCSProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	var result []string
+	pageToken := ""
+	for {
+		page, next, err := p.listPage(ctx, prefix, pageToken, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list GCS bucket: %w", err)
+		}
+		result = append(result, page...)
+		if next == "" {
+			return result, nil
+		}
+		pageToken = next
+	}
+}
+
+// listPage 获取一页对象名称，maxResults 为 0 时使用服务端的默认值
+func (p *GCSProvider) listPage(ctx context.Context, prefix, pageToken string, maxResults int) ([]string, string, error) {
+	query := url.Values{"fields": {"items(name),nextPageToken"}}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	if maxResults > 0 {
+		query.Set("maxResults", strconv.Itoa(maxResults))
+	}
+
+	target := p.endpoint + "/storage/v1/b/" + url.PathEscape(p.config.Bucket) + "/o?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	var page struct {
+		Items []struct {
+			Name string `json:"name"`
+		} `json:"items"`
+		NextPageToken string `json:"nextPageToken"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, "", fmt.Errorf("invalid list response: %w", err)
+	}
+
+	names := make([]string, 0, len(page.Items))
+	for _, item := range page.Items {
+		names = append(names, item.Name)
+	}
+	return names, page.NextPageToken, nil
+}
+
+func (p *GCSProvider) Exists(ctx context.Context, name string) (bool, error) {
+	if _, err := p.size(ctx, name); err != nil {
+		if isGCSNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check GCS object existence: %w", err)
+	}
+	return true, nil
+}
+
+// GetFileSize 获取对象大小。对象不存在但有未完成的上传时返回已上传的字节数，
+// 调用方可以从该位置通过 UploadPart 继续上传；都不存在时返回 0
+func (p *GCSProvider) GetFileSize(ctx context.Context, name string) (int64, error) {
+	size, err := p.size(ctx, name)
+	if err == nil {
+		return size, nil
+	}
+	if !isGCSNotFound(err) {
+		return 0, fmt.Errorf("failed to get GCS object size: %w", err)
+	}
+
+	object, err := p.objectName(name)
+	if err != nil {
+		return 0, err
+	}
+	session, ok := p.session(object)
+	if !ok {
+		return 0, nil
+	}
+	persisted, done, err := p.uploadStatus(ctx, session)
+	if err != nil || done {
+		return 0, nil
+	}
+	return persisted, nil
+}
+
+func (p *GCSProvider) size(ctx context.Context, name string) (int64, error) {
+	object, err := p.objectName(name)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.objectURL(object)+"?fields=size", nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := p.do(req, http.StatusOK)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// JSON API 中的 size 为字符串
+	var metadata struct {
+		Size string `json:"size"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return 0, fmt.Errorf("invalid object metadata: %w", err)
+	}
+	size, err := strconv.ParseInt(metadata.Size, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid object size %q", metadata.Size)
+	}
+	return size, nil
+}
+
+// HealthCheck 检查服务账号能否列出存储桶中的对象
+func (p *GCSProvider) HealthCheck(ctx context.Context) error {
+	if _, _, err := p.listPage(ctx, "", "", 1); err != nil {
+		return fmt.Errorf("failed to access GCS bucket %s: %w", p.config.Bucket, err)
+	}
+	return nil
+}
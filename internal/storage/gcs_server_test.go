@@ -0,0 +1,353 @@
+package storage
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testGCSClientEmail = "syncer@test-project.iam.gserviceaccount.com"
+
+var (
+	testGCSKeyOnce sync.Once
+	testGCSKey     *rsa.PrivateKey
+)
+
+// testGCSPrivateKey 返回测试用的服务账号私钥，所有测试共用一个以减少生成密钥的时间
+func testGCSPrivateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	testGCSKeyOnce.Do(func() {
+		testGCSKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+	})
+	if testGCSKey == nil {
+		t.Fatal("failed to generate test RSA key")
+	}
+	return testGCSKey
+}
+
+type testGCSObject struct {
+	data          []byte
+	storageClass  string
+	retentionMode string
+	retainUntil   time.Time
+}
+
+type testGCSUpload struct {
+	name     string
+	metadata testGCSObject
+	data     []byte
+	done     bool
+}
+
+// testGCSServer 为测试用的 JSON API 服务，按 fake-gcs-server 的方式提供一个存储桶，
+// 并在 /token 提供服务账号的令牌交换
+type testGCSServer struct {
+	*httptest.Server
+	bucket string
+	// anonymous 为 true 时不检查认证，与 fake-gcs-server 一致
+	anonymous bool
+	// partial 为 true 时每个非最终数据块只保存一半，覆盖客户端重发剩余数据的情况
+	partial bool
+	// pageSize 为列出对象时每页的默认数量，覆盖客户端分页的情况
+	pageSize int
+
+	mu            sync.Mutex
+	objects       map[string]*testGCSObject
+	uploads       map[string]*testGCSUpload
+	nextUpload    int
+	tokenRequests int
+}
+
+func startTestGCSServer(t *testing.T) *testGCSServer {
+	t.Helper()
+	testGCSPrivateKey(t)
+	server := &testGCSServer{
+		bucket:   "backups",
+		pageSize: 2,
+		objects:  map[string]*testGCSObject{},
+		uploads:  map[string]*testGCSUpload{},
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.handle))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// credentials 返回令牌地址指向测试服务的服务账号 JSON 密钥
+func (s *testGCSServer) credentials(t *testing.T) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(testGCSPrivateKey(t))
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+	data, _ := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "test-project",
+		"private_key_id": "test-key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email":   testGCSClientEmail,
+		"token_uri":      s.URL + "/token",
+	})
+	return string(data)
+}
+
+// config 返回使用服务账号认证的存储配置
+func (s *testGCSServer) config(t *testing.T) GCSConfig {
+	t.Helper()
+	return GCSConfig{
+		Name:            "test",
+		Bucket:          s.bucket,
+		CredentialsJSON: s.credentials(t),
+		Endpoint:        s.URL,
+	}
+}
+
+func (s *testGCSServer) object(name string) (*testGCSObject, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.objects[name]
+	return object, ok
+}
+
+func (s *testGCSServer) tokenRequestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokenRequests
+}
+
+func testGCSFail(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": status, "message": message}})
+}
+
+// testGCSTokenFail 按 OAuth 2.0 的格式返回令牌错误
+func testGCSTokenFail(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code, "error_description": code})
+}
+
+// token 用服务账号公钥验证 JWT 断言
+func (s *testGCSServer) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		testGCSTokenFail(w, "unsupported_grant_type")
+		return
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(r.FormValue("assertion"), claims, func(token *jwt.Token) (any, error) {
+		if token.Header["kid"] != "test-key-id" {
+			return nil, fmt.Errorf("unexpected key id %v", token.Header["kid"])
+		}
+		return &testGCSKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"RS256"}), jwt.WithAudience(s.URL+"/token"), jwt.WithIssuer(testGCSClientEmail))
+	if err != nil || claims["scope"] != gcsScope {
+		testGCSTokenFail(w, "invalid_grant")
+		return
+	}
+
+	s.mu.Lock()
+	s.tokenRequests++
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"access_token":"test-token","token_type":"Bearer","expires_in":3600}`)
+}
+
+func (s *testGCSServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/token" {
+		s.token(w, r)
+		return
+	}
+	if !s.anonymous && r.Header.Get("Authorization") != "Bearer test-token" {
+		testGCSFail(w, http.StatusUnauthorized, "Invalid Credentials")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucketPath := "/storage/v1/b/" + s.bucket + "/o"
+	escaped := r.URL.EscapedPath()
+	switch {
+	case r.URL.Path == "/upload"+bucketPath:
+		s.upload(w, r)
+	case r.URL.Path == bucketPath && r.Method == http.MethodGet:
+		s.list(w, r.URL.Query())
+	case strings.HasPrefix(escaped, bucketPath+"/"):
+		name, err := url.PathUnescape(strings.TrimPrefix(escaped, bucketPath+"/"))
+		if err != nil {
+			testGCSFail(w, http.StatusBadRequest, "Invalid object name")
+			return
+		}
+		s.handleObject(w, r, name)
+	default:
+		testGCSFail(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *testGCSServer) handleObject(w http.ResponseWriter, r *http.Request, name string) {
+	object, ok := s.objects[name]
+	if !ok {
+		testGCSFail(w, http.StatusNotFound, "No such object: "+s.bucket+"/"+name)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("alt") != "media" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"name": name, "size": strconv.Itoa(len(object.data))})
+			return
+		}
+		data := object.data
+		if byteRange := r.Header.Get("Range"); byteRange != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(byteRange, "bytes=%d-%d", &start, &end); err != nil || start > end || start >= len(data) {
+				testGCSFail(w, http.StatusRequestedRangeNotSatisfiable, "Requested range not satisfiable")
+				return
+			}
+			data = data[start:min(end+1, len(data))]
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		w.Write(data)
+
+	case http.MethodDelete:
+		if time.Now().Before(object.retainUntil) {
+			testGCSFail(w, http.StatusForbidden, "Object is under active retention")
+			return
+		}
+		delete(s.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		testGCSFail(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// upload 处理可恢复上传：POST 创建会话，PUT 按 Content-Range 上传数据或查询进度
+func (s *testGCSServer) upload(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("uploadType") != "resumable" {
+		testGCSFail(w, http.StatusBadRequest, "Unsupported upload type")
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		var metadata struct {
+			Name         string `json:"name"`
+			StorageClass string `json:"storageClass"`
+			Retention    *struct {
+				Mode            string    `json:"mode"`
+				RetainUntilTime time.Time `json:"retainUntilTime"`
+			} `json:"retention"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&metadata); err != nil || metadata.Name == "" {
+			testGCSFail(w, http.StatusBadRequest, "Invalid object metadata")
+			return
+		}
+		upload := &testGCSUpload{name: metadata.Name, metadata: testGCSObject{storageClass: metadata.StorageClass}}
+		if metadata.Retention != nil {
+			upload.metadata.retentionMode = metadata.Retention.Mode
+			upload.metadata.retainUntil = metadata.Retention.RetainUntilTime
+		}
+		s.nextUpload++
+		id := strconv.Itoa(s.nextUpload)
+		s.uploads[id] = upload
+		w.Header().Set("Location", s.URL+r.URL.Path+"?uploadType=resumable&upload_id="+id)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	upload, ok := s.uploads[query.Get("upload_id")]
+	if r.Method != http.MethodPut || !ok {
+		testGCSFail(w, http.StatusNotFound, "No such upload")
+		return
+	}
+
+	var start, end, total int64 = 0, -1, -1
+	contentRange := strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes ")
+	span, size, _ := strings.Cut(contentRange, "/")
+	if size != "*" {
+		total, _ = strconv.ParseInt(size, 10, 64)
+	}
+	if span != "*" {
+		if _, err := fmt.Sscanf(span, "%d-%d", &start, &end); err != nil {
+			testGCSFail(w, http.StatusBadRequest, "Invalid Content-Range")
+			return
+		}
+	}
+
+	if !upload.done && span != "*" {
+		if start != int64(len(upload.data)) {
+			testGCSFail(w, http.StatusBadRequest, "Invalid request. The upload offset does not match")
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		final := total >= 0 && end+1 == total
+		if s.partial && !final && len(data) > 1 {
+			data = data[:len(data)/2]
+		}
+		upload.data = append(upload.data, data...)
+	}
+	if !upload.done && total >= 0 && int64(len(upload.data)) == total {
+		upload.done = true
+		object := upload.metadata
+		object.data = upload.data
+		s.objects[upload.name] = &object
+	}
+
+	if upload.done {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"name": upload.name, "size": strconv.Itoa(len(upload.data))})
+		return
+	}
+	if len(upload.data) > 0 {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(upload.data)-1))
+	}
+	w.WriteHeader(http.StatusPermanentRedirect)
+}
+
+func (s *testGCSServer) list(w http.ResponseWriter, query url.Values) {
+	var names []string
+	for name := range s.objects {
+		if strings.HasPrefix(name, query.Get("prefix")) && name > query.Get("pageToken") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	pageSize := s.pageSize
+	if value, err := strconv.Atoi(query.Get("maxResults")); err == nil {
+		pageSize = value
+	}
+	page := map[string]any{}
+	if len(names) > pageSize {
+		names = names[:pageSize]
+		page["nextPageToken"] = names[pageSize-1]
+	}
+	items := []map[string]string{}
+	for _, name := range names {
+		items = append(items, map[string]string{"name": name})
+	}
+	if len(items) > 0 {
+		page["items"] = items
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
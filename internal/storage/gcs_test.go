@@ -0,0 +1,530 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func createTestGCSProvider(t *testing.T, config GCSConfig) *GCSProvider {
+	t.Helper()
+	provider, err := NewGCSProvider(config)
+	if err != nil {
+		t.Fatalf("NewGCSProvider() error = %v", err)
+	}
+	// 使用较小的数据块，少量数据即可覆盖多个块的情况
+	provider.chunkSize = 1024
+	return provider
+}
+
+func readGCSObject(t *testing.T, provider *GCSProvider, name string) []byte {
+	t.Helper()
+	reader, err := provider.Download(context.Background(), name)
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	return data
+}
+
+func TestGCSConfig_Validate(t *testing.T) {
+	server := startTestGCSServer(t)
+	valid := GCSConfig{Name: "gcs", Bucket: "backups", CredentialsJSON: server.credentials(t)}
+
+	tests := []struct {
+		name    string
+		modify  func(c *GCSConfig)
+		wantErr bool
+	}{
+		{"valid config", func(c *GCSConfig) {}, false},
+		{"anonymous endpoint", func(c *GCSConfig) { c.CredentialsJSON = ""; c.Endpoint = "http://127.0.0.1:4443" }, false},
+		{"storage class", func(c *GCSConfig) { c.StorageClass = GCSClassColdline }, false},
+		{"locked retention", func(c *GCSConfig) { c.RetentionDays = 30; c.RetentionMode = GCSRetentionLocked }, false},
+		{"missing bucket", func(c *GCSConfig) { c.Bucket = "" }, true},
+		{"missing credentials", func(c *GCSConfig) { c.CredentialsJSON = "" }, true},
+		{"invalid json", func(c *GCSConfig) { c.CredentialsJSON = "{" }, true},
+		{"not a service account", func(c *GCSConfig) { c.CredentialsJSON = `{"type":"authorized_user"}` }, true},
+		{"invalid private key", func(c *GCSConfig) {
+			c.CredentialsJSON = `{"type":"service_account","client_email":"a@b.c","private_key":"invalid"}`
+		}, true},
+		{"invalid endpoint", func(c *GCSConfig) { c.Endpoint = "ftp://example.com" }, true},
+		{"invalid storage class", func(c *GCSConfig) { c.StorageClass = "REGIONAL" }, true},
+		{"negative retention", func(c *GCSConfig) { c.RetentionDays = -1 }, true},
+		{"invalid retention mode", func(c *GCSConfig) { c.RetentionMode = "Governance" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// rfc7515Key 为 RFC 7515 附录 A.2 中的 RSA 密钥
+func rfc7515Key(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	decode := func(value string) *big.Int {
+		data, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			t.Fatalf("DecodeString() error = %v", err)
+		}
+		return new(big.Int).SetBytes(data)
+	}
+
+	key := &rsa.PrivateKey{
+		PublicKey: rsa.PublicKey{
+			N: decode("ofgWCuLjybRlzo0tZWJjNiuSfb4p4fAkd_wWJcyQoTbji9k0l8W26mPddxHmfHQp-Vaw-4qPCJrcS2mJPMEzP1Pt0Bm4d4QlL-yRT-SFd2lZS-pCgNMsD1W_YpRPEwOWvG6b32690r2jZ47soMZo9wGzjb_7OMg0LOL-bSf63kpaSHSXndS5z5rexMdbBYUsLA9e-KXBdQOS-UTo7WTBEMa2R2CapHg665xsmtdVMTBQY4uDZlxvb3qCo5ZwKh9kG4LT6_I5IhlJH7aGhyxXFvUK-DWNmoudF8NAco9_h9iaGNj8q2ethFkMLs91kzk2PAcDTW9gb54h4FRWyuXpoQ"),
+			E: 65537,
+		},
+		D: decode("Eq5xpGnNCivDflJsRQBXHx1hdR1k6Ulwe2JZD50LpXyWPEAeP88vLNO97IjlA7_GQ5sLKMgvfTeXZx9SE-7YwVol2NXOoAJe46sui395IW_GO-pWJ1O0BkTGoVEn2bKVRUCgu-GjBVaYLU6f3l9kJfFNS3E0QbVdxzubSu3Mkqzjkn439X0M_V51gfpRLI9JYanrC4D4qAdGcopV_0ZHHzQlBjudU2QvXt4ehNYTCBr6XCLQUShb1juUO1ZdiYoFaFQT5Tw8bGUl_x_jTj3ccPDVZFD9pIuhLhBOneufuBiB4cS98l2SR_RQyGWSeWjnczT0QU91p1DhOVRuOopznQ"),
+		Primes: []*big.Int{
+			decode("4BzEEOtIpmVdVEZNCqS7baC4crd0pqnRH_5IB3jw3bcxGn6QLvnEtfdUdiYrqBdss1l58BQ3KhooKeQTa9AB0Hw_Py5PJdTJNPY8cQn7ouZ2KKDcmnPGBY5t7yLc1QlQ5xHdwW1VhvKn-nXqhJTBgIPgtldC-KDV5z-y2XDwGUc"),
+			decode("uQPEfgmVtjL0Uyyx88GZFF1fOunH3-7cepKmtH4pxhtCoHqpWmT8YAmZxaewHgHAjLYsp1ZSe7zFYHj7C6ul7TjeLQeZD_YwD66t62wDmpe_HlB-TnBA-njbglfIsRLtXlnDzQkv5dTltRJ11BKBBypeeF6689rjcJIDEz9RWdc"),
+		},
+	}
+	if err := key.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	key.Precompute()
+	return key
+}
+
+// TestGCSAssertionSigner_RFC7515 检查断言使用的 RS256 签名与 RFC 7515 附录 A.2 的示例一致
+func TestGCSAssertionSigner_RFC7515(t *testing.T) {
+	const (
+		signingInput = "eyJhbGciOiJSUzI1NiJ9.eyJpc3MiOiJqb2UiLA0KICJleHAiOjEzMDA4MTkzODAsDQogImh0dHA6Ly9leGFtcGxlLmNvbS9pc19yb290Ijp0cnVlfQ"
+		want         = "cC4hiUPoj9Eetdgtv3hF80EGrhuB__dzERat0XF9g2VtQgr9PJbu3XOiZj5RZmh7AAuHIm4Bh-0Qc_lF5YKt_O8W2Fp5jujGbds9uJdbF9CUAr7t1dnZcAcQjbKBYNX4BAynRFdiuB--f_nZLgrnbyTyWzO75vRK5h6xBArLIARNPvkSjtQBMHlb1L07Qe7K0GarZRmB_eSN9383LcOLn6_dO--xi12jzDwusC-eOkHWEsqtFZESc6BfI7noOPqvhJ1phCnvWh6IeYI2w9QOYEUipUTI8np6LbgGY9Fs98rqVt5AXLIhWkWywlVmtVrBp0igcN_IoypGlUPQGe77Rw"
+	)
+
+	signature, err := jwt.SigningMethodRS256.Sign(signingInput, rfc7515Key(t))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if got := base64.RawURLEncoding.EncodeToString(signature); got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+}
+
+// TestGCSProvider_Assertion 不依赖 JWT 库检查断言的头部、声明和签名
+func TestGCSProvider_Assertion(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	now := time.Unix(1792137600, 0)
+
+	assertion, err := provider.assertion(now)
+	if err != nil {
+		t.Fatalf("assertion() error = %v", err)
+	}
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		t.Fatalf("assertion has %d parts, want 3", len(parts))
+	}
+
+	decode := func(part string, v any) {
+		data, err := base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			t.Fatalf("DecodeString() error = %v", err)
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+	}
+
+	var header map[string]string
+	decode(parts[0], &header)
+	wantHeader := map[string]string{"alg": "RS256", "typ": "JWT", "kid": "test-key-id"}
+	if len(header) != len(wantHeader) {
+		t.Errorf("header = %v, want %v", header, wantHeader)
+	}
+	for key, value := range wantHeader {
+		if header[key] != value {
+			t.Errorf("header[%s] = %q, want %q", key, header[key], value)
+		}
+	}
+
+	var claims map[string]any
+	decode(parts[1], &claims)
+	wantClaims := map[string]any{
+		"iss":   testGCSClientEmail,
+		"scope": gcsScope,
+		"aud":   server.URL + "/token",
+		"iat":   float64(1792137600),
+		"exp":   float64(1792141200),
+	}
+	if len(claims) != len(wantClaims) {
+		t.Errorf("claims = %v, want %v", claims, wantClaims)
+	}
+	for key, value := range wantClaims {
+		if claims[key] != value {
+			t.Errorf("claims[%s] = %v, want %v", key, claims[key], value)
+		}
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("DecodeString() error = %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&testGCSPrivateKey(t).PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("VerifyPKCS1v15() error = %v", err)
+	}
+}
+
+func TestGCSProvider_UploadDownload(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	testData := make([]byte, 5*1024+123)
+	rand.Read(testData)
+
+	if err := provider.Upload(ctx, "vault/backup 1.zip", bytes.NewReader(testData)); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if data := readGCSObject(t, provider, "vault/backup 1.zip"); !bytes.Equal(data, testData) {
+		t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+	}
+
+	// 空文件也应能上传
+	if err := provider.Upload(ctx, "empty.txt", bytes.NewReader(nil)); err != nil {
+		t.Fatalf("Upload() empty error = %v", err)
+	}
+	if object, ok := server.object("empty.txt"); !ok || len(object.data) != 0 {
+		t.Error("empty object was not created")
+	}
+}
+
+func TestGCSProvider_PartialChunks(t *testing.T) {
+	server := startTestGCSServer(t)
+	server.partial = true
+	provider := createTestGCSProvider(t, server.config(t))
+
+	testData := make([]byte, 4*1024+10)
+	rand.Read(testData)
+
+	// 服务端每次只保存一半数据时，客户端应重发未保存的部分
+	if err := provider.Upload(context.Background(), "backup.zip", bytes.NewReader(testData)); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if data := readGCSObject(t, provider, "backup.zip"); !bytes.Equal(data, testData) {
+		t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+	}
+}
+
+func TestGCSProvider_StorageClassAndRetention(t *testing.T) {
+	server := startTestGCSServer(t)
+	config := server.config(t)
+	config.StorageClass = GCSClassArchive
+	config.RetentionDays = 7
+	config.RetentionMode = GCSRetentionLocked
+	provider := createTestGCSProvider(t, config)
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "archive.zip", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	object, ok := server.object("archive.zip")
+	if !ok {
+		t.Fatal("uploaded object not found")
+	}
+	if object.storageClass != GCSClassArchive {
+		t.Errorf("storage class = %q, want %q", object.storageClass, GCSClassArchive)
+	}
+	if object.retentionMode != GCSRetentionLocked {
+		t.Errorf("retention mode = %q, want %q", object.retentionMode, GCSRetentionLocked)
+	}
+	if until := time.Until(object.retainUntil); until < 6*24*time.Hour || until > 7*24*time.Hour {
+		t.Errorf("retain until is %v from now, want about 7 days", until)
+	}
+
+	// 保留期内的对象无法删除
+	if err := provider.Delete(ctx, "archive.zip"); err == nil {
+		t.Error("Delete() expected error for retained object, got nil")
+	}
+}
+
+func TestGCSProvider_ResumeUpload(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	testData := make([]byte, 5*1024+500)
+	rand.Read(testData)
+
+	// 同步服务需要先把备份写入临时文件，重试时才能从中断处续传同一份数据
+	if !provider.ResumesUploads() {
+		t.Error("ResumesUploads() = false, want true")
+	}
+
+	// 上传在第 3 个数据块中途中断，前 2 个块已保存在会话中
+	err := provider.Upload(ctx, "backup.zip", &failingReader{r: bytes.NewReader(testData), limit: 2*1024 + 100})
+	if err == nil {
+		t.Fatal("Upload() expected error for interrupted reader, got nil")
+	}
+	if _, ok := server.object("backup.zip"); ok {
+		t.Fatal("interrupted upload should not create the object")
+	}
+
+	// 重新创建的 Provider 同样可以找到未完成的会话
+	provider = createTestGCSProvider(t, server.config(t))
+	size, err := provider.GetFileSize(ctx, "backup.zip")
+	if err != nil {
+		t.Fatalf("GetFileSize() error = %v", err)
+	}
+	if size != 2048 {
+		t.Fatalf("GetFileSize() = %d, want 2048", size)
+	}
+
+	if err := provider.UploadPart(ctx, "backup.zip", bytes.NewReader(testData[size:]), size); err != nil {
+		t.Fatalf("UploadPart() error = %v", err)
+	}
+	if data := readGCSObject(t, provider, "backup.zip"); !bytes.Equal(data, testData) {
+		t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+	}
+
+	// 上传完成后会话被移除
+	if err := provider.UploadPart(ctx, "backup.zip", strings.NewReader("more"), int64(len(testData))); err == nil {
+		t.Error("UploadPart() expected error after upload completed, got nil")
+	}
+}
+
+func TestGCSProvider_UploadPart(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	testData := make([]byte, 3*1024+10)
+	rand.Read(testData)
+
+	provider.Upload(ctx, "backup.zip", &failingReader{r: bytes.NewReader(testData), limit: 2*1024 + 1})
+
+	// 偏移超过服务端已保存的数据时无法续传
+	if err := provider.UploadPart(ctx, "backup.zip", bytes.NewReader(testData[3072:]), 3072); err == nil {
+		t.Error("UploadPart() expected error for offset beyond uploaded data, got nil")
+	}
+
+	// 偏移小于已保存的数据时跳过已上传的部分
+	if err := provider.UploadPart(ctx, "backup.zip", bytes.NewReader(testData[1024:]), 1024); err != nil {
+		t.Fatalf("UploadPart() error = %v", err)
+	}
+	if data := readGCSObject(t, provider, "backup.zip"); !bytes.Equal(data, testData) {
+		t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+	}
+
+	// 没有未完成的会话时，偏移为 0 等同于完整上传
+	if err := provider.UploadPart(ctx, "new.zip", strings.NewReader("data"), 0); err != nil {
+		t.Fatalf("UploadPart() at offset 0 error = %v", err)
+	}
+	if data := readGCSObject(t, provider, "new.zip"); string(data) != "data" {
+		t.Errorf("Download() = %q, want %q", data, "data")
+	}
+	if err := provider.UploadPart(ctx, "missing.zip", strings.NewReader("data"), 1024); err == nil {
+		t.Error("UploadPart() expected error without an unfinished upload, got nil")
+	}
+}
+
+func TestGCSProvider_DownloadPart(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	reader, err := provider.DownloadPart(ctx, "file.txt", 2, 5)
+	if err != nil {
+		t.Fatalf("DownloadPart() error = %v", err)
+	}
+	defer reader.Close()
+	data, _ := io.ReadAll(reader)
+	if string(data) != "23456" {
+		t.Errorf("DownloadPart() = %q, want %q", data, "23456")
+	}
+
+	if _, err := provider.DownloadPart(ctx, "missing.txt", 0, 5); err == nil {
+		t.Error("DownloadPart() expected error for missing object, got nil")
+	}
+}
+
+func TestGCSProvider_List(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	names := []string{"a.zip", "b.zip", "c.zip", "d.zip", "vault/e.zip"}
+	for _, name := range names {
+		if err := provider.Upload(ctx, name, strings.NewReader(name)); err != nil {
+			t.Fatalf("Upload(%q) error = %v", name, err)
+		}
+	}
+
+	// 每页 2 个对象，需要翻页才能列出全部
+	got, err := provider.List(ctx, "")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if !slices.Equal(got, names) {
+		t.Errorf("List() = %v, want %v", got, names)
+	}
+
+	got, err = provider.List(ctx, "vault/")
+	if err != nil {
+		t.Fatalf("List() with prefix error = %v", err)
+	}
+	if !slices.Equal(got, []string{"vault/e.zip"}) {
+		t.Errorf("List(vault/) = %v, want [vault/e.zip]", got)
+	}
+}
+
+func TestGCSProvider_ExistsAndSize(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		wantExists bool
+		wantSize   int64
+	}{
+		{"file.txt", true, 10},
+		{"missing.txt", false, 0},
+	}
+
+	for _, tt := range tests {
+		exists, err := provider.Exists(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("Exists(%q) error = %v", tt.name, err)
+		}
+		if exists != tt.wantExists {
+			t.Errorf("Exists(%q) = %v, want %v", tt.name, exists, tt.wantExists)
+		}
+
+		size, err := provider.GetFileSize(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("GetFileSize(%q) error = %v", tt.name, err)
+		}
+		if size != tt.wantSize {
+			t.Errorf("GetFileSize(%q) = %d, want %d", tt.name, size, tt.wantSize)
+		}
+	}
+}
+
+func TestGCSProvider_Delete(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := provider.Delete(ctx, "file.txt"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exists, err := provider.Exists(ctx, "file.txt")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if exists {
+		t.Error("Exists() = true after Delete()")
+	}
+}
+
+func TestGCSProvider_Authentication(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+	ctx := context.Background()
+
+	// 访问令牌在过期前复用
+	for i := 0; i < 3; i++ {
+		if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+			t.Fatalf("Upload() error = %v", err)
+		}
+	}
+	if got := server.tokenRequestCount(); got != 1 {
+		t.Errorf("token requests = %d, want 1", got)
+	}
+
+	// 令牌服务无法验证签名时返回错误
+	config := server.config(t)
+	config.CredentialsJSON = strings.Replace(config.CredentialsJSON, "test-key-id", "other-key-id", 1)
+	provider = createTestGCSProvider(t, config)
+	if err := provider.HealthCheck(ctx); err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("HealthCheck() error = %v, want invalid_grant", err)
+	}
+}
+
+func TestGCSProvider_Anonymous(t *testing.T) {
+	server := startTestGCSServer(t)
+	server.anonymous = true
+	provider := createTestGCSProvider(t, GCSConfig{Name: "test", Bucket: server.bucket, Endpoint: server.URL})
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if data := readGCSObject(t, provider, "file.txt"); string(data) != "data" {
+		t.Errorf("Download() = %q, want %q", data, "data")
+	}
+	if got := server.tokenRequestCount(); got != 0 {
+		t.Errorf("token requests = %d, want 0", got)
+	}
+}
+
+func TestGCSProvider_HealthCheck(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+
+	if err := provider.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+
+	config := server.config(t)
+	config.Bucket = "missing"
+	provider = createTestGCSProvider(t, config)
+	if err := provider.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error for missing bucket, got nil")
+	}
+}
+
+func TestGCSProvider_Methods(t *testing.T) {
+	server := startTestGCSServer(t)
+	provider := createTestGCSProvider(t, server.config(t))
+
+	if provider.Name() != "test" {
+		t.Errorf("Name() = %s, want test", provider.Name())
+	}
+	if provider.Type() != "gcs" {
+		t.Errorf("Type() = %s, want gcs", provider.Type())
+	}
+
+	var _ Provider = provider
+	var _ HealthChecker = provider
+	var _ StreamUploader = provider
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"io"
 )
 
@@ -15,12 +16,35 @@ type Provider interface {
 	List(ctx context.Context, prefix string) ([]string, error)
 	Exists(ctx context.Context, path string) (bool, error)
 
-	// 新增方法支持断点续传
+	// 新增方法支持断点续传。UploadPart 从 offset 处继续写入之前中断的上传，
+	// 无法续传时返回 ErrResumeUnsupported
 	UploadPart(ctx context.Context, path string, reader io.Reader, offset int64) error
 	DownloadPart(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error)
 	GetFileSize(ctx context.Context, path string) (int64, error)
 }
 
+// ErrResumeUnsupported 表示存储无法从指定位置继续写入对象，调用方需要从头上传整个对象
+var ErrResumeUnsupported = errors.New("resuming this upload is not supported")
+
+// HealthChecker 由能够自行检查健康状态的存储提供者实现，未实现时通过列出根目录检查连接
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// StreamUploader 由能够直接上传长度未知、只能顺序读取一次的数据流的存储提供者实现。
+// 未实现时上传前需要先把数据写入临时文件：S3 的 PutObject 需要可以 seek 的请求体，
+// WebDAV 在认证重试时需要重新读取请求体
+type StreamUploader interface {
+	UploadStream(ctx context.Context, path string, reader io.Reader) error
+}
+
+// ResumableUploader 由保留中断的上传、并能通过 GetFileSize 和 UploadPart 从中断处续传的存储提供者实现。
+// 加密的备份每次生成的内容都不同，续传时必须读取同一份数据，因此同步服务对这类存储不直接上传数据流，
+// 而是先写入临时文件，重试时从临时文件中断的位置继续上传
+type ResumableUploader interface {
+	ResumesUploads() bool
+}
+
 // Config 定义存储配置的接口
 type Config interface {
 	Validate() error
@@ -0,0 +1,362 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ErrInsufficientSpace 表示目标文件系统的可用空间不足
+var ErrInsufficientSpace = errors.New("insufficient free space")
+
+// ErrFreeSpaceUnsupported 表示当前平台无法获取文件系统的可用空间
+var ErrFreeSpaceUnsupported = errors.New("free space is not available on this platform")
+
+type LocalConfig struct {
+	Name string `json:"name"`
+	// Path 为保存备份的目录，例如挂载的 NFS 或 USB 磁盘
+	Path string `json:"path"`
+	// MinFreeSpace 为上传后目标文件系统至少要保留的可用空间（字节）
+	MinFreeSpace int64 `json:"min_free_space"`
+	// SyncDirectory 为 true 时在重命名后对所在目录执行 fsync，断电后文件仍然可见
+	SyncDirectory bool `json:"sync_directory"`
+}
+
+func (c LocalConfig) Validate() error {
+	if c.Path == "" {
+		return fmt.Errorf("path is required")
+	}
+	if !filepath.IsAbs(c.Path) {
+		return fmt.Errorf("path must be absolute")
+	}
+	if c.MinFreeSpace < 0 {
+		return fmt.Errorf("minimum free space must not be negative")
+	}
+	return nil
+}
+
+// LocalProvider 将备份保存到本地目录。文件先写入同一目录下的临时文件并 fsync，
+// 再重命名为目标文件名，中断的上传不会留下不完整的备份。
+type LocalProvider struct {
+	config LocalConfig
+}
+
+func NewLocalProvider(config LocalConfig) (*LocalProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid local storage config: %w", err)
+	}
+
+	return &LocalProvider{config: config}, nil
+}
+
+func (p *LocalProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *LocalProvider) Type() string {
+	return "local"
+}
+
+// resolve 将对象名转换为存储目录下的路径，拒绝指向目录之外的名称
+func (p *LocalProvider) resolve(name string) (string, error) {
+	cleaned, err := cleanObjectName(name)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(p.config.Path, filepath.FromSlash(cleaned)), nil
+}
+
+func (p *LocalProvider) Upload(ctx context.Context, name string, reader io.Reader) error {
+	target, err := p.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	// 写入前检查可用空间，大小已知时要求能容纳整个文件。io.Pipe 等数据流的大小未知，
+	// 写入过程中还会定期检查，可用空间低于 MinFreeSpace 时停止写入
+	size := int64(0)
+	if sized, ok := reader.(interface{ Size() int64 }); ok {
+		size = sized.Size()
+	}
+	if err := p.checkFreeSpace(size); err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(target)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, tempPrefix+"*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	writer := &freeSpaceWriter{w: tmp, provider: p, remaining: size}
+	if _, err := io.Copy(writer, contextReader{ctx: ctx, r: reader}); err != nil {
+		return fmt.Errorf("failed to write to local storage: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0640); err != nil {
+		return fmt.Errorf("failed to set file permissions: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("failed to move file into place: %w", err)
+	}
+	committed = true
+
+	if p.config.SyncDirectory {
+		if err := syncDir(dir); err != nil {
+			return fmt.Errorf("failed to sync directory: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UploadStream 上传长度未知的数据流，数据先写入目标目录中的临时文件，完整写入后才重命名为对象名
+func (p *LocalProvider) UploadStream(ctx context.Context, name string, reader io.Reader) error {
+	return p.Upload(ctx, name, reader)
+}
+
+func (p *LocalProvider) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	target, err := p.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file in local storage: %w", err)
+	}
+	return file, nil
+}
+
+func (p *LocalProvider) Delete(ctx context.Context, name string) error {
+	target, err := p.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(target); err != nil {
+		return fmt.Errorf("failed to delete from local storage: %w", err)
+	}
+	return nil
+}
+
+// List 递归列出名称以 prefix 开头的文件，从 prefix 所在的目录开始遍历。与 S3 一致，不存在的目录视为空
+func (p *LocalProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	dir := ""
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = prefix[:i+1]
+	}
+
+	root := p.config.Path
+	if dir != "" {
+		var err error
+		if root, err = p.resolve(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []string
+	err := filepath.WalkDir(root, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if current == root && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if current == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, current)
+		if err != nil {
+			return err
+		}
+		name := dir + filepath.ToSlash(rel)
+
+		if entry.IsDir() {
+			if !strings.HasPrefix(name+"/", prefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), tempPrefix) && strings.HasPrefix(name, prefix) {
+			result = append(result, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local directory: %w", err)
+	}
+
+	return result, nil
+}
+
+func (p *LocalProvider) Exists(ctx context.Context, name string) (bool, error) {
+	target, err := p.resolve(name)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(target); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check local file existence: %w", err)
+	}
+	return true, nil
+}
+
+// UploadPart 本地存储总是原子地写入整个文件，中断的上传不会留下部分内容，只能从头上传
+func (p *LocalProvider) UploadPart(ctx context.Context, name string, reader io.Reader, offset int64) error {
+	if offset != 0 {
+		return ErrResumeUnsupported
+	}
+	return p.Upload(ctx, name, reader)
+}
+
+// DownloadPart 下载文件的一部分
+func (p *LocalProvider) DownloadPart(ctx context.Context, name string, offset, length int64) (io.ReadCloser, error) {
+	target, err := p.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file in local storage: %w", err)
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.NewSectionReader(file, offset, length), file}, nil
+}
+
+// GetFileSize 获取文件大小，文件不存在时返回 0
+func (p *LocalProvider) GetFileSize(ctx context.Context, name string) (int64, error) {
+	target, err := p.resolve(name)
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := os.Stat(target)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get local file size: %w", err)
+	}
+	return info.Size(), nil
+}
+
+// HealthCheck 检查存储目录存在且可写，并且可用空间不少于配置的最小值
+func (p *LocalProvider) HealthCheck(ctx context.Context) error {
+	info, err := os.Stat(p.config.Path)
+	if err != nil {
+		return fmt.Errorf("storage directory is not accessible: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("storage path %s is not a directory", p.config.Path)
+	}
+
+	probe, err := os.CreateTemp(p.config.Path, tempPrefix+"probe-*")
+	if err != nil {
+		return fmt.Errorf("storage directory is not writable: %w", err)
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	return p.checkFreeSpace(0)
+}
+
+// checkFreeSpace 检查写入 size 字节后可用空间是否仍不少于 MinFreeSpace。无法获取可用空间时不做限制
+func (p *LocalProvider) checkFreeSpace(size int64) error {
+	free, err := FreeSpace(p.config.Path)
+	if err != nil {
+		if errors.Is(err, ErrFreeSpaceUnsupported) {
+			return nil
+		}
+		return fmt.Errorf("failed to get free space: %w", err)
+	}
+
+	if free < size+p.config.MinFreeSpace {
+		return fmt.Errorf("%w: %d bytes available in %s, %d bytes needed", ErrInsufficientSpace, free, p.config.Path, size+p.config.MinFreeSpace)
+	}
+	return nil
+}
+
+// freeSpaceCheckInterval 为写入过程中两次检查可用空间之间写入的字节数
+var freeSpaceCheckInterval int64 = 16 << 20
+
+// freeSpaceWriter 每写入 freeSpaceCheckInterval 字节检查一次可用空间，
+// 剩余数据写入后可用空间会低于 MinFreeSpace 时返回 ErrInsufficientSpace
+type freeSpaceWriter struct {
+	w        io.Writer
+	provider *LocalProvider
+	// remaining 为预计还要写入的字节数，大小未知时为 0
+	remaining int64
+	unchecked int64
+}
+
+func (w *freeSpaceWriter) Write(p []byte) (int, error) {
+	if w.unchecked >= freeSpaceCheckInterval {
+		if err := w.provider.checkFreeSpace(max(w.remaining, 0)); err != nil {
+			return 0, err
+		}
+		w.unchecked = 0
+	}
+
+	n, err := w.w.Write(p)
+	w.unchecked += int64(n)
+	w.remaining -= int64(n)
+	return n, err
+}
+
+// syncDir 对目录执行 fsync，使其中的重命名持久化。Windows 不支持对目录 fsync，直接返回
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// contextReader 在 context 被取消后停止读取
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
@@ -0,0 +1,8 @@
+//go:build !(linux || darwin || freebsd)
+
+package storage
+
+// FreeSpace 在不支持 statfs 的平台上无法获取可用空间
+func FreeSpace(dir string) (int64, error) {
+	return 0, ErrFreeSpaceUnsupported
+}
@@ -0,0 +1,349 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func createTestLocalProvider(t *testing.T) (*LocalProvider, string) {
+	t.Helper()
+	dir := t.TempDir()
+	provider, err := NewLocalProvider(LocalConfig{Name: "test", Path: dir})
+	if err != nil {
+		t.Fatalf("NewLocalProvider() error = %v", err)
+	}
+	return provider, dir
+}
+
+func TestLocalConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  LocalConfig
+		wantErr bool
+	}{
+		{
+			name:    "valid config",
+			config:  LocalConfig{Name: "test", Path: "/mnt/backup"},
+			wantErr: false,
+		},
+		{
+			name:    "missing path",
+			config:  LocalConfig{Name: "test"},
+			wantErr: true,
+		},
+		{
+			name:    "relative path",
+			config:  LocalConfig{Name: "test", Path: "backup"},
+			wantErr: true,
+		},
+		{
+			name:    "negative free space",
+			config:  LocalConfig{Name: "test", Path: "/mnt/backup", MinFreeSpace: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocalProvider_UploadDownload(t *testing.T) {
+	provider, dir := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	testData := "test data content"
+	if err := provider.Upload(ctx, "backups/test-file.txt", strings.NewReader(testData)); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	reader, err := provider.Download(ctx, "backups/test-file.txt")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(data) != testData {
+		t.Errorf("Download() = %q, want %q", data, testData)
+	}
+
+	// 上传完成后不应残留临时文件
+	entries, err := os.ReadDir(filepath.Join(dir, "backups"))
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "test-file.txt" {
+		t.Errorf("unexpected directory contents: %v", entries)
+	}
+}
+
+func TestLocalProvider_Upload_Overwrite(t *testing.T) {
+	provider, _ := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("old content")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("new")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	size, err := provider.GetFileSize(ctx, "file.txt")
+	if err != nil {
+		t.Fatalf("GetFileSize() error = %v", err)
+	}
+	if size != 3 {
+		t.Errorf("GetFileSize() = %d, want 3", size)
+	}
+}
+
+func TestLocalProvider_Upload_Error(t *testing.T) {
+	provider, dir := createTestLocalProvider(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err == nil {
+		t.Error("Upload() expected error for cancelled context, got nil")
+	}
+
+	// 失败的上传不应留下目标文件或临时文件
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("unexpected directory contents after failed upload: %v", entries)
+	}
+}
+
+func TestLocalProvider_InvalidName(t *testing.T) {
+	provider, _ := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"", "/", "../escape.txt", "a/../../escape.txt", "..\\escape.txt"} {
+		if err := provider.Upload(ctx, name, strings.NewReader("data")); err == nil {
+			t.Errorf("Upload(%q) expected error, got nil", name)
+		}
+		if _, err := provider.Download(ctx, name); err == nil {
+			t.Errorf("Download(%q) expected error, got nil", name)
+		}
+	}
+}
+
+func TestLocalProvider_InsufficientSpace(t *testing.T) {
+	dir := t.TempDir()
+	provider, err := NewLocalProvider(LocalConfig{Name: "test", Path: dir, MinFreeSpace: 1 << 62})
+	if err != nil {
+		t.Fatalf("NewLocalProvider() error = %v", err)
+	}
+	if _, err := FreeSpace(dir); errors.Is(err, ErrFreeSpaceUnsupported) {
+		t.Skip("free space is not available on this platform")
+	}
+
+	err = provider.Upload(context.Background(), "file.txt", strings.NewReader("data"))
+	if !errors.Is(err, ErrInsufficientSpace) {
+		t.Errorf("Upload() error = %v, want %v", err, ErrInsufficientSpace)
+	}
+	if err := provider.HealthCheck(context.Background()); !errors.Is(err, ErrInsufficientSpace) {
+		t.Errorf("HealthCheck() error = %v, want %v", err, ErrInsufficientSpace)
+	}
+}
+
+func TestLocalProvider_InsufficientSpaceForStream(t *testing.T) {
+	dir := t.TempDir()
+	free, err := FreeSpace(dir)
+	if errors.Is(err, ErrFreeSpaceUnsupported) {
+		t.Skip("free space is not available on this platform")
+	}
+	if err != nil {
+		t.Fatalf("FreeSpace() error = %v", err)
+	}
+	defer func(interval int64) { freeSpaceCheckInterval = interval }(freeSpaceCheckInterval)
+	freeSpaceCheckInterval = 256 << 10
+
+	// stream 通过 io.Pipe 写入 size 字节，与备份流一样无法预先得知大小
+	stream := func(size int) *io.PipeReader {
+		reader, writer := io.Pipe()
+		go func() {
+			_, err := writer.Write(make([]byte, size))
+			writer.CloseWithError(err)
+		}()
+		t.Cleanup(func() { reader.Close() })
+		return reader
+	}
+
+	// 可用空间已经低于最小值时不写入任何数据
+	provider, _ := NewLocalProvider(LocalConfig{Name: "test", Path: dir, MinFreeSpace: 1 << 62})
+	if err := provider.Upload(context.Background(), "full.bin", stream(1024)); !errors.Is(err, ErrInsufficientSpace) {
+		t.Errorf("Upload() error = %v, want %v", err, ErrInsufficientSpace)
+	}
+
+	// 写入过程中可用空间低于最小值时停止写入，不留下临时文件
+	provider, _ = NewLocalProvider(LocalConfig{Name: "test", Path: dir, MinFreeSpace: free - 4<<20})
+	if err := provider.Upload(context.Background(), "stream.bin", stream(64<<20)); !errors.Is(err, ErrInsufficientSpace) {
+		t.Errorf("Upload() error = %v, want %v", err, ErrInsufficientSpace)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("storage directory has %d entries after failed uploads, want 0", len(entries))
+	}
+}
+
+func TestLocalProvider_Delete(t *testing.T) {
+	provider, _ := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := provider.Delete(ctx, "file.txt"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exists, err := provider.Exists(ctx, "file.txt")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if exists {
+		t.Error("Exists() = true after Delete()")
+	}
+}
+
+func TestLocalProvider_List(t *testing.T) {
+	provider, dir := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"backup-1.zip", "backup-2.zip", "other.txt", "repo/chunk", "repo/nested/chunk"} {
+		if err := provider.Upload(ctx, name, strings.NewReader("data")); err != nil {
+			t.Fatalf("Upload(%q) error = %v", name, err)
+		}
+	}
+	// 未完成上传的临时文件不应被列出
+	for _, sub := range []string{".", "repo"} {
+		if err := os.WriteFile(filepath.Join(dir, sub, tempPrefix+"123"), []byte("partial"), 0600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"", []string{"backup-1.zip", "backup-2.zip", "other.txt", "repo/chunk", "repo/nested/chunk"}},
+		{"backup-", []string{"backup-1.zip", "backup-2.zip"}},
+		{"repo/", []string{"repo/chunk", "repo/nested/chunk"}},
+		{"repo/n", []string{"repo/nested/chunk"}},
+		{"missing/", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := provider.List(ctx, tt.prefix)
+		if err != nil {
+			t.Fatalf("List(%q) error = %v", tt.prefix, err)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("List(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestLocalProvider_Exists(t *testing.T) {
+	provider, _ := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"file.txt", true},
+		{"missing.txt", false},
+	}
+
+	for _, tt := range tests {
+		got, err := provider.Exists(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("Exists(%q) error = %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("Exists(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLocalProvider_DownloadPart(t *testing.T) {
+	provider, _ := createTestLocalProvider(t)
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	reader, err := provider.DownloadPart(ctx, "file.txt", 2, 5)
+	if err != nil {
+		t.Fatalf("DownloadPart() error = %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(data) != "23456" {
+		t.Errorf("DownloadPart() = %q, want %q", data, "23456")
+	}
+}
+
+func TestLocalProvider_HealthCheck(t *testing.T) {
+	provider, dir := createTestLocalProvider(t)
+
+	if err := provider.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+	// 健康检查不应留下探测文件
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("unexpected directory contents after health check: %v", entries)
+	}
+
+	missing, err := NewLocalProvider(LocalConfig{Name: "missing", Path: filepath.Join(dir, "missing")})
+	if err != nil {
+		t.Fatalf("NewLocalProvider() error = %v", err)
+	}
+	if err := missing.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error for missing directory, got nil")
+	}
+}
+
+func TestLocalProvider_Methods(t *testing.T) {
+	provider, _ := createTestLocalProvider(t)
+
+	if provider.Name() != "test" {
+		t.Errorf("Name() = %s, want test", provider.Name())
+	}
+	if provider.Type() != "local" {
+		t.Errorf("Type() = %s, want local", provider.Type())
+	}
+
+	var _ Provider = provider
+	var _ HealthChecker = provider
+	var _ StreamUploader = provider
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// tempPrefix 为原子上传过程中临时文件的文件名前缀，列出文件时会被忽略
+const tempPrefix = ".vaultwarden-syncer-tmp-"
+
+// cleanObjectName 将对象名规范化为以 / 开头的路径，拒绝空名称和包含 .. 的名称，
+// 保证对象不会被写到存储目录之外
+func cleanObjectName(name string) (string, error) {
+	normalized := strings.ReplaceAll(name, "\\", "/")
+	cleaned := path.Clean("/" + normalized)
+	if cleaned == "/" || strings.Contains(name, "\x00") {
+		return "", fmt.Errorf("invalid object name %q", name)
+	}
+	for _, part := range strings.Split(normalized, "/") {
+		if part == ".." {
+			return "", fmt.Errorf("invalid object name %q", name)
+		}
+	}
+	return cleaned, nil
+}
@@ -155,19 +155,23 @@ func (p *S3Provider) Delete(ctx context.Context, path string) error {
 }
 
 func (p *S3Provider) List(ctx context.Context, prefix string) ([]string, error) {
-	result, err := p.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+	var files []string
+
+	// ListObjectsV2 每次最多返回 1000 个对象，仓库的数据块和长期保留的备份都可能超过这个数量
+	paginator := s3.NewListObjectsV2Paginator(p.client, &s3.ListObjectsV2Input{
 		Bucket: aws.String(p.config.Bucket),
 		Prefix: aws.String(prefix),
 	})
+	for paginator.HasMorePages() {
+		result, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list S3 objects: %w", err)
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to list S3 objects: %w", err)
-	}
-
-	var files []string
-	for _, obj := range result.Contents {
-		if obj.Key != nil {
-			files = append(files, *obj.Key)
+		for _, obj := range result.Contents {
+			if obj.Key != nil {
+				files = append(files, *obj.Key)
+			}
 		}
 	}
 
@@ -192,10 +196,11 @@ func (p *S3Provider) Exists(ctx context.Context, path string) (bool, error) {
 	return true, nil
 }
 
-// UploadPart 上传文件的一部分（支持断点续传）
+// UploadPart S3 对象只能整体写入，只能从头上传
 func (p *S3Provider) UploadPart(ctx context.Context, path string, reader io.Reader, offset int64) error {
-	// S3的分段上传需要更复杂的实现，这里简化为完整上传
-	// 在生产环境中，应该使用S3的multipart upload功能
+	if offset != 0 {
+		return ErrResumeUnsupported
+	}
 	return p.Upload(ctx, path, reader)
 }
 
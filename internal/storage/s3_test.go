@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"testing"
 
@@ -82,28 +83,46 @@ func (m *MockS3Client) DeleteObject(ctx context.Context, params *s3.DeleteObject
 	return &s3.DeleteObjectOutput{}, nil
 }
 
+// mockS3ListPageSize 为模拟的 ListObjectsV2 每页返回的对象数，远小于 S3 的 1000 以便测试分页
+const mockS3ListPageSize = 2
+
 func (m *MockS3Client) ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
 
-	var contents []types.Object
 	prefix := ""
 	if params.Prefix != nil {
 		prefix = *params.Prefix
 	}
 
+	var keys []string
 	for key := range m.objects {
 		if strings.HasPrefix(key, prefix) {
-			contents = append(contents, types.Object{
-				Key: aws.String(key),
-			})
+			keys = append(keys, key)
 		}
 	}
+	sort.Strings(keys)
 
-	return &s3.ListObjectsV2Output{
-		Contents: contents,
-	}, nil
+	// 续传标记为上一页最后一个对象的键
+	if params.ContinuationToken != nil {
+		start := sort.SearchStrings(keys, *params.ContinuationToken)
+		if start < len(keys) && keys[start] == *params.ContinuationToken {
+			start++
+		}
+		keys = keys[start:]
+	}
+
+	output := &s3.ListObjectsV2Output{IsTruncated: aws.Bool(false)}
+	if len(keys) > mockS3ListPageSize {
+		keys = keys[:mockS3ListPageSize]
+		output.IsTruncated = aws.Bool(true)
+		output.NextContinuationToken = aws.String(keys[len(keys)-1])
+	}
+	for _, key := range keys {
+		output.Contents = append(output.Contents, types.Object{Key: aws.String(key)})
+	}
+	return output, nil
 }
 
 func (m *MockS3Client) HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
@@ -300,15 +319,19 @@ func TestS3Provider_List(t *testing.T) {
 	// 预先设置数据
 	mockClient.objects["backup/file1.txt"] = []byte("content1")
 	mockClient.objects["backup/file2.txt"] = []byte("content2")
-	mockClient.objects["other/file3.txt"] = []byte("content3")
+	mockClient.objects["backup/file3.txt"] = []byte("content3")
+	mockClient.objects["backup/file4.txt"] = []byte("content4")
+	mockClient.objects["backup/file5.txt"] = []byte("content5")
+	mockClient.objects["other/file6.txt"] = []byte("content6")
 
+	// 5 个对象分 3 页返回
 	ctx := context.Background()
 	files, err := provider.List(ctx, "backup/")
 	if err != nil {
 		t.Fatalf("List() error = %v", err)
 	}
 
-	expected := []string{"backup/file1.txt", "backup/file2.txt"}
+	expected := []string{"backup/file1.txt", "backup/file2.txt", "backup/file3.txt", "backup/file4.txt", "backup/file5.txt"}
 	if len(files) != len(expected) {
 		t.Errorf("List() expected %d files, got %d", len(expected), len(files))
 	}
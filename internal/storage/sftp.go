@@ -0,0 +1,541 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrHostKeyMismatch 表示 SFTP 服务器的主机密钥与配置中固定的密钥不一致
+var ErrHostKeyMismatch = errors.New("host key does not match the pinned key")
+
+type SFTPConfig struct {
+	Name     string `json:"name"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	// Password 和 PrivateKey 至少配置一个，同时配置时先尝试私钥认证
+	Password   string `json:"password"`
+	PrivateKey string `json:"private_key"`
+	// Passphrase 为加密私钥的密码
+	Passphrase string `json:"passphrase"`
+	// HostKey 为固定的服务器主机密钥，每行一个，可以是 SHA256 指纹（SHA256:...）、
+	// known_hosts 格式或 authorized_keys 格式的公钥
+	HostKey string `json:"host_key"`
+	// BaseDir 为保存备份的远程目录，不存在时会自动创建；相对路径相对于登录用户的主目录
+	BaseDir string `json:"base_dir"`
+}
+
+func (c SFTPConfig) Validate() error {
+	if c.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+	if c.Port < 0 || c.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+	if c.Username == "" {
+		return fmt.Errorf("username is required")
+	}
+	if c.Password == "" && c.PrivateKey == "" {
+		return fmt.Errorf("password or private key is required")
+	}
+	if c.PrivateKey != "" {
+		if _, err := c.signer(); err != nil {
+			return err
+		}
+	}
+	if _, err := parseHostKeys(c.HostKey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c SFTPConfig) signer() (ssh.Signer, error) {
+	var (
+		signer ssh.Signer
+		err    error
+	)
+	if c.Passphrase != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(c.PrivateKey), []byte(c.Passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey([]byte(c.PrivateKey))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return signer, nil
+}
+
+// pinnedHostKeys 为允许的主机密钥
+type pinnedHostKeys struct {
+	fingerprints map[string]bool
+	keys         map[string]bool
+	revoked      map[string]bool
+}
+
+// parseHostKeys 解析固定的主机密钥，至少需要一个密钥
+func parseHostKeys(text string) (*pinnedHostKeys, error) {
+	pinned := &pinnedHostKeys{fingerprints: map[string]bool{}, keys: map[string]bool{}, revoked: map[string]bool{}}
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "SHA256:") {
+			pinned.fingerprints[line] = true
+			continue
+		}
+
+		if marker, _, key, _, _, err := ssh.ParseKnownHosts([]byte(line)); err == nil {
+			switch marker {
+			case "":
+				pinned.keys[string(key.Marshal())] = true
+			case "revoked":
+				pinned.revoked[string(key.Marshal())] = true
+			default:
+				return nil, fmt.Errorf("unsupported host key marker @%s", marker)
+			}
+			continue
+		}
+
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid host key %q: %w", line, err)
+		}
+		pinned.keys[string(key.Marshal())] = true
+	}
+
+	if len(pinned.fingerprints) == 0 && len(pinned.keys) == 0 {
+		return nil, fmt.Errorf("host key is required")
+	}
+	return pinned, nil
+}
+
+func (p *pinnedHostKeys) check(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	marshaled := string(key.Marshal())
+	fingerprint := ssh.FingerprintSHA256(key)
+
+	if !p.revoked[marshaled] && (p.keys[marshaled] || p.fingerprints[fingerprint]) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s presented %s %s", ErrHostKeyMismatch, hostname, key.Type(), fingerprint)
+}
+
+// SFTPProvider 通过 SFTP 将备份保存到远程目录。每个操作使用独立的 SSH 连接，
+// 文件先写入同一目录下的临时文件，再重命名为目标文件名。
+type SFTPProvider struct {
+	config    SFTPConfig
+	addr      string
+	sshConfig *ssh.ClientConfig
+}
+
+func NewSFTPProvider(config SFTPConfig) (*SFTPProvider, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid SFTP config: %w", err)
+	}
+
+	pinned, err := parseHostKeys(config.HostKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SFTP config: %w", err)
+	}
+
+	var auth []ssh.AuthMethod
+	if config.PrivateKey != "" {
+		signer, err := config.signer()
+		if err != nil {
+			return nil, fmt.Errorf("invalid SFTP config: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if config.Password != "" {
+		password := config.Password
+		auth = append(auth,
+			ssh.Password(password),
+			ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+				answers := make([]string, len(questions))
+				for i := range answers {
+					answers[i] = password
+				}
+				return answers, nil
+			}),
+		)
+	}
+
+	port := config.Port
+	if port == 0 {
+		port = 22
+	}
+
+	return &SFTPProvider{
+		config: config,
+		addr:   net.JoinHostPort(config.Host, strconv.Itoa(port)),
+		sshConfig: &ssh.ClientConfig{
+			User:            config.Username,
+			Auth:            auth,
+			HostKeyCallback: pinned.check,
+			Timeout:         30 * time.Second,
+		},
+	}, nil
+}
+
+func (p *SFTPProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *SFTPProvider) Type() string {
+	return "sftp"
+}
+
+// sftpSession 为单次操作使用的 SFTP 客户端，关闭时同时关闭底层的 SSH 连接
+type sftpSession struct {
+	*sftp.Client
+	close func() error
+}
+
+func (s *sftpSession) Close() error {
+	s.Client.Close()
+	return s.close()
+}
+
+// connect 建立 SSH 连接并启动 SFTP 子系统。ctx 被取消时连接随之关闭，进行中的操作立即返回
+func (p *SFTPProvider) connect(ctx context.Context) (*sftpSession, error) {
+	dialer := net.Dialer{Timeout: p.sshConfig.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", p.addr, err)
+	}
+
+	// 握手阶段同样受超时时间限制
+	conn.SetDeadline(time.Now().Add(p.sshConfig.Timeout))
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, p.addr, p.sshConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("SSH handshake with %s failed: %w", p.addr, err)
+	}
+	conn.SetDeadline(time.Time{})
+	client := ssh.NewClient(sshConn, chans, reqs)
+
+	stop := context.AfterFunc(ctx, func() { client.Close() })
+	sftpClient, err := sftp.NewClient(client, sftp.UseConcurrentWrites(true))
+	if err != nil {
+		stop()
+		client.Close()
+		return nil, fmt.Errorf("failed to start SFTP subsystem: %w", err)
+	}
+
+	return &sftpSession{Client: sftpClient, close: func() error {
+		stop()
+		return client.Close()
+	}}, nil
+}
+
+// remotePath 将对象名转换为远程路径，拒绝指向 BaseDir 之外的名称
+func (p *SFTPProvider) remotePath(name string) (string, error) {
+	cleaned, err := cleanObjectName(name)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(p.config.BaseDir, cleaned[1:]), nil
+}
+
+// baseDir 返回用于列出文件的远程根目录
+func (p *SFTPProvider) baseDir() string {
+	if p.config.BaseDir == "" {
+		return "."
+	}
+	return p.config.BaseDir
+}
+
+// unsizedReader 隐藏数据长度，使 pkg/sftp 按长度未知的数据流以最大并发写入
+type unsizedReader struct {
+	io.Reader
+}
+
+func (unsizedReader) Size() int64 {
+	return -1
+}
+
+// replace 将临时文件移动到目标位置。服务器支持 posix-rename 扩展时原子地替换目标文件，
+// 否则按照 SFTP 的 RENAME 语义目标文件存在时会失败，需要先删除目标文件
+func (s *sftpSession) replace(oldPath, newPath string) error {
+	if _, ok := s.HasExtension("posix-rename@openssh.com"); ok {
+		err := s.PosixRename(oldPath, newPath)
+		var status *sftp.StatusError
+		if !errors.As(err, &status) || status.FxCode() != sftp.ErrSSHFxOpUnsupported {
+			return err
+		}
+	}
+
+	if err := s.Remove(newPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return s.Rename(oldPath, newPath)
+}
+
+func (p *SFTPProvider) Upload(ctx context.Context, name string, reader io.Reader) error {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return err
+	}
+
+	client, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	dir := path.Dir(target)
+	if err := client.MkdirAll(dir); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("failed to generate temporary file name: %w", err)
+	}
+	tmp := path.Join(dir, tempPrefix+hex.EncodeToString(suffix))
+
+	file, err := client.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_EXCL)
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			client.Remove(tmp)
+		}
+	}()
+
+	if err := file.Chmod(0640); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to set file permissions: %w", err)
+	}
+	if _, err := file.ReadFrom(unsizedReader{contextReader{ctx: ctx, r: reader}}); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write to SFTP: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+	if err := client.replace(tmp, target); err != nil {
+		return fmt.Errorf("failed to move file into place: %w", err)
+	}
+	committed = true
+
+	return nil
+}
+
+// UploadStream 上传长度未知的数据流，数据边读边写入远程文件
+func (p *SFTPProvider) UploadStream(ctx context.Context, name string, reader io.Reader) error {
+	return p.Upload(ctx, name, reader)
+}
+
+func (p *SFTPProvider) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	return p.openFile(ctx, name, 0)
+}
+
+// sftpReader 为打开的远程文件，关闭时同时关闭连接
+type sftpReader struct {
+	*sftp.File
+	session *sftpSession
+}
+
+func (r *sftpReader) Close() error {
+	r.File.Close()
+	return r.session.Close()
+}
+
+// openFile 打开远程文件用于读取并定位到 offset
+func (p *SFTPProvider) openFile(ctx context.Context, name string, offset int64) (*sftpReader, error) {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := client.Open(target)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to open file on SFTP: %w", err)
+	}
+
+	reader := &sftpReader{File: file, session: client}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		reader.Close()
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (p *SFTPProvider) Delete(ctx context.Context, name string) error {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return err
+	}
+
+	client, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Remove(target); err != nil {
+		return fmt.Errorf("failed to delete from SFTP: %w", err)
+	}
+	return nil
+}
+
+// List 递归列出名称以 prefix 开头的文件，从 prefix 所在的目录开始遍历。不存在的目录视为空
+func (p *SFTPProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	dir := ""
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = prefix[:i+1]
+	}
+
+	root := p.baseDir()
+	if dir != "" {
+		var err error
+		if root, err = p.remotePath(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	var result []string
+	walker := client.Walk(root)
+	for walker.Step() {
+		if err := walker.Err(); err != nil {
+			if walker.Path() == root && errors.Is(err, os.ErrNotExist) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to list SFTP directory: %w", err)
+		}
+		if walker.Path() == root {
+			continue
+		}
+
+		name := dir + strings.TrimPrefix(walker.Path(), root+"/")
+		if root == "." {
+			name = walker.Path()
+		}
+
+		if walker.Stat().IsDir() {
+			if !strings.HasPrefix(name+"/", prefix) {
+				walker.SkipDir()
+			}
+			continue
+		}
+		if strings.HasPrefix(path.Base(name), tempPrefix) || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		result = append(result, name)
+	}
+
+	return result, nil
+}
+
+// stat 获取文件属性，文件不存在时返回 os.ErrNotExist
+func (p *SFTPProvider) stat(ctx context.Context, name string) (os.FileInfo, error) {
+	target, err := p.remotePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return client.Stat(target)
+}
+
+func (p *SFTPProvider) Exists(ctx context.Context, name string) (bool, error) {
+	if _, err := p.stat(ctx, name); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check SFTP file existence: %w", err)
+	}
+	return true, nil
+}
+
+// UploadPart SFTP 存储先写入临时文件再重命名，目标位置不会留下未完成的文件，
+// 因此不支持从 offset 续传，offset 不为 0 时返回 ErrResumeUnsupported
+func (p *SFTPProvider) UploadPart(ctx context.Context, name string, reader io.Reader, offset int64) error {
+	if offset != 0 {
+		return ErrResumeUnsupported
+	}
+	return p.Upload(ctx, name, reader)
+}
+
+// DownloadPart 下载文件的一部分
+func (p *SFTPProvider) DownloadPart(ctx context.Context, name string, offset, length int64) (io.ReadCloser, error) {
+	file, err := p.openFile(ctx, name, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(file, length), file}, nil
+}
+
+// GetFileSize 获取文件大小，文件不存在时返回 0
+func (p *SFTPProvider) GetFileSize(ctx context.Context, name string) (int64, error) {
+	info, err := p.stat(ctx, name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get SFTP file size: %w", err)
+	}
+	return info.Size(), nil
+}
+
+// HealthCheck 检查能否连接并认证，且远程目录存在时是一个目录
+func (p *SFTPProvider) HealthCheck(ctx context.Context) error {
+	client, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	info, err := client.Stat(p.baseDir())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// 第一次上传时会创建远程目录
+			return nil
+		}
+		return fmt.Errorf("failed to stat remote directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("remote path %s is not a directory", p.baseDir())
+	}
+	return nil
+}
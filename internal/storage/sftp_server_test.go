@@ -0,0 +1,256 @@
+package storage
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// testSFTPServer 为测试用的进程内 SFTP 服务器，将 root 目录作为登录用户的主目录
+type testSFTPServer struct {
+	root        string
+	host        string
+	port        int
+	hostKey     ssh.Signer
+	clientKey   string
+	password    string
+	posixRename bool
+}
+
+// startTestSFTPServer 启动 SFTP 服务器，接受密码 secret 或生成的客户端私钥登录
+func startTestSFTPServer(t *testing.T, posixRename bool) *testSFTPServer {
+	t.Helper()
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	hostKey, err := ssh.NewSignerFromKey(hostPriv)
+	if err != nil {
+		t.Fatalf("NewSignerFromKey() error = %v", err)
+	}
+
+	clientPub, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	clientSSHPub, err := ssh.NewPublicKey(clientPub)
+	if err != nil {
+		t.Fatalf("NewPublicKey() error = %v", err)
+	}
+	block, err := ssh.MarshalPrivateKey(clientPriv, "")
+	if err != nil {
+		t.Fatalf("MarshalPrivateKey() error = %v", err)
+	}
+
+	server := &testSFTPServer{
+		root:        t.TempDir(),
+		hostKey:     hostKey,
+		clientKey:   string(pem.EncodeToMemory(block)),
+		password:    "secret",
+		posixRename: posixRename,
+	}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == "test" && string(password) == server.password {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("invalid password")
+		},
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() == "test" && string(key.Marshal()) == string(clientSSHPub.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown public key")
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	server.host = host
+	server.port, _ = strconv.Atoi(port)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serveConn(conn, config)
+		}
+	}()
+
+	return server
+}
+
+// config 返回使用密码认证并固定主机密钥指纹的存储配置
+func (s *testSFTPServer) config() SFTPConfig {
+	return SFTPConfig{
+		Name:     "test",
+		Host:     s.host,
+		Port:     s.port,
+		Username: "test",
+		Password: s.password,
+		HostKey:  ssh.FingerprintSHA256(s.hostKey.PublicKey()),
+	}
+}
+
+func (s *testSFTPServer) serveConn(conn net.Conn, config *ssh.ServerConfig) {
+	defer conn.Close()
+
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			for req := range requests {
+				var subsystem struct{ Name string }
+				ok := req.Type == "subsystem" && ssh.Unmarshal(req.Payload, &subsystem) == nil && subsystem.Name == "sftp"
+				req.Reply(ok, nil)
+				if ok {
+					handler := testSFTPHandlers{server: s}
+					go func() {
+						defer channel.Close()
+						sftp.NewRequestServer(channel, sftp.Handlers{
+							FileGet:  handler,
+							FilePut:  handler,
+							FileCmd:  handler,
+							FileList: handler,
+						}).Serve()
+					}()
+				}
+			}
+		}()
+	}
+}
+
+// testSFTPHandlers 将请求映射到 root 目录中的文件
+type testSFTPHandlers struct {
+	server *testSFTPServer
+}
+
+func (h testSFTPHandlers) resolve(name string) string {
+	return filepath.Join(h.server.root, filepath.FromSlash(path.Clean("/"+name)))
+}
+
+func (h testSFTPHandlers) Fileread(r *sftp.Request) (io.ReaderAt, error) {
+	return os.Open(h.resolve(r.Filepath))
+}
+
+func (h testSFTPHandlers) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	pflags := r.Pflags()
+	flags := os.O_WRONLY
+	if pflags.Creat {
+		flags |= os.O_CREATE
+	}
+	if pflags.Trunc {
+		flags |= os.O_TRUNC
+	}
+	if pflags.Excl {
+		flags |= os.O_EXCL
+	}
+	return os.OpenFile(h.resolve(r.Filepath), flags, 0600)
+}
+
+func (h testSFTPHandlers) Filecmd(r *sftp.Request) error {
+	name := h.resolve(r.Filepath)
+	switch r.Method {
+	case "Setstat":
+		if r.AttrFlags().Permissions {
+			return os.Chmod(name, r.Attributes().FileMode().Perm())
+		}
+		return nil
+	case "Mkdir":
+		return os.Mkdir(name, 0750)
+	case "Rmdir":
+		return os.Remove(name)
+	case "Remove":
+		if info, err := os.Stat(name); err == nil && info.IsDir() {
+			return fmt.Errorf("is a directory")
+		}
+		return os.Remove(name)
+	case "Rename":
+		// 与 OpenSSH 一致，目标文件存在时拒绝重命名
+		target := h.resolve(r.Target)
+		if _, err := os.Stat(target); err == nil {
+			return fmt.Errorf("target exists")
+		}
+		return os.Rename(name, target)
+	}
+	return sftp.ErrSSHFxOpUnsupported
+}
+
+func (h testSFTPHandlers) PosixRename(r *sftp.Request) error {
+	if !h.server.posixRename {
+		return sftp.ErrSSHFxOpUnsupported
+	}
+	return os.Rename(h.resolve(r.Filepath), h.resolve(r.Target))
+}
+
+// testSFTPLister 每次最多返回两个条目，覆盖客户端多次读取目录的情况
+type testSFTPLister []fs.FileInfo
+
+func (l testSFTPLister) ListAt(infos []fs.FileInfo, offset int64) (int, error) {
+	if offset >= int64(len(l)) {
+		return 0, io.EOF
+	}
+	n := copy(infos[:min(2, len(infos))], l[offset:])
+	return n, nil
+}
+
+func (h testSFTPHandlers) Filelist(r *sftp.Request) (sftp.ListerAt, error) {
+	name := h.resolve(r.Filepath)
+	switch r.Method {
+	case "List":
+		entries, err := os.ReadDir(name)
+		if err != nil {
+			return nil, err
+		}
+		infos := make(testSFTPLister, 0, len(entries))
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil {
+				return nil, err
+			}
+			infos = append(infos, info)
+		}
+		return infos, nil
+	case "Stat":
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+		return testSFTPLister{info}, nil
+	}
+	return nil, sftp.ErrSSHFxOpUnsupported
+}
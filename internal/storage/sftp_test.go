@@ -0,0 +1,352 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func createTestSFTPProvider(t *testing.T, config SFTPConfig) *SFTPProvider {
+	t.Helper()
+	provider, err := NewSFTPProvider(config)
+	if err != nil {
+		t.Fatalf("NewSFTPProvider() error = %v", err)
+	}
+	return provider
+}
+
+func TestSFTPConfig_Validate(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	valid := server.config()
+
+	tests := []struct {
+		name    string
+		modify  func(c *SFTPConfig)
+		wantErr bool
+	}{
+		{"valid config", func(c *SFTPConfig) {}, false},
+		{"private key only", func(c *SFTPConfig) { c.Password = ""; c.PrivateKey = server.clientKey }, false},
+		{"missing host", func(c *SFTPConfig) { c.Host = "" }, true},
+		{"invalid port", func(c *SFTPConfig) { c.Port = 70000 }, true},
+		{"missing username", func(c *SFTPConfig) { c.Username = "" }, true},
+		{"missing credentials", func(c *SFTPConfig) { c.Password = "" }, true},
+		{"invalid private key", func(c *SFTPConfig) { c.PrivateKey = "not a key" }, true},
+		{"missing host key", func(c *SFTPConfig) { c.HostKey = "" }, true},
+		{"invalid host key", func(c *SFTPConfig) { c.HostKey = "not a key" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSFTPProvider_UploadDownload(t *testing.T) {
+	for _, posixRename := range []bool{true, false} {
+		server := startTestSFTPServer(t, posixRename)
+		config := server.config()
+		config.BaseDir = "backups/vaultwarden"
+		provider := createTestSFTPProvider(t, config)
+		ctx := context.Background()
+
+		// 远大于单个写请求的数据量，覆盖并发写入
+		testData := make([]byte, 4<<20+123)
+		rand.Read(testData)
+
+		if err := provider.Upload(ctx, "test-file.bin", bytes.NewReader(testData)); err != nil {
+			t.Fatalf("Upload() error = %v", err)
+		}
+		// 再次上传同名文件应覆盖原文件
+		if err := provider.Upload(ctx, "test-file.bin", bytes.NewReader(testData)); err != nil {
+			t.Fatalf("Upload() overwrite error = %v", err)
+		}
+
+		reader, err := provider.Download(ctx, "test-file.bin")
+		if err != nil {
+			t.Fatalf("Download() error = %v", err)
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+		if !bytes.Equal(data, testData) {
+			t.Errorf("Download() returned %d bytes, want %d", len(data), len(testData))
+		}
+
+		// 远程目录被逐级创建，且没有残留的临时文件
+		entries, err := os.ReadDir(filepath.Join(server.root, "backups", "vaultwarden"))
+		if err != nil {
+			t.Fatalf("ReadDir() error = %v", err)
+		}
+		if len(entries) != 1 || entries[0].Name() != "test-file.bin" {
+			t.Errorf("unexpected remote directory contents: %v", entries)
+		}
+		info, err := os.Stat(filepath.Join(server.root, "backups", "vaultwarden", "test-file.bin"))
+		if err != nil {
+			t.Fatalf("Stat() error = %v", err)
+		}
+		if info.Mode().Perm() != 0640 {
+			t.Errorf("uploaded file mode = %v, want 0640", info.Mode().Perm())
+		}
+	}
+}
+
+func TestSFTPProvider_PrivateKeyAuth(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	config := server.config()
+	config.Password = ""
+	config.PrivateKey = server.clientKey
+	provider := createTestSFTPProvider(t, config)
+
+	if err := provider.Upload(context.Background(), "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(server.root, "file.txt")); err != nil {
+		t.Errorf("uploaded file not found: %v", err)
+	}
+}
+
+func TestSFTPProvider_WrongPassword(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	config := server.config()
+	config.Password = "wrong"
+	provider := createTestSFTPProvider(t, config)
+
+	if err := provider.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error for wrong password, got nil")
+	}
+}
+
+func TestSFTPProvider_HostKey(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	hostKey := server.hostKey.PublicKey()
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey)))
+
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	otherKey, err := ssh.NewPublicKey(otherPub)
+	if err != nil {
+		t.Fatalf("NewPublicKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hostKey  string
+		mismatch bool
+	}{
+		{"fingerprint", ssh.FingerprintSHA256(hostKey), false},
+		{"authorized key", authorizedKey, false},
+		{"known hosts", "# pinned\n[" + server.host + "]:1234 " + authorizedKey, false},
+		{"one of several", ssh.FingerprintSHA256(otherKey) + "\n" + ssh.FingerprintSHA256(hostKey), false},
+		{"other key", ssh.FingerprintSHA256(otherKey), true},
+		{"revoked", "@revoked * " + authorizedKey + "\n" + ssh.FingerprintSHA256(hostKey), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := server.config()
+			config.HostKey = tt.hostKey
+			provider := createTestSFTPProvider(t, config)
+
+			err := provider.HealthCheck(context.Background())
+			if tt.mismatch {
+				if !errors.Is(err, ErrHostKeyMismatch) {
+					t.Errorf("HealthCheck() error = %v, want %v", err, ErrHostKeyMismatch)
+				}
+			} else if err != nil {
+				t.Errorf("HealthCheck() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestSFTPProvider_InvalidName(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	config := server.config()
+	config.BaseDir = "backups"
+	provider := createTestSFTPProvider(t, config)
+
+	for _, name := range []string{"", "../escape.txt", "a/../../escape.txt"} {
+		if err := provider.Upload(context.Background(), name, strings.NewReader("data")); err == nil {
+			t.Errorf("Upload(%q) expected error, got nil", name)
+		}
+	}
+}
+
+func TestSFTPProvider_Delete(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	provider := createTestSFTPProvider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := provider.Delete(ctx, "file.txt"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exists, err := provider.Exists(ctx, "file.txt")
+	if err != nil {
+		t.Fatalf("Exists() error = %v", err)
+	}
+	if exists {
+		t.Error("Exists() = true after Delete()")
+	}
+}
+
+func TestSFTPProvider_List(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	config := server.config()
+	config.BaseDir = "backups"
+	provider := createTestSFTPProvider(t, config)
+	ctx := context.Background()
+
+	for _, name := range []string{"backup-1.zip", "backup-2.zip", "backup-3.zip", "other.txt", "repo/chunk", "repo/nested/chunk"} {
+		if err := provider.Upload(ctx, name, strings.NewReader("data")); err != nil {
+			t.Fatalf("Upload(%q) error = %v", name, err)
+		}
+	}
+	// 未完成上传的临时文件不应被列出
+	for _, dir := range []string{"backups", "backups/repo"} {
+		if err := os.WriteFile(filepath.Join(server.root, dir, tempPrefix+"123"), []byte("partial"), 0600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"", []string{"backup-1.zip", "backup-2.zip", "backup-3.zip", "other.txt", "repo/chunk", "repo/nested/chunk"}},
+		{"backup-", []string{"backup-1.zip", "backup-2.zip", "backup-3.zip"}},
+		{"repo/", []string{"repo/chunk", "repo/nested/chunk"}},
+		{"repo/n", []string{"repo/nested/chunk"}},
+		{"re", []string{"repo/chunk", "repo/nested/chunk"}},
+		{"missing/", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := provider.List(ctx, tt.prefix)
+		if err != nil {
+			t.Fatalf("List(%q) error = %v", tt.prefix, err)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("List(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestSFTPProvider_ExistsAndSize(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	provider := createTestSFTPProvider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		wantExists bool
+		wantSize   int64
+	}{
+		{"file.txt", true, 10},
+		{"missing.txt", false, 0},
+	}
+
+	for _, tt := range tests {
+		exists, err := provider.Exists(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("Exists(%q) error = %v", tt.name, err)
+		}
+		if exists != tt.wantExists {
+			t.Errorf("Exists(%q) = %v, want %v", tt.name, exists, tt.wantExists)
+		}
+
+		size, err := provider.GetFileSize(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("GetFileSize(%q) error = %v", tt.name, err)
+		}
+		if size != tt.wantSize {
+			t.Errorf("GetFileSize(%q) = %d, want %d", tt.name, size, tt.wantSize)
+		}
+	}
+}
+
+func TestSFTPProvider_DownloadPart(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	provider := createTestSFTPProvider(t, server.config())
+	ctx := context.Background()
+
+	if err := provider.Upload(ctx, "file.txt", strings.NewReader("0123456789")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	reader, err := provider.DownloadPart(ctx, "file.txt", 2, 5)
+	if err != nil {
+		t.Fatalf("DownloadPart() error = %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(data) != "23456" {
+		t.Errorf("DownloadPart() = %q, want %q", data, "23456")
+	}
+}
+
+func TestSFTPProvider_HealthCheck(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	config := server.config()
+	config.BaseDir = "backups"
+	provider := createTestSFTPProvider(t, config)
+
+	// 远程目录尚不存在时同样视为健康
+	if err := provider.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(server.root, "backups"), []byte("file"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := provider.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() expected error when base directory is a file, got nil")
+	}
+}
+
+func TestSFTPProvider_Methods(t *testing.T) {
+	server := startTestSFTPServer(t, true)
+	provider := createTestSFTPProvider(t, server.config())
+
+	if provider.Name() != "test" {
+		t.Errorf("Name() = %s, want test", provider.Name())
+	}
+	if provider.Type() != "sftp" {
+		t.Errorf("Type() = %s, want sftp", provider.Type())
+	}
+
+	var _ Provider = provider
+	var _ HealthChecker = provider
+	var _ StreamUploader = provider
+}
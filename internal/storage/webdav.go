@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"strings"
 
 	"github.com/studio-b12/gowebdav"
 )
@@ -59,12 +58,9 @@ func (p *WebDAVProvider) Type() string {
 }
 
 func (p *WebDAVProvider) Upload(ctx context.Context, path string, reader io.Reader) error {
-	data, err := io.ReadAll(reader)
-	if err != nil {
-		return fmt.Errorf("failed to read data: %w", err)
-	}
-
-	if err := p.client.Write(path, data, 0644); err != nil {
+	// WriteStream 直接把 reader 作为请求体发送。reader 实现 io.Seeker 时，
+	// 认证重试会通过 seek 重新读取，而不是把整个请求体缓存在内存中
+	if err := p.client.WriteStream(path, reader, 0644); err != nil {
 		return fmt.Errorf("failed to upload to WebDAV: %w", err)
 	}
 
@@ -72,12 +68,12 @@ func (p *WebDAVProvider) Upload(ctx context.Context, path string, reader io.Read
 }
 
 func (p *WebDAVProvider) Download(ctx context.Context, path string) (io.ReadCloser, error) {
-	data, err := p.client.Read(path)
+	stream, err := p.client.ReadStream(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download from WebDAV: %w", err)
 	}
 
-	return io.NopCloser(strings.NewReader(string(data))), nil
+	return stream, nil
 }
 
 func (p *WebDAVProvider) Delete(ctx context.Context, path string) error {
@@ -90,6 +86,10 @@ func (p *WebDAVProvider) Delete(ctx context.Context, path string) error {
 func (p *WebDAVProvider) List(ctx context.Context, prefix string) ([]string, error) {
 	files, err := p.client.ReadDir(prefix)
 	if err != nil {
+		// 与 S3 保持一致，不存在的目录视为空
+		if gowebdav.IsErrNotFound(err) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("failed to list WebDAV directory: %w", err)
 	}
 
@@ -106,7 +106,7 @@ func (p *WebDAVProvider) List(ctx context.Context, prefix string) ([]string, err
 func (p *WebDAVProvider) Exists(ctx context.Context, path string) (bool, error) {
 	info, err := p.client.Stat(path)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if gowebdav.IsErrNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("failed to check WebDAV file existence: %w", err)
@@ -115,41 +115,30 @@ func (p *WebDAVProvider) Exists(ctx context.Context, path string) (bool, error)
 	return info != nil, nil
 }
 
-// UploadPart 上传文件的一部分（WebDAV不直接支持，我们实现为完整上传）
+// UploadPart WebDAV 协议不支持从中间位置写入文件，只能从头上传
 func (p *WebDAVProvider) UploadPart(ctx context.Context, path string, reader io.Reader, offset int64) error {
-	// WebDAV协议本身不支持断点续传，这里简化实现为完整上传
-	// 在实际生产环境中，可以考虑使用其他机制实现断点续传
+	if offset != 0 {
+		return ErrResumeUnsupported
+	}
 	return p.Upload(ctx, path, reader)
 }
 
 // DownloadPart 下载文件的一部分
 func (p *WebDAVProvider) DownloadPart(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
-	// WebDAV协议本身不支持范围请求，这里简化实现为完整下载然后截取
-	data, err := p.client.Read(path)
+	// 使用 Range 请求；服务器不支持时 gowebdav 会自行跳过多余的数据
+	stream, err := p.client.ReadStreamRange(path, offset, length)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download from WebDAV: %w", err)
 	}
 
-	// 计算实际读取范围
-	end := offset + length
-	if end > int64(len(data)) {
-		end = int64(len(data))
-	}
-
-	// 截取数据
-	if offset < int64(len(data)) {
-		chunk := data[offset:end]
-		return io.NopCloser(strings.NewReader(string(chunk))), nil
-	}
-
-	return io.NopCloser(strings.NewReader("")), nil
+	return stream, nil
 }
 
 // GetFileSize 获取文件大小
 func (p *WebDAVProvider) GetFileSize(ctx context.Context, path string) (int64, error) {
 	info, err := p.client.Stat(path)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if gowebdav.IsErrNotFound(err) {
 			return 0, nil
 		}
 		return 0, fmt.Errorf("failed to get WebDAV file size: %w", err)
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
 	"time"
+
+	"golang.org/x/net/webdav"
 )
 
 // MockWebDAVClient 模拟WebDAV客户端
@@ -530,4 +533,36 @@ func TestWebDAVProvider_Methods(t *testing.T) {
 	if provider.Type() != "webdav" {
 		t.Errorf("Type() expected webdav, got %s", provider.Type())
 	}
-}
\ No newline at end of file
+}
+
+// TestWebDAVProvider_NotFound 使用 golang.org/x/net/webdav 服务器检查不存在的文件和目录
+func TestWebDAVProvider_NotFound(t *testing.T) {
+	server := httptest.NewServer(&webdav.Handler{
+		FileSystem: webdav.NewMemFS(),
+		LockSystem: webdav.NewMemLS(),
+	})
+	t.Cleanup(server.Close)
+
+	provider, err := NewWebDAVProvider(WebDAVConfig{Name: "test", URL: server.URL, Username: "user", Password: "pass"})
+	if err != nil {
+		t.Fatalf("NewWebDAVProvider() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if exists, err := provider.Exists(ctx, "missing.zip"); err != nil || exists {
+		t.Errorf("Exists() = %v, %v, want false, nil", exists, err)
+	}
+	if size, err := provider.GetFileSize(ctx, "missing.zip"); err != nil || size != 0 {
+		t.Errorf("GetFileSize() = %d, %v, want 0, nil", size, err)
+	}
+	if files, err := provider.List(ctx, "missing/"); err != nil || len(files) != 0 {
+		t.Errorf("List() = %v, %v, want no files", files, err)
+	}
+
+	if err := provider.Upload(ctx, "backup.zip", strings.NewReader("data")); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if exists, err := provider.Exists(ctx, "backup.zip"); err != nil || !exists {
+		t.Errorf("Exists() = %v, %v, want true, nil", exists, err)
+	}
+}
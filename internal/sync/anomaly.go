@@ -0,0 +1,170 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+// defaultQuarantinePrefix 为可疑备份在存储中的默认目录
+const defaultQuarantinePrefix = "quarantine"
+
+// anomalyHistoryRuns 为与新备份比较的最近备份次数。比较时使用它们的中位数，
+// 个别异常的备份不会影响判断
+const anomalyHistoryRuns = 5
+
+// anomalyHistoryBatch 为读取备份历史时每次查询的任务数
+const anomalyHistoryBatch = 50
+
+// minAnomalyCount 为参与比较的文件数和数据条数的最小基准值，数量很少时百分比的变化没有意义
+const minAnomalyCount = 10
+
+// SetAnomalyDetection 设置异常备份的检测。数据目录大小、文件数或密码库数据条数相对最近备份的中位数
+// 减少超过 shrinkPercent，或上一次备份中超过 changedPercent 的文件同时被修改或删除（勒索软件加密文件的特征）时，
+// 备份被标记为可疑并上传到 quarantinePrefix 目录下。百分比为 0 时关闭对应的检查。
+func (s *Service) SetAnomalyDetection(shrinkPercent, changedPercent int, quarantinePrefix string) {
+	s.shrinkPercent = shrinkPercent
+	s.changedPercent = changedPercent
+	if prefix := strings.Trim(quarantinePrefix, "/"); prefix != "" {
+		s.quarantinePrefix = prefix
+	}
+}
+
+// checkAnomalies 在上传前检查新备份是否异常，返回备份在存储中的对象名和可疑的原因。
+// vault 为备份中数据库的数据条数。可疑的备份放在隔离目录下，与正常的备份分开保存。
+func (s *Service) checkAnomalies(ctx context.Context, changes backup.ChangeSummary, vault *backup.VaultStats, filename string) (string, []string) {
+	reasons := s.detectAnomalies(ctx, changes, vault)
+	if len(reasons) == 0 {
+		return filename, nil
+	}
+
+	log.Printf("Backup %s looks suspicious: %s", filename, strings.Join(reasons, "; "))
+	return path.Join(s.quarantinePrefix, filename), reasons
+}
+
+// detectAnomalies 将新备份与最近的备份比较，返回可疑的原因；没有异常时返回 nil
+func (s *Service) detectAnomalies(ctx context.Context, changes backup.ChangeSummary, vault *backup.VaultStats) []string {
+	var reasons []string
+
+	if s.changedPercent > 0 && changes.Compared >= minAnomalyCount {
+		if percent := changes.ChangedRatio() * 100; percent >= float64(s.changedPercent) {
+			reasons = append(reasons, fmt.Sprintf("%d of %d files were modified or deleted since the last backup (%.0f%%)",
+				changes.Changed+changes.Deleted, changes.Compared, percent))
+		}
+	}
+
+	if s.shrinkPercent <= 0 {
+		return reasons
+	}
+
+	history, err := s.anomalyHistory(ctx)
+	if err != nil {
+		log.Printf("Failed to load backup history for anomaly detection: %v", err)
+		return reasons
+	}
+
+	check := func(name string, current int64, minimum int64, value func(*ent.SyncJob) int64) {
+		baseline := medianOf(history, value)
+		if baseline < minimum || baseline <= 0 || current >= baseline {
+			return
+		}
+		if percent := float64(baseline-current) * 100 / float64(baseline); percent >= float64(s.shrinkPercent) {
+			reasons = append(reasons, fmt.Sprintf("%s dropped from %d to %d (-%.0f%%) compared with recent backups",
+				name, baseline, current, percent))
+		}
+	}
+
+	check("data size", changes.Size, 1, func(job *ent.SyncJob) int64 { return job.DataSize })
+	check("file count", int64(changes.Files), minAnomalyCount, func(job *ent.SyncJob) int64 { return int64(job.DataFiles) })
+	// Send 会按设置的时间自动过期，数量大幅变化是正常的，不参与比较
+	if vault != nil {
+		check("user count", vault.Users, minAnomalyCount, func(job *ent.SyncJob) int64 { return job.VaultUsers })
+		check("organization count", vault.Organizations, minAnomalyCount, func(job *ent.SyncJob) int64 { return job.VaultOrganizations })
+		check("cipher count", vault.Ciphers, minAnomalyCount, func(job *ent.SyncJob) int64 { return job.VaultCiphers })
+		check("attachment count", vault.Attachments, minAnomalyCount, func(job *ent.SyncJob) int64 { return job.VaultAttachments })
+	}
+
+	return reasons
+}
+
+// anomalyHistory 返回最近 anomalyHistoryRuns 次备份的任务记录。同一次备份上传到多个存储时
+// 每个存储各有一条任务记录，它们的数据指纹相同，只保留其中一条，
+// 否则存储较多时历史只覆盖最近一次备份。可疑的备份也计入历史，数据被有意清理后逐渐成为新的基准
+func (s *Service) anomalyHistory(ctx context.Context) ([]*ent.SyncJob, error) {
+	var history []*ent.SyncJob
+	var last *ent.SyncJob
+
+	for offset := 0; len(history) < anomalyHistoryRuns; offset += anomalyHistoryBatch {
+		jobs, err := s.client.SyncJob.Query().
+			Where(
+				s.sourceJobs(),
+				syncjob.OperationEQ(syncjob.OperationBackup),
+				syncjob.StatusIn(syncjob.StatusCompleted, syncjob.StatusSuspicious),
+				syncjob.DataSizeNotNil(),
+			).
+			Order(ent.Desc(syncjob.FieldCreatedAt), ent.Desc(syncjob.FieldID)).
+			Offset(offset).
+			Limit(anomalyHistoryBatch).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range jobs {
+			// 早期的任务没有记录指纹，每条都算作一次备份
+			if last != nil && job.Fingerprint != "" && job.Fingerprint == last.Fingerprint {
+				continue
+			}
+			last = job
+			history = append(history, job)
+			if len(history) == anomalyHistoryRuns {
+				break
+			}
+		}
+
+		if len(jobs) < anomalyHistoryBatch {
+			break
+		}
+	}
+
+	return history, nil
+}
+
+// medianOf 返回历史任务中非零值的中位数，没有记录时返回 0。
+// 早期的任务没有记录对应的统计，值为 0，不参与计算。
+func medianOf(jobs []*ent.SyncJob, value func(*ent.SyncJob) int64) int64 {
+	var values []int64
+	for _, job := range jobs {
+		if v := value(job); v > 0 {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return 0
+	}
+
+	slices.Sort(values)
+	return values[(len(values)-1)/2]
+}
+
+// markSuspicious 将已上传到隔离目录的备份任务标记为可疑
+func (s *Service) markSuspicious(ctx context.Context, jobID int, objectName string, reasons []string, summary string) error {
+	return s.updateJobStatus(ctx, jobID, syncjob.StatusSuspicious, fmt.Sprintf(
+		"Suspicious backup quarantined as %s%s: %s", objectName, summary, strings.Join(reasons, "; ")))
+}
+
+// alertSuspicious 发送可疑备份的告警
+func (s *Service) alertSuspicious(objectName string, reasons []string) {
+	s.alert("Suspicious Vaultwarden backup quarantined", fmt.Sprintf(
+		"The latest backup differs sharply from recent backups and was uploaded to %s instead of replacing them. "+
+			"It will not be used as the base for incremental backups.\n\n%s\n\n"+
+			"Check the Vaultwarden data directory for accidental deletion or ransomware before trusting new backups.",
+		objectName, strings.Join(reasons, "\n")))
+}
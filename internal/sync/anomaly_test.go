@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+// createBackupRun 为一次上传到 storages 个存储的备份创建任务记录
+func createBackupRun(t *testing.T, service *Service, run, storages int, dataSize int64) {
+	t.Helper()
+
+	createdAt := time.Now().Add(time.Duration(run-100) * time.Hour)
+	for i := 0; i < storages; i++ {
+		if _, err := service.newSyncJob().
+			SetStatus(syncjob.StatusCompleted).
+			SetOperation(syncjob.OperationBackup).
+			SetFingerprint(fmt.Sprintf("run-%d", run)).
+			SetDataSize(dataSize).
+			SetCreatedAt(createdAt.Add(time.Duration(i) * time.Second)).
+			Save(context.Background()); err != nil {
+			t.Fatalf("Failed to create sync job: %v", err)
+		}
+	}
+}
+
+func TestAnomalyHistoryCountsRunsNotStorages(t *testing.T) {
+	service := newTestService(t, newTestClient(t), t.TempDir())
+	service.SetAnomalyDetection(50, 0, "")
+
+	// 三次小备份之后有一次较大的备份上传到了 5 个存储
+	for run := 1; run <= 3; run++ {
+		createBackupRun(t, service, run, 1, 100)
+	}
+	createBackupRun(t, service, 4, 5, 1000)
+
+	history, err := service.anomalyHistory(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to load history: %v", err)
+	}
+	if len(history) != 4 {
+		t.Fatalf("Expected one history entry per run, got %d", len(history))
+	}
+	for i, want := range []string{"run-4", "run-3", "run-2", "run-1"} {
+		if history[i].Fingerprint != want {
+			t.Errorf("history[%d] = %s, want %s", i, history[i].Fingerprint, want)
+		}
+	}
+
+	// 与最近 4 次备份的中位数比较，不是与最近一次备份的 5 条记录比较
+	if reasons := service.detectAnomalies(context.Background(), backup.ChangeSummary{Size: 100}, nil); len(reasons) != 0 {
+		t.Errorf("Did not expect anomalies, got %v", reasons)
+	}
+	if reasons := service.detectAnomalies(context.Background(), backup.ChangeSummary{Size: 40}, nil); len(reasons) != 1 {
+		t.Errorf("Expected the data size to be flagged, got %v", reasons)
+	}
+}
+
+func TestAnomalyHistoryLimit(t *testing.T) {
+	service := newTestService(t, newTestClient(t), t.TempDir())
+
+	// 每次备份的记录数超过一次查询的批次时也能找到足够的备份
+	for run := 1; run <= anomalyHistoryRuns+2; run++ {
+		createBackupRun(t, service, run, anomalyHistoryBatch/2+1, int64(run*100))
+	}
+
+	history, err := service.anomalyHistory(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to load history: %v", err)
+	}
+	if len(history) != anomalyHistoryRuns {
+		t.Fatalf("Expected %d runs, got %d", anomalyHistoryRuns, len(history))
+	}
+	if history[0].Fingerprint != fmt.Sprintf("run-%d", anomalyHistoryRuns+2) {
+		t.Errorf("Expected the latest run first, got %s", history[0].Fingerprint)
+	}
+}
@@ -0,0 +1,124 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	entstorage "github.com/ca-x/vaultwarden-syncer/ent/storage"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/hook"
+)
+
+// SetHooks 设置备份前后执行的 hook 命令
+func (s *Service) SetHooks(runner *hook.Runner) {
+	s.hooks = runner
+}
+
+// formatHookOutput 将一次 hook 执行的结果格式化为记录在任务上的文本
+func formatHookOutput(event hook.Event, output string, err error) string {
+	status := "ok"
+	if err != nil {
+		status = err.Error()
+	}
+	if output != "" && !strings.HasSuffix(output, "\n") {
+		output += "\n"
+	}
+	return fmt.Sprintf("[%s] %s\n%s", event, status, output)
+}
+
+// runPreBackupHook 在生成备份前执行 pre_backup 命令，返回需要记录到各个任务上的输出。
+// 命令失败时为每个存储记录一个失败的任务并返回错误，本次备份被中止。
+func (s *Service) runPreBackupHook(ctx context.Context, storageIDs []int) (string, error) {
+	if !s.hooks.Enabled(hook.PreBackup) {
+		return "", nil
+	}
+
+	storages, err := s.client.Storage.Query().Where(entstorage.IDIn(storageIDs...)).All(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get storages: %w", err)
+	}
+	names := make([]string, len(storages))
+	for i, st := range storages {
+		names[i] = st.Name
+	}
+
+	output, err := s.hooks.Run(ctx, hook.PreBackup, hook.Job{
+		Source:  s.sourceName(),
+		Storage: strings.Join(names, ","),
+		Status:  string(syncjob.StatusRunning),
+	})
+	output = formatHookOutput(hook.PreBackup, output, err)
+	if err == nil {
+		return output, nil
+	}
+
+	log.Printf("Pre-backup hook failed, backup aborted: %v", err)
+	for _, id := range storageIDs {
+		job, cerr := s.newSyncJob().
+			SetStatus(syncjob.StatusPending).
+			SetOperation(syncjob.OperationBackup).
+			SetStorageID(id).
+			SetHookOutput(output).
+			Save(ctx)
+		if cerr != nil {
+			log.Printf("Failed to create sync job for storage %d: %v", id, cerr)
+			continue
+		}
+		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Pre-backup hook failed, backup aborted: %v", err))
+		s.runPostBackupHooks(ctx, job.ID)
+	}
+
+	return "", fmt.Errorf("pre-backup hook failed: %w", err)
+}
+
+// runPostBackupHooks 在备份任务结束后执行 post_backup 命令，再根据任务的状态执行 on_success 或 on_failure 命令，
+// 输出追加到任务上。hook 的失败只记录下来，不影响任务的状态。
+func (s *Service) runPostBackupHooks(ctx context.Context, jobID int) {
+	if !s.hooks.Enabled(hook.PostBackup) && !s.hooks.Enabled(hook.OnSuccess) && !s.hooks.Enabled(hook.OnFailure) {
+		return
+	}
+
+	job, err := s.client.SyncJob.Query().Where(syncjob.ID(jobID)).WithStorage().Only(ctx)
+	if err != nil {
+		log.Printf("Failed to get sync job %d for hooks: %v", jobID, err)
+		return
+	}
+
+	info := hook.Job{
+		ID:       job.ID,
+		Source:   s.sourceName(),
+		Filename: job.Filename,
+		Size:     job.ArchiveSize,
+		Status:   string(job.Status),
+		Message:  job.Message,
+	}
+	if job.Edges.Storage != nil {
+		info.Storage = job.Edges.Storage.Name
+	}
+
+	events := []hook.Event{hook.PostBackup}
+	switch job.Status {
+	case syncjob.StatusCompleted:
+		events = append(events, hook.OnSuccess)
+	case syncjob.StatusFailed, syncjob.StatusSuspicious:
+		events = append(events, hook.OnFailure)
+	}
+
+	outputs := job.HookOutput
+	for _, event := range events {
+		if !s.hooks.Enabled(event) {
+			continue
+		}
+		output, err := s.hooks.Run(ctx, event, info)
+		if err != nil {
+			log.Printf("Hook %s for sync job %d failed: %v", event, jobID, err)
+		}
+		outputs += formatHookOutput(event, output, err)
+	}
+
+	if err := s.client.SyncJob.UpdateOneID(jobID).SetHookOutput(outputs).Exec(ctx); err != nil {
+		log.Printf("Failed to record hook output: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+// isIntegrityFailure 判断备份是否因为数据库未通过完整性检查而失败
+func isIntegrityFailure(err error) bool {
+	return errors.Is(err, backup.ErrDatabaseCorrupt)
+}
+
+// failIntegrityCheck 将数据库完整性检查的失败记录到任务上。此时不会生成和上传新的备份，
+// 存储中已有的备份保持不变，也不会被保留策略清理。
+func (s *Service) failIntegrityCheck(ctx context.Context, jobID int, err error) {
+	if uerr := s.client.SyncJob.UpdateOneID(jobID).SetIntegrityCheck(err.Error()).Exec(ctx); uerr != nil {
+		log.Printf("Failed to record integrity check result: %v", uerr)
+	}
+	s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Refusing to back up a corrupt database: %v", err))
+}
+
+// failIntegrityCheckForStorages 为每个存储记录一个因数据库损坏而失败的备份任务
+func (s *Service) failIntegrityCheckForStorages(ctx context.Context, storageIDs []int, err error) {
+	for _, id := range storageIDs {
+		job, cerr := s.newSyncJob().
+			SetStatus(syncjob.StatusPending).
+			SetOperation(syncjob.OperationBackup).
+			SetStorageID(id).
+			Save(ctx)
+		if cerr != nil {
+			log.Printf("Failed to create sync job for storage %d: %v", id, cerr)
+			continue
+		}
+		s.failIntegrityCheck(ctx, job.ID, err)
+		s.runPostBackupHooks(ctx, job.ID)
+	}
+}
+
+// alertIntegrityFailure 发送数据库损坏的告警
+func (s *Service) alertIntegrityFailure(err error) {
+	log.Printf("Vaultwarden database failed the integrity check, backup aborted: %v", err)
+	s.alert("Vaultwarden database integrity check failed",
+		fmt.Sprintf("The Vaultwarden database failed the pre-backup integrity check, so no new backup was created "+
+			"and existing backups were left untouched.\n\n%v", err))
+}
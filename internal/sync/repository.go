@@ -0,0 +1,193 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/repository"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+	"github.com/cloudflare/backoff"
+)
+
+// repositoryPrefix 为去重仓库在存储中的目录
+const repositoryPrefix = "vaultwarden-repository"
+
+var (
+	// ErrRepositoryDisabled 表示没有启用去重仓库
+	ErrRepositoryDisabled = errors.New("repository mode is disabled")
+	// ErrBackupRunning 表示有正在写入仓库的备份，此时不能清理仓库
+	ErrBackupRunning = errors.New("a backup to the repository is running")
+)
+
+// repositoryLock 防止清理仓库与备份同时进行：备份在开始时列出已有的分块并跳过上传，
+// 上传的分块在快照写入前也不被引用，同时清理会删除这些分块。备份持有读锁，清理持有写锁
+var repositoryLock sync.RWMutex
+
+// SetRepositoryMode 设置是否使用去重仓库代替每次上传一个归档
+func (s *Service) SetRepositoryMode(enabled bool, password string) {
+	s.repositoryMode = enabled
+	s.repositoryPassword = password
+}
+
+// syncToRepository 将数据目录写入存储中的去重仓库
+func (s *Service) syncToRepository(ctx context.Context, jobID int, provider storageProvider.Provider) error {
+	integrity, err := s.backupService.CheckDatabase(ctx)
+	if err != nil {
+		if isIntegrityFailure(err) {
+			s.failIntegrityCheck(ctx, jobID, err)
+			s.alertIntegrityFailure(err)
+		} else {
+			s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Failed to check database integrity: %v", err))
+		}
+		return fmt.Errorf("failed to check database integrity: %w", err)
+	}
+	update := s.client.SyncJob.UpdateOneID(jobID).SetIntegrityCheck(integrity)
+	if fingerprint, err := s.backupService.Fingerprint(); err == nil {
+		update = update.SetFingerprint(fingerprint)
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.Printf("Failed to record backup details: %v", err)
+	}
+
+	if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, "Writing snapshot to repository..."); err != nil {
+		return err
+	}
+
+	filter := s.backupService.PathFilter()
+	maxDuration := s.retryDelay * time.Duration(s.maxRetries)
+	b := backoff.New(maxDuration, s.retryDelay)
+	var lastErr error
+
+	for i := 0; i <= s.maxRetries; i++ {
+		if i > 0 {
+			if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Retrying snapshot (%d/%d)...", i, s.maxRetries)); err != nil {
+				return err
+			}
+			select {
+			case <-time.After(b.Duration()):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		// 重试时已上传的分块会被复用，相当于断点续传
+		stats, err := s.backupToRepository(ctx, provider, filter.Include, filter.Exclude)
+		if err == nil {
+			return s.updateJobStatus(ctx, jobID, syncjob.StatusCompleted, fmt.Sprintf(
+				"Snapshot %s saved: %d files, %d new chunks (%d bytes uploaded), %d reused chunks",
+				stats.SnapshotID, stats.Files, stats.NewChunks, stats.UploadedBytes, stats.ReusedChunks))
+		}
+
+		lastErr = err
+		log.Printf("Snapshot attempt %d failed: %v", i+1, err)
+	}
+
+	s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Failed to write snapshot after retries: %v", lastErr))
+	return fmt.Errorf("failed to write snapshot after retries: %w", lastErr)
+}
+
+func (s *Service) backupToRepository(ctx context.Context, provider storageProvider.Provider, include, exclude []string) (*repository.Stats, error) {
+	repositoryLock.RLock()
+	defer repositoryLock.RUnlock()
+
+	repo, err := repository.Open(ctx, provider, s.repositoryDir(), s.repositoryPassword, s.backupService.KDF())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	return repo.Backup(ctx, s.backupService.WalkFiles, include, exclude)
+}
+
+// restoreFromRepository 将去重仓库中的快照恢复到 destPath（恢复使用的暂存目录）
+func (s *Service) restoreFromRepository(ctx context.Context, jobID int, provider storageProvider.Provider, snapshotID, destPath string) error {
+	repo, err := repository.Open(ctx, provider, s.repositoryDir(), s.repositoryPassword, s.backupService.KDF())
+	if err != nil {
+		s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Failed to open repository: %v", err))
+		return fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Restoring snapshot %s...", snapshotID)); err != nil {
+		return err
+	}
+
+	if err := repo.Restore(ctx, snapshotID, destPath); err != nil {
+		s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Failed to restore snapshot: %v", err))
+		return fmt.Errorf("failed to restore snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// openRepository 打开存储中当前数据源的去重仓库
+func (s *Service) openRepository(ctx context.Context, storageID int) (*repository.Repository, error) {
+	if !s.repositoryMode {
+		return nil, ErrRepositoryDisabled
+	}
+
+	storage, err := s.client.Storage.Get(ctx, storageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage: %w", err)
+	}
+	provider, err := s.createStorageProvider(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage provider: %w", err)
+	}
+
+	repo, err := repository.Open(ctx, provider, s.repositoryDir(), s.repositoryPassword, s.backupService.KDF())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+	return repo, nil
+}
+
+// RepositorySnapshots 返回存储的去重仓库中的快照，按创建时间排序
+func (s *Service) RepositorySnapshots(ctx context.Context, storageID int) ([]*repository.Snapshot, error) {
+	repo, err := s.openRepository(ctx, storageID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := repo.Snapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*repository.Snapshot, 0, len(ids))
+	for _, id := range ids {
+		snapshot, err := repo.LoadSnapshot(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load snapshot %s: %w", id, err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// ForgetSnapshots 删除存储的去重仓库中的快照，并删除不再被任何快照引用的分块。
+// 有正在写入仓库的备份时返回 ErrBackupRunning
+func (s *Service) ForgetSnapshots(ctx context.Context, storageID int, ids []string) (*repository.PruneStats, error) {
+	if !repositoryLock.TryLock() {
+		return nil, ErrBackupRunning
+	}
+	defer repositoryLock.Unlock()
+
+	repo, err := s.openRepository(ctx, storageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.Forget(ctx, ids...); err != nil {
+		return nil, err
+	}
+	stats, err := repo.Prune(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune repository: %w", err)
+	}
+	log.Printf("Forgot %d snapshots in %s: %d chunks deleted, %d chunks kept", len(ids), s.repositoryDir(), stats.DeletedChunks, stats.KeptChunks)
+	return stats, nil
+}
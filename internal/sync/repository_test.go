@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestForgetRepositorySnapshots(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	a, _ := createLocalStorage(t, client, "a")
+
+	dataDir := t.TempDir()
+	service := newTestService(t, client, dataDir)
+	if _, err := service.RepositorySnapshots(ctx, a.ID); !errors.Is(err, ErrRepositoryDisabled) {
+		t.Fatalf("Expected ErrRepositoryDisabled without repository mode, got %v", err)
+	}
+	service.SetRepositoryMode(true, "repository-password")
+
+	writeDataFile(t, dataDir, "config.json", "v1")
+	if err := service.SyncToStorage(ctx, a.ID); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+	writeDataFile(t, dataDir, "config.json", "v2")
+	if err := service.SyncToStorage(ctx, a.ID); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+
+	snapshots, err := service.RepositorySnapshots(ctx, a.ID)
+	if err != nil {
+		t.Fatalf("Failed to list snapshots: %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("Expected 2 snapshots, got %d", len(snapshots))
+	}
+
+	// 有正在写入仓库的备份时不能清理
+	repositoryLock.RLock()
+	_, err = service.ForgetSnapshots(ctx, a.ID, []string{snapshots[0].ID})
+	repositoryLock.RUnlock()
+	if !errors.Is(err, ErrBackupRunning) {
+		t.Fatalf("Expected ErrBackupRunning during a backup, got %v", err)
+	}
+
+	stats, err := service.ForgetSnapshots(ctx, a.ID, []string{snapshots[0].ID})
+	if err != nil {
+		t.Fatalf("Failed to forget snapshot: %v", err)
+	}
+	if stats.Snapshots != 1 || stats.DeletedChunks == 0 {
+		t.Errorf("Expected the chunk only referenced by the forgotten snapshot to be deleted, got %+v", stats)
+	}
+
+	remaining, err := service.RepositorySnapshots(ctx, a.ID)
+	if err != nil || len(remaining) != 1 || remaining[0].ID != snapshots[1].ID {
+		t.Fatalf("Expected only snapshot %s to remain, got %v (%v)", snapshots[1].ID, remaining, err)
+	}
+}
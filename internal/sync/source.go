@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	"github.com/ca-x/vaultwarden-syncer/ent/predicate"
+	entsource "github.com/ca-x/vaultwarden-syncer/ent/source"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+// ForSource 返回备份指定数据源的服务。数据源使用自己的数据目录、备份规则、加密设置和增量备份状态，
+// 其余配置与默认实例相同；备份文件名和去重仓库目录包含数据源名称，任务关联到该数据源。
+func (s *Service) ForSource(ctx context.Context, sourceID int) (*Service, error) {
+	source, err := s.client.Source.Get(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source: %w", err)
+	}
+	// 名称会用作本地状态目录、去重仓库目录和备份文件名，不能指向这些目录之外
+	if err := backup.ValidateSourceName(source.Name); err != nil {
+		return nil, fmt.Errorf("source %d: %w", source.ID, err)
+	}
+
+	opts := s.backupService.Options()
+	opts.Name = source.Name
+	opts.VaultwardenDataPath = source.DataPath
+	if len(source.Include) > 0 {
+		opts.Include = source.Include
+	}
+	if len(source.Exclude) > 0 {
+		opts.Exclude = source.Exclude
+	}
+	if source.Password != "" || len(source.Recipients) > 0 {
+		opts.Password = source.Password
+		opts.Recipients = source.Recipients
+	}
+	// 每个数据源的增量备份链相互独立
+	if opts.StateDir != "" {
+		opts.StateDir = filepath.Join(opts.StateDir, "sources", source.Name)
+	}
+
+	scoped := *s
+	scoped.backupService = backup.NewService(opts)
+	scoped.source = source
+	return &scoped, nil
+}
+
+// Source 返回服务备份的数据源，默认实例返回 nil
+func (s *Service) Source() *ent.Source {
+	return s.source
+}
+
+// sourceName 返回数据源名称，默认实例返回空字符串
+func (s *Service) sourceName() string {
+	if s.source == nil {
+		return ""
+	}
+	return s.source.Name
+}
+
+// newSyncJob 返回关联到当前数据源的任务创建器
+func (s *Service) newSyncJob() *ent.SyncJobCreate {
+	create := s.client.SyncJob.Create()
+	if s.source != nil {
+		create = create.SetSourceID(s.source.ID)
+	}
+	return create
+}
+
+// sourceJobs 返回只匹配当前数据源的任务的条件，用于与同一实例之前的备份比较
+func (s *Service) sourceJobs() predicate.SyncJob {
+	if s.source == nil {
+		return syncjob.Not(syncjob.HasSource())
+	}
+	return syncjob.HasSourceWith(entsource.IDEQ(s.source.ID))
+}
+
+// repositoryDir 返回当前数据源的去重仓库在存储中的目录
+func (s *Service) repositoryDir() string {
+	if name := s.sourceName(); name != "" {
+		return repositoryPrefix + "-" + name
+	}
+	return repositoryPrefix
+}
@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+)
+
+// spoolSpaceMargin 为估计归档大小时额外预留的空间，容纳归档头、manifest 以及无法压缩的数据
+const spoolSpaceMargin = 16 << 20
+
+// spooledBackup 是落盘到临时文件的备份，可以被多次、并发地读取
+type spooledBackup struct {
+	file *os.File
+	size int64
+}
+
+// Close 关闭并删除临时文件
+func (b *spooledBackup) Close() error {
+	err := b.file.Close()
+	os.Remove(b.file.Name())
+	return err
+}
+
+// reader 返回从头读取备份的 reader，多个 reader 可以并发使用
+func (b *spooledBackup) reader() *io.SectionReader {
+	return io.NewSectionReader(b.file, 0, b.size)
+}
+
+// SetTempDir 设置备份和下载写入临时文件时使用的目录，为空时使用系统临时目录
+func (s *Service) SetTempDir(dir string) {
+	s.tempDir = dir
+}
+
+// createTempFile 在临时目录中创建临时文件
+func (s *Service) createTempFile() (*os.File, error) {
+	if s.tempDir != "" {
+		if err := os.MkdirAll(s.tempDir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+	}
+
+	file, err := os.CreateTemp(s.tempDir, "vaultwarden-backup-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	return file, nil
+}
+
+// spoolToTempFile 将 reader 的内容写入临时文件
+func (s *Service) spoolToTempFile(reader io.Reader) (*spooledBackup, error) {
+	file, err := s.createTempFile()
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := io.Copy(file, reader)
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	return &spooledBackup{file: file, size: size}, nil
+}
+
+// checkSpoolSpace 检查临时目录是否有足够的空间写入约 size 字节的备份，
+// 避免写满系统盘后才失败。无法获取可用空间时不做限制
+func (s *Service) checkSpoolSpace(size int64) error {
+	dir := s.tempDir
+	if dir == "" {
+		dir = os.TempDir()
+	} else if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	free, err := storageProvider.FreeSpace(dir)
+	if err != nil {
+		if errors.Is(err, storageProvider.ErrFreeSpaceUnsupported) {
+			return nil
+		}
+		return fmt.Errorf("failed to get free space of %s: %w", dir, err)
+	}
+
+	if needed := size + spoolSpaceMargin; free < needed {
+		return fmt.Errorf("%w: %d bytes available in temporary directory %s, about %d bytes needed (see sync.temp_dir)",
+			storageProvider.ErrInsufficientSpace, free, dir, needed)
+	}
+	return nil
+}
+
+// createSpooledBackup 将指定格式的备份流写入临时文件。重试和多存储上传都从该文件重新读取，
+// 而不是在内存中保留整个备份
+func (s *Service) createSpooledBackup(ctx context.Context, prepared *backup.PreparedBackup, format backup.ArchiveFormat) (*spooledBackup, string, error) {
+	if err := s.checkSpoolSpace(prepared.Size()); err != nil {
+		return nil, "", err
+	}
+
+	backupReader, filename, err := prepared.Open(ctx, format)
+	if err != nil {
+		return nil, "", err
+	}
+	defer backupReader.Close()
+
+	spool, err := s.spoolToTempFile(backupReader)
+	if err != nil {
+		s.backupService.FinishBackup(filename)
+		return nil, "", err
+	}
+
+	return spool, filename, nil
+}
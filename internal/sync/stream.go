@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+	"github.com/cloudflare/backoff"
+)
+
+// uploadBackup 生成指定格式的备份并上传到 objectName。存储能够直接上传数据流时边生成边上传，
+// 备份不经过本地磁盘；否则先写入临时文件，重试和分卷都从该文件读取。
+// 能够续传的存储同样先写入临时文件，重试时从中断处续传而不是重新生成备份
+func (s *Service) uploadBackup(ctx context.Context, jobID int, provider storageProvider.Provider, prepared *backup.PreparedBackup, format backup.ArchiveFormat, objectName string) error {
+	uploader, ok := provider.(storageProvider.StreamUploader)
+	if !ok || resumesUploads(provider) {
+		spool, _, err := s.createSpooledBackup(ctx, prepared, format)
+		if err != nil {
+			return fmt.Errorf("failed to create backup: %w", err)
+		}
+		defer spool.Close()
+
+		return s.uploadArchive(ctx, jobID, provider, objectName, spool.file, spool.size)
+	}
+
+	if s.volumeSize > 0 {
+		return s.uploadVolumeStream(ctx, jobID, provider, prepared, format, objectName)
+	}
+	return s.uploadStreamWithBackoff(ctx, jobID, uploader, prepared, format, objectName)
+}
+
+// resumesUploads 返回存储能否从中断处续传上传
+func resumesUploads(provider storageProvider.Provider) bool {
+	resumable, ok := provider.(storageProvider.ResumableUploader)
+	return ok && resumable.ResumesUploads()
+}
+
+// uploadStreamWithBackoff 将备份流直接上传到存储。数据流只能读取一次，
+// 每次重试都重新生成备份并从头上传，不使用断点续传
+func (s *Service) uploadStreamWithBackoff(ctx context.Context, jobID int, uploader storageProvider.StreamUploader, prepared *backup.PreparedBackup, format backup.ArchiveFormat, objectName string) error {
+	maxDuration := s.retryDelay * time.Duration(s.maxRetries)
+	b := backoff.New(maxDuration, s.retryDelay)
+	var lastErr error
+
+	for i := 0; i <= s.maxRetries; i++ {
+		if i > 0 {
+			if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Retrying upload (%d/%d)...", i, s.maxRetries)); err != nil {
+				return err
+			}
+			select {
+			case <-time.After(b.Duration()):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		backupReader, _, err := prepared.Open(ctx, format)
+		if err != nil {
+			return err
+		}
+		err = uploader.UploadStream(ctx, objectName, backupReader)
+		backupReader.Close()
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+		log.Printf("Upload attempt %d failed: %v", i+1, err)
+	}
+
+	return fmt.Errorf("upload failed after %d retries: %w", s.maxRetries, lastErr)
+}
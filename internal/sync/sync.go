@@ -1,11 +1,16 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"path"
+	"strconv"
 	"sync"
 	"time"
 
@@ -13,6 +18,8 @@ import (
 	entstorage "github.com/ca-x/vaultwarden-syncer/ent/storage"
 	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
 	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	"github.com/ca-x/vaultwarden-syncer/internal/hook"
+	"github.com/ca-x/vaultwarden-syncer/internal/notification"
 	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
 	"github.com/cloudflare/backoff"
 )
@@ -24,6 +31,31 @@ type Service struct {
 	retryDelay    time.Duration
 	concurrency   int
 	enableResume  bool // 是否启用断点续传
+
+	// 去重仓库模式
+	repositoryMode     bool
+	repositoryPassword string
+
+	// verifyAfterUpload 为 true 时每次上传后下载并校验备份
+	verifyAfterUpload bool
+	notifier          *notification.Service
+
+	// 异常备份检测，见 SetAnomalyDetection
+	shrinkPercent    int
+	changedPercent   int
+	quarantinePrefix string
+
+	// volumeSize 大于 0 时超过该大小的备份被拆分为分卷上传，见 SetVolumeSize
+	volumeSize int64
+
+	// tempDir 为写入临时文件的目录，为空时使用系统临时目录，见 SetTempDir
+	tempDir string
+
+	// hooks 为备份前后执行的命令，nil 表示没有配置
+	hooks *hook.Runner
+
+	// source 为备份的数据源，nil 表示配置文件中的默认实例，见 ForSource
+	source *ent.Source
 }
 
 func NewService(client *ent.Client, backupService *backup.Service) *Service {
@@ -34,6 +66,8 @@ func NewService(client *ent.Client, backupService *backup.Service) *Service {
 		retryDelay:    5 * time.Second, // 默认重试间隔5秒
 		concurrency:   3,               // 默认并发数3
 		enableResume:  true,            // 默认启用断点续传
+
+		quarantinePrefix: defaultQuarantinePrefix,
 	}
 }
 
@@ -55,7 +89,27 @@ func (s *Service) SetResumeEnabled(enabled bool) {
 	s.enableResume = enabled
 }
 
+// SetVerifyAfterUpload 设置是否在每次上传后校验备份
+func (s *Service) SetVerifyAfterUpload(enabled bool) {
+	s.verifyAfterUpload = enabled
+}
+
+// SetNotifier 设置校验失败等告警使用的通知服务
+func (s *Service) SetNotifier(notifier *notification.Service) {
+	s.notifier = notifier
+}
+
 func (s *Service) SyncToStorage(ctx context.Context, storageID int) error {
+	hookOutput, err := s.runPreBackupHook(ctx, []int{storageID})
+	if err != nil {
+		return err
+	}
+
+	return s.syncToStorage(ctx, storageID, hookOutput)
+}
+
+// syncToStorage 生成备份并上传到指定存储，hookOutput 为已经执行的 pre_backup 命令的输出
+func (s *Service) syncToStorage(ctx context.Context, storageID int, hookOutput string) error {
 	storage, err := s.client.Storage.Get(ctx, storageID)
 	if err != nil {
 		return fmt.Errorf("failed to get storage: %w", err)
@@ -65,16 +119,17 @@ func (s *Service) SyncToStorage(ctx context.Context, storageID int) error {
 		return fmt.Errorf("storage %s is disabled", storage.Name)
 	}
 
-	job, err := s.client.SyncJob.
-		Create().
+	job, err := s.newSyncJob().
 		SetStatus(syncjob.StatusPending).
 		SetOperation(syncjob.OperationBackup).
 		SetStorageID(storageID).
+		SetHookOutput(hookOutput).
 		Save(ctx)
 
 	if err != nil {
 		return fmt.Errorf("failed to create sync job: %w", err)
 	}
+	defer s.runPostBackupHooks(ctx, job.ID)
 
 	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, "Creating backup..."); err != nil {
 		return err
@@ -86,42 +141,80 @@ func (s *Service) SyncToStorage(ctx context.Context, storageID int) error {
 		return fmt.Errorf("failed to create storage provider: %w", err)
 	}
 
-	// 检查是否已存在相同备份
-	exists, filename, err := s.checkExistingBackup(ctx, provider)
-	if err != nil {
-		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to check existing backup: %v", err))
-		return fmt.Errorf("failed to check existing backup: %w", err)
+	if s.repositoryMode {
+		if err := s.syncToRepository(ctx, job.ID, provider); err != nil {
+			return err
+		}
+		log.Printf("Snapshot synced successfully to %s", storage.Name)
+		return nil
 	}
 
-	var backupReader io.Reader
-	if !exists {
-		// 创建新备份
-		backupReader, filename, err = s.backupService.CreateBackup(ctx)
-		if err != nil {
+	// 创建新备份，只有该存储保存了基准时才做增量/差异备份
+	prepared, err := s.backupService.PrepareBackup(ctx, storageTarget(storageID))
+	if err != nil {
+		if isIntegrityFailure(err) {
+			s.failIntegrityCheck(ctx, job.ID, err)
+			s.alertIntegrityFailure(err)
+		} else {
 			s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to create backup: %v", err))
-			return fmt.Errorf("failed to create backup: %w", err)
-		}
-	} else {
-		// 使用已存在的备份
-		if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, fmt.Sprintf("Using existing backup: %s", filename)); err != nil {
-			return err
 		}
+		return fmt.Errorf("failed to create backup: %w", err)
 	}
+	defer prepared.Close()
+	changes := prepared.Changes()
+	if err := s.client.SyncJob.UpdateOneID(job.ID).
+		SetIntegrityCheck(prepared.IntegrityCheck()).
+		SetFingerprint(prepared.Fingerprint()).
+		SetDataFiles(changes.Files).
+		SetDataSize(changes.Size).
+		Exec(ctx); err != nil {
+		log.Printf("Failed to record backup details: %v", err)
+	}
+
+	format := storageArchiveFormat(storage)
+	filename := prepared.Filename(format)
+
+	// 与最近的备份相比出现异常时上传到隔离目录
+	objectName, anomalies := s.checkAnomalies(ctx, changes, prepared.Vault(), filename)
 
 	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, "Uploading backup..."); err != nil {
 		return err
 	}
 
 	// 使用backoff机制上传备份
-	if err := s.uploadWithBackoff(ctx, job.ID, provider, filename, backupReader); err != nil {
+	if err := s.uploadBackup(ctx, job.ID, provider, prepared, format, objectName); err != nil {
+		s.backupService.FinishBackup(filename)
 		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to upload backup after retries: %v", err))
 		return fmt.Errorf("failed to upload backup after retries: %w", err)
 	}
 
-	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted, fmt.Sprintf("Backup uploaded successfully: %s", filename)); err != nil {
+	s.uploadSidecar(ctx, provider, filename, objectName)
+	summary := s.recordArchiveStats(ctx, job.ID, filename, objectName)
+
+	// 可疑的备份保留在隔离目录中供检查，不能作为后续增量备份的基准
+	if len(anomalies) > 0 {
+		s.backupService.FinishBackup(filename)
+		s.alertSuspicious(objectName, anomalies)
+		return s.markSuspicious(ctx, job.ID, objectName, anomalies, summary)
+	}
+
+	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted, fmt.Sprintf("Backup uploaded successfully: %s%s", filename, summary)); err != nil {
+		s.backupService.FinishBackup(filename)
 		return err
 	}
 
+	// 校验失败的备份不能作为后续增量备份的基准
+	if s.verifyAfterUpload {
+		if err := s.VerifyBackup(ctx, storage.ID, filename); err != nil {
+			s.backupService.FinishBackup(filename)
+			return fmt.Errorf("failed to verify backup: %w", err)
+		}
+	}
+
+	if err := s.backupService.FinishBackup(filename, storageTarget(storageID)); err != nil {
+		log.Printf("Failed to save backup state: %v", err)
+	}
+
 	log.Printf("Backup synced successfully to %s: %s", storage.Name, filename)
 	return nil
 }
@@ -132,10 +225,53 @@ func (s *Service) ConcurrentSyncToStorages(ctx context.Context, storageIDs []int
 		return fmt.Errorf("no storage IDs provided")
 	}
 
-	// 创建共享的备份
-	backupReader, filename, err := s.backupService.CreateBackup(ctx)
+	// pre_backup 命令在整个批次开始前执行一次
+	hookOutput, err := s.runPreBackupHook(ctx, storageIDs)
 	if err != nil {
-		return fmt.Errorf("failed to create backup: %w", err)
+		return err
+	}
+
+	// 只有一个存储时不需要共享备份，直接边生成边上传
+	if len(storageIDs) == 1 {
+		if err := s.syncToStorage(ctx, storageIDs[0], hookOutput); err != nil {
+			return fmt.Errorf("failed to sync to storage %d: %w", storageIDs[0], err)
+		}
+		return nil
+	}
+
+	// 创建共享的备份。备份写入临时文件，各个存储后端通过独立的
+	// SectionReader 并发读取，互不影响读取位置。使用不同归档格式的存储
+	// 共享同一次备份计划，每种格式只生成一次。
+	// 去重仓库模式下每个存储单独写入快照，不需要共享归档。
+	var errors []error
+	archives := make(map[int]*spooledArchive)
+	if !s.repositoryMode {
+		archives, errors, err = s.createSpooledArchives(ctx, storageIDs)
+		if err != nil {
+			if isIntegrityFailure(err) {
+				s.failIntegrityCheckForStorages(ctx, storageIDs, err)
+				s.alertIntegrityFailure(err)
+			}
+			return fmt.Errorf("failed to create backup: %w", err)
+		}
+		defer func() {
+			for _, archive := range uniqueArchives(archives) {
+				archive.spool.Close()
+			}
+		}()
+	}
+
+	// 各个格式的备份内容相同，只需检查一次是否异常
+	suspicious := false
+	if unique := uniqueArchives(archives); len(unique) > 0 {
+		_, anomalies := s.checkAnomalies(ctx, unique[0].changes, unique[0].vault, unique[0].filename)
+		if len(anomalies) > 0 {
+			suspicious = true
+			for _, archive := range unique {
+				archive.anomalies = anomalies
+			}
+			s.alertSuspicious(path.Join(s.quarantinePrefix, unique[0].filename), anomalies)
+		}
 	}
 
 	// 使用buffered channel控制并发数
@@ -143,8 +279,16 @@ func (s *Service) ConcurrentSyncToStorages(ctx context.Context, storageIDs []int
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(storageIDs))
 
+	// stored 为成功保存了本次备份的存储
+	var storedMu sync.Mutex
+	var stored []string
+
 	// 并发同步到各个存储后端
 	for _, storageID := range storageIDs {
+		if !s.repositoryMode && archives[storageID] == nil {
+			continue
+		}
+
 		wg.Add(1)
 
 		// 启动goroutine进行同步
@@ -156,9 +300,19 @@ func (s *Service) ConcurrentSyncToStorages(ctx context.Context, storageIDs []int
 			defer func() { <-semaphore }() // 释放信号量
 
 			// 执行同步
-			if err := s.syncToStorageWithBackup(ctx, id, backupReader, filename); err != nil {
+			var err error
+			if s.repositoryMode {
+				err = s.syncToStorage(ctx, id, hookOutput)
+			} else {
+				err = s.syncToStorageWithBackup(ctx, id, archives[id], hookOutput)
+			}
+			if err != nil {
 				errChan <- fmt.Errorf("failed to sync to storage %d: %w", id, err)
+				return
 			}
+			storedMu.Lock()
+			stored = append(stored, storageTarget(id))
+			storedMu.Unlock()
 		}(storageID)
 	}
 
@@ -167,11 +321,22 @@ func (s *Service) ConcurrentSyncToStorages(ctx context.Context, storageIDs []int
 	close(errChan)
 
 	// 收集错误
-	var errors []error
 	for err := range errChan {
 		errors = append(errors, err)
 	}
 
+	// 本次备份只作为成功上传的存储后续增量备份的基准，上传失败的存储下一次仍基于它们已有的备份；
+	// 异常的备份在隔离目录中，不能作为任何存储的基准。
+	// 各个格式的备份共享同一个基准，结束其中任意一个即可
+	if archives := uniqueArchives(archives); len(archives) > 0 {
+		if suspicious {
+			stored = nil
+		}
+		if err := s.backupService.FinishBackup(archives[0].filename, stored...); err != nil {
+			log.Printf("Failed to save backup state: %v", err)
+		}
+	}
+
 	if len(errors) > 0 {
 		return fmt.Errorf("sync failed with %d errors: %v", len(errors), errors)
 	}
@@ -180,7 +345,9 @@ func (s *Service) ConcurrentSyncToStorages(ctx context.Context, storageIDs []int
 }
 
 // syncToStorageWithBackup 使用指定备份同步到特定存储
-func (s *Service) syncToStorageWithBackup(ctx context.Context, storageID int, backupReader io.Reader, filename string) error {
+func (s *Service) syncToStorageWithBackup(ctx context.Context, storageID int, archive *spooledArchive, hookOutput string) error {
+	spool, filename := archive.spool, archive.filename
+
 	storage, err := s.client.Storage.Get(ctx, storageID)
 	if err != nil {
 		return fmt.Errorf("failed to get storage: %w", err)
@@ -190,16 +357,21 @@ func (s *Service) syncToStorageWithBackup(ctx context.Context, storageID int, ba
 		return fmt.Errorf("storage %s is disabled", storage.Name)
 	}
 
-	job, err := s.client.SyncJob.
-		Create().
+	job, err := s.newSyncJob().
 		SetStatus(syncjob.StatusPending).
 		SetOperation(syncjob.OperationBackup).
 		SetStorageID(storageID).
+		SetIntegrityCheck(archive.integrity).
+		SetFingerprint(archive.fingerprint).
+		SetDataFiles(archive.changes.Files).
+		SetDataSize(archive.changes.Size).
+		SetHookOutput(hookOutput).
 		Save(ctx)
 
 	if err != nil {
 		return fmt.Errorf("failed to create sync job: %w", err)
 	}
+	defer s.runPostBackupHooks(ctx, job.ID)
 
 	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, "Uploading backup..."); err != nil {
 		return err
@@ -211,50 +383,143 @@ func (s *Service) syncToStorageWithBackup(ctx context.Context, storageID int, ba
 		return fmt.Errorf("failed to create storage provider: %w", err)
 	}
 
+	objectName := filename
+	if len(archive.anomalies) > 0 {
+		objectName = path.Join(s.quarantinePrefix, filename)
+	}
+
 	// 使用backoff机制上传备份
-	if err := s.uploadWithBackoff(ctx, job.ID, provider, filename, backupReader); err != nil {
+	if err := s.uploadArchive(ctx, job.ID, provider, objectName, spool.file, spool.size); err != nil {
 		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to upload backup after retries: %v", err))
 		return fmt.Errorf("failed to upload backup after retries: %w", err)
 	}
 
-	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted, fmt.Sprintf("Backup uploaded successfully: %s", filename)); err != nil {
+	s.uploadSidecar(ctx, provider, filename, objectName)
+	summary := s.recordArchiveStats(ctx, job.ID, filename, objectName)
+
+	if len(archive.anomalies) > 0 {
+		return s.markSuspicious(ctx, job.ID, objectName, archive.anomalies, summary)
+	}
+
+	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted, fmt.Sprintf("Backup uploaded successfully: %s%s", filename, summary)); err != nil {
 		return err
 	}
 
+	if s.verifyAfterUpload {
+		if err := s.VerifyBackup(ctx, storageID, filename); err != nil {
+			return fmt.Errorf("failed to verify backup: %w", err)
+		}
+	}
+
 	log.Printf("Backup synced successfully to %s: %s", storage.Name, filename)
 	return nil
 }
 
-// checkExistingBackup 检查是否已存在相同备份
-func (s *Service) checkExistingBackup(ctx context.Context, provider storageProvider.Provider) (bool, string, error) {
-	// 获取数据目录信息
-	_, err := s.backupService.GetDataInfo()
+// spooledArchive 为某一归档格式的备份文件
+type spooledArchive struct {
+	spool    *spooledBackup
+	filename string
+	// integrity 为创建备份前数据库完整性检查的结果
+	integrity string
+	changes   backup.ChangeSummary
+	vault     *backup.VaultStats
+	// fingerprint 为生成备份时数据目录的指纹
+	fingerprint string
+	// anomalies 非空时备份被视为可疑，上传到隔离目录
+	anomalies []string
+}
+
+// createSpooledArchives 为每个存储生成其归档格式的备份，相同格式的存储共享同一个文件。
+// 无法读取的存储作为错误返回，不影响其他存储。
+func (s *Service) createSpooledArchives(ctx context.Context, storageIDs []int) (map[int]*spooledArchive, []error, error) {
+	targets := make([]string, len(storageIDs))
+	for i, id := range storageIDs {
+		targets[i] = storageTarget(id)
+	}
+
+	prepared, err := s.backupService.PrepareBackup(ctx, targets...)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to get data info: %w", err)
+		return nil, nil, err
 	}
+	// 所有格式的归档都写入临时文件后即可删除数据库快照
+	defer prepared.Close()
 
-	// 生成基于数据信息的文件名
-	// 这里简化实现，实际应用中可以使用更复杂的算法
-	timestamp := time.Now().Format("20060102-150405")
-	filename := fmt.Sprintf("vaultwarden-backup-%s.zip", timestamp)
+	archives := make(map[int]*spooledArchive)
+	byFormat := make(map[backup.ArchiveFormat]*spooledArchive)
+	var errors []error
 
-	// 检查文件是否已存在
-	exists, err := provider.Exists(ctx, filename)
-	if err != nil {
-		return false, "", fmt.Errorf("failed to check file existence: %w", err)
+	for _, id := range storageIDs {
+		storage, err := s.client.Storage.Get(ctx, id)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("failed to sync to storage %d: failed to get storage: %w", id, err))
+			continue
+		}
+
+		format := storageArchiveFormat(storage)
+		if format == "" {
+			format = s.backupService.Format()
+		}
+
+		archive, ok := byFormat[format]
+		if !ok {
+			spool, filename, err := s.createSpooledBackup(ctx, prepared, format)
+			if err != nil {
+				for _, created := range byFormat {
+					created.spool.Close()
+				}
+				return nil, nil, err
+			}
+			archive = &spooledArchive{
+				spool:       spool,
+				filename:    filename,
+				integrity:   prepared.IntegrityCheck(),
+				changes:     prepared.Changes(),
+				vault:       prepared.Vault(),
+				fingerprint: prepared.Fingerprint(),
+			}
+			byFormat[format] = archive
+		}
+		archives[id] = archive
+	}
+
+	return archives, errors, nil
+}
+
+// storageTarget 返回存储在增量备份状态中的标识。每个存储单独记录备份链，
+// 只上传到部分存储的备份不会成为其他存储的增量备份基准
+func storageTarget(storageID int) string {
+	return strconv.Itoa(storageID)
+}
+
+// uniqueArchives 返回去重后的备份文件
+func uniqueArchives(archives map[int]*spooledArchive) []*spooledArchive {
+	seen := make(map[*spooledArchive]bool)
+	var result []*spooledArchive
+	for _, archive := range archives {
+		if !seen[archive] {
+			seen[archive] = true
+			result = append(result, archive)
+		}
 	}
+	return result
+}
 
-	if exists {
-		// 文件存在，可以进一步检查校验和是否匹配
-		// 这里简化处理，直接返回存在
-		return true, filename, nil
+// storageArchiveFormat 返回存储配置的归档格式，为空或无效时返回空字符串表示使用全局配置
+func storageArchiveFormat(storage *ent.Storage) backup.ArchiveFormat {
+	if storage.ArchiveFormat == "" {
+		return ""
 	}
 
-	return false, filename, nil
+	format, err := backup.ParseArchiveFormat(storage.ArchiveFormat)
+	if err != nil {
+		log.Printf("Storage %s: %v, using default format", storage.Name, err)
+		return ""
+	}
+	return format
 }
 
 // uploadWithBackoff 使用backoff机制的上传
-func (s *Service) uploadWithBackoff(ctx context.Context, jobID int, provider storageProvider.Provider, filename string, reader io.Reader) error {
+func (s *Service) uploadWithBackoff(ctx context.Context, jobID int, provider storageProvider.Provider, filename string, src io.ReaderAt, size int64) error {
 	// 创建backoff实例
 	maxDuration := s.retryDelay * time.Duration(s.maxRetries)
 	b := backoff.New(maxDuration, s.retryDelay)
@@ -274,8 +539,8 @@ func (s *Service) uploadWithBackoff(ctx context.Context, jobID int, provider sto
 			}
 		}
 
-		// 尝试上传（使用断点续传）
-		err := s.uploadWithResume(ctx, jobID, provider, filename, reader)
+		// 尝试上传（使用断点续传），每次尝试都从头读取
+		err := s.uploadWithResume(ctx, jobID, provider, filename, io.NewSectionReader(src, 0, size))
 		if err == nil {
 			return nil // 成功
 		}
@@ -287,38 +552,37 @@ func (s *Service) uploadWithBackoff(ctx context.Context, jobID int, provider sto
 	return fmt.Errorf("upload failed after %d retries: %w", s.maxRetries, lastErr)
 }
 
-// uploadWithResume 带断点续传的上传
-func (s *Service) uploadWithResume(ctx context.Context, jobID int, provider storageProvider.Provider, filename string, reader io.Reader) error {
+// uploadWithResume 带断点续传的上传。存储中已有之前中断的上传留下的部分数据时，
+// 通过 UploadPart 从已上传的位置继续；存储无法续传时从头上传
+func (s *Service) uploadWithResume(ctx context.Context, jobID int, provider storageProvider.Provider, filename string, reader *io.SectionReader) error {
 	if !s.enableResume {
 		// 如果未启用断点续传，使用普通上传
 		return provider.Upload(ctx, filename, reader)
 	}
 
-	// 检查远程文件是否存在
-	exists, err := provider.Exists(ctx, filename)
+	// GetFileSize 对不存在的对象返回 0，对未完成的上传返回已保存的大小
+	remoteSize, err := provider.GetFileSize(ctx, filename)
 	if err != nil {
-		return fmt.Errorf("failed to check file existence: %w", err)
+		return fmt.Errorf("failed to get remote file size: %w", err)
 	}
 
-	if !exists {
-		// 文件不存在，使用普通上传
+	// 远程对象不小于本地备份时无法判断内容是否一致，重新上传
+	size := reader.Size()
+	if remoteSize <= 0 || remoteSize >= size {
 		return provider.Upload(ctx, filename, reader)
 	}
 
-	// 文件存在，尝试断点续传
-	remoteSize, err := provider.GetFileSize(ctx, filename)
-	if err != nil {
-		return fmt.Errorf("failed to get remote file size: %w", err)
+	if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning,
+		fmt.Sprintf("Resuming upload at %d of %d bytes...", remoteSize, size)); err != nil {
+		return err
 	}
 
-	if remoteSize > 0 {
-		// 这里需要实现更复杂的断点续传逻辑
-		// 由于Go的io.Reader不支持seek，我们需要特殊处理
-		// 简化实现：使用普通上传
-		return provider.Upload(ctx, filename, reader)
+	err = provider.UploadPart(ctx, filename, io.NewSectionReader(reader, remoteSize, size-remoteSize), remoteSize)
+	if errors.Is(err, storageProvider.ErrResumeUnsupported) {
+		log.Printf("Cannot resume upload of %s, uploading from the start: %v", filename, err)
+		return provider.Upload(ctx, filename, io.NewSectionReader(reader, 0, size))
 	}
-
-	return provider.Upload(ctx, filename, reader)
+	return err
 }
 
 func (s *Service) RestoreFromStorage(ctx context.Context, storageID int, filename, destPath string) error {
@@ -327,10 +591,10 @@ func (s *Service) RestoreFromStorage(ctx context.Context, storageID int, filenam
 		return fmt.Errorf("failed to get storage: %w", err)
 	}
 
-	job, err := s.client.SyncJob.
-		Create().
+	job, err := s.newSyncJob().
 		SetStatus(syncjob.StatusPending).
 		SetOperation(syncjob.OperationRestore).
+		SetFilename(filename).
 		SetStorageID(storageID).
 		Save(ctx)
 
@@ -348,8 +612,179 @@ func (s *Service) RestoreFromStorage(ctx context.Context, storageID int, filenam
 		return fmt.Errorf("failed to create storage provider: %w", err)
 	}
 
-	// 使用backoff机制下载
-	var backupReader io.ReadCloser
+	// 先解压到目标目录旁的暂存目录，校验通过后再整体替换，
+	// 失败时正在使用的数据目录保持不变
+	staging, err := backup.NewStagingDir(destPath)
+	if err != nil {
+		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to prepare restore: %v", err))
+		return fmt.Errorf("failed to prepare restore: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	// 去重仓库模式下 filename 为快照 ID
+	if s.repositoryMode {
+		if err := s.restoreFromRepository(ctx, job.ID, provider, filename, staging); err != nil {
+			return err
+		}
+		return s.swapRestoredData(ctx, job.ID, staging, destPath, nil, fmt.Sprintf("Snapshot restored successfully: %s", filename))
+	}
+
+	// 下载备份以及它所依赖的完整备份和增量备份
+	chain, err := s.downloadBackupChain(ctx, job.ID, provider, filename)
+	if err != nil {
+		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to download backup: %v", err))
+		return fmt.Errorf("failed to download backup: %w", err)
+	}
+	defer func() {
+		for _, spool := range chain {
+			spool.Close()
+		}
+	}()
+
+	// 从完整备份开始依次应用备份链
+	for i, spool := range chain {
+		if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, fmt.Sprintf("Extracting backup (%d/%d)...", i+1, len(chain))); err != nil {
+			return err
+		}
+
+		if err := s.backupService.ExtractBackup(ctx, spool.reader(), staging); err != nil {
+			s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to extract backup: %v", err))
+			return fmt.Errorf("failed to extract backup: %w", err)
+		}
+	}
+
+	// 链中最后一个备份的 manifest 记录了恢复后应有的全部文件
+	manifest, err := s.backupService.ReadManifest(ctx, chain[len(chain)-1].reader())
+	if err != nil {
+		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to read manifest: %v", err))
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	if err := s.swapRestoredData(ctx, job.ID, staging, destPath, manifest, fmt.Sprintf("Backup restored successfully from: %s", filename)); err != nil {
+		return err
+	}
+
+	log.Printf("Backup restored successfully from %s: %s", storage.Name, filename)
+	return nil
+}
+
+// swapRestoredData 校验暂存目录中恢复出的数据，然后用它替换 destPath。
+// 原目录被保留并记录在任务中，可以通过 UndoRestore 撤销本次恢复。
+func (s *Service) swapRestoredData(ctx context.Context, jobID int, staging, destPath string, manifest *backup.Manifest, message string) error {
+	if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, "Validating restored data..."); err != nil {
+		return err
+	}
+
+	if err := s.backupService.ValidateRestore(ctx, staging, manifest); err != nil {
+		s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Restored data failed validation, current data left untouched: %v", err))
+		return fmt.Errorf("failed to validate restored data: %w", err)
+	}
+
+	rollback, err := backup.SwapIntoPlace(staging, destPath)
+	if err != nil {
+		s.updateJobStatus(ctx, jobID, syncjob.StatusFailed, fmt.Sprintf("Failed to replace data directory: %v", err))
+		return fmt.Errorf("failed to replace data directory: %w", err)
+	}
+
+	update := s.client.SyncJob.UpdateOneID(jobID).SetRestorePath(destPath)
+	if rollback != "" {
+		update = update.SetRollbackPath(rollback)
+		message += fmt.Sprintf(" (previous data kept at %s)", rollback)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("Failed to record restore paths: %v", err)
+	}
+
+	return s.updateJobStatus(ctx, jobID, syncjob.StatusCompleted, message)
+}
+
+// UndoRestore 撤销一次成功的恢复，把恢复前的数据目录换回原位置
+func (s *Service) UndoRestore(ctx context.Context, jobID int) error {
+	job, err := s.client.SyncJob.Get(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to get sync job: %w", err)
+	}
+
+	if job.Operation != syncjob.OperationRestore || job.Status != syncjob.StatusCompleted || job.RollbackPath == "" {
+		return fmt.Errorf("sync job %d is not a restore that can be undone", jobID)
+	}
+
+	if err := backup.UndoRestore(job.RestorePath, job.RollbackPath); err != nil {
+		return fmt.Errorf("failed to undo restore: %w", err)
+	}
+
+	_, err = s.client.SyncJob.UpdateOneID(jobID).
+		ClearRollbackPath().
+		SetMessage(fmt.Sprintf("%s; restore undone, previous data moved back to %s", job.Message, job.RestorePath)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update sync job: %w", err)
+	}
+
+	log.Printf("Restore %d undone: %s moved back to %s", jobID, job.RollbackPath, job.RestorePath)
+	return nil
+}
+
+// maxBackupChainLength 限制备份链长度，防止损坏的 manifest 形成循环
+const maxBackupChainLength = 1000
+
+// downloadBackupChain 下载 filename 及其依赖的备份，按恢复顺序（完整备份在前）返回
+func (s *Service) downloadBackupChain(ctx context.Context, jobID int, provider storageProvider.Provider, filename string) ([]*spooledBackup, error) {
+	var chain []*spooledBackup
+	closeChain := func() {
+		for _, spool := range chain {
+			spool.Close()
+		}
+	}
+
+	for name := filename; name != ""; {
+		if len(chain) >= maxBackupChainLength {
+			closeChain()
+			return nil, fmt.Errorf("backup chain is longer than %d", maxBackupChainLength)
+		}
+
+		if len(chain) > 0 {
+			if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Downloading base backup: %s", name)); err != nil {
+				closeChain()
+				return nil, err
+			}
+		}
+
+		spool, err := s.downloadWithBackoff(ctx, jobID, provider, name)
+		if err != nil {
+			closeChain()
+			return nil, err
+		}
+		chain = append([]*spooledBackup{spool}, chain...)
+
+		manifest, err := s.backupService.ReadManifest(ctx, spool.reader())
+		if err != nil {
+			closeChain()
+			return nil, fmt.Errorf("failed to read manifest of %s: %w", name, err)
+		}
+
+		// 没有 manifest 的旧备份都是完整备份
+		if manifest == nil || manifest.Type == backup.BackupTypeFull {
+			break
+		}
+		name = manifest.Base
+	}
+
+	return chain, nil
+}
+
+// downloadWithBackoff 使用backoff机制下载备份到临时文件
+func (s *Service) downloadWithBackoff(ctx context.Context, jobID int, provider storageProvider.Provider, filename string) (*spooledBackup, error) {
+	// 分卷备份按顺序下载各个分卷。索引无法读取时，只有不存在第一个分卷才当作普通备份下载
+	index, err := s.readVolumeIndex(ctx, provider, filename)
+	if err != nil {
+		exists, existsErr := provider.Exists(ctx, volumeName(filename, 1))
+		if existsErr != nil || exists {
+			return nil, fmt.Errorf("failed to read volume index of %s: %w", filename, err)
+		}
+		log.Printf("Failed to read volume index of %s, downloading it as a single file: %v", filename, err)
+	}
+
 	var lastErr error
 
 	// 创建backoff实例
@@ -358,47 +793,83 @@ func (s *Service) RestoreFromStorage(ctx context.Context, storageID int, filenam
 
 	for i := 0; i <= s.maxRetries; i++ {
 		if i > 0 {
-			if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, fmt.Sprintf("Retrying download (%d/%d)...", i, s.maxRetries)); err != nil {
-				return err
+			if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Retrying download (%d/%d)...", i, s.maxRetries)); err != nil {
+				return nil, err
 			}
 			// 等待backoff时间
 			select {
 			case <-time.After(b.Duration()):
 			case <-ctx.Done():
-				return ctx.Err()
+				return nil, ctx.Err()
 			}
 		}
 
-		backupReader, err = provider.Download(ctx, filename)
+		var backupReader io.ReadCloser
+		var err error
+		if index != nil {
+			backupReader = s.openVolumes(ctx, jobID, provider, index)
+		} else {
+			backupReader, err = provider.Download(ctx, filename)
+		}
 		if err == nil {
-			break // 成功
+			var spool *spooledBackup
+			spool, err = s.spoolToTempFile(backupReader)
+			backupReader.Close()
+			if err == nil {
+				return spool, nil // 成功
+			}
 		}
 
 		lastErr = err
 		log.Printf("Download attempt %d failed: %v", i+1, err)
 	}
 
-	if backupReader == nil {
-		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to download backup after retries: %v", lastErr))
-		return fmt.Errorf("failed to download backup after retries: %w", lastErr)
-	}
-	defer backupReader.Close()
+	return nil, fmt.Errorf("download of %s failed after %d retries: %w", filename, s.maxRetries, lastErr)
+}
 
-	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, "Extracting backup..."); err != nil {
-		return err
+// recordArchiveStats 将备份在存储中的对象名、压缩统计和密码库统计写入任务记录，返回附加到任务消息中的摘要
+func (s *Service) recordArchiveStats(ctx context.Context, jobID int, filename, objectName string) string {
+	update := s.client.SyncJob.UpdateOneID(jobID).SetFilename(objectName)
+
+	stats, ok := s.backupService.ArchiveStats(filename)
+	if ok {
+		update = update.
+			SetOriginalSize(stats.OriginalSize).
+			SetArchiveSize(stats.ArchiveSize).
+			SetCompressionRatio(stats.Ratio())
+		if vault := stats.Vault; vault != nil {
+			update = update.
+				SetVaultUsers(vault.Users).
+				SetVaultOrganizations(vault.Organizations).
+				SetVaultCiphers(vault.Ciphers).
+				SetVaultAttachments(vault.Attachments).
+				SetVaultSends(vault.Sends)
+		}
 	}
 
-	if err := s.backupService.ExtractBackup(ctx, backupReader, destPath); err != nil {
-		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("Failed to extract backup: %v", err))
-		return fmt.Errorf("failed to extract backup: %w", err)
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("Failed to record archive stats: %v", err)
+	}
+	if !ok {
+		return ""
 	}
 
-	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted, fmt.Sprintf("Backup restored successfully from: %s", filename)); err != nil {
-		return err
+	return fmt.Sprintf(" (%d files, %d -> %d bytes, %.1f%% of original)",
+		stats.Files, stats.OriginalSize, stats.ArchiveSize, stats.Ratio()*100)
+}
+
+// uploadSidecar 在备份旁上传 manifest 副本。副本只用于校验和预览，
+// 备份内已包含同样的 manifest，因此上传失败不影响本次同步
+func (s *Service) uploadSidecar(ctx context.Context, provider storageProvider.Provider, filename, objectName string) {
+	data, err := s.backupService.Sidecar(filename)
+	if err != nil {
+		log.Printf("Failed to create manifest sidecar for %s: %v", filename, err)
+		return
 	}
 
-	log.Printf("Backup restored successfully from %s: %s", storage.Name, filename)
-	return nil
+	if err := provider.Upload(ctx, backup.SidecarName(objectName), bytes.NewReader(data)); err != nil {
+		log.Printf("Failed to upload manifest sidecar for %s: %v", filename, err)
+	}
 }
 
 func (s *Service) updateJobStatus(ctx context.Context, jobID int, status syncjob.Status, message string) error {
@@ -406,7 +877,8 @@ func (s *Service) updateJobStatus(ctx context.Context, jobID int, status syncjob
 
 	if status == syncjob.StatusRunning {
 		update = update.SetStartedAt(time.Now())
-	} else if status == syncjob.StatusCompleted || status == syncjob.StatusFailed {
+	} else if status == syncjob.StatusCompleted || status == syncjob.StatusFailed ||
+		status == syncjob.StatusSuspicious || status == syncjob.StatusSkipped {
 		update = update.SetCompletedAt(time.Now())
 	}
 
@@ -420,6 +892,11 @@ func (s *Service) createStorageProvider(storage *ent.Storage) (storageProvider.P
 		Where(entstorage.IDEQ(storage.ID)).
 		WithWebdavConfig().
 		WithS3Config().
+		WithLocalConfig().
+		WithSftpConfig().
+		WithFtpConfig().
+		WithAzureBlobConfig().
+		WithGcsConfig().
 		Only(context.Background())
 
 	if err != nil {
@@ -453,6 +930,86 @@ func (s *Service) createStorageProvider(storage *ent.Storage) (storageProvider.P
 		}
 		return storageProvider.NewS3Provider(config)
 
+	case "local":
+		if loadedStorage.Edges.LocalConfig == nil {
+			return nil, fmt.Errorf("local config not found for storage %s", loadedStorage.Name)
+		}
+
+		config := storageProvider.LocalConfig{
+			Name:          loadedStorage.Name,
+			Path:          loadedStorage.Edges.LocalConfig.Path,
+			MinFreeSpace:  loadedStorage.Edges.LocalConfig.MinFreeSpaceMB << 20,
+			SyncDirectory: loadedStorage.Edges.LocalConfig.SyncDirectory,
+		}
+		return storageProvider.NewLocalProvider(config)
+
+	case "sftp":
+		if loadedStorage.Edges.SftpConfig == nil {
+			return nil, fmt.Errorf("SFTP config not found for storage %s", loadedStorage.Name)
+		}
+
+		config := storageProvider.SFTPConfig{
+			Name:       loadedStorage.Name,
+			Host:       loadedStorage.Edges.SftpConfig.Host,
+			Port:       loadedStorage.Edges.SftpConfig.Port,
+			Username:   loadedStorage.Edges.SftpConfig.Username,
+			Password:   loadedStorage.Edges.SftpConfig.Password,
+			PrivateKey: loadedStorage.Edges.SftpConfig.PrivateKey,
+			Passphrase: loadedStorage.Edges.SftpConfig.Passphrase,
+			HostKey:    loadedStorage.Edges.SftpConfig.HostKey,
+			BaseDir:    loadedStorage.Edges.SftpConfig.BaseDir,
+		}
+		return storageProvider.NewSFTPProvider(config)
+
+	case "ftp":
+		if loadedStorage.Edges.FtpConfig == nil {
+			return nil, fmt.Errorf("FTP config not found for storage %s", loadedStorage.Name)
+		}
+
+		config := storageProvider.FTPConfig{
+			Name:          loadedStorage.Name,
+			Host:          loadedStorage.Edges.FtpConfig.Host,
+			Port:          loadedStorage.Edges.FtpConfig.Port,
+			Username:      loadedStorage.Edges.FtpConfig.Username,
+			Password:      loadedStorage.Edges.FtpConfig.Password,
+			TLS:           string(loadedStorage.Edges.FtpConfig.TLSMode),
+			SkipTLSVerify: loadedStorage.Edges.FtpConfig.SkipTLSVerify,
+			BaseDir:       loadedStorage.Edges.FtpConfig.BaseDir,
+		}
+		return storageProvider.NewFTPProvider(config)
+
+	case "azureblob":
+		if loadedStorage.Edges.AzureBlobConfig == nil {
+			return nil, fmt.Errorf("Azure Blob config not found for storage %s", loadedStorage.Name)
+		}
+
+		config := storageProvider.AzureBlobConfig{
+			Name:        loadedStorage.Name,
+			AccountName: loadedStorage.Edges.AzureBlobConfig.AccountName,
+			AccountKey:  loadedStorage.Edges.AzureBlobConfig.AccountKey,
+			SASToken:    loadedStorage.Edges.AzureBlobConfig.SasToken,
+			Container:   loadedStorage.Edges.AzureBlobConfig.Container,
+			Endpoint:    loadedStorage.Edges.AzureBlobConfig.Endpoint,
+			AccessTier:  string(loadedStorage.Edges.AzureBlobConfig.AccessTier),
+		}
+		return storageProvider.NewAzureBlobProvider(config)
+
+	case "gcs":
+		if loadedStorage.Edges.GcsConfig == nil {
+			return nil, fmt.Errorf("GCS config not found for storage %s", loadedStorage.Name)
+		}
+
+		config := storageProvider.GCSConfig{
+			Name:            loadedStorage.Name,
+			Bucket:          loadedStorage.Edges.GcsConfig.Bucket,
+			CredentialsJSON: loadedStorage.Edges.GcsConfig.CredentialsJSON,
+			Endpoint:        loadedStorage.Edges.GcsConfig.Endpoint,
+			StorageClass:    string(loadedStorage.Edges.GcsConfig.StorageClass),
+			RetentionDays:   loadedStorage.Edges.GcsConfig.RetentionDays,
+			RetentionMode:   string(loadedStorage.Edges.GcsConfig.RetentionMode),
+		}
+		return storageProvider.NewGCSProvider(config)
+
 	default:
 		return nil, fmt.Errorf("unsupported storage type: %s", loadedStorage.Type)
 	}
@@ -488,8 +1045,12 @@ func (s *Service) HealthCheck(ctx context.Context, storageID int) error {
 		return fmt.Errorf("failed to create storage provider: %w", err)
 	}
 
-	// 尝试列出根目录来检查连接
-	_, err = provider.List(ctx, "")
+	if checker, ok := provider.(storageProvider.HealthChecker); ok {
+		err = checker.HealthCheck(ctx)
+	} else {
+		// 尝试列出根目录来检查连接
+		_, err = provider.List(ctx, "")
+	}
 	if err != nil {
 		return fmt.Errorf("health check failed for %s (%s): %w", storage.Name, provider.Type(), err)
 	}
@@ -0,0 +1,322 @@
+package sync
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	"github.com/ca-x/vaultwarden-syncer/ent/enttest"
+	entstorage "github.com/ca-x/vaultwarden-syncer/ent/storage"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+
+	_ "github.com/lib-x/entsqlite"
+)
+
+// newTestClient 返回使用临时 SQLite 数据库的 ent 客户端
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "syncer.db") + "?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(10000)"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createLocalStorage 创建一个备份到临时目录的本地存储，返回存储及其目录
+func createLocalStorage(t *testing.T, client *ent.Client, name string) (*ent.Storage, string) {
+	t.Helper()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := client.Storage.Create().SetName(name).SetType(entstorage.TypeLocal).Save(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+	if _, err := client.LocalConfig.Create().SetPath(dir).SetStorageID(storage.ID).Save(ctx); err != nil {
+		t.Fatalf("Failed to create local config: %v", err)
+	}
+	return storage, dir
+}
+
+// setLocalPath 修改本地存储的目录
+func setLocalPath(t *testing.T, client *ent.Client, storage *ent.Storage, path string) {
+	t.Helper()
+
+	config, err := storage.QueryLocalConfig().Only(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get local config: %v", err)
+	}
+	if _, err := config.Update().SetPath(path).Save(context.Background()); err != nil {
+		t.Fatalf("Failed to update local config: %v", err)
+	}
+}
+
+// newTestService 返回备份 dataDir 的增量备份同步服务，失败时不重试
+func newTestService(t *testing.T, client *ent.Client, dataDir string) *Service {
+	t.Helper()
+
+	service := NewService(client, backup.NewService(backup.BackupOptions{
+		VaultwardenDataPath: dataDir,
+		Mode:                backup.BackupTypeIncremental,
+		StateDir:            t.TempDir(),
+	}))
+	service.SetRetryConfig(0, 0)
+	return service
+}
+
+// writeDataFile 写入数据目录中的文件。备份文件名精确到秒，
+// 写入前等待到下一秒，保证每次备份的文件名不同
+func writeDataFile(t *testing.T, dataDir, name, content string) {
+	t.Helper()
+
+	now := time.Now()
+	time.Sleep(now.Truncate(time.Second).Add(time.Second).Sub(now))
+
+	path := filepath.Join(dataDir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+}
+
+// latestBackup 返回存储中最近一次成功备份的文件名
+func latestBackup(t *testing.T, client *ent.Client, storageID int) string {
+	t.Helper()
+
+	job, err := client.SyncJob.Query().
+		Where(
+			syncjob.HasStorageWith(entstorage.IDEQ(storageID)),
+			syncjob.OperationEQ(syncjob.OperationBackup),
+			syncjob.StatusEQ(syncjob.StatusCompleted),
+		).
+		Order(ent.Desc(syncjob.FieldID)).
+		First(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to find latest backup of storage %d: %v", storageID, err)
+	}
+	return job.Filename
+}
+
+// assertRestores 从存储恢复最近一次备份并检查文件内容
+func assertRestores(t *testing.T, service *Service, storageID int, want map[string]string) {
+	t.Helper()
+
+	filename := latestBackup(t, service.client, storageID)
+	dest := filepath.Join(t.TempDir(), "restore")
+	if err := service.RestoreFromStorage(context.Background(), storageID, filename, dest); err != nil {
+		t.Fatalf("Failed to restore %s from storage %d: %v", filename, storageID, err)
+	}
+
+	for name, content := range want {
+		data, err := os.ReadFile(filepath.Join(dest, name))
+		if err != nil {
+			t.Errorf("Restored backup %s is missing %s: %v", filename, name, err)
+			continue
+		}
+		if string(data) != content {
+			t.Errorf("Restored %s from %s = %q, want %q", name, filename, data, content)
+		}
+	}
+}
+
+func TestSingleStorageSyncDoesNotMoveOtherChains(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	a, _ := createLocalStorage(t, client, "a")
+	b, _ := createLocalStorage(t, client, "b")
+
+	dataDir := t.TempDir()
+	writeDataFile(t, dataDir, "config.json", "v1")
+	service := newTestService(t, client, dataDir)
+
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+
+	// 手动同步只上传到 a
+	writeDataFile(t, dataDir, "config.json", "v2")
+	if err := service.SyncToStorage(ctx, a.ID); err != nil {
+		t.Fatalf("Failed to sync to storage a: %v", err)
+	}
+	if name := latestBackup(t, client, a.ID); !strings.Contains(name, "-incremental") {
+		t.Fatalf("Expected an incremental backup on storage a, got %s", name)
+	}
+
+	// b 中没有 a 的增量备份，两者一起备份时必须做完整备份
+	writeDataFile(t, dataDir, "config.json", "v3")
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+	if name := latestBackup(t, client, b.ID); strings.Contains(name, "-incremental") {
+		t.Errorf("Expected a full backup on storage b, got %s", name)
+	}
+	assertRestores(t, service, b.ID, map[string]string{"config.json": "v3"})
+
+	// 之后的增量备份基于两个存储都有的完整备份
+	writeDataFile(t, dataDir, "config.json", "v4")
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+	if name := latestBackup(t, client, b.ID); !strings.Contains(name, "-incremental") {
+		t.Errorf("Expected an incremental backup on storage b, got %s", name)
+	}
+	assertRestores(t, service, a.ID, map[string]string{"config.json": "v4"})
+	assertRestores(t, service, b.ID, map[string]string{"config.json": "v4"})
+}
+
+func TestFailedStorageKeepsItsOwnChain(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	a, _ := createLocalStorage(t, client, "a")
+	b, bDir := createLocalStorage(t, client, "b")
+
+	dataDir := t.TempDir()
+	writeDataFile(t, dataDir, "config.json", "v1")
+	service := newTestService(t, client, dataDir)
+
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+
+	// b 的目录位于普通文件之下，上传失败
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	setLocalPath(t, client, b, filepath.Join(blocker, "backups"))
+
+	writeDataFile(t, dataDir, "config.json", "v2")
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err == nil {
+		t.Fatal("Expected the sync to storage b to fail")
+	}
+	if name := latestBackup(t, client, a.ID); !strings.Contains(name, "-incremental") {
+		t.Fatalf("Expected an incremental backup on storage a, got %s", name)
+	}
+
+	// b 恢复后仍然可以单独基于它已有的完整备份做增量备份
+	setLocalPath(t, client, b, bDir)
+	writeDataFile(t, dataDir, "config.json", "v3")
+	if err := service.SyncToStorage(ctx, b.ID); err != nil {
+		t.Fatalf("Failed to sync to storage b: %v", err)
+	}
+	if name := latestBackup(t, client, b.ID); !strings.Contains(name, "-incremental") {
+		t.Errorf("Expected an incremental backup on storage b, got %s", name)
+	}
+	assertRestores(t, service, b.ID, map[string]string{"config.json": "v3"})
+	assertRestores(t, service, a.ID, map[string]string{"config.json": "v2"})
+}
+
+func TestForSourceRejectsUnsafeName(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	service := newTestService(t, client, t.TempDir())
+
+	// 绕过 API 直接写入数据库的名称
+	source, err := client.Source.Create().SetName("../escape").SetDataPath(t.TempDir()).Save(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create source: %v", err)
+	}
+
+	if _, err := service.ForSource(ctx, source.ID); err == nil {
+		t.Error("Expected ForSource to reject a name containing a path separator")
+	}
+}
+
+// unusableDir 返回位于普通文件之下、无法创建的目录
+func unusableDir(t *testing.T) string {
+	t.Helper()
+
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(blocker, "tmp")
+}
+
+func TestSingleStorageSyncStreamsWithoutTempFile(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	a, dir := createLocalStorage(t, client, "a")
+
+	dataDir := t.TempDir()
+	writeDataFile(t, dataDir, "config.json", "v1")
+	service := newTestService(t, client, dataDir)
+	service.SetTempDir(unusableDir(t))
+
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID}); err != nil {
+		t.Fatalf("Expected a single storage sync to stream without the temporary directory: %v", err)
+	}
+
+	filename := latestBackup(t, client, a.ID)
+	if _, err := os.Stat(filepath.Join(dir, filename)); err != nil {
+		t.Fatalf("Expected %s in storage: %v", filename, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, backup.SidecarName(filename))); err != nil {
+		t.Errorf("Expected the manifest sidecar of a streamed backup: %v", err)
+	}
+
+	service.SetTempDir("")
+	assertRestores(t, service, a.ID, map[string]string{"config.json": "v1"})
+}
+
+func TestFanOutChecksTempDir(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	a, _ := createLocalStorage(t, client, "a")
+	b, _ := createLocalStorage(t, client, "b")
+
+	dataDir := t.TempDir()
+	writeDataFile(t, dataDir, "config.json", "v1")
+	service := newTestService(t, client, dataDir)
+	service.SetTempDir(unusableDir(t))
+
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err == nil {
+		t.Fatal("Expected a shared backup to fail without a usable temporary directory")
+	}
+
+	service.SetTempDir(filepath.Join(t.TempDir(), "spool"))
+	if err := service.ConcurrentSyncToStorages(ctx, []int{a.ID, b.ID}); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+	assertRestores(t, service, b.ID, map[string]string{"config.json": "v1"})
+}
+
+func TestStreamedBackupIsSplitIntoVolumes(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	a, dir := createLocalStorage(t, client, "a")
+
+	// 随机数据无法压缩，归档大小超过多个分卷
+	content := make([]byte, 10000)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+	dataDir := t.TempDir()
+	writeDataFile(t, dataDir, "attachments/blob", string(content))
+	service := newTestService(t, client, dataDir)
+	service.SetVolumeSize(4096)
+
+	if err := service.SyncToStorage(ctx, a.ID); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+
+	filename := latestBackup(t, client, a.ID)
+	for _, name := range []string{volumeName(filename, 1), volumeName(filename, 3), volumeIndexName(filename)} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Expected %s in storage: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, filename)); !os.IsNotExist(err) {
+		t.Errorf("Did not expect the unsplit backup %s in storage", filename)
+	}
+
+	assertRestores(t, service, a.ID, map[string]string{"attachments/blob": string(content)})
+}
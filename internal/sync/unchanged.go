@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	entstorage "github.com/ca-x/vaultwarden-syncer/ent/storage"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+)
+
+// SkipUnchanged 返回数据目录自上一次成功备份以来发生了变化的存储。没有变化的存储记录一个
+// skipped 任务，不再重复上传内容相同的备份。供定时备份使用，手动触发的备份总是执行。
+func (s *Service) SkipUnchanged(ctx context.Context, storageIDs []int) ([]int, error) {
+	fingerprint, err := s.backupService.Fingerprint()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint data directory: %w", err)
+	}
+
+	var changed []int
+	for _, id := range storageIDs {
+		last, err := s.client.SyncJob.Query().
+			Where(
+				s.sourceJobs(),
+				syncjob.HasStorageWith(entstorage.IDEQ(id)),
+				syncjob.OperationEQ(syncjob.OperationBackup),
+				syncjob.StatusEQ(syncjob.StatusCompleted),
+			).
+			Order(ent.Desc(syncjob.FieldCreatedAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			log.Printf("Failed to find last backup for storage %d: %v", id, err)
+		}
+		if err != nil || last.Fingerprint != fingerprint {
+			changed = append(changed, id)
+			continue
+		}
+
+		now := time.Now()
+		if err := s.newSyncJob().
+			SetStatus(syncjob.StatusSkipped).
+			SetOperation(syncjob.OperationBackup).
+			SetStorageID(id).
+			SetFingerprint(fingerprint).
+			SetMessage(fmt.Sprintf("No changes since the last backup %s", last.Filename)).
+			SetStartedAt(now).
+			SetCompletedAt(now).
+			Exec(ctx); err != nil {
+			log.Printf("Failed to record skipped backup for storage %d: %v", id, err)
+		}
+	}
+
+	if skipped := len(storageIDs) - len(changed); skipped > 0 {
+		log.Printf("Data directory unchanged since the last backup, skipped %d storage(s)", skipped)
+	}
+	return changed, nil
+}
@@ -0,0 +1,244 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+)
+
+// errConnectionReset 为 memoryProvider 模拟的网络中断
+var errConnectionReset = errors.New("connection reset by peer")
+
+// memoryProvider 将对象保存在内存中。interruptAfter 大于 0 时，下一次写入在写入该字节数后中断，
+// 已写入的部分保留下来并由 GetFileSize 返回其大小，与 FTP 保留的临时文件、GCS 未完成的上传会话
+// 一样可以通过 UploadPart 续传
+type memoryProvider struct {
+	mu             gosync.Mutex
+	objects        map[string][]byte
+	interruptAfter int64
+	resumable      bool
+
+	uploads []int64 // 每次写入的起始位置
+	written int64   // 所有写入传输的字节数
+}
+
+func newMemoryProvider(resumable bool) *memoryProvider {
+	return &memoryProvider{objects: make(map[string][]byte), resumable: resumable}
+}
+
+func (p *memoryProvider) Name() string { return "memory" }
+func (p *memoryProvider) Type() string { return "memory" }
+
+func (p *memoryProvider) Upload(ctx context.Context, path string, reader io.Reader) error {
+	return p.write(path, reader, 0)
+}
+
+func (p *memoryProvider) UploadPart(ctx context.Context, path string, reader io.Reader, offset int64) error {
+	if !p.resumable && offset != 0 {
+		return storageProvider.ErrResumeUnsupported
+	}
+	return p.write(path, reader, offset)
+}
+
+func (p *memoryProvider) write(path string, reader io.Reader, offset int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if int64(len(p.objects[path])) < offset {
+		return fmt.Errorf("cannot write %s at offset %d beyond its size %d", path, offset, len(p.objects[path]))
+	}
+	p.uploads = append(p.uploads, offset)
+
+	var buf bytes.Buffer
+	var err error
+	if p.interruptAfter > 0 {
+		_, err = io.CopyN(&buf, reader, p.interruptAfter)
+		if err == nil {
+			err = errConnectionReset
+		}
+		p.interruptAfter = 0
+	} else {
+		_, err = io.Copy(&buf, reader)
+	}
+
+	p.written += int64(buf.Len())
+	p.objects[path] = append(p.objects[path][:offset:offset], buf.Bytes()...)
+	return err
+}
+
+func (p *memoryProvider) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	data, ok := p.objects[path]
+	if !ok {
+		return nil, fmt.Errorf("object %s not found", path)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (p *memoryProvider) DownloadPart(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	reader, err := p.Download(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	io.CopyN(io.Discard, reader, offset)
+	return io.NopCloser(io.LimitReader(reader, length)), nil
+}
+
+func (p *memoryProvider) Delete(ctx context.Context, path string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.objects, path)
+	return nil
+}
+
+func (p *memoryProvider) List(ctx context.Context, prefix string) ([]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var names []string
+	for name := range p.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (p *memoryProvider) Exists(ctx context.Context, path string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.objects[path]
+	return ok, nil
+}
+
+func (p *memoryProvider) GetFileSize(ctx context.Context, path string) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return int64(len(p.objects[path])), nil
+}
+
+// newUploadJob 创建上传使用的任务记录
+func newUploadJob(t *testing.T, service *Service) int {
+	t.Helper()
+
+	job, err := service.newSyncJob().
+		SetStatus(syncjob.StatusRunning).
+		SetOperation(syncjob.OperationBackup).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to create sync job: %v", err)
+	}
+	return job.ID
+}
+
+func TestUploadResumesInterruptedUpload(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t, newTestClient(t), t.TempDir())
+	service.SetRetryConfig(1, time.Millisecond)
+
+	data := make([]byte, 100000)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	provider := newMemoryProvider(true)
+	provider.interruptAfter = 30000
+
+	jobID := newUploadJob(t, service)
+	if err := service.uploadWithBackoff(ctx, jobID, provider, "backup.zip", bytes.NewReader(data), int64(len(data))); err != nil {
+		t.Fatalf("Expected the retry to resume the upload: %v", err)
+	}
+
+	if !bytes.Equal(provider.objects["backup.zip"], data) {
+		t.Fatal("Resumed upload does not match the backup")
+	}
+	if len(provider.uploads) != 2 || provider.uploads[0] != 0 || provider.uploads[1] != 30000 {
+		t.Errorf("Expected an upload from 0 and a resume at 30000, got %v", provider.uploads)
+	}
+	if provider.written != int64(len(data)) {
+		t.Errorf("Expected every byte to be sent once, %d bytes sent for %d", provider.written, len(data))
+	}
+}
+
+func TestUploadRestartsWhenResumeIsUnsupported(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t, newTestClient(t), t.TempDir())
+	service.SetRetryConfig(1, time.Millisecond)
+
+	data := bytes.Repeat([]byte("vaultwarden"), 1000)
+	provider := newMemoryProvider(false)
+	provider.interruptAfter = 4000
+
+	jobID := newUploadJob(t, service)
+	if err := service.uploadWithBackoff(ctx, jobID, provider, "backup.zip", bytes.NewReader(data), int64(len(data))); err != nil {
+		t.Fatalf("Expected the retry to upload from the start: %v", err)
+	}
+
+	if !bytes.Equal(provider.objects["backup.zip"], data) {
+		t.Fatal("Uploaded object does not match the backup")
+	}
+	if len(provider.uploads) != 2 || provider.uploads[1] != 0 {
+		t.Errorf("Expected the retry to start at offset 0, got %v", provider.uploads)
+	}
+}
+
+// resumableStreamProvider 为能够上传数据流、同时保留中断的上传用于续传的存储，如 FTP
+type resumableStreamProvider struct {
+	*memoryProvider
+	streamed int
+}
+
+func (p *resumableStreamProvider) UploadStream(ctx context.Context, path string, reader io.Reader) error {
+	p.streamed++
+	return p.Upload(ctx, path, reader)
+}
+
+func (p *resumableStreamProvider) ResumesUploads() bool { return true }
+
+func TestBackupUploadResumesOnResumableStreamProvider(t *testing.T) {
+	ctx := context.Background()
+	content := make([]byte, 100000)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+	dataDir := t.TempDir()
+	writeDataFile(t, dataDir, "attachments/blob", string(content))
+	service := newTestService(t, newTestClient(t), dataDir)
+	service.SetRetryConfig(1, time.Millisecond)
+
+	prepared, err := service.backupService.PrepareBackup(ctx)
+	if err != nil {
+		t.Fatalf("Failed to prepare backup: %v", err)
+	}
+	defer prepared.Close()
+
+	provider := &resumableStreamProvider{memoryProvider: newMemoryProvider(true)}
+	provider.interruptAfter = 30000
+
+	jobID := newUploadJob(t, service)
+	if err := service.uploadBackup(ctx, jobID, provider, prepared, "", "backup.zip"); err != nil {
+		t.Fatalf("Expected the retry to resume the upload: %v", err)
+	}
+
+	if provider.streamed != 0 {
+		t.Errorf("Expected the backup to be spooled for a resumable storage, streamed %d times", provider.streamed)
+	}
+	if len(provider.uploads) != 2 || provider.uploads[1] != 30000 {
+		t.Errorf("Expected a resume at 30000, got %v", provider.uploads)
+	}
+	if provider.written != int64(len(provider.objects["backup.zip"])) {
+		t.Errorf("Expected every byte to be sent once, %d bytes sent for %d", provider.written, len(provider.objects["backup.zip"]))
+	}
+}
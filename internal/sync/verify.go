@@ -0,0 +1,188 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	entstorage "github.com/ca-x/vaultwarden-syncer/ent/storage"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+)
+
+// ErrNoBackupToVerify 表示存储中没有可以校验的备份记录，例如数据源还没有备份到该存储
+var ErrNoBackupToVerify = errors.New("no completed backup to verify")
+
+// expectedArchive 为上传时记录的备份大小和 SHA-256
+type expectedArchive struct {
+	size   int64
+	sha256 string
+	source string
+	// unsigned 为 true 时大小和哈希来自未签名的 manifest 副本（只使用 age 公钥加密时），
+	// 能发现传输和存储中的损坏，但不能证明备份没有被替换
+	unsigned bool
+}
+
+// VerifyBackup 下载存储中的备份，检查大小和 SHA-256 是否与上传时一致，
+// 然后解密并读取整个归档，逐个文件与 manifest 比对。结果记录为 verify 类型的任务，
+// 校验失败时发送告警。
+func (s *Service) VerifyBackup(ctx context.Context, storageID int, filename string) error {
+	if s.repositoryMode {
+		return fmt.Errorf("backup verification is not supported in repository mode")
+	}
+
+	storage, err := s.client.Storage.Get(ctx, storageID)
+	if err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+
+	job, err := s.newSyncJob().
+		SetStatus(syncjob.StatusPending).
+		SetOperation(syncjob.OperationVerify).
+		SetFilename(filename).
+		SetStorageID(storageID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create sync job: %w", err)
+	}
+
+	// fail 将任务标记为失败并发送告警
+	fail := func(message string, err error) error {
+		s.updateJobStatus(ctx, job.ID, syncjob.StatusFailed, fmt.Sprintf("%s: %v", message, err))
+		s.alert(fmt.Sprintf("Backup verification failed on %s", storage.Name),
+			fmt.Sprintf("Backup %s on storage %s could not be verified.\r\n\r\n%s: %v", filename, storage.Name, message, err))
+		return fmt.Errorf("%s: %w", message, err)
+	}
+
+	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, "Downloading backup..."); err != nil {
+		return err
+	}
+
+	provider, err := s.createStorageProvider(storage)
+	if err != nil {
+		return fail("Failed to create storage provider", err)
+	}
+
+	expected := s.expectedArchive(ctx, provider, filename)
+
+	spool, err := s.downloadWithBackoff(ctx, job.ID, provider, filename)
+	if err != nil {
+		return fail("Failed to download backup", err)
+	}
+	defer spool.Close()
+
+	if expected != nil {
+		hash := sha256.New()
+		if _, err := io.Copy(hash, spool.reader()); err != nil {
+			return fail("Failed to read backup", err)
+		}
+		sum := fmt.Sprintf("%x", hash.Sum(nil))
+		if spool.size != expected.size || sum != expected.sha256 {
+			return fail("Backup corrupt", fmt.Errorf("size %d and sha256 %s do not match %s (size %d, sha256 %s)",
+				spool.size, sum, expected.source, expected.size, expected.sha256))
+		}
+	}
+
+	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusRunning, "Verifying backup contents..."); err != nil {
+		return err
+	}
+
+	checksum := "checksum not recorded"
+	if expected != nil {
+		checksum = "checksum matches " + expected.source
+	}
+	// 未签名的副本只作为警告报告，不能当作校验通过
+	status := "Backup verified"
+	if expected != nil && expected.unsigned {
+		status = "Backup verified with warnings"
+		checksum += ", which is unsigned and does not prove the backup is authentic"
+		log.Printf("Manifest sidecar of %s is unsigned; set a backup password to sign it", filename)
+	}
+
+	result, err := s.backupService.VerifyBackup(ctx, spool.reader())
+	if errors.Is(err, backup.ErrNoIdentity) {
+		// 使用 age 公钥加密时 syncer 没有私钥，只能校验对象的完整性
+		return s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted,
+			fmt.Sprintf("%s: %s (%s; contents not checked, the age identity is required)", status, filename, checksum))
+	}
+	if err != nil {
+		if errors.Is(err, backup.ErrCorrupt) {
+			return fail("Backup corrupt", err)
+		}
+		return fail("Failed to verify backup", err)
+	}
+
+	if err := s.updateJobStatus(ctx, job.ID, syncjob.StatusCompleted,
+		fmt.Sprintf("%s: %s (%s; %d files, %d checked against the manifest)", status, filename, checksum, result.Files, result.Checked)); err != nil {
+		return err
+	}
+
+	log.Printf("Backup verified on %s: %s", storage.Name, filename)
+	return nil
+}
+
+// VerifyLatest 校验存储中最近一次成功上传的备份
+func (s *Service) VerifyLatest(ctx context.Context, storageID int) error {
+	job, err := s.client.SyncJob.Query().
+		Where(
+			s.sourceJobs(),
+			syncjob.HasStorageWith(entstorage.IDEQ(storageID)),
+			syncjob.OperationEQ(syncjob.OperationBackup),
+			syncjob.StatusEQ(syncjob.StatusCompleted),
+			syncjob.FilenameNEQ(""),
+		).
+		Order(ent.Desc(syncjob.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNoBackupToVerify
+		}
+		return fmt.Errorf("failed to find latest backup: %w", err)
+	}
+
+	return s.VerifyBackup(ctx, storageID, job.Filename)
+}
+
+// expectedArchive 返回上传时记录的备份大小和哈希：刚生成的备份使用内存中的统计，
+// 否则读取一同上传的 manifest 副本。两者都没有时返回 nil，只校验归档内容
+func (s *Service) expectedArchive(ctx context.Context, provider storageProvider.Provider, filename string) *expectedArchive {
+	if stats, ok := s.backupService.ArchiveStats(filename); ok && stats.SHA256 != "" {
+		return &expectedArchive{size: stats.ArchiveSize, sha256: stats.SHA256, source: "the uploaded archive"}
+	}
+
+	reader, err := provider.Download(ctx, backup.SidecarName(filename))
+	if err != nil {
+		log.Printf("No manifest sidecar for %s: %v", filename, err)
+		return nil
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		log.Printf("Failed to read manifest sidecar for %s: %v", filename, err)
+		return nil
+	}
+
+	sidecar, err := s.backupService.ReadSidecar(data)
+	if err != nil {
+		log.Printf("Ignoring manifest sidecar for %s: %v", filename, err)
+		return nil
+	}
+
+	return &expectedArchive{size: sidecar.ArchiveSize, sha256: sidecar.ArchiveSHA256, source: "the manifest sidecar", unsigned: !sidecar.Signed}
+}
+
+// alert 通过邮件发送告警，未配置通知时忽略
+func (s *Service) alert(subject, message string) {
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.SendFailureNotification(subject, message); err != nil {
+		log.Printf("Failed to send alert: %v", err)
+	}
+}
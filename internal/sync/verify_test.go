@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/ca-x/vaultwarden-syncer/ent"
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+)
+
+// verifyAfterRestart 备份到本地存储，然后使用新的服务校验，校验只能依靠存储中的 manifest 副本
+func verifyAfterRestart(t *testing.T, opts backup.BackupOptions) *ent.SyncJob {
+	t.Helper()
+
+	ctx := context.Background()
+	client := newTestClient(t)
+	storage, _ := createLocalStorage(t, client, "a")
+	opts.VaultwardenDataPath = t.TempDir()
+	writeDataFile(t, opts.VaultwardenDataPath, "config.json", "v1")
+
+	if err := NewService(client, backup.NewService(opts)).SyncToStorage(ctx, storage.ID); err != nil {
+		t.Fatalf("Failed to sync: %v", err)
+	}
+	if err := NewService(client, backup.NewService(opts)).VerifyLatest(ctx, storage.ID); err != nil {
+		t.Fatalf("Failed to verify: %v", err)
+	}
+
+	job, err := client.SyncJob.Query().Where(syncjob.OperationEQ(syncjob.OperationVerify)).Only(ctx)
+	if err != nil {
+		t.Fatalf("Failed to find verify job: %v", err)
+	}
+	return job
+}
+
+func TestVerifyWarnsAboutUnsignedSidecar(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := verifyAfterRestart(t, backup.BackupOptions{Recipients: []string{identity.Recipient().String()}})
+	if !strings.HasPrefix(job.Message, "Backup verified with warnings:") || !strings.Contains(job.Message, "unsigned") {
+		t.Errorf("Expected an unsigned sidecar to be reported as a warning, got %q", job.Message)
+	}
+}
+
+func TestVerifyTrustsSignedSidecar(t *testing.T) {
+	job := verifyAfterRestart(t, backup.BackupOptions{Password: "secret", KDF: backup.KDFPBKDF2})
+	if !strings.HasPrefix(job.Message, "Backup verified:") || !strings.Contains(job.Message, "checksum matches the manifest sidecar;") {
+		t.Errorf("Expected the signed sidecar to be trusted, got %q", job.Message)
+	}
+}
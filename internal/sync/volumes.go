@@ -0,0 +1,294 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+
+	"github.com/ca-x/vaultwarden-syncer/ent/syncjob"
+	"github.com/ca-x/vaultwarden-syncer/internal/backup"
+	storageProvider "github.com/ca-x/vaultwarden-syncer/internal/storage"
+)
+
+// volumeIndexSuffix 为分卷备份索引对象的文件名后缀
+const volumeIndexSuffix = ".volumes.json"
+
+// volumeIndex 记录分卷备份的各个分卷，恢复时按顺序下载并拼接
+type volumeIndex struct {
+	Filename   string        `json:"filename"`
+	Size       int64         `json:"size"`
+	VolumeSize int64         `json:"volume_size"`
+	Volumes    []volumeEntry `json:"volumes"`
+}
+
+type volumeEntry struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// volumeName 返回备份的第 n 个分卷（从 1 开始）的对象名，例如 backup.zip.001
+func volumeName(objectName string, n int) string {
+	return fmt.Sprintf("%s.%03d", objectName, n)
+}
+
+// volumeIndexName 返回备份的分卷索引的对象名
+func volumeIndexName(objectName string) string {
+	return objectName + volumeIndexSuffix
+}
+
+// SetVolumeSize 设置分卷大小（字节）。超过该大小的备份被拆分为多个分卷分别上传，0 表示不拆分
+func (s *Service) SetVolumeSize(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	s.volumeSize = size
+}
+
+// uploadArchive 上传备份。备份超过分卷大小时拆分为多个分卷，每个分卷单独重试，
+// 全部上传成功后再上传索引，没有索引的分卷不会被恢复使用。
+func (s *Service) uploadArchive(ctx context.Context, jobID int, provider storageProvider.Provider, objectName string, src io.ReaderAt, size int64) error {
+	if s.volumeSize <= 0 || size <= s.volumeSize {
+		return s.uploadWithBackoff(ctx, jobID, provider, objectName, src, size)
+	}
+
+	count := int((size + s.volumeSize - 1) / s.volumeSize)
+	index := volumeIndex{Filename: objectName, Size: size, VolumeSize: s.volumeSize}
+
+	for n := 1; n <= count; n++ {
+		offset := int64(n-1) * s.volumeSize
+		length := min(s.volumeSize, size-offset)
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(src, offset, length)); err != nil {
+			return fmt.Errorf("failed to read volume %d: %w", n, err)
+		}
+
+		if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Uploading volume %d/%d...", n, count)); err != nil {
+			return err
+		}
+
+		name := volumeName(objectName, n)
+		if err := s.uploadWithBackoff(ctx, jobID, provider, name, io.NewSectionReader(src, offset, length), length); err != nil {
+			return fmt.Errorf("failed to upload volume %s: %w", name, err)
+		}
+
+		index.Volumes = append(index.Volumes, volumeEntry{Name: name, Size: length, SHA256: fmt.Sprintf("%x", hash.Sum(nil))})
+	}
+
+	return s.uploadVolumeIndex(ctx, jobID, provider, &index)
+}
+
+// uploadVolumeStream 将备份流按分卷大小切分上传。每次只把一个分卷写入临时文件，
+// 临时空间不超过一个分卷；整个备份不超过一个分卷时作为普通对象上传
+func (s *Service) uploadVolumeStream(ctx context.Context, jobID int, provider storageProvider.Provider, prepared *backup.PreparedBackup, format backup.ArchiveFormat, objectName string) error {
+	if err := s.checkSpoolSpace(min(s.volumeSize, prepared.Size())); err != nil {
+		return err
+	}
+
+	backupReader, _, err := prepared.Open(ctx, format)
+	if err != nil {
+		return err
+	}
+	defer backupReader.Close()
+
+	reader := bufio.NewReader(backupReader)
+	index := volumeIndex{Filename: objectName, VolumeSize: s.volumeSize}
+
+	for n := 1; ; n++ {
+		hash := sha256.New()
+		spool, err := s.spoolToTempFile(io.TeeReader(io.LimitReader(reader, s.volumeSize), hash))
+		if err != nil {
+			return fmt.Errorf("failed to create volume %d: %w", n, err)
+		}
+
+		_, err = reader.Peek(1)
+		last := err == io.EOF
+		if err != nil && !last {
+			spool.Close()
+			return fmt.Errorf("failed to create volume %d: %w", n, err)
+		}
+
+		if n == 1 && last {
+			err := s.uploadWithBackoff(ctx, jobID, provider, objectName, spool.file, spool.size)
+			spool.Close()
+			return err
+		}
+
+		if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning, fmt.Sprintf("Uploading volume %d...", n)); err != nil {
+			spool.Close()
+			return err
+		}
+
+		name := volumeName(objectName, n)
+		err = s.uploadWithBackoff(ctx, jobID, provider, name, spool.file, spool.size)
+		spool.Close()
+		if err != nil {
+			return fmt.Errorf("failed to upload volume %s: %w", name, err)
+		}
+
+		index.Size += spool.size
+		index.Volumes = append(index.Volumes, volumeEntry{Name: name, Size: spool.size, SHA256: fmt.Sprintf("%x", hash.Sum(nil))})
+		if last {
+			return s.uploadVolumeIndex(ctx, jobID, provider, &index)
+		}
+	}
+}
+
+// uploadVolumeIndex 在所有分卷上传成功后上传分卷索引
+func (s *Service) uploadVolumeIndex(ctx context.Context, jobID int, provider storageProvider.Provider, index *volumeIndex) error {
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode volume index: %w", err)
+	}
+	if err := s.uploadWithBackoff(ctx, jobID, provider, volumeIndexName(index.Filename), bytes.NewReader(data), int64(len(data))); err != nil {
+		return fmt.Errorf("failed to upload volume index: %w", err)
+	}
+
+	log.Printf("Backup %s uploaded in %d volumes", index.Filename, len(index.Volumes))
+	return nil
+}
+
+// readVolumeIndex 读取备份的分卷索引，备份没有被拆分时返回 nil。
+// 索引来自远端存储，分卷名必须依次为 volumeName(filename, n)，分卷大小之和必须等于备份大小
+func (s *Service) readVolumeIndex(ctx context.Context, provider storageProvider.Provider, filename string) (*volumeIndex, error) {
+	name := volumeIndexName(filename)
+	exists, err := provider.Exists(ctx, name)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	reader, err := provider.Download(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var index volumeIndex
+	if err := json.NewDecoder(io.LimitReader(reader, 16<<20)).Decode(&index); err != nil {
+		return nil, fmt.Errorf("failed to decode volume index %s: %w", name, err)
+	}
+	if len(index.Volumes) == 0 {
+		return nil, fmt.Errorf("volume index %s lists no volumes", name)
+	}
+	if index.Filename != filename {
+		return nil, fmt.Errorf("volume index %s belongs to %q", name, index.Filename)
+	}
+
+	var size int64
+	for n, volume := range index.Volumes {
+		if want := volumeName(filename, n+1); volume.Name != want {
+			return nil, fmt.Errorf("volume index %s lists %q as volume %d, expected %q", name, volume.Name, n+1, want)
+		}
+		if volume.Size <= 0 {
+			return nil, fmt.Errorf("volume index %s lists volume %s with size %d", name, volume.Name, volume.Size)
+		}
+		size += volume.Size
+	}
+	if size != index.Size {
+		return nil, fmt.Errorf("volumes in %s add up to %d bytes, expected %d", name, size, index.Size)
+	}
+	return &index, nil
+}
+
+// openVolumes 返回按顺序拼接各个分卷的数据流。分卷在读到时才开始下载，
+// 每个分卷读完时校验大小和 SHA-256，不一致时返回错误
+func (s *Service) openVolumes(ctx context.Context, jobID int, provider storageProvider.Provider, index *volumeIndex) io.ReadCloser {
+	readers := make([]io.Reader, len(index.Volumes))
+	volumes := &volumesReader{volumes: make([]*volumeReader, len(index.Volumes))}
+
+	for n, volume := range index.Volumes {
+		volumes.volumes[n] = &volumeReader{
+			volume: volume,
+			hash:   sha256.New(),
+			open: func() (io.ReadCloser, error) {
+				if err := s.updateJobStatus(ctx, jobID, syncjob.StatusRunning,
+					fmt.Sprintf("Downloading volume %d/%d...", n+1, len(index.Volumes))); err != nil {
+					return nil, err
+				}
+				return provider.Download(ctx, volume.Name)
+			},
+		}
+		readers[n] = volumes.volumes[n]
+	}
+
+	volumes.Reader = io.MultiReader(readers...)
+	return volumes
+}
+
+// volumesReader 拼接各个分卷，Close 关闭仍在下载的分卷
+type volumesReader struct {
+	io.Reader
+	volumes []*volumeReader
+}
+
+func (r *volumesReader) Close() error {
+	var firstErr error
+	for _, volume := range r.volumes {
+		if err := volume.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// volumeReader 在第一次读取时下载分卷，读到结尾时校验分卷的大小和 SHA-256
+type volumeReader struct {
+	volume volumeEntry
+	open   func() (io.ReadCloser, error)
+	hash   hash.Hash
+	reader io.ReadCloser
+	read   int64
+	done   bool
+}
+
+func (r *volumeReader) Read(p []byte) (int, error) {
+	if r.done {
+		return 0, io.EOF
+	}
+	if r.reader == nil {
+		reader, err := r.open()
+		if err != nil {
+			return 0, fmt.Errorf("failed to download volume %s: %w", r.volume.Name, err)
+		}
+		r.reader = reader
+	}
+
+	// 多读一个字节以发现比索引记录更大的分卷
+	if remaining := r.volume.Size + 1 - r.read; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	r.hash.Write(p[:n])
+
+	if r.read > r.volume.Size {
+		return n, fmt.Errorf("volume %s is larger than %d bytes", r.volume.Name, r.volume.Size)
+	}
+	if err == io.EOF {
+		if r.read != r.volume.Size {
+			return n, fmt.Errorf("volume %s is %d bytes, expected %d", r.volume.Name, r.read, r.volume.Size)
+		}
+		if sum := fmt.Sprintf("%x", r.hash.Sum(nil)); sum != r.volume.SHA256 {
+			return n, fmt.Errorf("volume %s has sha256 %s, expected %s", r.volume.Name, sum, r.volume.SHA256)
+		}
+		r.done = true
+		r.Close()
+	}
+	return n, err
+}
+
+func (r *volumeReader) Close() error {
+	if r.reader == nil {
+		return nil
+	}
+	err := r.reader.Close()
+	r.reader = nil
+	return err
+}
@@ -0,0 +1,183 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// uploadTestVolumes 将 size 字节的随机数据按 4096 字节分卷上传到 provider
+func uploadTestVolumes(t *testing.T, service *Service, provider *memoryProvider, objectName string, size int) []byte {
+	t.Helper()
+
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	service.SetVolumeSize(4096)
+	jobID := newUploadJob(t, service)
+	if err := service.uploadArchive(context.Background(), jobID, provider, objectName, bytes.NewReader(data), int64(len(data))); err != nil {
+		t.Fatalf("Failed to upload volumes: %v", err)
+	}
+	return data
+}
+
+// downloadTestBackup 下载备份并返回其内容
+func downloadTestBackup(t *testing.T, service *Service, provider *memoryProvider, objectName string) ([]byte, error) {
+	t.Helper()
+
+	spool, err := service.downloadWithBackoff(context.Background(), newUploadJob(t, service), provider, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer spool.Close()
+	return io.ReadAll(spool.reader())
+}
+
+// rewriteVolumeIndex 修改 provider 中保存的分卷索引
+func rewriteVolumeIndex(t *testing.T, provider *memoryProvider, objectName string, rewrite func(*volumeIndex)) {
+	t.Helper()
+
+	var index volumeIndex
+	if err := json.Unmarshal(provider.objects[volumeIndexName(objectName)], &index); err != nil {
+		t.Fatalf("Failed to decode volume index: %v", err)
+	}
+	rewrite(&index)
+	data, err := json.Marshal(&index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.objects[volumeIndexName(objectName)] = data
+}
+
+func TestVolumesRoundTrip(t *testing.T) {
+	service := newTestService(t, newTestClient(t), t.TempDir())
+	service.SetRetryConfig(1, time.Millisecond)
+	provider := newMemoryProvider(false)
+
+	data := uploadTestVolumes(t, service, provider, "backup.zip", 10000)
+
+	for n, size := range []int{4096, 4096, 1808} {
+		volume, ok := provider.objects[volumeName("backup.zip", n+1)]
+		if !ok || len(volume) != size {
+			t.Errorf("Expected volume %d of %d bytes, got %d", n+1, size, len(volume))
+		}
+	}
+	if _, ok := provider.objects["backup.zip"]; ok {
+		t.Error("Did not expect the unsplit backup in storage")
+	}
+
+	restored, err := downloadTestBackup(t, service, provider, "backup.zip")
+	if err != nil {
+		t.Fatalf("Failed to download volumes: %v", err)
+	}
+	if !bytes.Equal(restored, data) {
+		t.Fatal("Restored volumes do not match the backup")
+	}
+}
+
+func TestVolumesSmallBackupIsNotSplit(t *testing.T) {
+	service := newTestService(t, newTestClient(t), t.TempDir())
+	provider := newMemoryProvider(false)
+
+	data := uploadTestVolumes(t, service, provider, "backup.zip", 4096)
+
+	if len(provider.objects) != 1 || !bytes.Equal(provider.objects["backup.zip"], data) {
+		t.Fatalf("Expected a single object, got %d objects", len(provider.objects))
+	}
+	restored, err := downloadTestBackup(t, service, provider, "backup.zip")
+	if err != nil || !bytes.Equal(restored, data) {
+		t.Fatalf("Failed to download backup: %v", err)
+	}
+}
+
+func TestVolumesRejectCorruptVolume(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func([]byte) []byte
+		want    string
+	}{
+		{"modified", func(b []byte) []byte { b[100] ^= 0xff; return b }, "sha256"},
+		{"truncated", func(b []byte) []byte { return b[:len(b)-1] }, "expected 4096"},
+		{"extended", func(b []byte) []byte { return append(b, 0) }, "larger than 4096"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestService(t, newTestClient(t), t.TempDir())
+			service.SetRetryConfig(1, time.Millisecond)
+			provider := newMemoryProvider(false)
+			uploadTestVolumes(t, service, provider, "backup.zip", 10000)
+
+			name := volumeName("backup.zip", 2)
+			provider.objects[name] = tt.corrupt(provider.objects[name])
+
+			_, err := downloadTestBackup(t, service, provider, "backup.zip")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Expected a %q error for the corrupt volume, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestVolumesRejectUnexpectedVolumeNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		rewrite func(*volumeIndex)
+	}{
+		{"path outside backup", func(index *volumeIndex) { index.Volumes[0].Name = "../secrets/backup.zip.001" }},
+		{"reordered", func(index *volumeIndex) {
+			index.Volumes[0], index.Volumes[1] = index.Volumes[1], index.Volumes[0]
+		}},
+		{"other backup", func(index *volumeIndex) {
+			index.Filename = "other.zip"
+			for n := range index.Volumes {
+				index.Volumes[n].Name = volumeName("other.zip", n+1)
+			}
+		}},
+		{"missing volume", func(index *volumeIndex) { index.Volumes = index.Volumes[:2] }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestService(t, newTestClient(t), t.TempDir())
+			service.SetRetryConfig(1, time.Millisecond)
+			provider := newMemoryProvider(false)
+			uploadTestVolumes(t, service, provider, "backup.zip", 10000)
+			rewriteVolumeIndex(t, provider, "backup.zip", tt.rewrite)
+
+			if _, err := downloadTestBackup(t, service, provider, "backup.zip"); err == nil || !strings.Contains(err.Error(), "volume index") {
+				t.Fatalf("Expected the volume index to be rejected, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVolumesUnreadableIndex(t *testing.T) {
+	service := newTestService(t, newTestClient(t), t.TempDir())
+	service.SetRetryConfig(1, time.Millisecond)
+	provider := newMemoryProvider(false)
+	uploadTestVolumes(t, service, provider, "backup.zip", 10000)
+	provider.objects[volumeIndexName("backup.zip")] = []byte("{")
+
+	if _, err := downloadTestBackup(t, service, provider, "backup.zip"); err == nil || !strings.Contains(err.Error(), "failed to read volume index") {
+		t.Fatalf("Expected a split backup with an unreadable index to fail, got %v", err)
+	}
+
+	// 没有分卷时按普通备份下载
+	data := []byte("single backup")
+	provider = newMemoryProvider(false)
+	provider.objects["backup.zip"] = data
+	provider.objects[volumeIndexName("backup.zip")] = []byte("{")
+
+	restored, err := downloadTestBackup(t, service, provider, "backup.zip")
+	if err != nil || !bytes.Equal(restored, data) {
+		t.Fatalf("Expected the backup to be downloaded as a single file, got %q, %v", restored, err)
+	}
+}
@@ -84,6 +84,30 @@ type DashboardData struct {
 	SyncStatusClass string
 	SyncStatusIcon  string
 	LastSyncError   string
+	// 最近一次备份前数据库完整性检查的结果
+	IntegrityStatus string
+	IntegrityClass  string
+	IntegrityIcon   string
+	IntegrityError  string
+	// VaultHistory 为最近备份的数据统计，按时间先后排列
+	VaultHistory []VaultPoint
+	VaultLatest  *VaultPoint
+	// CipherDrop 在最新备份的密码项数量明显下降时为提示信息
+	CipherDrop string
+}
+
+// VaultPoint 为数据增长图中一次备份的数据统计
+type VaultPoint struct {
+	Time          string
+	Users         int64
+	Organizations int64
+	Ciphers       int64
+	Attachments   int64
+	Sends         int64
+	// Height 为密码项数量相对图中最大值的百分比
+	Height int
+	// Dropped 表示密码项数量相对上一次备份明显下降
+	Dropped bool
 }
 
 // New creates a new template manager with singleton pattern for efficiency
@@ -274,12 +298,20 @@ func (m *Manager) RenderStorage(storages []*ent.Storage, client *ent.Client, lan
 	return m.renderLayout(pageData)
 }
 
+// SettingsData contains the current values shown on the settings page
+type SettingsData struct {
+	Include string
+	Exclude string
+}
+
 // RenderSettings renders the settings page
-func (m *Manager) RenderSettings(lang i18n.Language, translator *i18n.Translator) (string, error) {
+func (m *Manager) RenderSettings(lang i18n.Language, translator *i18n.Translator, data SettingsData) (string, error) {
 	// Create template data with translations
 	templateData := struct {
+		SettingsData
 		T func(string, ...interface{}) string
 	}{
+		SettingsData: data,
 		T: func(key string, args ...interface{}) string {
 			return translator.T(lang, key, args...)
 		},
@@ -325,8 +357,11 @@ func (m *Manager) RenderStorageCards(storages []*ent.Storage, client *ent.Client
 		}
 
 		typeIcon := m.Icon("web")
-		if string(s.Type) == "s3" {
+		switch string(s.Type) {
+		case "s3":
 			typeIcon = m.Icon("aws")
+		case "local", "sftp", "ftp":
+			typeIcon = m.Icon("database")
 		}
 
 		// Get last sync info for this storage
@@ -358,6 +393,17 @@ func (m *Manager) RenderStorageCards(storages []*ent.Storage, client *ent.Client
 					if lastJob.Message != "" {
 						syncError = lastJob.Message
 					}
+				case syncjob.StatusSuspicious:
+					lastSyncStatus = translator.T(lang, "status.sync_suspicious")
+					syncStatusClass = "icon-warning"
+					syncStatusIcon = "alert-circle"
+					if lastJob.Message != "" {
+						syncError = lastJob.Message
+					}
+				case syncjob.StatusSkipped:
+					lastSyncStatus = translator.T(lang, "status.sync_skipped")
+					syncStatusClass = "icon-success"
+					syncStatusIcon = "check-circle"
 				case syncjob.StatusRunning:
 					lastSyncStatus = translator.T(lang, "status.sync_running")
 					syncStatusClass = "icon-warning"
@@ -380,6 +426,11 @@ func (m *Manager) RenderStorageCards(storages []*ent.Storage, client *ent.Client
 				Where(storage.IDEQ(s.ID)).
 				WithWebdavConfig().
 				WithS3Config().
+				WithLocalConfig().
+				WithSftpConfig().
+				WithFtpConfig().
+				WithAzureBlobConfig().
+				WithGcsConfig().
 				Only(ctx)
 
 			if err == nil {
@@ -392,6 +443,29 @@ func (m *Manager) RenderStorageCards(storages []*ent.Storage, client *ent.Client
 					config["secret_access_key"] = loadedStorage.Edges.S3Config.SecretAccessKey
 					config["region"] = loadedStorage.Edges.S3Config.Region
 					config["bucket"] = loadedStorage.Edges.S3Config.Bucket
+				} else if loadedStorage.Edges.LocalConfig != nil {
+					config["path"] = loadedStorage.Edges.LocalConfig.Path
+					config["min_free_space_mb"] = loadedStorage.Edges.LocalConfig.MinFreeSpaceMB
+					config["sync_directory"] = loadedStorage.Edges.LocalConfig.SyncDirectory
+				} else if loadedStorage.Edges.SftpConfig != nil {
+					config["host"] = loadedStorage.Edges.SftpConfig.Host
+					config["port"] = loadedStorage.Edges.SftpConfig.Port
+					config["username"] = loadedStorage.Edges.SftpConfig.Username
+					config["base_dir"] = loadedStorage.Edges.SftpConfig.BaseDir
+				} else if loadedStorage.Edges.FtpConfig != nil {
+					config["host"] = loadedStorage.Edges.FtpConfig.Host
+					config["port"] = loadedStorage.Edges.FtpConfig.Port
+					config["username"] = loadedStorage.Edges.FtpConfig.Username
+					config["tls_mode"] = string(loadedStorage.Edges.FtpConfig.TLSMode)
+					config["base_dir"] = loadedStorage.Edges.FtpConfig.BaseDir
+				} else if loadedStorage.Edges.AzureBlobConfig != nil {
+					config["account_name"] = loadedStorage.Edges.AzureBlobConfig.AccountName
+					config["container"] = loadedStorage.Edges.AzureBlobConfig.Container
+					config["access_tier"] = string(loadedStorage.Edges.AzureBlobConfig.AccessTier)
+				} else if loadedStorage.Edges.GcsConfig != nil {
+					config["bucket"] = loadedStorage.Edges.GcsConfig.Bucket
+					config["storage_class"] = string(loadedStorage.Edges.GcsConfig.StorageClass)
+					config["retention_days"] = loadedStorage.Edges.GcsConfig.RetentionDays
 				}
 			}
 		}
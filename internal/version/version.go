@@ -0,0 +1,13 @@
+// Package version 保存构建时注入的版本信息，例如：
+//
+//	go build -ldflags "-X github.com/ca-x/vaultwarden-syncer/internal/version.Version=v1.2.0"
+package version
+
+var (
+	// Version 为 syncer 的版本号
+	Version = "v1.0.0"
+	// BuildDate 为构建日期
+	BuildDate = "2024-01-01"
+	// GitCommit 为构建时的 git 提交
+	GitCommit = "dev"
+)